@@ -373,6 +373,7 @@ func (c cBuild) getBuildInVarStr(ctx context.Context, in cBuildInput) string {
 	buildInVarMap[gbuild.BuiltGit] = c.getGitCommit(ctx)
 	buildInVarMap[gbuild.BuiltTime] = gtime.Now().String()
 	buildInVarMap[gbuild.BuiltVersion] = in.Version
+	buildInVarMap[gbuild.BuiltDirty] = c.getGitDirty(ctx)
 	b, err := json.Marshal(buildInVarMap)
 	if err != nil {
 		mlog.Fatal(err)
@@ -380,6 +381,22 @@ func (c cBuild) getBuildInVarStr(ctx context.Context, in cBuildInput) string {
 	return gbase64.EncodeToString(b)
 }
 
+// getGitDirty checks and returns whether there are uncommitted changes in the git repository.
+func (c cBuild) getGitDirty(ctx context.Context) bool {
+	if gproc.SearchBinary("git") == "" {
+		return false
+	}
+	var (
+		cmd    = `git status --porcelain`
+		s, err = gproc.ShellExec(ctx, cmd)
+	)
+	mlog.Debug(cmd)
+	if err != nil {
+		return false
+	}
+	return gstr.Trim(s) != ""
+}
+
 // getGitCommit retrieves and returns the latest git commit hash string if present.
 func (c cBuild) getGitCommit(ctx context.Context) string {
 	if gproc.SearchBinary("git") == "" {
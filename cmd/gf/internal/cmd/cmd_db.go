@@ -0,0 +1,124 @@
+// Copyright GoFrame gf Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/util/gtag"
+
+	"github.com/gogf/gf/cmd/gf/v2/internal/utility/mlog"
+)
+
+var (
+	Db = cDb{}
+)
+
+type cDb struct {
+	g.Meta `name:"db" brief:"{cDbBrief}" dc:"{cDbDc}"`
+}
+
+const (
+	cDbBrief = `database tools using the database configuration of current project`
+	cDbDc    = `
+The "db" command provides tools for the databases configured in current project.
+Please use "gf db shell -h" for specified command help.
+`
+	cDbShellUsage = `gf db shell [GROUP] [OPTION]`
+	cDbShellBrief = `open an interactive console for the configured database group`
+	cDbShellEg    = `
+gf db shell
+gf db shell user
+gf db shell -f csv
+gf db shell -l "mysql:root:12345678@tcp(127.0.0.1:3306)/test"
+`
+	cDbShellDc = `
+The "shell" command opens an interactive console for the database group of the project configuration,
+so that you do not need to find the client and credential of each database.
+
+The SQL statements end with ";" and can span multiple lines. The console commands are:
+    \d             list tables
+    \d TABLE       describe the fields of the table
+    \format FORMAT switch the output format, which is "table" or "csv"
+    \history [N]   list the statement history, or execute the Nth statement of the history
+    \h, \?         show the console commands
+    \q, exit       quit the console
+`
+	cDbShellGroupBrief   = `database configuration group name, it's "default" in default`
+	cDbShellLinkBrief    = `database configuration link, which is used instead of the configuration group if passed`
+	cDbShellFormatBrief  = `output format of the query result, which is "table" or "csv", it's "table" in default`
+	cDbShellHistoryBrief = `file path of the statement history, it's ".gf_db_history" in the home directory in default`
+)
+
+func init() {
+	gtag.Sets(g.MapStrStr{
+		`cDbBrief`:             cDbBrief,
+		`cDbDc`:                cDbDc,
+		`cDbShellUsage`:        cDbShellUsage,
+		`cDbShellBrief`:        cDbShellBrief,
+		`cDbShellEg`:           cDbShellEg,
+		`cDbShellDc`:           cDbShellDc,
+		`cDbShellGroupBrief`:   cDbShellGroupBrief,
+		`cDbShellLinkBrief`:    cDbShellLinkBrief,
+		`cDbShellFormatBrief`:  cDbShellFormatBrief,
+		`cDbShellHistoryBrief`: cDbShellHistoryBrief,
+	})
+}
+
+type cDbShellInput struct {
+	g.Meta  `name:"shell" usage:"{cDbShellUsage}" brief:"{cDbShellBrief}" eg:"{cDbShellEg}" dc:"{cDbShellDc}"`
+	Group   string `name:"GROUP"   arg:"true" brief:"{cDbShellGroupBrief}" d:"default"`
+	Link    string `name:"link"    short:"l"  brief:"{cDbShellLinkBrief}"`
+	Format  string `name:"format"  short:"f"  brief:"{cDbShellFormatBrief}" d:"table" v:"in:table,csv"`
+	History string `name:"history" short:"hi" brief:"{cDbShellHistoryBrief}"`
+}
+
+type cDbShellOutput struct{}
+
+func (c cDb) Shell(ctx context.Context, in cDbShellInput) (out *cDbShellOutput, err error) {
+	var db gdb.DB
+	// It uses user passed database configuration.
+	if in.Link != "" {
+		var tempGroup = gtime.TimestampNanoStr()
+		if err = gdb.AddConfigNode(tempGroup, gdb.ConfigNode{Link: in.Link}); err != nil {
+			mlog.Fatalf(`database configuration failed: %+v`, err)
+		}
+		if db, err = gdb.Instance(tempGroup); err != nil {
+			mlog.Fatalf(`database initialization failed: %+v`, err)
+		}
+	} else {
+		db = g.DB(in.Group)
+	}
+	if db == nil {
+		mlog.Fatal(`database initialization failed, may be invalid database configuration`)
+	}
+	defer db.Close(ctx)
+
+	if in.History == "" {
+		if home, err := gfile.Home(); err == nil {
+			in.History = gfile.Join(home, ".gf_db_history")
+		}
+	}
+	shell := &dbShell{
+		db:          db,
+		name:        in.Group,
+		reader:      os.Stdin,
+		writer:      os.Stdout,
+		format:      in.Format,
+		historyPath: in.History,
+	}
+	if in.Link != "" {
+		shell.name = db.GetConfig().Type
+	}
+	err = shell.Run(ctx)
+	return
+}
@@ -0,0 +1,325 @@
+// Copyright GoFrame gf Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/renderer"
+	"github.com/olekukonko/tablewriter/tw"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+const (
+	dbShellFormatTable = "table"
+	dbShellFormatCsv   = "csv"
+	dbShellNullValue   = "NULL"
+	dbShellHistorySize = 100 // Max count of the statements listed by the history command.
+	dbShellHelp        = `\d             list tables
+\d TABLE       describe the fields of the table
+\format FORMAT switch the output format, which is "table" or "csv"
+\history [N]   list the statement history, or execute the Nth statement of the history
+\h, \?         show the console commands
+\q, exit       quit the console
+SQL statements end with ";" and can span multiple lines.`
+)
+
+// dbShellQueryKeywords are the leading keywords of the statements returning rows.
+var dbShellQueryKeywords = []string{
+	"SELECT", "WITH", "SHOW", "DESC", "DESCRIBE", "EXPLAIN", "PRAGMA", "VALUES",
+}
+
+// dbShell is the interactive console of command "gf db shell".
+type dbShell struct {
+	db          gdb.DB
+	name        string    // Name displayed in the prompt.
+	reader      io.Reader // Input of the statements and console commands.
+	writer      io.Writer // Output of the results.
+	format      string    // Output format of the query result, "table" or "csv".
+	historyPath string    // File path of the statement history, the history is not saved if it is empty.
+}
+
+// Run reads and executes the statements and console commands from the reader until it ends,
+// or the quit command is received.
+func (s *dbShell) Run(ctx context.Context) error {
+	var (
+		scanner   = bufio.NewScanner(s.reader)
+		statement strings.Builder
+	)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	s.printf("Connected to %s, type \\h for help.\n", s.name)
+	for {
+		if statement.Len() == 0 {
+			s.printf("%s> ", s.name)
+		} else {
+			s.printf("%s-> ", strings.Repeat(" ", len(s.name)))
+		}
+		if !scanner.Scan() {
+			s.printf("\n")
+			return scanner.Err()
+		}
+		var (
+			rawLine = scanner.Text()
+			line    = strings.TrimSpace(rawLine)
+		)
+		if statement.Len() == 0 {
+			if line == "" {
+				continue
+			}
+			if line == "exit" || line == "quit" || line == `\q` {
+				return nil
+			}
+			if strings.HasPrefix(line, `\`) {
+				s.addHistory(line)
+				s.execCommand(ctx, line)
+				continue
+			}
+		}
+		if statement.Len() > 0 {
+			statement.WriteString("\n")
+		}
+		statement.WriteString(rawLine)
+		if !strings.HasSuffix(line, ";") {
+			continue
+		}
+		sql := strings.TrimSpace(strings.TrimRight(statement.String(), "; \t\n"))
+		statement.Reset()
+		if sql == "" {
+			continue
+		}
+		s.addHistory(sql + ";")
+		s.execSql(ctx, sql)
+	}
+}
+
+// execCommand executes the console command starting with "\".
+func (s *dbShell) execCommand(ctx context.Context, line string) {
+	var (
+		fields  = strings.Fields(line)
+		command = fields[0]
+		args    = fields[1:]
+	)
+	switch command {
+	case `\h`, `\?`:
+		s.printf("%s\n", dbShellHelp)
+
+	case `\d`:
+		if len(args) == 0 {
+			s.listTables(ctx)
+		} else {
+			s.describeTable(ctx, strings.TrimSuffix(args[0], ";"))
+		}
+
+	case `\format`:
+		if len(args) == 0 || (args[0] != dbShellFormatTable && args[0] != dbShellFormatCsv) {
+			s.printf("Error: format should be %s or %s\n", dbShellFormatTable, dbShellFormatCsv)
+			return
+		}
+		s.format = args[0]
+
+	case `\history`:
+		history := s.getHistory()
+		if len(args) == 0 {
+			for i, item := range history {
+				s.printf("%4d  %s\n", i+1, strings.ReplaceAll(item, "\n", "\n      "))
+			}
+			return
+		}
+		index := gconv.Int(args[0])
+		if index < 1 || index > len(history) {
+			s.printf("Error: invalid history number %s\n", args[0])
+			return
+		}
+		item := history[index-1]
+		s.printf("%s\n", item)
+		if strings.HasPrefix(item, `\`) {
+			if !strings.HasPrefix(item, `\history`) {
+				s.execCommand(ctx, item)
+			}
+			return
+		}
+		s.execSql(ctx, strings.TrimSpace(strings.TrimSuffix(item, ";")))
+
+	default:
+		s.printf("Error: unknown command %s, type \\h for help\n", command)
+	}
+}
+
+// execSql executes the SQL statement and prints its result.
+func (s *dbShell) execSql(ctx context.Context, sql string) {
+	keyword := strings.ToUpper(strings.TrimLeft(gstr.Trim(sql), "("))
+	if index := strings.IndexAny(keyword, " \t\n("); index != -1 {
+		keyword = keyword[:index]
+	}
+	if gstr.InArray(dbShellQueryKeywords, keyword) {
+		result, err := s.db.GetCore().GetAllWithColumns(ctx, sql)
+		if err != nil {
+			s.printf("Error: %s\n", err.Error())
+			return
+		}
+		s.printResult(result)
+		return
+	}
+	result, err := s.db.Exec(ctx, sql)
+	if err != nil {
+		s.printf("Error: %s\n", err.Error())
+		return
+	}
+	affected, _ := result.RowsAffected()
+	s.printf("OK, %d rows affected\n", affected)
+}
+
+// listTables prints the tables of the database.
+func (s *dbShell) listTables(ctx context.Context) {
+	tables, err := s.db.Tables(ctx)
+	if err != nil {
+		s.printf("Error: %s\n", err.Error())
+		return
+	}
+	rows := make([][]string, 0, len(tables))
+	for _, table := range tables {
+		rows = append(rows, []string{table})
+	}
+	s.printRows([]string{"Table"}, rows)
+}
+
+// describeTable prints the fields of the table using TableFields.
+func (s *dbShell) describeTable(ctx context.Context, table string) {
+	fields, err := s.db.TableFields(ctx, table)
+	if err != nil {
+		s.printf("Error: %s\n", err.Error())
+		return
+	}
+	if len(fields) == 0 {
+		s.printf("Error: table %s does not exist\n", table)
+		return
+	}
+	sortedFields := make([]*gdb.TableField, 0, len(fields))
+	for _, field := range fields {
+		sortedFields = append(sortedFields, field)
+	}
+	sort.Slice(sortedFields, func(i, j int) bool {
+		return sortedFields[i].Index < sortedFields[j].Index
+	})
+	rows := make([][]string, 0, len(sortedFields))
+	for _, field := range sortedFields {
+		defaultValue := dbShellNullValue
+		if field.Default != nil {
+			defaultValue = gconv.String(field.Default)
+		}
+		rows = append(rows, []string{
+			field.Name, field.Type, gconv.String(field.Null), field.Key, defaultValue, field.Extra, field.Comment,
+		})
+	}
+	s.printRows([]string{"Field", "Type", "Null", "Key", "Default", "Extra", "Comment"}, rows)
+}
+
+// printResult prints the query result in the order of the query columns.
+func (s *dbShell) printResult(result *gdb.ResultWithColumns) {
+	if result.IsEmpty() {
+		s.printf("Empty set\n")
+		return
+	}
+	var columns []string
+	if len(result.Columns) > 0 {
+		for _, columnType := range result.Columns {
+			columns = append(columns, columnType.Name)
+		}
+	} else {
+		for column := range result.Result[0] {
+			columns = append(columns, column)
+		}
+		sort.Strings(columns)
+	}
+	rows := make([][]string, 0, len(result.Result))
+	for _, record := range result.Result {
+		row := make([]string, len(columns))
+		for i, column := range columns {
+			if value := record[column]; value == nil || value.IsNil() {
+				row[i] = dbShellNullValue
+			} else {
+				row[i] = value.String()
+			}
+		}
+		rows = append(rows, row)
+	}
+	s.printRows(columns, rows)
+	if s.format != dbShellFormatCsv {
+		s.printf("%d rows in set\n", len(rows))
+	}
+}
+
+// printRows prints the rows with header in current output format.
+func (s *dbShell) printRows(header []string, rows [][]string) {
+	if s.format == dbShellFormatCsv {
+		writer := csv.NewWriter(s.writer)
+		_ = writer.Write(header)
+		_ = writer.WriteAll(rows)
+		return
+	}
+	table := tablewriter.NewTable(s.writer,
+		tablewriter.WithRenderer(renderer.NewBlueprint(tw.Rendition{
+			Symbols: tw.NewSymbols(tw.StyleASCII),
+		})),
+		tablewriter.WithConfig(tablewriter.Config{
+			Header: tw.CellConfig{
+				Formatting: tw.CellFormatting{AutoFormat: tw.Off},
+			},
+			Row: tw.CellConfig{
+				Formatting: tw.CellFormatting{AutoWrap: tw.WrapNone},
+				Alignment:  tw.CellAlignment{Global: tw.AlignLeft},
+			},
+		}),
+	)
+	table.Header(header)
+	_ = table.Bulk(rows)
+	_ = table.Render()
+}
+
+// addHistory appends the statement or console command to the history file,
+// which is quoted as one line in the file as the statement might contain multiple lines.
+func (s *dbShell) addHistory(item string) {
+	if s.historyPath == "" {
+		return
+	}
+	if err := gfile.PutContentsAppend(s.historyPath, strconv.Quote(item)+"\n"); err != nil {
+		s.printf("Error: save history failed: %s\n", err.Error())
+	}
+}
+
+// getHistory returns the latest statements and console commands of the history file.
+func (s *dbShell) getHistory() []string {
+	if s.historyPath == "" {
+		return nil
+	}
+	var history []string
+	for _, line := range gstr.SplitAndTrim(gfile.GetContents(s.historyPath), "\n") {
+		if item, err := strconv.Unquote(line); err == nil {
+			history = append(history, item)
+		}
+	}
+	if len(history) > dbShellHistorySize {
+		history = history[len(history)-dbShellHistorySize:]
+	}
+	return history
+}
+
+func (s *dbShell) printf(format string, args ...any) {
+	_, _ = fmt.Fprintf(s.writer, format, args...)
+}
@@ -9,7 +9,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"os"
 	"path"
@@ -18,6 +17,7 @@ import (
 
 	"github.com/gogf/gf/v2/encoding/gcompress"
 	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/gclient"
 	"github.com/gogf/gf/v2/os/gfile"
 
 	"github.com/gogf/gf/cmd/gf/v2/internal/utility/mlog"
@@ -146,27 +146,13 @@ func (d *DocSetting) DownloadDoc() error {
 		mlog.Printf("File does not exist, start downloading: %s", d.DocURL)
 		startTime := time.Now()
 		// Download the file
-		resp, err := http.Get(d.DocURL)
+		err := g.Client().Download(context.Background(), d.DocURL, d.DocZipFile, gclient.DownloadOption{
+			Resume: true,
+		})
 		if err != nil {
 			mlog.Print("Failed to download file:", err)
 			return err
 		}
-		defer resp.Body.Close()
-
-		// Create the file
-		out, err := os.Create(d.DocZipFile)
-		if err != nil {
-			mlog.Print("Failed to create file:", err)
-			return err
-		}
-		defer out.Close()
-
-		// Write the response body to the file
-		_, err = io.Copy(out, resp.Body)
-		if err != nil {
-			mlog.Print("Failed to write file:", err)
-			return err
-		}
 		mlog.Printf("Download successful, time-consuming: %v", time.Since(startTime))
 	}
 
@@ -10,10 +10,12 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"time"
 
 	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/os/gfile"
 	"github.com/gogf/gf/v2/os/gproc"
+	"github.com/gogf/gf/v2/os/gtime"
 	"github.com/gogf/gf/v2/text/gstr"
 	"github.com/gogf/gf/v2/util/gtag"
 
@@ -40,12 +42,26 @@ gf docker main.go -t hub.docker.com/john/image:tag
 gf docker main.go -t hub.docker.com/john/image:tag
 gf docker main.go -p -t hub.docker.com/john/image:tag
 gf docker main.go -p -tp ["hub.docker.com/john","hub.docker.com/smith"] -tn image:tag
+gf docker main.go -p -t hub.docker.com/john/image:tag -pf linux/amd64,linux/arm64 -sb manifest/docker/sbom.json
 `
 	cDockerDc = `
 The "docker" command builds the GF project to a docker images.
 It runs "gf build" firstly to compile the project to binary file.
 It then runs "docker build" command automatically to generate the docker image.
 You should have docker installed, and there must be a Dockerfile in the root of the project.
+
+Multi-arch images are built by "docker buildx" if "platforms" option passed. The binary files
+are built for each platform into "{os}_{arch}" directory under the "-p" path of "build" option,
+which can be copied using the "TARGETOS" and "TARGETARCH" arguments in Dockerfile, for example
+with build option "-p ./temp":
+    ARG TARGETOS
+    ARG TARGETARCH
+    COPY ./temp/${TARGETOS}_${TARGETARCH}/main $WORKDIR/main
+The multi-arch images are pushed by buildx directly if "push" option passed, as they cannot be
+loaded into the local image store.
+
+The build metadata embedded in binary by "gf build" is also added to the image as OCI labels,
+and a CycloneDX SBOM of the go modules is generated if "sbom" option passed.
 `
 	cDockerMainBrief        = `main file path for "gf build", it's "main.go" in default. empty string for no binary build`
 	cDockerBuildBrief       = `binary build options before docker image build, it's "-a amd64 -s linux" in default`
@@ -56,6 +72,8 @@ You should have docker installed, and there must be a Dockerfile in the root of
 	cDockerTagNameBrief     = `tag name for this docker, pattern like "image:tag". this option is required with TagPrefixes`
 	cDockerTagPrefixesBrief = `tag prefixes for this docker, which are used for docker push. this option is required with TagName`
 	cDockerExtraBrief       = `extra build options passed to "docker image"`
+	cDockerPlatformsBrief   = `target platforms for multi-arch image building by buildx, like "linux/amd64,linux/arm64"`
+	cDockerSbomBrief        = `file path for generating SBOM of go modules in CycloneDX format, like "manifest/docker/sbom.json"`
 )
 
 func init() {
@@ -73,6 +91,8 @@ func init() {
 		`cDockerTagNameBrief`:     cDockerTagNameBrief,
 		`cDockerTagPrefixesBrief`: cDockerTagPrefixesBrief,
 		`cDockerExtraBrief`:       cDockerExtraBrief,
+		`cDockerPlatformsBrief`:   cDockerPlatformsBrief,
+		`cDockerSbomBrief`:        cDockerSbomBrief,
 	})
 }
 
@@ -87,6 +107,8 @@ type cDockerInput struct {
 	TagPrefixes []string `name:"tagPrefixes" short:"tp" brief:"{cDockerTagPrefixesBrief}" v:"required-with:TagName"`
 	Push        bool     `name:"push"        short:"p"  brief:"{cDockerPushBrief}" orphan:"true"`
 	Extra       string   `name:"extra"       short:"e"  brief:"{cDockerExtraBrief}"`
+	Platforms   []string `name:"platforms"   short:"pf" brief:"{cDockerPlatformsBrief}"`
+	Sbom        string   `name:"sbom"        short:"sb" brief:"{cDockerSbomBrief}"`
 }
 
 type cDockerOutput struct{}
@@ -99,6 +121,14 @@ func (c cDocker) Index(ctx context.Context, in cDockerInput) (out *cDockerOutput
 
 	mlog.Debugf(`docker command input: %+v`, in)
 
+	platforms, err := parseDockerPlatforms(in.Platforms)
+	if err != nil {
+		mlog.Fatal(err)
+	}
+	if len(platforms) > 0 && in.Main != "" {
+		in.Build = fmt.Sprintf(`%s %s`, in.Build, platforms.BuildOptions())
+	}
+
 	// Binary build.
 	if in.Main != "" && in.Build != "" {
 		in.Build += " --exitWhenError"
@@ -117,11 +147,20 @@ func (c cDocker) Index(ctx context.Context, in cDockerInput) (out *cDockerOutput
 			return
 		}
 	}
+	// SBOM generating.
+	if in.Sbom != "" {
+		if err = generateDockerSbom(ctx, in.Sbom); err != nil {
+			mlog.Debugf(`generate sbom failed with error: %+v`, err)
+			return
+		}
+		mlog.Printf(`generated sbom: %s`, in.Sbom)
+	}
 	// Docker build.
 	var (
 		dockerBuildOptions string
 		dockerTags         []string
 		dockerTagBase      string
+		dockerLabels       = c.getDockerLabelOptions(ctx)
 	)
 	if len(in.TagPrefixes) > 0 {
 		for _, tagPrefix := range in.TagPrefixes {
@@ -132,6 +171,10 @@ func (c cDocker) Index(ctx context.Context, in cDockerInput) (out *cDockerOutput
 	if len(dockerTags) == 0 {
 		dockerTags = []string{in.Tag}
 	}
+	// Multi-arch building and pushing by buildx.
+	if len(platforms) > 0 {
+		return out, c.doBuildxBuild(ctx, in, platforms, dockerTags, dockerLabels)
+	}
 	for i, dockerTag := range dockerTags {
 		if i > 0 {
 			err = gproc.ShellRun(ctx, fmt.Sprintf(`docker tag %s %s`, dockerTagBase, dockerTag))
@@ -141,9 +184,9 @@ func (c cDocker) Index(ctx context.Context, in cDockerInput) (out *cDockerOutput
 			continue
 		}
 		dockerTagBase = dockerTag
-		dockerBuildOptions = ""
+		dockerBuildOptions = dockerLabels
 		if dockerTag != "" {
-			dockerBuildOptions = fmt.Sprintf(`-t %s`, dockerTag)
+			dockerBuildOptions = fmt.Sprintf(`%s -t %s`, dockerBuildOptions, dockerTag)
 		}
 		if in.Extra != "" {
 			dockerBuildOptions = fmt.Sprintf(`%s %s`, dockerBuildOptions, in.Extra)
@@ -170,6 +213,46 @@ func (c cDocker) Index(ctx context.Context, in cDockerInput) (out *cDockerOutput
 	return
 }
 
+// doBuildxBuild builds the multi-arch image for `platforms` using buildx with all tags,
+// and pushes the image if necessary.
+func (c cDocker) doBuildxBuild(
+	ctx context.Context, in cDockerInput, platforms dockerPlatforms, dockerTags []string, dockerLabels string,
+) error {
+	var buildOptions = fmt.Sprintf(`--platform %s %s`, platforms.String(), dockerLabels)
+	for _, dockerTag := range dockerTags {
+		if dockerTag != "" {
+			buildOptions = fmt.Sprintf(`%s -t %s`, buildOptions, dockerTag)
+		}
+	}
+	if in.Sbom != "" {
+		buildOptions += " --sbom=true"
+	}
+	if in.Push {
+		buildOptions += " --push"
+	} else {
+		mlog.Print(`multi-arch image is kept in build cache only, use "push" option to push it to registry`)
+	}
+	if in.Extra != "" {
+		buildOptions = fmt.Sprintf(`%s %s`, buildOptions, in.Extra)
+	}
+	return gproc.ShellRun(ctx, fmt.Sprintf(`docker buildx build -f %s . %s`, in.File, buildOptions))
+}
+
+// getDockerLabelOptions returns the label options of OCI annotations for the image, which are the
+// same build metadata embedded into binary by "gf build".
+func (c cDocker) getDockerLabelOptions(ctx context.Context) string {
+	var labels = []string{
+		fmt.Sprintf(`--label org.opencontainers.image.created=%s`, gtime.Now().Format(time.RFC3339)),
+	}
+	if gitCommit := Build.getGitCommit(ctx); gitCommit != "" {
+		array := gstr.SplitAndTrim(gitCommit, " ")
+		labels = append(labels, fmt.Sprintf(
+			`--label org.opencontainers.image.revision=%s`, array[len(array)-1],
+		))
+	}
+	return gstr.Join(labels, " ")
+}
+
 func (c cDocker) exeDockerShell(ctx context.Context, shellFilePath string) error {
 	if gfile.ExtName(shellFilePath) == "sh" && runtime.GOOS == "windows" {
 		mlog.Debugf(`ignore shell file "%s", as it cannot be run on windows system`, shellFilePath)
@@ -0,0 +1,167 @@
+// Copyright GoFrame gf Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/os/gproc"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+// dockerPlatform is the target platform of image like "linux/arm64".
+type dockerPlatform struct {
+	System string
+	Arch   string
+}
+
+// dockerPlatforms is the target platforms of multi-arch image.
+type dockerPlatforms []dockerPlatform
+
+// parseDockerPlatforms parses and returns the platforms like "linux/amd64,linux/arm64".
+func parseDockerPlatforms(values []string) (dockerPlatforms, error) {
+	var platforms dockerPlatforms
+	for _, value := range parseCommaSeparatedArgs(values) {
+		array := gstr.SplitAndTrim(value, "/")
+		if len(array) != 2 {
+			return nil, gerror.Newf(`invalid platform "%s", it should be like "linux/amd64"`, value)
+		}
+		platforms = append(platforms, dockerPlatform{System: array[0], Arch: array[1]})
+	}
+	return platforms, nil
+}
+
+// String returns the platforms string for "--platform" option of buildx.
+func (ps dockerPlatforms) String() string {
+	var array = make([]string, 0, len(ps))
+	for _, p := range ps {
+		array = append(array, p.System+"/"+p.Arch)
+	}
+	return gstr.Join(array, ",")
+}
+
+// BuildOptions returns the system and arch options of "gf build" for the platforms.
+func (ps dockerPlatforms) BuildOptions() string {
+	var systems, arches []string
+	for _, p := range ps {
+		if !gstr.InArray(systems, p.System) {
+			systems = append(systems, p.System)
+		}
+		if !gstr.InArray(arches, p.Arch) {
+			arches = append(arches, p.Arch)
+		}
+	}
+	return fmt.Sprintf(`-s %s -a %s`, gstr.Join(systems, ","), gstr.Join(arches, ","))
+}
+
+// sbomModule is the module item of "go list -m -json all".
+type sbomModule struct {
+	Path    string
+	Version string
+	Main    bool
+	Replace *sbomModule
+}
+
+// sbomComponent is the component of CycloneDX SBOM.
+type sbomComponent struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	Purl    string `json:"purl,omitempty"`
+}
+
+// sbomDocument is the CycloneDX SBOM document.
+type sbomDocument struct {
+	BomFormat   string          `json:"bomFormat"`
+	SpecVersion string          `json:"specVersion"`
+	Version     int             `json:"version"`
+	Metadata    sbomMetadata    `json:"metadata"`
+	Components  []sbomComponent `json:"components"`
+}
+
+type sbomMetadata struct {
+	Timestamp string        `json:"timestamp"`
+	Component sbomComponent `json:"component"`
+}
+
+// generateDockerSbom generates the CycloneDX SBOM of the go modules of current project to `path`.
+func generateDockerSbom(ctx context.Context, path string) error {
+	result, err := gproc.ShellExec(ctx, `go list -m -json all`)
+	if err != nil {
+		return gerror.Wrapf(err, `list go modules failed: %s`, result)
+	}
+	modules, err := parseSbomModules(strings.NewReader(result))
+	if err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(buildSbomDocument(modules, gtime.Now().Time), "", "  ")
+	if err != nil {
+		return err
+	}
+	return gfile.PutBytes(path, content)
+}
+
+// parseSbomModules parses the module json stream output of "go list -m -json all".
+func parseSbomModules(reader io.Reader) ([]sbomModule, error) {
+	var (
+		modules []sbomModule
+		decoder = json.NewDecoder(reader)
+	)
+	for decoder.More() {
+		var module sbomModule
+		if err := decoder.Decode(&module); err != nil {
+			return nil, gerror.Wrap(err, `parse go modules failed`)
+		}
+		modules = append(modules, module)
+	}
+	return modules, nil
+}
+
+// buildSbomDocument builds the CycloneDX SBOM document of the modules.
+// The replaced module is recorded as its replacement.
+func buildSbomDocument(modules []sbomModule, now time.Time) sbomDocument {
+	document := sbomDocument{
+		BomFormat:   "CycloneDX",
+		SpecVersion: "1.5",
+		Version:     1,
+		Metadata: sbomMetadata{
+			Timestamp: now.UTC().Format(time.RFC3339),
+		},
+		Components: make([]sbomComponent, 0, len(modules)),
+	}
+	for _, module := range modules {
+		if module.Main {
+			document.Metadata.Component = sbomComponent{
+				Type: "application",
+				Name: module.Path,
+			}
+			continue
+		}
+		if module.Replace != nil {
+			module = *module.Replace
+		}
+		component := sbomComponent{
+			Type:    "library",
+			Name:    module.Path,
+			Version: module.Version,
+		}
+		// Local replacement has no version.
+		if module.Version != "" {
+			component.Purl = fmt.Sprintf(`pkg:golang/%s@%s`, module.Path, module.Version)
+		}
+		document.Components = append(document.Components, component)
+	}
+	return document
+}
@@ -23,13 +23,14 @@ type cGen struct {
 	cGenPb
 	cGenPbEntity
 	cGenService
+	cGenClient
 }
 
 const (
-	cGenBrief = `automatically generate go files for dao/do/entity/pb/pbentity`
+	cGenBrief = `automatically generate go files for dao/do/entity/pb/pbentity/client`
 	cGenDc    = `
 The "gen" command is designed for multiple generating purposes. 
-It's currently supporting generating go files for ORM models, protobuf and protobuf entity files.
+It's currently supporting generating go files for ORM models, protobuf, protobuf entity files and API clients.
 Please use "gf gen dao -h" for specified type help.
 `
 )
@@ -0,0 +1,15 @@
+// Copyright GoFrame gf Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package cmd
+
+import (
+	"github.com/gogf/gf/cmd/gf/v2/internal/cmd/genclient"
+)
+
+type (
+	cGenClient = genclient.CGenClient
+)
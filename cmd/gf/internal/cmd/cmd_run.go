@@ -39,12 +39,15 @@ type watchPath struct {
 }
 
 type cRunApp struct {
-	File           string   // Go run file name.
-	Path           string   // Directory storing built binary.
-	Options        string   // Extra "go run" options.
-	Args           string   // Custom arguments.
-	WatchPaths     []string // Watch paths for live reload.
-	IgnorePatterns []string // Custom ignore patterns.
+	File           string        // Go run file name.
+	Path           string        // Directory storing built binary.
+	Options        string        // Extra "go run" options.
+	Args           string        // Custom arguments.
+	WatchPaths     []string      // Watch paths for live reload.
+	IgnorePatterns []string      // Custom ignore patterns.
+	HealthPath     string        // Health check path of the process in proxy mode.
+	HealthTimeout  time.Duration // Health check timeout of the process in proxy mode.
+	proxy          *cRunProxy    // Proxy handing off requests between processes, nil if proxy mode disabled.
 }
 
 const (
@@ -56,16 +59,26 @@ gf run main.go --args "server -p 8080"
 gf run main.go -mod=vendor
 gf run main.go -w internal,api
 gf run main.go -i ".git,node_modules"
+gf run main.go -x :8000 -a "--port={port}" -hp /healthz
 `
 	cRunDc = `
 The "run" command is used for running go codes with hot-compiled-like feature,
 which compiles and runs the go codes asynchronously when codes change.
+
+In proxy mode enabled by "proxy" option, it serves a reverse proxy on the given address,
+and runs each new process on a free port passed by "{port}" placeholder of the arguments
+and the "PORT" environment variable. The old process keeps serving until the new one is
+healthy, then the proxy hands off to the new one and the old one is stopped gracefully,
+so that in-flight requests are not dropped during reloading.
 `
 	cRunFileBrief          = `building file path.`
 	cRunPathBrief          = `output directory path for built binary file. it's "./" in default`
 	cRunExtraBrief         = `the same options as "go run"/"go build" except some options as follows defined`
 	cRunArgsBrief          = `custom arguments for your process`
 	cRunWatchPathsBrief    = `watch additional paths for live reload, separated by ",". i.e. "internal,api"`
+	cRunProxyBrief         = `enable proxy mode and listen on the given address like ":8000" for handing off requests between processes`
+	cRunHealthPathBrief    = `health check path of the new process in proxy mode, it checks the port connectable if it's empty`
+	cRunHealthTimeoutBrief = `health check timeout of the new process in proxy mode`
 	cRunIgnorePatternBrief = `custom ignore patterns for watch, separated by ",". i.e. ".git,node_modules". default patterns: node_modules, vendor, .*, _*. Glob syntax: "*" matches any chars, "?" matches single char, "[abc]" matches char class. Note: patterns match directory names only, not paths`
 )
 
@@ -83,18 +96,24 @@ func init() {
 		`cRunArgsBrief`:          cRunArgsBrief,
 		`cRunWatchPathsBrief`:    cRunWatchPathsBrief,
 		`cRunIgnorePatternBrief`: cRunIgnorePatternBrief,
+		`cRunProxyBrief`:         cRunProxyBrief,
+		`cRunHealthPathBrief`:    cRunHealthPathBrief,
+		`cRunHealthTimeoutBrief`: cRunHealthTimeoutBrief,
 	})
 }
 
 type (
 	cRunInput struct {
 		g.Meta         `name:"run" config:"gfcli.run"`
-		File           string   `name:"FILE"           arg:"true" brief:"{cRunFileBrief}" v:"required"`
-		Path           string   `name:"path"           short:"p"  brief:"{cRunPathBrief}" d:"./"`
-		Extra          string   `name:"extra"          short:"e"  brief:"{cRunExtraBrief}"`
-		Args           string   `name:"args"           short:"a"  brief:"{cRunArgsBrief}"`
-		WatchPaths     []string `name:"watchPaths"     short:"w"  brief:"{cRunWatchPathsBrief}"`
-		IgnorePatterns []string `name:"ignorePatterns" short:"i"  brief:"{cRunIgnorePatternBrief}"`
+		File           string        `name:"FILE"           arg:"true" brief:"{cRunFileBrief}" v:"required"`
+		Path           string        `name:"path"           short:"p"  brief:"{cRunPathBrief}" d:"./"`
+		Extra          string        `name:"extra"          short:"e"  brief:"{cRunExtraBrief}"`
+		Args           string        `name:"args"           short:"a"  brief:"{cRunArgsBrief}"`
+		WatchPaths     []string      `name:"watchPaths"     short:"w"  brief:"{cRunWatchPathsBrief}"`
+		IgnorePatterns []string      `name:"ignorePatterns" short:"i"  brief:"{cRunIgnorePatternBrief}"`
+		Proxy          string        `name:"proxy"          short:"x"  brief:"{cRunProxyBrief}"`
+		HealthPath     string        `name:"healthPath"     short:"hp" brief:"{cRunHealthPathBrief}"`
+		HealthTimeout  time.Duration `name:"healthTimeout"  short:"ht" brief:"{cRunHealthTimeoutBrief}" d:"30s"`
 	}
 	cRunOutput struct{}
 )
@@ -130,6 +149,14 @@ func (c cRun) Index(ctx context.Context, in cRunInput) (out *cRunOutput, err err
 		Args:           in.Args,
 		WatchPaths:     in.WatchPaths,
 		IgnorePatterns: in.IgnorePatterns,
+		HealthPath:     in.HealthPath,
+		HealthTimeout:  in.HealthTimeout,
+	}
+	if in.Proxy != "" {
+		app.proxy = newRunProxy(in.Proxy)
+		if err = app.proxy.Start(); err != nil {
+			mlog.Fatalf(`proxy listening on "%s" failed: %+v`, in.Proxy, err)
+		}
 	}
 	dirty := gtype.NewBool()
 
@@ -196,6 +223,10 @@ func (app *cRunApp) Run(ctx context.Context, outputPath string) {
 		mlog.Printf("build error: \n%s%s", result, err.Error())
 		return
 	}
+	if app.proxy != nil {
+		app.runWithHandoff(ctx, outputPath)
+		return
+	}
 	// Kill the old process if build successfully.
 	if process != nil {
 		if err := process.Kill(); err != nil {
@@ -220,6 +251,9 @@ func (app *cRunApp) Run(ctx context.Context, outputPath string) {
 }
 
 func (app *cRunApp) End(ctx context.Context, sig os.Signal, outputPath string) {
+	if app.proxy != nil {
+		app.proxy.Shutdown(ctx)
+	}
 	// Delete the binary file.
 	// firstly, kill the process.
 	if process != nil {
@@ -0,0 +1,214 @@
+// Copyright GoFrame gf Author(https://goframe.org). All Rights Reserved
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/container/gtype"
+	"github.com/gogf/gf/v2/net/gtcp"
+	"github.com/gogf/gf/v2/os/gproc"
+
+	"github.com/gogf/gf/cmd/gf/v2/internal/utility/mlog"
+)
+
+const (
+	// cRunPortPlaceholder is the placeholder in custom arguments that is replaced with the
+	// port assigned to the process in proxy mode.
+	cRunPortPlaceholder = `{port}`
+	// cRunPortEnvName is the environment variable name of the port assigned to the process in proxy mode.
+	cRunPortEnvName = `PORT`
+	// cRunHealthCheckInterval is the interval checking the health of new process.
+	cRunHealthCheckInterval = 100 * time.Millisecond
+	// cRunStopTimeout is the max waiting duration for the old process exiting gracefully.
+	cRunStopTimeout = 30 * time.Second
+)
+
+// cRunProxy is the reverse proxy serving on a fixed address for the running processes,
+// which switches its backend to the new process only after it is healthy, so that the
+// in-flight requests are not dropped during reloading.
+type cRunProxy struct {
+	address string        // Listening address of the proxy.
+	target  *gtype.String // Backend address like "127.0.0.1:8000" of current process.
+	server  *http.Server  // Underlying http server of the proxy.
+}
+
+// newRunProxy creates and returns a proxy listening on `address`.
+func newRunProxy(address string) *cRunProxy {
+	p := &cRunProxy{
+		address: address,
+		target:  gtype.NewString(),
+	}
+	reverseProxy := &httputil.ReverseProxy{
+		Director: func(r *http.Request) {
+			r.URL.Scheme = "http"
+			r.URL.Host = p.target.Val()
+		},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			mlog.Debugf("proxy error: %s", err.Error())
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+	p.server = &http.Server{
+		Addr: address,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if p.target.Val() == "" {
+				http.Error(w, "application is starting", http.StatusServiceUnavailable)
+				return
+			}
+			reverseProxy.ServeHTTP(w, r)
+		}),
+	}
+	return p
+}
+
+// Start starts the proxy in asynchronous way.
+func (p *cRunProxy) Start() error {
+	listener, err := net.Listen("tcp", p.address)
+	if err != nil {
+		return err
+	}
+	mlog.Printf("proxy listening on: %s", listener.Addr().String())
+	go func() {
+		if err := p.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			mlog.Printf("proxy serving error: %s", err.Error())
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops the proxy gracefully.
+func (p *cRunProxy) Shutdown(ctx context.Context) {
+	if err := p.server.Shutdown(ctx); err != nil {
+		mlog.Debugf("proxy shutdown error: %s", err.Error())
+	}
+}
+
+// SetTarget switches the backend of the proxy to `target`.
+func (p *cRunProxy) SetTarget(target string) {
+	p.target.Set(target)
+}
+
+// runWithHandoff starts the new process on a free port, and hands off the proxy to it after it
+// is healthy. The old process keeps serving until the handoff, and then it is stopped gracefully.
+// The new process is killed and the old one keeps serving if the new one is not healthy in time.
+func (app *cRunApp) runWithHandoff(ctx context.Context, outputPath string) {
+	port, err := gtcp.GetFreePort()
+	if err != nil {
+		mlog.Printf("get free port error: %s", err.Error())
+		return
+	}
+	var (
+		args       = strings.ReplaceAll(app.Args, cRunPortPlaceholder, fmt.Sprint(port))
+		env        = []string{fmt.Sprintf(`%s=%d`, cRunPortEnvName, port)}
+		runCommand = fmt.Sprintf(`%s %s`, outputPath, args)
+		newProcess *gproc.Process
+	)
+	mlog.Print(runCommand)
+	if runtime.GOOS == "windows" {
+		newProcess = gproc.NewProcess(outputPath, strings.Fields(args), env)
+	} else {
+		newProcess = gproc.NewProcessCmd(runCommand, env)
+	}
+	pid, err := newProcess.Start(ctx)
+	if err != nil {
+		mlog.Printf("build running error: %s", err.Error())
+		return
+	}
+	mlog.Printf("build running pid: %d, port: %d", pid, port)
+
+	target := fmt.Sprintf(`127.0.0.1:%d`, port)
+	if !waitForHealthy(ctx, target, app.HealthPath, app.HealthTimeout) {
+		mlog.Printf(
+			"process pid %d is not healthy in %s, keep the old process serving",
+			pid, app.HealthTimeout.String(),
+		)
+		if err = newProcess.Kill(); err != nil {
+			mlog.Debugf("kill process error: %s", err.Error())
+		}
+		return
+	}
+	app.proxy.SetTarget(target)
+	mlog.Printf("proxy handed off to pid %d", pid)
+
+	oldProcess := process
+	process = newProcess
+	if oldProcess != nil {
+		go stopProcessGracefully(ctx, oldProcess)
+	}
+}
+
+// waitForHealthy checks the health of the process serving on `target` until it is healthy
+// or the `timeout` exceeds. The process is healthy if the `healthPath` responds with status
+// less than 500, or the port is connectable if `healthPath` is empty.
+func waitForHealthy(ctx context.Context, target, healthPath string, timeout time.Duration) bool {
+	var (
+		deadline = time.Now().Add(timeout)
+		client   = &http.Client{Timeout: time.Second}
+	)
+	for time.Now().Before(deadline) {
+		if healthPath == "" {
+			if conn, err := net.DialTimeout("tcp", target, time.Second); err == nil {
+				_ = conn.Close()
+				return true
+			}
+		} else {
+			healthUrl := (&url.URL{Scheme: "http", Host: target, Path: healthPath}).String()
+			if response, err := client.Get(healthUrl); err == nil {
+				_ = response.Body.Close()
+				if response.StatusCode < http.StatusInternalServerError {
+					return true
+				}
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(cRunHealthCheckInterval):
+		}
+	}
+	return false
+}
+
+// stopProcessGracefully stops the process by signal, and kills it if it does not exit in time.
+func stopProcessGracefully(ctx context.Context, p *gproc.Process) {
+	if runtime.GOOS == "windows" {
+		if err := p.Kill(); err != nil {
+			mlog.Debugf("kill process error: %s", err.Error())
+		}
+		return
+	}
+	if err := p.Signal(os.Interrupt); err != nil {
+		mlog.Debugf("send signal to process error: %s", err.Error())
+		if err = p.Kill(); err != nil {
+			mlog.Debugf("kill process error: %s", err.Error())
+		}
+		return
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- p.Wait()
+	}()
+	select {
+	case <-done:
+		mlog.Debugf("old process %d exited gracefully", p.Pid())
+	case <-time.After(cRunStopTimeout):
+		if err := p.Kill(); err != nil {
+			mlog.Debugf("kill process error: %s", err.Error())
+		}
+	case <-ctx.Done():
+	}
+}
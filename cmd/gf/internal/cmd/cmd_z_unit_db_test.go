@@ -0,0 +1,80 @@
+// Copyright GoFrame gf Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Db_Shell(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		path := gfile.Temp(guid.S())
+		t.AssertNil(gfile.Mkdir(path))
+		defer gfile.Remove(path)
+
+		db, err := gdb.New(gdb.ConfigNode{
+			Link: fmt.Sprintf("sqlite::@file(%s/db.sqlite3)", path),
+		})
+		t.AssertNil(err)
+		defer db.Close(ctx)
+
+		var (
+			output = bytes.NewBuffer(nil)
+			input  = strings.Join([]string{
+				`CREATE TABLE user (`,
+				`    id   INTEGER PRIMARY KEY, -- User ID`,
+				`    name VARCHAR(45)`,
+				`);`,
+				`INSERT INTO user(id, name) VALUES(1, 'john'), (2, NULL);`,
+				`\d`,
+				`\d user`,
+				`SELECT id, name`,
+				`FROM user ORDER BY id;`,
+				`\format csv`,
+				`SELECT name, id FROM user ORDER BY id;`,
+				`SELECT * FROM unknown;`,
+				`\history 7`,
+				`\q`,
+				`SELECT 1;`,
+			}, "\n")
+			shell = &dbShell{
+				db:          db,
+				name:        "test",
+				reader:      strings.NewReader(input),
+				writer:      output,
+				format:      dbShellFormatTable,
+				historyPath: gfile.Join(path, "history"),
+			}
+		)
+		t.AssertNil(shell.Run(ctx))
+
+		content := output.String()
+		t.Assert(gstr.Contains(content, "OK, 2 rows affected"), true)
+		t.Assert(gstr.Contains(content, "| user"), true)
+		t.Assert(gstr.Contains(content, "User ID"), true)
+		t.Assert(gstr.Contains(content, "| NULL"), true)
+		t.Assert(gstr.Contains(content, "2 rows in set"), true)
+		t.Assert(gstr.Contains(content, "name,id\njohn,1\nNULL,2\ntest>"), true)
+		t.Assert(gstr.Contains(content, "Error: "), true)
+		t.Assert(gstr.Count(content, "name,id\n"), 2)
+
+		history := shell.getHistory()
+		t.Assert(len(history), 9)
+		t.Assert(history[0], "CREATE TABLE user (\n    id   INTEGER PRIMARY KEY, -- User ID\n    name VARCHAR(45)\n);")
+		t.Assert(history[4], "SELECT id, name\nFROM user ORDER BY id;")
+		t.Assert(history[8], `\history 7`)
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright GoFrame gf Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Docker_ParsePlatforms(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		platforms, err := parseDockerPlatforms([]string{"linux/amd64, linux/arm64", "darwin/arm64"})
+		t.AssertNil(err)
+		t.Assert(len(platforms), 3)
+		t.Assert(platforms.String(), "linux/amd64,linux/arm64,darwin/arm64")
+		t.Assert(platforms.BuildOptions(), "-s linux,darwin -a amd64,arm64")
+
+		platforms, err = parseDockerPlatforms(nil)
+		t.AssertNil(err)
+		t.Assert(len(platforms), 0)
+
+		_, err = parseDockerPlatforms([]string{"linux"})
+		t.AssertNE(err, nil)
+	})
+}
+
+func Test_Docker_Sbom(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		modules, err := parseSbomModules(strings.NewReader(`
+{"Path": "github.com/john/app", "Main": true}
+{"Path": "github.com/gogf/gf/v2", "Version": "v2.9.0"}
+{"Path": "github.com/old/lib", "Version": "v1.0.0", "Replace": {"Path": "github.com/new/lib", "Version": "v1.1.0"}}
+{"Path": "github.com/local/lib", "Version": "v0.0.1", "Replace": {"Path": "../lib"}}
+`))
+		t.AssertNil(err)
+		t.Assert(len(modules), 4)
+
+		document := buildSbomDocument(modules, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+		t.Assert(document.BomFormat, "CycloneDX")
+		t.Assert(document.Metadata.Timestamp, "2024-01-02T03:04:05Z")
+		t.Assert(document.Metadata.Component.Name, "github.com/john/app")
+		t.Assert(len(document.Components), 3)
+		t.Assert(document.Components[0].Purl, "pkg:golang/github.com/gogf/gf/v2@v2.9.0")
+		t.Assert(document.Components[1].Name, "github.com/new/lib")
+		t.Assert(document.Components[1].Purl, "pkg:golang/github.com/new/lib@v1.1.0")
+		t.Assert(document.Components[2].Name, "../lib")
+		t.Assert(document.Components[2].Purl, "")
+
+		_, err = parseSbomModules(strings.NewReader(`{"Path":`))
+		t.AssertNE(err, nil)
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright GoFrame gf Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+	"github.com/gogf/gf/v2/util/gutil"
+
+	"github.com/gogf/gf/cmd/gf/v2/internal/cmd/genclient"
+)
+
+func Test_Gen_Client_Default(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			path = gfile.Temp(guid.S())
+			in   = genclient.CGenClientInput{
+				Src:  gtest.DataPath("genclient", "openapi.json"),
+				Path: filepath.Join(path, "user"),
+			}
+		)
+		err := gutil.FillStructWithDefault(&in)
+		t.AssertNil(err)
+		defer gfile.Remove(path)
+
+		_, err = genclient.CGenClient{}.Client(ctx, in)
+		t.AssertNil(err)
+
+		var (
+			clientContent = gfile.GetContents(filepath.Join(in.Path, "client.go"))
+			modelContent  = gfile.GetContents(filepath.Join(in.Path, "client_model.go"))
+			apiContent    = gfile.GetContents(filepath.Join(in.Path, "client_api.go"))
+		)
+		t.Assert(gfile.Exists(filepath.Join(in.Path, "client.go")), true)
+		t.AssertIN("package user", clientContent)
+		t.AssertIN("Wrapped: true", clientContent)
+		// Models from components.
+		t.AssertIN("type User struct {", modelContent)
+		t.AssertIN("type UserAddress struct {", modelContent)
+		t.AssertIN("*gtime.Time", modelContent)
+		t.AssertIN("map[string]int", modelContent)
+		t.AssertIN(`dc:"User 'nick' name"`, modelContent)
+		// APIs from operations.
+		t.AssertIN("type ListUsersRes = []User", apiContent)
+		t.AssertIN("type PostUserRes = CreateRes", apiContent)
+		t.AssertIN("`path:\"/user/{id}\" method:\"get\"`", apiContent)
+		t.AssertIN("`json:\"id\" in:\"path\" v:\"required\"`", apiContent)
+		t.AssertIN("func (c *Client) GetUserId(ctx context.Context, req *GetUserIdReq) (res *GetUserIdRes, err error)", apiContent)
+		t.AssertIN("Deprecated:", apiContent)
+		// Header parameter is not supported.
+		t.AssertNI("X-Trace", apiContent)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/gogf/gf/v2/os/gfile"
 	"github.com/gogf/gf/v2/test/gtest"
@@ -509,3 +510,73 @@ func Test_Gen_Pbentity_Sharding(t *testing.T) {
 		t.Assert(gstr.Contains(msgOrdersContent, "message Orders {"), true)
 	})
 }
+
+func Test_Gen_Pbentity_EnumMapping(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			err        error
+			db         = testDB
+			table      = "table_user_enum"
+			sqlContent = fmt.Sprintf(
+				gtest.DataContent(`genpbentity`, `user_enum.tpl.sql`),
+				table,
+			)
+		)
+		dropTableWithDb(db, table)
+		array := gstr.SplitAndTrim(sqlContent, ";")
+		for _, v := range array {
+			if _, err = db.Exec(ctx, v); err != nil {
+				t.AssertNil(err)
+			}
+		}
+		defer dropTableWithDb(db, table)
+
+		var (
+			path = gfile.Temp(guid.S())
+			in   = genpbentity.CGenPbEntityInput{
+				Path:    path,
+				Package: "unittest",
+				Link:    link,
+				Tables:  table,
+				EnumMapping: map[genpbentity.DBTableFieldName]genpbentity.CustomEnumType{
+					table + ".status": {},
+					table + ".level": {
+						Name:   "UserLevel",
+						Values: []string{"normal", "vip"},
+					},
+				},
+			}
+		)
+		err = gutil.FillStructWithDefault(&in)
+		t.AssertNil(err)
+
+		err = gfile.Mkdir(path)
+		t.AssertNil(err)
+		defer gfile.Remove(path)
+
+		_, err = genpbentity.CGenPbEntity{}.PbEntity(ctx, in)
+		t.AssertNil(err)
+
+		var (
+			filePath = gfile.Join(path, "table_user_enum.proto")
+			content  = gfile.GetContents(filePath)
+		)
+		t.Assert(gstr.Contains(content, "// TableUserEnumStatus User Status\nenum TableUserEnumStatus {"), true)
+		t.Assert(gstr.Contains(content, "    TABLE_USER_ENUM_STATUS_UNSPECIFIED = 0;\n"), true)
+		t.Assert(gstr.Contains(content, "    TABLE_USER_ENUM_STATUS_ACTIVE = 1;\n"), true)
+		t.Assert(gstr.Contains(content, "    TABLE_USER_ENUM_STATUS_DISABLED = 2;\n"), true)
+		t.Assert(gstr.Contains(content, "enum UserLevel {\n    USER_LEVEL_UNSPECIFIED = 0;\n    USER_LEVEL_NORMAL = 1;\n    USER_LEVEL_VIP = 2;\n}"), true)
+		t.AssertNE(gstr.Pos(content, "TableUserEnumStatus Status"), -1)
+		t.AssertNE(gstr.Pos(content, "UserLevel           Level"), -1)
+		// The field without comment has no comment mark.
+		t.Assert(gstr.Contains(content, "//\n"), false)
+
+		// Regenerating does not rewrite the unchanged file.
+		modTime := gfile.MTimestampMilli(filePath)
+		time.Sleep(10 * time.Millisecond)
+		_, err = genpbentity.CGenPbEntity{}.PbEntity(ctx, in)
+		t.AssertNil(err)
+		t.Assert(gfile.MTimestampMilli(filePath), modTime)
+		t.Assert(gfile.GetContents(filePath), content)
+	})
+}
@@ -7,13 +7,21 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/gogf/gf/v2/net/gtcp"
 	"github.com/gogf/gf/v2/os/gfile"
 	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
 )
 
 func Test_cRunApp_getWatchPaths_Basic(t *testing.T) {
@@ -334,3 +342,57 @@ func Test_cRunApp_getWatchPaths_EmptyDirectory(t *testing.T) {
 		t.Assert(watchPaths[0].Recursive, true)
 	})
 }
+
+func Test_cRunProxy_Handoff(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			ctx     = context.Background()
+			server1 = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte("server1"))
+			}))
+			server2 = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/healthz" {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				_, _ = w.Write([]byte("server2"))
+			}))
+		)
+		defer server1.Close()
+		defer server2.Close()
+
+		port, err := gtcp.GetFreePort()
+		t.AssertNil(err)
+		proxy := newRunProxy(fmt.Sprintf(`127.0.0.1:%d`, port))
+		t.AssertNil(proxy.Start())
+		defer proxy.Shutdown(ctx)
+
+		proxyUrl := fmt.Sprintf(`http://127.0.0.1:%d/`, port)
+		response, err := http.Get(proxyUrl)
+		t.AssertNil(err)
+		_ = response.Body.Close()
+		t.Assert(response.StatusCode, http.StatusServiceUnavailable)
+
+		target1 := gstr.TrimLeftStr(server1.URL, "http://")
+		target2 := gstr.TrimLeftStr(server2.URL, "http://")
+		t.Assert(waitForHealthy(ctx, target1, "", time.Second), true)
+		proxy.SetTarget(target1)
+		t.Assert(getProxyContent(proxyUrl), "server1")
+
+		// Unhealthy process does not take over the proxy.
+		t.Assert(waitForHealthy(ctx, target2, "/healthz", 300*time.Millisecond), false)
+		t.Assert(waitForHealthy(ctx, target2, "/", time.Second), true)
+		proxy.SetTarget(target2)
+		t.Assert(getProxyContent(proxyUrl), "server2")
+	})
+}
+
+func getProxyContent(url string) string {
+	response, err := http.Get(url)
+	if err != nil {
+		return err.Error()
+	}
+	defer response.Body.Close()
+	content, _ := io.ReadAll(response.Body)
+	return string(content)
+}
@@ -0,0 +1,137 @@
+// Copyright GoFrame gf Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package genclient
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gogf/gf/v2/encoding/gjson"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gconv"
+	"github.com/gogf/gf/v2/util/gtag"
+
+	"github.com/gogf/gf/cmd/gf/v2/internal/consts"
+	"github.com/gogf/gf/cmd/gf/v2/internal/utility/mlog"
+	"github.com/gogf/gf/cmd/gf/v2/internal/utility/utils"
+)
+
+const (
+	CGenClientConfig = `gfcli.gen.client`
+	CGenClientUsage  = `gf gen client [OPTION]`
+	CGenClientBrief  = `parse OpenAPI document to generate typed API client go files`
+	CGenClientEg     = `
+gf gen client -s api.json
+gf gen client -s http://127.0.0.1:8000/api.json -p internal/client/user
+gf gen client -s openapi.yaml -p internal/client/order -k order
+`
+	CGenClientBriefSrc     = `OpenAPI v3 document file path or URL, in JSON or YAML format`
+	CGenClientBriefPath    = `destination folder path storing automatically generated go files. default: internal/client`
+	CGenClientBriefPackage = `package name of generated go files, which is the base name of destination folder if not given`
+)
+
+const (
+	genClientFileNameClient = "client.go"
+	genClientFileNameModel  = "client_model.go"
+	genClientFileNameApi    = "client_api.go"
+)
+
+func init() {
+	gtag.Sets(g.MapStrStr{
+		`CGenClientConfig`:       CGenClientConfig,
+		`CGenClientUsage`:        CGenClientUsage,
+		`CGenClientBrief`:        CGenClientBrief,
+		`CGenClientEg`:           CGenClientEg,
+		`CGenClientBriefSrc`:     CGenClientBriefSrc,
+		`CGenClientBriefPath`:    CGenClientBriefPath,
+		`CGenClientBriefPackage`: CGenClientBriefPackage,
+	})
+}
+
+type (
+	CGenClient      struct{}
+	CGenClientInput struct {
+		g.Meta  `name:"client" config:"{CGenClientConfig}" usage:"{CGenClientUsage}" brief:"{CGenClientBrief}" eg:"{CGenClientEg}"`
+		Src     string `short:"s" name:"src"     brief:"{CGenClientBriefSrc}" v:"required"`
+		Path    string `short:"p" name:"path"    brief:"{CGenClientBriefPath}" d:"internal/client"`
+		Package string `short:"k" name:"package" brief:"{CGenClientBriefPackage}"`
+	}
+	CGenClientOutput struct{}
+)
+
+// Client generates the typed API client go files from OpenAPI document, like "gen dao" generating
+// database access go files from database tables. The generated client is based on package
+// contrib/sdk/httpclient, of which the requests are declared with g.Meta like the api definitions.
+func (c CGenClient) Client(ctx context.Context, in CGenClientInput) (out *CGenClientOutput, err error) {
+	content, err := loadDocumentContent(ctx, in.Src)
+	if err != nil {
+		mlog.Fatalf(`load OpenAPI document "%s" failed: %+v`, in.Src, err)
+	}
+	doc, err := gjson.LoadContent(content, true)
+	if err != nil {
+		mlog.Fatalf(`parse OpenAPI document "%s" failed: %+v`, in.Src, err)
+	}
+	var (
+		dstPath = gfile.Abs(in.Path)
+		pkgName = in.Package
+	)
+	if pkgName == "" {
+		pkgName = gstr.Replace(gfile.Basename(dstPath), "-", "_")
+	}
+	parser := newDocumentParser(doc.Map())
+	if err = parser.Parse(); err != nil {
+		mlog.Fatalf(`parse OpenAPI document "%s" failed: %+v`, in.Src, err)
+	}
+	generator := newClientGenerator(parser)
+	files := map[string]string{
+		genClientFileNameClient: gstr.ReplaceByMap(consts.TemplateGenClientClient, g.MapStrStr{
+			"{PkgName}": pkgName,
+			"{Title}":   parser.Title(),
+			"{Wrapped}": gconv.String(parser.Wrapped()),
+		}),
+		genClientFileNameModel: gstr.ReplaceByMap(consts.TemplateGenClientModel, g.MapStrStr{
+			"{PkgName}": pkgName,
+			"{Models}":  generator.Models(),
+		}),
+		genClientFileNameApi: gstr.ReplaceByMap(consts.TemplateGenClientApi, g.MapStrStr{
+			"{PkgName}": pkgName,
+			"{Apis}":    generator.Apis(),
+		}),
+	}
+	for fileName, fileContent := range files {
+		filePath := gfile.Join(dstPath, fileName)
+		if err = gfile.PutContents(filePath, gstr.TrimLeft(fileContent)); err != nil {
+			mlog.Fatalf(`writing content to "%s" failed: %v`, filePath, err)
+		}
+		utils.GoFmt(filePath)
+		mlog.Print(`generated:`, gfile.RealPath(filePath))
+	}
+	mlog.Print("done!")
+	return
+}
+
+// loadDocumentContent loads the OpenAPI document content from local file or remote URL.
+func loadDocumentContent(ctx context.Context, src string) ([]byte, error) {
+	if gstr.HasPrefix(src, "http://") || gstr.HasPrefix(src, "https://") {
+		response, err := g.Client().Get(ctx, src)
+		if err != nil {
+			return nil, err
+		}
+		defer response.Close()
+		if response.StatusCode != http.StatusOK {
+			return nil, gerror.Newf(`unexpected response status: %s`, response.Status)
+		}
+		return response.ReadAll(), nil
+	}
+	if !gfile.IsFile(src) {
+		return nil, gerror.Newf(`file "%s" does not exist`, src)
+	}
+	return gfile.GetBytes(src), nil
+}
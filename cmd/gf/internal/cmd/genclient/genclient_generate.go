@@ -0,0 +1,106 @@
+// Copyright GoFrame gf Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package genclient
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/gogf/gf/v2/text/gstr"
+
+	"github.com/gogf/gf/cmd/gf/v2/internal/consts"
+)
+
+var (
+	// tagEscapes is the replacements of characters that cannot be used in struct tag value.
+	tagEscapes = map[string]string{
+		"`":  "'",
+		`"`:  `'`,
+		"\r": "",
+		"\n": " ",
+	}
+)
+
+// clientGenerator generates the go source of models and APIs from parsed document.
+type clientGenerator struct {
+	parser *documentParser
+}
+
+func newClientGenerator(parser *documentParser) *clientGenerator {
+	return &clientGenerator{
+		parser: parser,
+	}
+}
+
+// Models generates the go source of models.
+func (g *clientGenerator) Models() string {
+	buffer := bytes.NewBuffer(nil)
+	for _, model := range g.parser.models {
+		buffer.WriteString(g.typeDefinition(model, ""))
+		buffer.WriteString("\n")
+	}
+	return buffer.String()
+}
+
+// Apis generates the go source of request, response types and client methods for operations.
+func (g *clientGenerator) Apis() string {
+	buffer := bytes.NewBuffer(nil)
+	for _, op := range g.parser.operations {
+		buffer.WriteString(g.typeDefinition(op.Request, fmt.Sprintf(
+			"g.Meta `path:\"%s\" method:\"%s\"`", op.Request.Path, op.Request.Method,
+		)))
+		buffer.WriteString("\n")
+		buffer.WriteString(g.typeDefinition(op.Response, ""))
+		buffer.WriteString(gstr.ReplaceByMap(consts.TemplateGenClientApiFunc, map[string]string{
+			"{MethodComment}": op.Comment,
+			"{MethodName}":    op.Name,
+		}))
+		buffer.WriteString("\n")
+	}
+	return buffer.String()
+}
+
+// typeDefinition generates the go type definition of `model`, which has `meta` as the first field if given.
+func (g *clientGenerator) typeDefinition(model *clientModel, meta string) string {
+	buffer := bytes.NewBuffer(nil)
+	if model.Comment != "" {
+		buffer.WriteString(model.Comment + "\n")
+	}
+	if model.Type != "" {
+		buffer.WriteString(fmt.Sprintf("type %s = %s\n", model.Name, model.Type))
+		return buffer.String()
+	}
+	buffer.WriteString(fmt.Sprintf("type %s struct {\n", model.Name))
+	if meta != "" {
+		buffer.WriteString("\t" + meta + "\n")
+	}
+	for _, embed := range model.Embeds {
+		buffer.WriteString("\t" + embed + "\n")
+	}
+	for _, field := range model.Fields {
+		buffer.WriteString(fmt.Sprintf("\t%s %s `%s`\n", field.Name, field.Type, g.fieldTag(field)))
+	}
+	buffer.WriteString("}\n")
+	return buffer.String()
+}
+
+// fieldTag generates the struct tag of `field`.
+func (g *clientGenerator) fieldTag(field clientField) string {
+	tags := []string{fmt.Sprintf(`json:"%s"`, field.JsonName)}
+	if field.In != "" {
+		tags = append(tags, fmt.Sprintf(`in:"%s"`, field.In))
+	}
+	if field.Required {
+		tags = append(tags, `v:"required"`)
+	}
+	if field.Description != "" {
+		description := gstr.ReplaceByMap(strings.TrimSpace(field.Description), tagEscapes)
+		tags = append(tags, fmt.Sprintf(`dc:"%s"`, description))
+	}
+	return strings.Join(tags, " ")
+}
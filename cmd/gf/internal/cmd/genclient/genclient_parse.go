@@ -0,0 +1,528 @@
+// Copyright GoFrame gf Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package genclient
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/text/gregex"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gconv"
+
+	"github.com/gogf/gf/cmd/gf/v2/internal/utility/mlog"
+)
+
+const (
+	schemaRefPrefix      = "#/components/schemas/"
+	parameterRefPrefix   = "#/components/parameters/"
+	requestBodyRefPrefix = "#/components/requestBodies/"
+	responseRefPrefix    = "#/components/responses/"
+)
+
+var (
+	// operationMethods is the HTTP methods of operations in generating order.
+	operationMethods = []string{"get", "post", "put", "patch", "delete", "head", "options"}
+)
+
+// documentParser parses the OpenAPI document into models and operations for generating.
+type documentParser struct {
+	doc        map[string]any
+	schemas    map[string]map[string]any // Component schemas by schema name.
+	typeNames  map[string]string         // Component schema name to go type name.
+	usedNames  map[string]bool           // Used go type names.
+	models     []*clientModel            // Models of components and inline objects.
+	operations []*clientOperation        // Operations in order of path and method.
+	wrapped    bool                      // Whether the responses are wrapped as ghttp.DefaultHandlerResponse.
+}
+
+// clientModel is the go type definition, which is a struct if Type is empty.
+type clientModel struct {
+	Name        string        // Go type name.
+	Comment     string        // Comment of the type.
+	Type        string        // Aliased type like "[]User" for none struct type.
+	Embeds      []string      // Embedded struct type names.
+	Fields      []clientField // Struct fields.
+	Path        string        // Request path for request struct.
+	Method      string        // Request method for request struct.
+	jsonNameSet map[string]bool
+}
+
+// clientField is the struct field of go type definition.
+type clientField struct {
+	Name        string // Field name.
+	JsonName    string // Json name of the field.
+	Type        string // Go type of the field.
+	In          string // Parameter location like "path" and "query", empty for body field.
+	Required    bool   // Whether the field is required.
+	Description string // Description of the field.
+}
+
+// clientOperation is the API operation, which generates a client method.
+type clientOperation struct {
+	Name       string       // Method name.
+	Comment    string       // Comment of the method.
+	Deprecated bool         // Whether the operation is deprecated.
+	Request    *clientModel // Request struct.
+	Response   *clientModel // Response type.
+}
+
+func newDocumentParser(doc map[string]any) *documentParser {
+	return &documentParser{
+		doc:       doc,
+		schemas:   make(map[string]map[string]any),
+		typeNames: make(map[string]string),
+		usedNames: make(map[string]bool),
+	}
+}
+
+// Title returns the title of the document.
+func (p *documentParser) Title() string {
+	title := gconv.String(p.getMap(p.doc, "info")["title"])
+	if title == "" {
+		return "the API"
+	}
+	if version := gconv.String(p.getMap(p.doc, "info")["version"]); version != "" {
+		title += " " + version
+	}
+	return fmt.Sprintf(`"%s"`, title)
+}
+
+// Wrapped returns whether the responses are wrapped as ghttp.DefaultHandlerResponse.
+func (p *documentParser) Wrapped() bool {
+	return p.wrapped
+}
+
+// Parse parses the document into models and operations.
+func (p *documentParser) Parse() error {
+	version := gconv.String(p.doc["openapi"])
+	if !gstr.HasPrefix(version, "3.") {
+		return gerror.Newf(`unsupported OpenAPI version "%s", only OpenAPI v3 is supported`, version)
+	}
+	for name, schema := range p.getMap(p.getMap(p.doc, "components"), "schemas") {
+		p.schemas[name] = gconv.Map(schema)
+	}
+	p.parseTypeNames()
+	schemaNames := p.sortedKeys(p.schemas)
+	for _, name := range schemaNames {
+		// It reserves the position for the model, so that its inline object models follow it.
+		index := len(p.models)
+		p.models = append(p.models, nil)
+		model := p.parseModel(p.typeNames[name], p.schemas[name])
+		model.Comment = p.formatComment(model.Name, fmt.Sprintf(`is the model of schema "%s".`, name), p.schemas[name])
+		p.models[index] = model
+	}
+	paths := p.getMap(p.doc, "paths")
+	for _, path := range p.sortedKeys(paths) {
+		pathItem := gconv.Map(paths[path])
+		for _, method := range operationMethods {
+			if operation := p.getMap(pathItem, method); len(operation) > 0 {
+				p.operations = append(p.operations, p.parseOperation(path, method, pathItem, operation))
+			}
+		}
+	}
+	return nil
+}
+
+// parseTypeNames makes the go type names for component schemas. The GoFrame OpenAPI document names
+// the schemas with package path like "github.com.gogf.demo.api.v1.UserRes", so it uses the last part
+// as the type name if there's no conflict.
+func (p *documentParser) parseTypeNames() {
+	var (
+		names      = p.sortedKeys(p.schemas)
+		shortCount = make(map[string]int)
+	)
+	for _, name := range names {
+		shortCount[p.shortName(name)]++
+	}
+	for _, name := range names {
+		typeName := p.shortName(name)
+		if shortCount[typeName] > 1 {
+			typeName = toGoName(gstr.Replace(name, ".", "_"))
+		}
+		p.typeNames[name] = p.uniqueName(typeName)
+	}
+}
+
+func (p *documentParser) shortName(name string) string {
+	if index := strings.LastIndex(name, "."); index >= 0 {
+		name = name[index+1:]
+	}
+	return toGoName(name)
+}
+
+// uniqueName returns a go type name based on `name` that is not used, and marks it used.
+func (p *documentParser) uniqueName(name string) string {
+	uniqueName := name
+	for i := 2; p.usedNames[uniqueName]; i++ {
+		uniqueName = fmt.Sprintf(`%s%d`, name, i)
+	}
+	p.usedNames[uniqueName] = true
+	return uniqueName
+}
+
+// parseModel parses the schema into model of type name `name`.
+func (p *documentParser) parseModel(name string, schema map[string]any) *clientModel {
+	model := &clientModel{
+		Name:        name,
+		jsonNameSet: make(map[string]bool),
+	}
+	if !p.isObjectSchema(schema) {
+		model.Type = p.goType(name, "", schema, false)
+		return model
+	}
+	p.mergeObjectSchema(model, schema)
+	return model
+}
+
+// mergeObjectSchema merges the properties and embedded types of object schema into struct `model`.
+func (p *documentParser) mergeObjectSchema(model *clientModel, schema map[string]any) {
+	if ref := gconv.String(schema["$ref"]); ref != "" {
+		model.Embeds = append(model.Embeds, p.refTypeName(ref))
+		return
+	}
+	for _, item := range gconv.SliceAny(schema["allOf"]) {
+		p.mergeObjectSchema(model, gconv.Map(item))
+	}
+	var (
+		properties = p.getMap(schema, "properties")
+		required   = make(map[string]bool)
+	)
+	for _, name := range gconv.Strings(schema["required"]) {
+		required[name] = true
+	}
+	for _, jsonName := range p.sortedKeys(properties) {
+		if model.jsonNameSet[jsonName] {
+			continue
+		}
+		var (
+			property  = gconv.Map(properties[jsonName])
+			fieldName = toGoName(jsonName)
+		)
+		model.jsonNameSet[jsonName] = true
+		model.Fields = append(model.Fields, clientField{
+			Name:        fieldName,
+			JsonName:    jsonName,
+			Type:        p.goType(model.Name, fieldName, property, true),
+			Required:    required[jsonName],
+			Description: p.description(property),
+		})
+	}
+}
+
+// parseOperation parses the operation of `method` and `path` into client operation.
+func (p *documentParser) parseOperation(path, method string, pathItem, operation map[string]any) *clientOperation {
+	baseName := toGoName(gconv.String(operation["operationId"]))
+	if baseName == "" {
+		baseName = toGoName(method + "_" + gstr.ReplaceByArray(path, []string{"{", "", "}", ""}))
+	}
+	var (
+		name = baseName
+		op   = &clientOperation{
+			Deprecated: gconv.Bool(operation["deprecated"]),
+		}
+		request = &clientModel{
+			Path:        path,
+			Method:      method,
+			jsonNameSet: make(map[string]bool),
+		}
+	)
+	// The request and response types are named after the method name, which should not conflict.
+	for i := 2; p.usedNames[name+"Req"] || p.usedNames[name+"Res"]; i++ {
+		name = fmt.Sprintf(`%s%d`, baseName, i)
+	}
+	op.Name = name
+	request.Name = p.uniqueName(name + "Req")
+	op.Comment = p.formatComment(name, "", operation)
+	request.Comment = fmt.Sprintf(`// %s is the request of "%s %s".`, request.Name, gstr.ToUpper(method), path)
+	// Parameters, of which the operation ones override the path ones.
+	parameters := make([]map[string]any, 0)
+	for _, item := range append(gconv.SliceAny(operation["parameters"]), gconv.SliceAny(pathItem["parameters"])...) {
+		parameters = append(parameters, p.resolveRef(gconv.Map(item), parameterRefPrefix, "parameters"))
+	}
+	for _, parameter := range parameters {
+		var (
+			jsonName = gconv.String(parameter["name"])
+			in       = gconv.String(parameter["in"])
+		)
+		if jsonName == "" || request.jsonNameSet[jsonName] {
+			continue
+		}
+		if in != "path" && in != "query" {
+			mlog.Printf(`parameter "%s" in %s of "%s %s" is not supported, ignored`, jsonName, in, method, path)
+			continue
+		}
+		fieldName := toGoName(jsonName)
+		request.jsonNameSet[jsonName] = true
+		request.Fields = append(request.Fields, clientField{
+			Name:        fieldName,
+			JsonName:    jsonName,
+			Type:        p.goType(request.Name, fieldName, p.getMap(parameter, "schema"), false),
+			In:          in,
+			Required:    in == "path" || gconv.Bool(parameter["required"]),
+			Description: p.description(parameter),
+		})
+	}
+	// Request body.
+	requestBody := p.resolveRef(p.getMap(operation, "requestBody"), requestBodyRefPrefix, "requestBodies")
+	if bodySchema := p.contentSchema(requestBody); len(bodySchema) > 0 {
+		if p.isObjectSchema(bodySchema) {
+			p.mergeObjectSchema(request, bodySchema)
+		} else {
+			mlog.Printf(`request body of none object type of "%s %s" is not supported, ignored`, method, path)
+		}
+	}
+	op.Request = request
+	// Response.
+	var (
+		response       = p.successResponse(operation)
+		responseSchema = p.contentSchema(response)
+	)
+	if data, ok := p.unwrapResponseSchema(responseSchema); ok {
+		p.wrapped = true
+		responseSchema = data
+	}
+	responseName := p.uniqueName(name + "Res")
+	switch {
+	case len(responseSchema) == 0:
+		op.Response = &clientModel{Name: responseName}
+	case gconv.String(responseSchema["$ref"]) != "" || !p.isObjectSchema(responseSchema):
+		op.Response = &clientModel{
+			Name: responseName,
+			Type: p.goType(responseName, "", responseSchema, false),
+		}
+	default:
+		op.Response = p.parseModel(responseName, responseSchema)
+	}
+	op.Response.Comment = fmt.Sprintf(`// %s is the response of "%s %s".`, responseName, gstr.ToUpper(method), path)
+	return op
+}
+
+// successResponse returns the first success response of the operation.
+func (p *documentParser) successResponse(operation map[string]any) map[string]any {
+	responses := p.getMap(operation, "responses")
+	for _, status := range p.sortedKeys(responses) {
+		if gstr.HasPrefix(status, "2") {
+			return p.resolveRef(gconv.Map(responses[status]), responseRefPrefix, "responses")
+		}
+	}
+	return p.resolveRef(p.getMap(responses, "default"), responseRefPrefix, "responses")
+}
+
+// unwrapResponseSchema returns the schema of "data" if `schema` is the schema of ghttp.DefaultHandlerResponse.
+func (p *documentParser) unwrapResponseSchema(schema map[string]any) (map[string]any, bool) {
+	properties := p.getMap(p.resolveSchema(schema), "properties")
+	if len(properties) != 3 {
+		return nil, false
+	}
+	for _, name := range []string{"code", "message", "data"} {
+		if _, ok := properties[name]; !ok {
+			return nil, false
+		}
+	}
+	return gconv.Map(properties["data"]), true
+}
+
+// contentSchema returns the JSON schema of the content of request body or response.
+func (p *documentParser) contentSchema(item map[string]any) map[string]any {
+	var (
+		content  = p.getMap(item, "content")
+		mimeKeys = p.sortedKeys(content)
+	)
+	for _, mime := range mimeKeys {
+		if gstr.Contains(mime, "json") {
+			return p.getMap(gconv.Map(content[mime]), "schema")
+		}
+	}
+	if len(mimeKeys) > 0 {
+		return p.getMap(gconv.Map(content[mimeKeys[0]]), "schema")
+	}
+	return nil
+}
+
+// goType returns the go type of `schema`, which creates model for inline object with properties.
+// The parameters `parentName` and `fieldName` are used for naming the inline object model.
+func (p *documentParser) goType(parentName, fieldName string, schema map[string]any, pointer bool) string {
+	if ref := gconv.String(schema["$ref"]); ref != "" {
+		typeName := p.refTypeName(ref)
+		if pointer && p.isObjectSchema(p.resolveSchema(schema)) {
+			return "*" + typeName
+		}
+		return typeName
+	}
+	if allOf := gconv.SliceAny(schema["allOf"]); len(allOf) == 1 {
+		return p.goType(parentName, fieldName, gconv.Map(allOf[0]), pointer)
+	}
+	if len(gconv.SliceAny(schema["oneOf"])) > 0 || len(gconv.SliceAny(schema["anyOf"])) > 0 {
+		return "any"
+	}
+	switch gconv.String(schema["type"]) {
+	case "string":
+		switch gconv.String(schema["format"]) {
+		case "date-time", "date":
+			return "*gtime.Time"
+		case "binary", "byte":
+			return "[]byte"
+		}
+		return "string"
+
+	case "integer":
+		switch gconv.String(schema["format"]) {
+		case "int64":
+			return "int64"
+		case "uint64":
+			return "uint64"
+		}
+		return "int"
+
+	case "number":
+		if gconv.String(schema["format"]) == "float" {
+			return "float32"
+		}
+		return "float64"
+
+	case "boolean":
+		return "bool"
+
+	case "array":
+		return "[]" + p.goType(parentName, fieldName, p.getMap(schema, "items"), false)
+	}
+	if p.isObjectSchema(schema) {
+		if len(p.getMap(schema, "properties")) > 0 || len(gconv.SliceAny(schema["allOf"])) > 0 {
+			index := len(p.models)
+			p.models = append(p.models, nil)
+			model := p.parseModel(p.uniqueName(parentName+fieldName), schema)
+			model.Comment = p.formatComment(model.Name, fmt.Sprintf(`is the type of %s.%s.`, parentName, fieldName), schema)
+			p.models[index] = model
+			if pointer {
+				return "*" + model.Name
+			}
+			return model.Name
+		}
+		if additional, ok := schema["additionalProperties"].(map[string]any); ok && len(additional) > 0 {
+			return "map[string]" + p.goType(parentName, fieldName, additional, false)
+		}
+		return "map[string]any"
+	}
+	return "any"
+}
+
+// isObjectSchema checks whether `schema` is an object schema, of which the reference is resolved.
+func (p *documentParser) isObjectSchema(schema map[string]any) bool {
+	schema = p.resolveSchema(schema)
+	if gconv.String(schema["type"]) == "object" || len(p.getMap(schema, "properties")) > 0 {
+		return true
+	}
+	for _, item := range gconv.SliceAny(schema["allOf"]) {
+		if !p.isObjectSchema(gconv.Map(item)) {
+			return false
+		}
+	}
+	return len(gconv.SliceAny(schema["allOf"])) > 0
+}
+
+// resolveSchema returns the referenced schema if `schema` is a reference.
+func (p *documentParser) resolveSchema(schema map[string]any) map[string]any {
+	for i := 0; i < 10; i++ {
+		ref := gconv.String(schema["$ref"])
+		if ref == "" {
+			break
+		}
+		schema = p.schemas[gstr.TrimLeftStr(ref, schemaRefPrefix)]
+	}
+	return schema
+}
+
+// resolveRef returns the referenced component of `componentType` if `item` is a reference.
+func (p *documentParser) resolveRef(item map[string]any, refPrefix, componentType string) map[string]any {
+	ref := gconv.String(item["$ref"])
+	if ref == "" || !gstr.HasPrefix(ref, refPrefix) {
+		return item
+	}
+	return p.getMap(p.getMap(p.getMap(p.doc, "components"), componentType), gstr.TrimLeftStr(ref, refPrefix))
+}
+
+// refTypeName returns the go type name of schema reference.
+func (p *documentParser) refTypeName(ref string) string {
+	if typeName, ok := p.typeNames[gstr.TrimLeftStr(ref, schemaRefPrefix)]; ok {
+		return typeName
+	}
+	mlog.Printf(`schema reference "%s" not found, it uses type "any" instead`, ref)
+	return "any"
+}
+
+// description returns the description or else the title of the item.
+func (p *documentParser) description(item map[string]any) string {
+	if description := gconv.String(item["description"]); description != "" {
+		return description
+	}
+	if summary := gconv.String(item["summary"]); summary != "" {
+		return summary
+	}
+	return gconv.String(item["title"])
+}
+
+// formatComment formats the comment for type or method `name` using the description of `item`.
+func (p *documentParser) formatComment(name, defaultComment string, item map[string]any) string {
+	var (
+		lines       = make([]string, 0)
+		summary     = gconv.String(item["summary"])
+		description = gconv.String(item["description"])
+	)
+	if summary == "" {
+		summary, description = description, ""
+	}
+	if summary == "" {
+		summary = defaultComment
+	}
+	if !gstr.HasPrefix(summary, name+" ") {
+		summary = name + " " + summary
+	}
+	lines = append(lines, strings.TrimSpace(summary))
+	if description != "" && description != summary {
+		lines = append(lines, "")
+		lines = append(lines, gstr.SplitAndTrim(description, "\n")...)
+	}
+	if gconv.Bool(item["deprecated"]) {
+		lines = append(lines, "", "Deprecated: this API is deprecated.")
+	}
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace("// " + line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *documentParser) getMap(data map[string]any, key string) map[string]any {
+	if data == nil {
+		return nil
+	}
+	return gconv.Map(data[key])
+}
+
+func (p *documentParser) sortedKeys(data any) []string {
+	keys := make([]string, 0)
+	for key := range gconv.Map(data) {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// toGoName converts `s` to exported go identifier in camel case.
+func toGoName(s string) string {
+	s, _ = gregex.ReplaceString(`[^\w]+`, "_", s)
+	name := gstr.CaseCamel(s)
+	if name == "" {
+		return ""
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "N" + name
+	}
+	return name
+}
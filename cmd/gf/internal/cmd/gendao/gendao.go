@@ -56,6 +56,8 @@ type (
 		TplDaoInternalPath string   `name:"tplDaoInternalPath"  short:"t2" brief:"{CGenDaoBriefTplDaoInternalPath}"`
 		TplDaoDoPath       string   `name:"tplDaoDoPath"        short:"t3" brief:"{CGenDaoBriefTplDaoDoPathPath}"`
 		TplDaoEntityPath   string   `name:"tplDaoEntityPath"    short:"t4" brief:"{CGenDaoBriefTplDaoEntityPath}"`
+		TplPath            string   `name:"tplPath"             short:"tpl" brief:"{CGenDaoBriefTplPath}"`
+		Hooks              []string `name:"hooks"               short:"hk" brief:"{CGenDaoBriefHooks}"`
 		StdTime            bool     `name:"stdTime"             short:"s"  brief:"{CGenDaoBriefStdTime}" orphan:"true"`
 		WithTime           bool     `name:"withTime"            short:"w"  brief:"{CGenDaoBriefWithTime}" orphan:"true"`
 		GJsonSupport       bool     `name:"gJsonSupport"        short:"n"  brief:"{CGenDaoBriefGJsonSupport}" orphan:"true"`
@@ -68,6 +70,7 @@ type (
 
 		TypeMapping  map[DBFieldTypeName]CustomAttributeType  `name:"typeMapping"  short:"y"  brief:"{CGenDaoBriefTypeMapping}"  orphan:"true"`
 		FieldMapping map[DBTableFieldName]CustomAttributeType `name:"fieldMapping" short:"fm" brief:"{CGenDaoBriefFieldMapping}" orphan:"true"`
+		TplVars      map[string]any                           `name:"tplVars"      short:"tv" brief:"{CGenDaoBriefTplVars}"      orphan:"true"`
 
 		// internal usage purpose.
 		genItems *CGenDaoInternalGenItems
@@ -330,6 +333,9 @@ func doGenDaoForArray(ctx context.Context, index int, in CGenDaoInput) {
 	})
 
 	in.genItems.SetClear(in.Clear)
+
+	// Hooks after generation.
+	doGenDaoHooks(ctx, in)
 }
 
 func getImportPartContent(ctx context.Context, source string, isDo bool, appendImports []string) string {
@@ -396,6 +402,7 @@ func assignDefaultVar(view *gview.View, in CGenDaoInternalInput) {
 	view.Assigns(g.Map{
 		tplVarDatetimeStr:          tplDatetimeStr,
 		tplVarCreatedAtDatetimeStr: tplCreatedAtDatetimeStr,
+		tplVarCustomVars:           in.TplVars,
 	})
 }
 
@@ -423,6 +430,18 @@ func sortFieldKeyForDao(fieldMap map[string]*gdb.TableField) []string {
 	return result
 }
 
+// getTemplateFilePath returns the template file path of `filePath` if it is specified,
+// or else the file `fileName` under template directory `tplPath` if it exists.
+func getTemplateFilePath(tplPath, filePath, fileName string) string {
+	if filePath != "" || tplPath == "" {
+		return filePath
+	}
+	if path := gfile.Join(tplPath, fileName); gfile.Exists(path) {
+		return path
+	}
+	return ""
+}
+
 func getTemplateFromPathOrDefault(filePath string, def string) string {
 	if filePath != "" {
 		if contents := gfile.GetContents(filePath); contents != "" {
@@ -121,7 +121,7 @@ func generateDaoIndex(in generateDaoIndexInput) {
 		var (
 			ctx        = context.Background()
 			tplContent = getTemplateFromPathOrDefault(
-				in.TplDaoIndexPath, consts.TemplateGenDaoIndexContent,
+				getTemplateFilePath(in.TplPath, in.TplDaoIndexPath, tplFileDaoIndex), consts.TemplateGenDaoIndexContent,
 			)
 		)
 		tplView.ClearAssigns()
@@ -134,6 +134,7 @@ func generateDaoIndex(in generateDaoIndexInput) {
 			tplVarTableNameCamelLowerCase: in.TableNameCamelLowerCase,
 			tplVarPackageName:             filepath.Base(in.DaoPath),
 		})
+		assignDefaultVar(tplView, in.CGenDaoInternalInput)
 		indexContent, err := tplView.ParseContent(ctx, tplContent)
 		if err != nil {
 			mlog.Fatalf("parsing template content failed: %v", err)
@@ -161,7 +162,7 @@ func generateDaoInternal(in generateDaoInternalInput) {
 		ctx                    = context.Background()
 		removeFieldPrefixArray = gstr.SplitAndTrim(in.RemoveFieldPrefix, ",")
 		tplContent             = getTemplateFromPathOrDefault(
-			in.TplDaoInternalPath, consts.TemplateGenDaoInternalContent,
+			getTemplateFilePath(in.TplPath, in.TplDaoInternalPath, tplFileDaoInternal), consts.TemplateGenDaoInternalContent,
 		)
 	)
 	tplView.ClearAssigns()
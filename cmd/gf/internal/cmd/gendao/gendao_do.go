@@ -80,7 +80,7 @@ func generateDoContent(
 ) string {
 	var (
 		tplContent = getTemplateFromPathOrDefault(
-			in.TplDaoDoPath, consts.TemplateGenDaoDoContent,
+			getTemplateFilePath(in.TplPath, in.TplDaoDoPath, tplFileDaoDo), consts.TemplateGenDaoDoContent,
 		)
 	)
 	tplView.ClearAssigns()
@@ -65,7 +65,7 @@ func generateEntityContent(
 ) string {
 	var (
 		tplContent = getTemplateFromPathOrDefault(
-			in.TplDaoEntityPath, consts.TemplateGenDaoEntityContent,
+			getTemplateFilePath(in.TplPath, in.TplDaoEntityPath, tplFileDaoEntity), consts.TemplateGenDaoEntityContent,
 		)
 	)
 	tplView.ClearAssigns()
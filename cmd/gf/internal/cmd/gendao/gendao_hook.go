@@ -0,0 +1,53 @@
+// Copyright GoFrame gf Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gendao
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/os/gproc"
+	"github.com/gogf/gf/v2/text/gstr"
+
+	"github.com/gogf/gf/cmd/gf/v2/internal/utility/mlog"
+)
+
+const (
+	hookPlaceholderPath  = `{path}`
+	hookPlaceholderFiles = `{files}`
+)
+
+// doGenDaoHooks runs the hook commands one by one after generation of current configuration item.
+func doGenDaoHooks(ctx context.Context, in CGenDaoInput) {
+	if len(in.Hooks) == 0 {
+		return
+	}
+	var generatedFilePaths = make([]string, 0)
+	for _, filePath := range in.genItems.Items[in.genItems.index].GeneratedFilePaths {
+		if gfile.Exists(filePath) {
+			generatedFilePaths = append(generatedFilePaths, filePath)
+		}
+	}
+	for _, hook := range in.Hooks {
+		command := formatGenDaoHookCommand(hook, in.Path, generatedFilePaths)
+		if command == "" {
+			continue
+		}
+		mlog.Print("hook:", command)
+		if err := gproc.ShellRun(ctx, command); err != nil {
+			mlog.Fatalf(`running hook "%s" failed: %+v`, command, err)
+		}
+	}
+}
+
+// formatGenDaoHookCommand replaces the placeholders of the hook command.
+func formatGenDaoHookCommand(hook, path string, generatedFilePaths []string) string {
+	return gstr.Trim(gstr.ReplaceByMap(hook, map[string]string{
+		hookPlaceholderPath:  path,
+		hookPlaceholderFiles: gstr.Join(generatedFilePaths, " "),
+	}))
+}
@@ -136,7 +136,7 @@ func generateStructFieldDefinition(
 
 	var (
 		tagKey         = "`"
-		descriptionTag = gstr.Replace(formatComment(field.Comment), `"`, `\"`)
+		descriptionTag = formatDescriptionTag(field.Comment)
 	)
 	removeFieldPrefixArray := gstr.SplitAndTrim(in.RemoveFieldPrefix, ",")
 	newFiledName := field.Name
@@ -228,3 +228,13 @@ func formatComment(comment string) string {
 	comment = gstr.Trim(comment)
 	return comment
 }
+
+// formatDescriptionTag formats the comment string to fit the value of the description tag,
+// which is used as the description of the field in OpenAPI.
+func formatDescriptionTag(comment string) string {
+	return gstr.ReplaceByArray(formatComment(comment), g.SliceStr{
+		`\`, `\\`,
+		`"`, `\"`,
+		"`", `'`,
+	})
+}
@@ -80,7 +80,7 @@ func generateTableSingle(ctx context.Context, in generateTableSingleInput) {
 		var (
 			ctx        = context.Background()
 			tplContent = getTemplateFromPathOrDefault(
-				in.TplDaoTablePath, consts.TemplateGenTableContent,
+				getTemplateFilePath(in.TplPath, in.TplDaoTablePath, tplFileDaoTable), consts.TemplateGenTableContent,
 			)
 		)
 		tplView.ClearAssigns()
@@ -91,6 +91,7 @@ func generateTableSingle(ctx context.Context, in generateTableSingleInput) {
 			tplVarPackageName:        filepath.Base(in.TablePath),
 			tplVarTableFields:        generateTableFields(fieldMap),
 		})
+		assignDefaultVar(tplView, in.CGenDaoInternalInput)
 		indexContent, err := tplView.ParseContent(ctx, tplContent)
 		if err != nil {
 			mlog.Fatalf("parsing template content failed: %v", err)
@@ -47,6 +47,12 @@ CONFIGURATION SUPPORT
 			table_name.field_name:
 			  type:   decimal.Decimal
 			  import: github.com/shopspring/decimal
+		  tplPath: "./hack/template/dao"
+		  tplVars:
+			tenantField: tenant_id
+		  hooks:
+		  - "gofumpt -w {path}"
+		  - "golangci-lint run {path}/..."
 `
 	CGenDaoBriefPath              = `directory path for generated files`
 	CGenDaoBriefLink              = `database configuration, the same as the ORM configuration of GoFrame`
@@ -94,6 +100,22 @@ generated json tag case for model struct, cases are as follows:
 	CGenDaoBriefTplDaoInternalPath = `template file path for dao internal file`
 	CGenDaoBriefTplDaoDoPathPath   = `template file path for dao do file`
 	CGenDaoBriefTplDaoEntityPath   = `template file path for dao entity file`
+	CGenDaoBriefTplPath            = `
+template directory path containing custom template files, which are used if the template file exists:
+| File             | Usage                                   |
+|------------------|-----------------------------------------|
+| dao_index.tpl    | dao index file                          |
+| dao_internal.tpl | dao internal file                       |
+| dao_table.tpl    | table fields file                       |
+| do.tpl           | do file                                 |
+| entity.tpl       | entity file                             |
+the template file path specified by "tplDao*Path" options has higher priority than the template directory
+`
+	CGenDaoBriefTplVars = `custom variables for templates, which can be used as "{{.TplVars.name}}" in template files`
+	CGenDaoBriefHooks   = `
+shell commands executed one by one after generation like "gofumpt -w {path}", the generation fails if any command fails.
+the placeholder "{path}" is replaced with the generating directory path, and "{files}" with the generated file paths
+`
 
 	tplVarTableName               = `TplTableName`
 	tplVarTableNameCamelCase      = `TplTableNameCamelCase`
@@ -110,6 +132,13 @@ generated json tag case for model struct, cases are as follows:
 	tplVarDatetimeStr             = `TplDatetimeStr`
 	tplVarCreatedAtDatetimeStr    = `TplCreatedAtDatetimeStr`
 	tplVarPackageName             = `TplPackageName`
+	tplVarCustomVars              = `TplVars`
+
+	tplFileDaoIndex    = `dao_index.tpl`
+	tplFileDaoInternal = `dao_internal.tpl`
+	tplFileDaoTable    = `dao_table.tpl`
+	tplFileDaoDo       = `do.tpl`
+	tplFileDaoEntity   = `entity.tpl`
 )
 
 func init() {
@@ -151,5 +180,8 @@ func init() {
 		`CGenDaoBriefTplDaoInternalPath`: CGenDaoBriefTplDaoInternalPath,
 		`CGenDaoBriefTplDaoDoPathPath`:   CGenDaoBriefTplDaoDoPathPath,
 		`CGenDaoBriefTplDaoEntityPath`:   CGenDaoBriefTplDaoEntityPath,
+		`CGenDaoBriefTplPath`:            CGenDaoBriefTplPath,
+		`CGenDaoBriefTplVars`:            CGenDaoBriefTplVars,
+		`CGenDaoBriefHooks`:              CGenDaoBriefHooks,
 	})
 }
@@ -9,6 +9,8 @@ package gendao
 import (
 	"testing"
 
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/os/gtime"
 	"github.com/gogf/gf/v2/test/gtest"
 )
 
@@ -180,3 +182,43 @@ func Test_filterTablesByPatterns_NonExistent(t *testing.T) {
 		t.AssertNI("nonexistent", result)
 	})
 }
+
+// Test getTemplateFilePath with template directory.
+func Test_getTemplateFilePath(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		tplPath := gfile.Temp(gtime.TimestampNanoStr())
+		defer gfile.Remove(tplPath)
+		t.AssertNil(gfile.PutContents(gfile.Join(tplPath, tplFileDaoEntity), "entity"))
+
+		// Template file path has higher priority.
+		t.Assert(getTemplateFilePath(tplPath, "/custom/entity.tpl", tplFileDaoEntity), "/custom/entity.tpl")
+		t.Assert(getTemplateFilePath(tplPath, "", tplFileDaoEntity), gfile.Join(tplPath, tplFileDaoEntity))
+		// Template file does not exist in template directory.
+		t.Assert(getTemplateFilePath(tplPath, "", tplFileDaoDo), "")
+		t.Assert(getTemplateFilePath("", "", tplFileDaoDo), "")
+	})
+}
+
+// Test formatGenDaoHookCommand placeholders.
+func Test_formatGenDaoHookCommand(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		files := []string{"internal/dao/user.go", "internal/model/entity/user.go"}
+		t.Assert(formatGenDaoHookCommand("gofumpt -w {path}", "internal", files), "gofumpt -w internal")
+		t.Assert(
+			formatGenDaoHookCommand("gofumpt -w {files}", "internal", files),
+			"gofumpt -w internal/dao/user.go internal/model/entity/user.go",
+		)
+		t.Assert(formatGenDaoHookCommand(" ", "internal", files), "")
+	})
+}
+
+// Test formatDescriptionTag escaping.
+func Test_formatDescriptionTag(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(formatDescriptionTag("User ID"), "User ID")
+		t.Assert(formatDescriptionTag("Line1\nLine2\r\n"), "Line1 Line2")
+		t.Assert(formatDescriptionTag(`The "nick" name`), `The \"nick\" name`)
+		t.Assert(formatDescriptionTag("The `nick` name"), "The 'nick' name")
+		t.Assert(formatDescriptionTag(`C:\dir`), `C:\\dir`)
+	})
+}
@@ -55,6 +55,7 @@ type (
 
 		TypeMapping  map[DBFieldTypeName]CustomAttributeType  `name:"typeMapping"  short:"y"  brief:"{CGenPbEntityBriefTypeMapping}"  orphan:"true"`
 		FieldMapping map[DBTableFieldName]CustomAttributeType `name:"fieldMapping" short:"fm" brief:"{CGenPbEntityBriefFieldMapping}" orphan:"true"`
+		EnumMapping  map[DBTableFieldName]CustomEnumType      `name:"enumMapping"  short:"em" brief:"{CGenPbEntityBriefEnumMapping}"  orphan:"true"`
 	}
 	CGenPbEntityOutput struct{}
 
@@ -71,6 +72,10 @@ type (
 		Type   string `brief:"custom attribute type name"`
 		Import string `brief:"custom import for this type"`
 	}
+	CustomEnumType struct {
+		Name   string   `brief:"custom enum name, default is the camel case of table and field name"`
+		Values []string `brief:"enum values in order, default is the values of enum type of the field"`
+	}
 )
 
 const (
@@ -114,6 +119,10 @@ CONFIGURATION SUPPORT
               jsonb:
                 type: google.protobuf.Value
                 import: google/protobuf/struct.proto
+            enumMapping:
+              user.status:
+                name: UserStatus
+                values: [active, disabled]
 `
 	CGenPbEntityBriefPath              = `directory path for generated files storing`
 	CGenPbEntityBriefPackage           = `package path for all entity proto files`
@@ -151,6 +160,11 @@ set it to "none" to ignore json tag generating.
 
 	CGenPbEntityBriefTypeMapping  = `custom local type mapping for generated struct attributes relevant to fields of table`
 	CGenPbEntityBriefFieldMapping = `custom local type mapping for generated struct attributes relevant to specific fields of table`
+	CGenPbEntityBriefEnumMapping  = `
+custom enum mapping for specific fields of table like "table_name.field_name", which generates proto enum for the field.
+the enum values are "values" of the configuration or else the values of enum type of the field, in order,
+and the zero value "{ENUM_NAME}_UNSPECIFIED" is always generated as the first value
+`
 )
 
 var defaultTypeMapping = map[DBFieldTypeName]CustomAttributeType{
@@ -259,6 +273,7 @@ func init() {
 		`CGenPbEntityBriefShardingPattern`:   CGenPbEntityBriefShardingPattern,
 		`CGenPbEntityBriefTypeMapping`:       CGenPbEntityBriefTypeMapping,
 		`CGenPbEntityBriefFieldMapping`:      CGenPbEntityBriefFieldMapping,
+		`CGenPbEntityBriefEnumMapping`:       CGenPbEntityBriefEnumMapping,
 	})
 }
 
@@ -403,15 +418,13 @@ func generatePbEntityContentFile(ctx context.Context, in CGenPbEntityInternalInp
 		fileName                           = gstr.Trim(tableNameSnakeCase, "-_.")
 		path                               = filepath.FromSlash(gfile.Join(in.Path, fileName+".proto"))
 	)
-	packageImportStr := ""
-	var packageImportsArray = garray.NewStrArray()
-	if len(appendImports) > 0 {
-		for _, appendImport := range appendImports {
-			packageImportStr = fmt.Sprintf(`import "%s";`, appendImport)
-			if packageImportsArray.Search(packageImportStr) == -1 {
-				packageImportsArray.Append(packageImportStr)
-			}
-		}
+	if enumDefine := generateEnumDefinitions(fieldMap, in); enumDefine != "" {
+		entityMessageDefine = enumDefine + "\n\n" + entityMessageDefine
+	}
+	// The imports are sorted for stable content of regenerating.
+	var packageImportsArray = garray.NewSortedStrArray().SetUnique(true)
+	for _, appendImport := range appendImports {
+		packageImportsArray.Add(fmt.Sprintf(`import "%s";`, appendImport))
 	}
 	if in.GoPackage == "" {
 		in.GoPackage = in.Package
@@ -423,7 +436,13 @@ func generatePbEntityContentFile(ctx context.Context, in CGenPbEntityInternalInp
 		"{OptionContent}": in.Option,
 		"{EntityMessage}": entityMessageDefine,
 	})
-	if err := gfile.PutContents(path, strings.TrimSpace(entityContent)); err != nil {
+	entityContent = strings.TrimSpace(entityContent)
+	// It does not rewrite the file if the content is not changed, which avoids churn of regenerating.
+	if gfile.Exists(path) && gfile.GetContents(path) == entityContent {
+		mlog.Print("unchanged:", gfile.RealPath(path))
+		return
+	}
+	if err := gfile.PutContents(path, entityContent); err != nil {
 		mlog.Fatalf("writing content to '%s' failed: %v", path, err)
 	} else {
 		mlog.Print("generated:", gfile.RealPath(path))
@@ -508,13 +527,7 @@ func generateMessageFieldForPbEntity(index int, field *gdb.TableField, in CGenPb
 		localTypeNameStr = "string"
 	}
 
-	comment = gstr.ReplaceByArray(field.Comment, g.SliceStr{
-		"\n", " ",
-		"\r", " ",
-	})
-	comment = gstr.Trim(comment)
-	comment = gstr.Replace(comment, `\n`, " ")
-	comment, _ = gregex.ReplaceString(`\s{2,}`, ` `, comment)
+	comment = formatComment(field.Comment)
 	if jsonTagName := formatCase(field.Name, in.JsonCase); jsonTagName != "" {
 		jsonTagStr = fmt.Sprintf(`[json_name = "%s"]`, jsonTagName)
 		// beautiful indent.
@@ -542,15 +555,101 @@ func generateMessageFieldForPbEntity(index int, field *gdb.TableField, in CGenPb
 			appendImport = typeMapping.Import
 		}
 	}
+	if enumType, ok := in.EnumMapping[fmt.Sprintf("%s.%s", in.TableName, newFiledName)]; ok {
+		localTypeNameStr = getEnumName(enumType, newFiledName, in)
+		appendImport = ""
+	}
+	if comment != "" {
+		comment = fmt.Sprintf(`// %s`, comment)
+	}
 
 	return []string{
 		"    #" + localTypeNameStr,
 		" #" + formatCase(newFiledName, in.NameCase),
 		" #= " + gconv.String(index) + jsonTagStr + ";",
-		" #" + fmt.Sprintf(`// %s`, comment),
+		" #" + comment,
 	}, appendImport
 }
 
+// generateEnumDefinitions generates and returns the enum definitions for the fields of `enumMapping`.
+func generateEnumDefinitions(fieldMap map[string]*gdb.TableField, in CGenPbEntityInternalInput) string {
+	if len(in.EnumMapping) == 0 {
+		return ""
+	}
+	var (
+		enumDefines            = make([]string, 0)
+		removeFieldPrefixArray = gstr.SplitAndTrim(in.RemoveFieldPrefix, ",")
+	)
+	for _, name := range sortFieldKeyForPbEntity(fieldMap) {
+		var (
+			field        = fieldMap[name]
+			newFieldName = field.Name
+		)
+		for _, v := range removeFieldPrefixArray {
+			newFieldName = gstr.TrimLeftStr(newFieldName, v, 1)
+		}
+		enumType, ok := in.EnumMapping[fmt.Sprintf("%s.%s", in.TableName, newFieldName)]
+		if !ok {
+			continue
+		}
+		var (
+			enumName   = getEnumName(enumType, newFieldName, in)
+			enumPrefix = gstr.CaseSnakeScreaming(enumName)
+			enumValues = enumType.Values
+			buffer     = bytes.NewBuffer(nil)
+		)
+		if len(enumValues) == 0 {
+			enumValues = getEnumValuesFromFieldType(field.Type)
+		}
+		if field.Comment != "" {
+			buffer.WriteString(fmt.Sprintf("// %s %s\n", enumName, formatComment(field.Comment)))
+		}
+		buffer.WriteString(fmt.Sprintf("enum %s {\n", enumName))
+		buffer.WriteString(fmt.Sprintf("    %s_UNSPECIFIED = 0;\n", enumPrefix))
+		for i, value := range enumValues {
+			buffer.WriteString(fmt.Sprintf(
+				"    %s_%s = %d;\n", enumPrefix, gstr.CaseSnakeScreaming(value), i+1,
+			))
+		}
+		buffer.WriteString("}")
+		enumDefines = append(enumDefines, buffer.String())
+	}
+	return gstr.Join(enumDefines, "\n\n")
+}
+
+// getEnumName returns the enum name of the field.
+func getEnumName(enumType CustomEnumType, fieldName string, in CGenPbEntityInternalInput) string {
+	if enumType.Name != "" {
+		return enumType.Name
+	}
+	return gstr.CaseCamel(in.Prefix+in.NewTableName) + gstr.CaseCamel(fieldName)
+}
+
+// getEnumValuesFromFieldType returns the values of enum type like "enum('a','b')" of the field.
+func getEnumValuesFromFieldType(fieldType string) []string {
+	match, _ := gregex.MatchString(`(?i)^enum\s*\((.+)\)`, fieldType)
+	if len(match) < 2 {
+		return nil
+	}
+	var values = make([]string, 0)
+	for _, v := range gstr.SplitAndTrim(match[1], ",") {
+		values = append(values, gstr.Trim(v, `'"`))
+	}
+	return values
+}
+
+// formatComment formats the column comment as single line comment.
+func formatComment(comment string) string {
+	comment = gstr.ReplaceByArray(comment, g.SliceStr{
+		"\n", " ",
+		"\r", " ",
+	})
+	comment = gstr.Trim(comment)
+	comment = gstr.Replace(comment, `\n`, " ")
+	comment, _ = gregex.ReplaceString(`\s{2,}`, ` `, comment)
+	return comment
+}
+
 func getTplPbEntityContent(tplEntityPath string) string {
 	if tplEntityPath != "" {
 		return gfile.GetContents(tplEntityPath)
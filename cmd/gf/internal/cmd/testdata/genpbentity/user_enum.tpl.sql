@@ -0,0 +1,7 @@
+CREATE TABLE `%s` (
+    `id` int unsigned NOT NULL AUTO_INCREMENT COMMENT 'User ID',
+    `passport` varchar(45) NOT NULL COMMENT 'User Passport',
+    `status` enum('active','disabled') NOT NULL DEFAULT 'active' COMMENT 'User Status',
+    `level` tinyint NOT NULL DEFAULT 0,
+    PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
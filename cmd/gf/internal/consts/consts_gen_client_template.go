@@ -0,0 +1,172 @@
+// Copyright GoFrame gf Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package consts
+
+const TemplateGenClientClient = `
+// =================================================================================
+// Code generated and maintained by GoFrame CLI tool. DO NOT EDIT.
+// =================================================================================
+
+package {PkgName}
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gogf/gf/contrib/sdk/httpclient/v2"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/net/gclient"
+	"github.com/gogf/gf/v2/net/ghttp"
+)
+
+// Client is the API client of {Title}.
+type Client struct {
+	*httpclient.Client
+}
+
+// New creates and returns the API client.
+func New(config httpclient.Config) *Client {
+	if config.Handler == nil {
+		config.Handler = &Handler{Wrapped: {Wrapped}}
+	}
+	return &Client{
+		Client: httpclient.New(config),
+	}
+}
+
+// Handler handles the API response and maps the failed response to *Error.
+type Handler struct {
+	// Wrapped specifies whether the response is wrapped as ghttp.DefaultHandlerResponse.
+	Wrapped bool
+}
+
+// Error is the error of failed API response, of which the error code can be retrieved by gerror.Code.
+type Error struct {
+	Status  int    // HTTP status code.
+	Message string // Error message.
+	code    gcode.Code
+}
+
+// Error implements interface error.
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d: %s", e.Status, e.Message)
+}
+
+// Code returns the error code.
+func (e *Error) Code() gcode.Code {
+	return e.code
+}
+
+// HandleResponse implements interface httpclient.Handler.
+func (h *Handler) HandleResponse(ctx context.Context, res *gclient.Response, out any) error {
+	defer res.Close()
+	body := res.ReadAll()
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return newError(res.StatusCode, body)
+	}
+	if len(body) == 0 || out == nil {
+		return nil
+	}
+	if !h.Wrapped {
+		if err := json.Unmarshal(body, out); err != nil {
+			return gerror.Wrapf(err, "json.Unmarshal failed with content: %s", body)
+		}
+		return nil
+	}
+	result := ghttp.DefaultHandlerResponse{
+		Data: out,
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return gerror.Wrapf(err, "json.Unmarshal failed with content: %s", body)
+	}
+	if result.Code != gcode.CodeOK.Code() {
+		return &Error{
+			Status:  res.StatusCode,
+			Message: result.Message,
+			code:    gcode.New(result.Code, result.Message, nil),
+		}
+	}
+	return nil
+}
+
+// newError creates the error from HTTP status and response body.
+func newError(status int, body []byte) *Error {
+	var result struct {
+		Code    int    ` + "`json:\"code\"`" + `
+		Message string ` + "`json:\"message\"`" + `
+	}
+	_ = json.Unmarshal(body, &result)
+	if result.Message == "" {
+		result.Message = http.StatusText(status)
+	}
+	err := &Error{
+		Status:  status,
+		Message: result.Message,
+	}
+	if result.Code != 0 {
+		err.code = gcode.New(result.Code, result.Message, nil)
+		return err
+	}
+	switch {
+	case status == http.StatusBadRequest || status == http.StatusUnprocessableEntity:
+		err.code = gcode.CodeInvalidParameter
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		err.code = gcode.CodeNotAuthorized
+	case status == http.StatusNotFound:
+		err.code = gcode.CodeNotFound
+	case status == http.StatusNotImplemented:
+		err.code = gcode.CodeNotImplemented
+	case status >= http.StatusInternalServerError:
+		err.code = gcode.CodeInternalError
+	default:
+		err.code = gcode.CodeUnknown
+	}
+	return err
+}
+`
+
+const TemplateGenClientModel = `
+// =================================================================================
+// Code generated and maintained by GoFrame CLI tool. DO NOT EDIT.
+// =================================================================================
+
+package {PkgName}
+
+import (
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+{Models}
+`
+
+const TemplateGenClientApi = `
+// =================================================================================
+// Code generated and maintained by GoFrame CLI tool. DO NOT EDIT.
+// =================================================================================
+
+package {PkgName}
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+{Apis}
+`
+
+const TemplateGenClientApiFunc = `
+{MethodComment}
+func (c *Client) {MethodName}(ctx context.Context, req *{MethodName}Req) (res *{MethodName}Res, err error) {
+	err = c.Request(ctx, req, &res)
+	return
+}
+`
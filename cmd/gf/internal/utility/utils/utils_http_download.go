@@ -7,42 +7,39 @@
 package utils
 
 import (
-	"io"
-	"net/http"
-	"os"
+	"context"
 	"time"
 
 	"github.com/schollz/progressbar/v3"
 
 	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/gclient"
 
 	"github.com/gogf/gf/cmd/gf/v2/internal/utility/mlog"
 )
 
 // HTTPDownloadFileWithPercent downloads target url file to local path with percent process printing.
+// It resumes the download from the partial file of last failed downloading.
 func HTTPDownloadFileWithPercent(url string, localSaveFilePath string) error {
-	start := time.Now()
-	out, err := os.Create(localSaveFilePath)
+	var (
+		start = time.Now()
+		bar   *progressbar.ProgressBar
+	)
+	err := g.Client().Download(context.Background(), url, localSaveFilePath, gclient.DownloadOption{
+		Resume: true,
+		Progress: func(progress gclient.DownloadProgress) {
+			if bar == nil {
+				bar = progressbar.NewOptions64(
+					progress.Total, progressbar.OptionShowBytes(true), progressbar.OptionShowCount(),
+				)
+			}
+			_ = bar.Set64(progress.Downloaded)
+		},
+	})
 	if err != nil {
 		return gerror.Wrapf(err, `download "%s" to "%s" failed`, url, localSaveFilePath)
 	}
-	defer out.Close()
-
-	headResp, err := http.Head(url)
-	if err != nil {
-		return gerror.Wrapf(err, `download "%s" to "%s" failed`, url, localSaveFilePath)
-	}
-	defer headResp.Body.Close()
-
-	resp, err := http.Get(url)
-	if err != nil {
-		return gerror.Wrapf(err, `download "%s" to "%s" failed`, url, localSaveFilePath)
-	}
-	defer resp.Body.Close()
-
-	bar := progressbar.NewOptions(int(resp.ContentLength), progressbar.OptionShowBytes(true), progressbar.OptionShowCount())
-	writer := io.MultiWriter(out, bar)
-	_, err = io.Copy(writer, resp.Body)
 
 	elapsed := time.Since(start)
 	if elapsed > time.Minute {
@@ -9,14 +9,25 @@ package clickhouse
 import (
 	"context"
 	"database/sql/driver"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 
 	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/util/gconv"
 )
 
+// compositeTypePrefixes are the prefixes of the composite types of ClickHouse,
+// of which the values are scanned as Go maps and slices by the underlying driver.
+var compositeTypePrefixes = []string{"Map(", "Tuple(", "Array(", "Nested("}
+
+// wrapperTypePrefixes are the prefixes of the wrapper types of ClickHouse,
+// which do not change the local type of the wrapped type.
+var wrapperTypePrefixes = []string{"LowCardinality(", "Nullable("}
+
 // ConvertValueForField converts value to the type of the record field.
 func (d *Driver) ConvertValueForField(ctx context.Context, fieldType string, fieldValue any) (any, error) {
 	switch itemValue := fieldValue.(type) {
@@ -81,3 +92,77 @@ func (d *Driver) ConvertValueForField(ctx context.Context, fieldType string, fie
 		return convertedValue, nil
 	}
 }
+
+// ConvertValueForLocal converts value to local Golang type of value according field type name from database.
+// The parameter `fieldType` is the type name of ClickHouse, like:
+// `UInt64`, `LowCardinality(String)`, `Nullable(DateTime)`, `Map(String, UInt8)`, `Array(String)`, etc.
+//
+// The values of composite types Map, Tuple, Array and Nested are converted to Go maps and slices,
+// eg: map[string]any, []any and []map[string]any, so that they can be converted to the attributes
+// of struct by gvar. The wrapper types LowCardinality and Nullable are converted as the wrapped type.
+func (d *Driver) ConvertValueForLocal(ctx context.Context, fieldType string, fieldValue any) (any, error) {
+	fieldType = unwrapFieldType(fieldType)
+	for _, prefix := range compositeTypePrefixes {
+		if strings.HasPrefix(fieldType, prefix) {
+			return convertCompositeValueForLocal(reflect.ValueOf(fieldValue)), nil
+		}
+	}
+	return d.Core.ConvertValueForLocal(ctx, fieldType, fieldValue)
+}
+
+// unwrapFieldType removes the wrapper types from the field type,
+// eg: `LowCardinality(Nullable(String))` -> `String`.
+func unwrapFieldType(fieldType string) string {
+	fieldType = strings.TrimSpace(fieldType)
+	for {
+		var unwrapped bool
+		for _, prefix := range wrapperTypePrefixes {
+			if strings.HasPrefix(fieldType, prefix) && strings.HasSuffix(fieldType, ")") {
+				fieldType = strings.TrimSpace(fieldType[len(prefix) : len(fieldType)-1])
+				unwrapped = true
+			}
+		}
+		if !unwrapped {
+			return fieldType
+		}
+	}
+}
+
+// convertCompositeValueForLocal converts the value of composite types recursively,
+// in which the maps are converted to map[string]any and the slices are converted to []any.
+// The bytes and other values are returned as they are.
+func convertCompositeValueForLocal(value reflect.Value) any {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Invalid:
+		return nil
+
+	case reflect.Map:
+		var (
+			iter   = value.MapRange()
+			result = make(map[string]any, value.Len())
+		)
+		for iter.Next() {
+			result[gconv.String(iter.Key().Interface())] = convertCompositeValueForLocal(iter.Value())
+		}
+		return result
+
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return value.Interface()
+		}
+		result := make([]any, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			result[i] = convertCompositeValueForLocal(value.Index(i))
+		}
+		return result
+
+	default:
+		return value.Interface()
+	}
+}
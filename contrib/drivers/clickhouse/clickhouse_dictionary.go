@@ -0,0 +1,90 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// DictGetExpr returns the raw sql expression of dictionary function dictGet, which retrieves
+// `attribute` from dictionary `dict` by key expression `keyExpr`. It is usually used in the
+// Fields or Where statements of the model, in which `dict` and `attribute` are quoted as string
+// literals and `keyExpr` is used as it is, eg:
+//
+//	db.Model("events").Fields("id", clickhouse.DictGetExpr("geo", "country", "ip")+" AS country").All()
+func DictGetExpr(dict, attribute, keyExpr string) string {
+	return fmt.Sprintf(`dictGet(%s, %s, %s)`, quoteDictName(dict), quoteDictName(attribute), keyExpr)
+}
+
+// DictGet retrieves and returns the value of `attribute` from dictionary `dict` by `key`.
+// It returns the default value of the attribute configured in the dictionary if the key
+// is not found. The `key` should be a slice for dictionaries of complex key.
+func (d *Driver) DictGet(ctx context.Context, dict, attribute string, key any) (gdb.Value, error) {
+	if dict == "" || attribute == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, `dictionary name and attribute should not be empty`)
+	}
+	return d.GetValue(ctx, fmt.Sprintf(`SELECT dictGet(?, ?, %s)`, dictKeyHolder(key)), dict, attribute, key)
+}
+
+// DictGetOrDefault retrieves and returns the value of `attribute` from dictionary `dict` by `key`.
+// It returns `defaultValue` if the key is not found.
+func (d *Driver) DictGetOrDefault(
+	ctx context.Context, dict, attribute string, key any, defaultValue any,
+) (gdb.Value, error) {
+	if dict == "" || attribute == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, `dictionary name and attribute should not be empty`)
+	}
+	return d.GetValue(
+		ctx,
+		fmt.Sprintf(`SELECT dictGetOrDefault(?, ?, %s, ?)`, dictKeyHolder(key)),
+		dict, attribute, key, defaultValue,
+	)
+}
+
+// DictHas checks and returns whether `key` exists in dictionary `dict`.
+func (d *Driver) DictHas(ctx context.Context, dict string, key any) (bool, error) {
+	if dict == "" {
+		return false, gerror.NewCode(gcode.CodeMissingParameter, `dictionary name should not be empty`)
+	}
+	value, err := d.GetValue(ctx, fmt.Sprintf(`SELECT dictHas(?, %s)`, dictKeyHolder(key)), dict, key)
+	if err != nil {
+		return false, err
+	}
+	return value.Bool(), nil
+}
+
+// ReloadDictionary reloads dictionary `dict` from its source, or all the dictionaries if `dict` is empty.
+func (d *Driver) ReloadDictionary(ctx context.Context, dict string) error {
+	sql := `SYSTEM RELOAD DICTIONARIES`
+	if dict != "" {
+		sql = `SYSTEM RELOAD DICTIONARY ` + d.QuoteWord(dict)
+	}
+	_, err := d.Exec(ctx, sql)
+	return err
+}
+
+// dictKeyHolder returns the placeholder of the dictionary key, which is tuple for the complex key.
+func dictKeyHolder(key any) string {
+	switch key.(type) {
+	case []any, []string, []int, []int64, []uint64:
+		return `tuple(?)`
+	default:
+		return `?`
+	}
+}
+
+// quoteDictName returns `name` as the string literal of ClickHouse, in which the quotes and backslashes
+// are escaped.
+func quoteDictName(name string) string {
+	return `'` + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(name) + `'`
+}
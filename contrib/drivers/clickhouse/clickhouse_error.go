@@ -0,0 +1,37 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package clickhouse
+
+import (
+	"errors"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+)
+
+// ClassifyError classifies the exception of ClickHouse into the stable error code by its code.
+// Note that ClickHouse has no constraint of unique or foreign key, and no transaction for deadlock.
+func (d *Driver) ClassifyError(err error) gcode.Code {
+	var exception *clickhouse.Exception
+	if errors.As(err, &exception) {
+		return classifyExceptionCode(exception.Code)
+	}
+	return d.Core.ClassifyError(err)
+}
+
+// classifyExceptionCode classifies the exception code of ClickHouse into the stable error code.
+func classifyExceptionCode(code int32) gcode.Code {
+	switch code {
+	case 62: // SYNTAX_ERROR
+		return gcode.CodeDbSyntaxError
+	case 209, 210: // SOCKET_TIMEOUT, NETWORK_ERROR
+		return gcode.CodeDbConnectionLost
+	default:
+		return gcode.CodeNil
+	}
+}
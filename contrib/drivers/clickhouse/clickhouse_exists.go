@@ -0,0 +1,18 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package clickhouse
+
+import (
+	"fmt"
+)
+
+// FormatExists returns the statement selecting whether the select statement `sql` returns any row,
+// which selects at most one row from the derived table, as EXISTS is not supported by the old versions
+// of ClickHouse. It returns no row if `sql` returns no row, which is treated as false.
+func (d *Driver) FormatExists(sql string) string {
+	return fmt.Sprintf(`SELECT 1 FROM (%s) LIMIT 1`, sql)
+}
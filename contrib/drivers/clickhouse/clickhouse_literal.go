@@ -0,0 +1,21 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package clickhouse
+
+import (
+	"strings"
+)
+
+// FormatLiteral returns `value` as the SQL literal of ClickHouse, in which the backslashes of string
+// are also escaped, as backslash is the escape char of string literals.
+func (d *Driver) FormatLiteral(value any) string {
+	literal := d.Core.FormatLiteral(value)
+	if strings.HasPrefix(literal, "'") {
+		return strings.ReplaceAll(literal, `\`, `\\`)
+	}
+	return literal
+}
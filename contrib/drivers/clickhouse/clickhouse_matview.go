@@ -0,0 +1,128 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/util/gutil"
+)
+
+const (
+	matViewsSql = "select name from `system`.tables where database = ? and engine = 'MaterializedView' order by name"
+)
+
+// MaterializedViewOption is the option for creating materialized view.
+type MaterializedViewOption struct {
+	// To specifies the target table storing the data of the view, eg: "metrics_hourly".
+	// The view stores data in an inner table of Engine if it is empty.
+	To string
+
+	// Engine is the table engine of the inner table, eg: "SummingMergeTree()".
+	// It is ignored if To is given.
+	Engine string
+
+	// OrderBy is the ORDER BY expression of the inner table, eg: "(metric, hour)".
+	OrderBy string
+
+	// PartitionBy is the PARTITION BY expression of the inner table, eg: "toYYYYMM(hour)".
+	PartitionBy string
+
+	// TTL is the TTL expression of the inner table, eg: "hour + INTERVAL 30 DAY".
+	TTL string
+
+	// Populate specifies populating the inner table with the existing data of the source table.
+	// It is ignored if To is given, as ClickHouse does not support POPULATE with TO.
+	Populate bool
+}
+
+// MaterializedViews retrieves and returns the materialized views of current database,
+// or of the database `schema` if it is given.
+func (d *Driver) MaterializedViews(ctx context.Context, schema ...string) (views []string, err error) {
+	usedSchema := gutil.GetOrDefaultStr(d.GetSchema(), schema...)
+	link, err := d.SlaveLink(usedSchema)
+	if err != nil {
+		return nil, err
+	}
+	result, err := d.DoSelect(ctx, link, matViewsSql, usedSchema)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range result {
+		views = append(views, record["name"].String())
+	}
+	return
+}
+
+// CreateMaterializedView creates materialized view `name` if it does not exist,
+// with select statement `query` and `option`.
+//
+// Example:
+//
+//	driver.CreateMaterializedView(ctx, "metrics_hourly_mv", "SELECT ...", clickhouse.MaterializedViewOption{
+//		To: "metrics_hourly",
+//	})
+func (d *Driver) CreateMaterializedView(
+	ctx context.Context, name, query string, option MaterializedViewOption,
+) error {
+	if name == "" || query == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, `materialized view name and query should not be empty`)
+	}
+	_, err := d.Exec(ctx, formatCreateMaterializedViewSql(d.QuoteWord(name), query, option))
+	return err
+}
+
+// DropMaterializedView drops materialized view `name` if it exists.
+// Note that the target table specified by option To is not dropped.
+func (d *Driver) DropMaterializedView(ctx context.Context, name string) error {
+	if name == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, `materialized view name should not be empty`)
+	}
+	_, err := d.Exec(ctx, fmt.Sprintf(`DROP VIEW IF EXISTS %s`, d.QuoteWord(name)))
+	return err
+}
+
+// SetTableTTL sets the TTL expression of `table`, eg: "create_time + INTERVAL 30 DAY".
+// It removes the TTL of the table if `ttl` is empty.
+func (d *Driver) SetTableTTL(ctx context.Context, table, ttl string) error {
+	if table == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, `table name should not be empty`)
+	}
+	sql := fmt.Sprintf(`ALTER TABLE %s REMOVE TTL`, d.QuoteWord(table))
+	if ttl != "" {
+		sql = fmt.Sprintf(`ALTER TABLE %s MODIFY TTL %s`, d.QuoteWord(table), ttl)
+	}
+	_, err := d.Exec(ctx, sql)
+	return err
+}
+
+// formatCreateMaterializedViewSql formats and returns the statement creating materialized view.
+func formatCreateMaterializedViewSql(name, query string, option MaterializedViewOption) string {
+	sql := `CREATE MATERIALIZED VIEW IF NOT EXISTS ` + name
+	if option.To != "" {
+		return fmt.Sprintf(`%s TO %s AS %s`, sql, option.To, query)
+	}
+	if option.Engine != "" {
+		sql += ` ENGINE = ` + option.Engine
+	}
+	if option.PartitionBy != "" {
+		sql += ` PARTITION BY ` + option.PartitionBy
+	}
+	if option.OrderBy != "" {
+		sql += ` ORDER BY ` + option.OrderBy
+	}
+	if option.TTL != "" {
+		sql += ` TTL ` + option.TTL
+	}
+	if option.Populate {
+		sql += ` POPULATE`
+	}
+	return fmt.Sprintf(`%s AS %s`, sql, query)
+}
@@ -0,0 +1,28 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package clickhouse
+
+import (
+	"context"
+)
+
+const (
+	isViewSql = `SELECT count() AS total FROM system.tables WHERE database = currentDatabase() AND name = ? AND engine = 'View'`
+)
+
+// IsView checks and returns whether the specified table is a view of current schema.
+func (d *Driver) IsView(ctx context.Context, table string, schema ...string) (bool, error) {
+	link, err := d.SlaveLink(schema...)
+	if err != nil {
+		return false, err
+	}
+	result, err := d.DoSelect(ctx, link, isViewSql, table)
+	if err != nil {
+		return false, err
+	}
+	return len(result) > 0 && result[0]["total"].Int() > 0, nil
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/shopspring/decimal"
 
 	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/os/gtime"
 	"github.com/gogf/gf/v2/test/gtest"
@@ -596,3 +598,222 @@ func TestDriverClickhouse_TableFields_HasField(t *testing.T) {
 	gtest.AssertNil(err)
 	gtest.AssertEQ(b, true)
 }
+
+func TestDriverClickhouse_ConvertValueForLocal_CompositeTypes(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		driver = &Driver{}
+	)
+	gtest.C(t, func(t *gtest.T) {
+		value, err := driver.ConvertValueForLocal(ctx, "Map(String, UInt8)", map[string]uint8{"a": 1})
+		t.AssertNil(err)
+		t.AssertEQ(value, map[string]any{"a": uint8(1)})
+
+		value, err = driver.ConvertValueForLocal(ctx, "Map(UInt64, Array(String))", map[uint64][]string{1: {"a", "b"}})
+		t.AssertNil(err)
+		t.AssertEQ(value, map[string]any{"1": []any{"a", "b"}})
+
+		value, err = driver.ConvertValueForLocal(ctx, "Array(LowCardinality(String))", []string{"a", "b"})
+		t.AssertNil(err)
+		t.AssertEQ(value, []any{"a", "b"})
+
+		value, err = driver.ConvertValueForLocal(ctx, "Tuple(String, UInt8)", []any{"a", uint8(1)})
+		t.AssertNil(err)
+		t.AssertEQ(value, []any{"a", uint8(1)})
+
+		value, err = driver.ConvertValueForLocal(ctx, "Tuple(name String, age UInt8)", map[string]any{"name": "john", "age": uint8(18)})
+		t.AssertNil(err)
+		t.AssertEQ(value, map[string]any{"name": "john", "age": uint8(18)})
+
+		value, err = driver.ConvertValueForLocal(ctx, "Nested(name String, age UInt8)", []map[string]any{{"name": "john", "age": uint8(18)}})
+		t.AssertNil(err)
+		t.AssertEQ(value, []any{map[string]any{"name": "john", "age": uint8(18)}})
+
+		value, err = driver.ConvertValueForLocal(ctx, "Nullable(Array(String))", (*[]string)(nil))
+		t.AssertNil(err)
+		t.AssertNil(value)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(unwrapFieldType("LowCardinality(Nullable(String))"), "String")
+		t.Assert(unwrapFieldType("Nullable(DateTime)"), "DateTime")
+		t.Assert(unwrapFieldType("Map(LowCardinality(String), UInt8)"), "Map(LowCardinality(String), UInt8)")
+	})
+}
+
+func TestDriverClickhouse_CompositeTypes_Struct(t *testing.T) {
+	connect := clickhouseConfigDB()
+	_, err := connect.Exec(context.Background(), `
+	CREATE TABLE IF NOT EXISTS composite_type (
+		  id UInt64
+		, status LowCardinality(String)
+		, code LowCardinality(Nullable(UInt32))
+		, tags Map(String, UInt8)
+		, names Array(String)
+		, point Tuple(x Int32, y Int32)
+		, scores Array(Map(String, UInt8))
+	) ENGINE = MergeTree()
+	ORDER BY id`)
+	gtest.AssertNil(err)
+	defer connect.Exec(context.Background(), "DROP TABLE IF EXISTS `composite_type`")
+
+	_, err = connect.Exec(context.Background(), `
+	INSERT INTO composite_type VALUES (1, 'active', 100, {'a': 1, 'b': 2}, ['x', 'y'], (3, 4), [{'math': 90}, {'art': 80}])`)
+	gtest.AssertNil(err)
+
+	gtest.C(t, func(t *gtest.T) {
+		type Point struct {
+			X int32
+			Y int32
+		}
+		type Entity struct {
+			Id     uint64
+			Status string
+			Code   *uint32
+			Tags   map[string]uint8
+			Names  []string
+			Point  Point
+			Scores []map[string]int
+		}
+		var entity *Entity
+		err := connect.Model("composite_type").Where("id", 1).Scan(&entity)
+		t.AssertNil(err)
+		t.Assert(entity.Status, "active")
+		t.Assert(*entity.Code, 100)
+		t.Assert(entity.Tags, map[string]uint8{"a": 1, "b": 2})
+		t.Assert(entity.Names, []string{"x", "y"})
+		t.Assert(entity.Point, Point{X: 3, Y: 4})
+		t.Assert(entity.Scores, []map[string]int{{"math": 90}, {"art": 80}})
+
+		one, err := connect.Model("composite_type").Where("id", 1).One()
+		t.AssertNil(err)
+		t.Assert(one["tags"].Map()["b"], 2)
+		t.Assert(one["names"].Strings(), []string{"x", "y"})
+	})
+}
+
+func TestDriverClickhouse_FormatCreateMaterializedViewSql(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(
+			formatCreateMaterializedViewSql("mv", "SELECT 1", MaterializedViewOption{To: "target", Populate: true}),
+			"CREATE MATERIALIZED VIEW IF NOT EXISTS mv TO target AS SELECT 1",
+		)
+		t.Assert(
+			formatCreateMaterializedViewSql("mv", "SELECT 1", MaterializedViewOption{
+				Engine:      "SummingMergeTree()",
+				PartitionBy: "toYYYYMM(hour)",
+				OrderBy:     "(metric, hour)",
+				TTL:         "hour + INTERVAL 30 DAY",
+				Populate:    true,
+			}),
+			"CREATE MATERIALIZED VIEW IF NOT EXISTS mv ENGINE = SummingMergeTree() PARTITION BY toYYYYMM(hour) "+
+				"ORDER BY (metric, hour) TTL hour + INTERVAL 30 DAY POPULATE AS SELECT 1",
+		)
+		t.Assert(DictGetExpr("geo", "country", "ip"), "dictGet('geo', 'country', ip)")
+		t.Assert(DictGetExpr("geo') OR ('1", `a\`, "ip"), `dictGet('geo\') OR (\'1', 'a\\', ip)`)
+		t.Assert(dictKeyHolder(1), "?")
+		t.Assert(dictKeyHolder(g.Slice{1, "a"}), "tuple(?)")
+	})
+}
+
+func TestDriverClickhouse_Dictionary(t *testing.T) {
+	connect := clickhouseConfigDB()
+	driver := connect.GetCore().GetDB().(*gdb.DriverWrapperDB).DB.(*Driver)
+	_, err := connect.Exec(context.Background(), `
+	CREATE TABLE IF NOT EXISTS dict_source (id UInt64, name String) ENGINE = MergeTree() ORDER BY id`)
+	gtest.AssertNil(err)
+	defer connect.Exec(context.Background(), "DROP TABLE IF EXISTS `dict_source`")
+	_, err = connect.Exec(context.Background(), `INSERT INTO dict_source VALUES (1, 'john'), (2, 'smith')`)
+	gtest.AssertNil(err)
+	_, err = connect.Exec(context.Background(), `
+	CREATE DICTIONARY IF NOT EXISTS dict_user (id UInt64, name String DEFAULT 'unknown')
+	PRIMARY KEY id
+	SOURCE(CLICKHOUSE(TABLE 'dict_source'))
+	LAYOUT(FLAT())
+	LIFETIME(0)`)
+	gtest.AssertNil(err)
+	defer connect.Exec(context.Background(), "DROP DICTIONARY IF EXISTS `dict_user`")
+
+	gtest.C(t, func(t *gtest.T) {
+		ctx := context.Background()
+		t.AssertNil(driver.ReloadDictionary(ctx, "dict_user"))
+
+		value, err := driver.DictGet(ctx, "dict_user", "name", 1)
+		t.AssertNil(err)
+		t.Assert(value, "john")
+
+		value, err = driver.DictGet(ctx, "dict_user", "name", 3)
+		t.AssertNil(err)
+		t.Assert(value, "unknown")
+
+		value, err = driver.DictGetOrDefault(ctx, "dict_user", "name", 3, "nobody")
+		t.AssertNil(err)
+		t.Assert(value, "nobody")
+
+		has, err := driver.DictHas(ctx, "dict_user", 2)
+		t.AssertNil(err)
+		t.Assert(has, true)
+
+		array, err := connect.Model("dict_source").
+			Fields(DictGetExpr("dict_user", "name", "id") + " AS user_name").
+			Order("id").Array()
+		t.AssertNil(err)
+		t.Assert(array, g.Slice{"john", "smith"})
+	})
+}
+
+func TestDriverClickhouse_MaterializedView(t *testing.T) {
+	connect := clickhouseConfigDB()
+	driver := connect.GetCore().GetDB().(*gdb.DriverWrapperDB).DB.(*Driver)
+	_, err := connect.Exec(context.Background(), `
+	CREATE TABLE IF NOT EXISTS mv_source (metric String, value UInt64, create_time DateTime)
+	ENGINE = MergeTree() ORDER BY create_time`)
+	gtest.AssertNil(err)
+	defer connect.Exec(context.Background(), "DROP TABLE IF EXISTS `mv_source`")
+
+	gtest.C(t, func(t *gtest.T) {
+		ctx := context.Background()
+		err := driver.CreateMaterializedView(
+			ctx, "mv_metric", "SELECT metric, sum(value) AS total FROM mv_source GROUP BY metric",
+			MaterializedViewOption{Engine: "SummingMergeTree()", OrderBy: "metric"},
+		)
+		t.AssertNil(err)
+		defer driver.DropMaterializedView(ctx, "mv_metric")
+
+		views, err := driver.MaterializedViews(ctx)
+		t.AssertNil(err)
+		t.AssertIN("mv_metric", views)
+		views, err = driver.MaterializedViews(ctx, "default")
+		t.AssertNil(err)
+		t.AssertIN("mv_metric", views)
+		views, err = driver.MaterializedViews(ctx, "system")
+		t.AssertNil(err)
+		t.AssertNI("mv_metric", views)
+
+		_, err = connect.Exec(ctx, `INSERT INTO mv_source VALUES ('cpu', 1, now()), ('cpu', 2, now())`)
+		t.AssertNil(err)
+		value, err := connect.GetValue(ctx, `SELECT sum(total) FROM mv_metric WHERE metric = 'cpu'`)
+		t.AssertNil(err)
+		t.Assert(value, 3)
+
+		t.AssertNil(driver.SetTableTTL(ctx, "mv_source", "create_time + INTERVAL 30 DAY"))
+		t.AssertNil(driver.SetTableTTL(ctx, "mv_source", ""))
+
+		t.AssertNil(driver.DropMaterializedView(ctx, "mv_metric"))
+		views, err = driver.MaterializedViews(ctx)
+		t.AssertNil(err)
+		t.AssertNI("mv_metric", views)
+	})
+}
+
+func TestDriverClickhouse_ClassifyError(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(classifyExceptionCode(62), gcode.CodeDbSyntaxError)
+		t.Assert(classifyExceptionCode(210), gcode.CodeDbConnectionLost)
+		t.Assert(classifyExceptionCode(60), gcode.CodeNil)
+
+		connect := clickhouseConfigDB()
+		_, err := connect.Query(context.Background(), "SELEC 1")
+		t.AssertNE(err, nil)
+		t.Assert(gerror.HasCode(err, gcode.CodeDbSyntaxError), true)
+	})
+}
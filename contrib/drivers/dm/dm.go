@@ -12,6 +12,7 @@ import (
 
 	"github.com/gogf/gf/v2/database/gdb"
 	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gctx"
 )
 
 // Driver is the driver for dm database.
@@ -21,6 +22,9 @@ type Driver struct {
 
 const (
 	quoteChar = `"`
+
+	// internalPrimaryKeyInCtx is the context key of the primary key field retrieved by RETURNING clause.
+	internalPrimaryKeyInCtx gctx.StrKey = "primary_key_field"
 )
 
 func init() {
@@ -0,0 +1,92 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package dm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+const (
+	returningClause = " RETURNING %s INTO ?"
+)
+
+// DoExec commits the sql string and its arguments to underlying driver
+// through given link object and returns the execution result.
+// It handles INSERT statements specially to support LastInsertId of IDENTITY columns and sequences.
+func (d *Driver) DoExec(
+	ctx context.Context, link gdb.Link, sqlStr string, args ...any,
+) (result sql.Result, err error) {
+	pkField, ok := ctx.Value(internalPrimaryKeyInCtx).(gdb.TableField)
+	if !ok || pkField.Name == "" || !strings.Contains(strings.ToUpper(sqlStr), "INSERT INTO") {
+		return d.Core.DoExec(ctx, link, sqlStr, args...)
+	}
+
+	// Transaction checks.
+	if link == nil {
+		if tx := gdb.TXFromCtx(ctx, d.GetGroup()); tx != nil {
+			link = tx
+		} else if link, err = d.MasterLink(); err != nil {
+			return nil, err
+		}
+	} else if !link.IsTransaction() {
+		if tx := gdb.TXFromCtx(ctx, d.GetGroup()); tx != nil {
+			link = tx
+		}
+	}
+
+	// DM supports RETURNING clause to get the primary key of the inserted record,
+	// no matter it is generated by IDENTITY column, sequence or trigger.
+	sqlStr += fmt.Sprintf(returningClause, d.QuoteWord(pkField.Name))
+
+	// SQL filtering.
+	sqlStr, args = d.FormatSqlBeforeExecuting(sqlStr, args)
+	sqlStr, args, err = d.DoFilter(ctx, link, sqlStr, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Append the output parameter for the RETURNING clause.
+	var lastInsertId int64
+	args = append(args, sql.Out{Dest: &lastInsertId})
+
+	// Link execution.
+	_, err = d.DoCommit(ctx, gdb.DoCommitInput{
+		Link:          link,
+		Sql:           sqlStr,
+		Args:          args,
+		Stmt:          nil,
+		Type:          gdb.SqlTypeExecContext,
+		IsTransaction: link.IsTransaction(),
+	})
+	if err != nil {
+		return &Result{lastInsertIdError: err}, err
+	}
+
+	// For single insert with RETURNING clause, affected is always 1.
+	var affected int64 = 1
+	if !strings.Contains(strings.ToLower(pkField.Type), "int") {
+		return &Result{
+			rowsAffected: affected,
+			lastInsertIdError: gerror.NewCodef(
+				gcode.CodeNotSupported,
+				"LastInsertId is not supported by primary key type: %s",
+				pkField.Type,
+			),
+		}, nil
+	}
+	return &Result{
+		lastInsertId: lastInsertId,
+		rowsAffected: affected,
+	}, nil
+}
@@ -37,16 +37,57 @@ func (d *Driver) DoInsert(
 		return d.doInsertIgnore(ctx, link, table, list, option)
 
 	default:
-		// DM database supports IDENTITY auto-increment columns natively.
-		// The driver automatically returns LastInsertId through sql.Result.
+		// The underlying driver does not return LastInsertId for IDENTITY columns and sequences,
+		// so the primary key is retrieved by RETURNING clause for single record insert.
 		//
 		// Note: DM IDENTITY columns cannot accept explicit ID values unless
 		// IDENTITY_INSERT is enabled. When using tables with IDENTITY columns,
 		// avoid providing explicit ID values in the data.
+		if len(list) == 1 {
+			if pkField := d.getReturningPrimaryKey(ctx, table, list[0]); pkField != nil {
+				ctx = context.WithValue(ctx, internalPrimaryKeyInCtx, *pkField)
+			}
+		}
 		return d.Core.DoInsert(ctx, link, table, list, option)
 	}
 }
 
+// getReturningPrimaryKey returns the primary key field of the table that should be retrieved
+// by RETURNING clause, which is the one not provided in the data, eg: IDENTITY column or column
+// filled by trigger, or provided as raw sql like gdb.Raw("SEQ_USER.NEXTVAL") of sequence.
+// It returns nil if there's no such primary key field or the primary key is composite.
+func (d *Driver) getReturningPrimaryKey(ctx context.Context, table string, data gdb.Map) *gdb.TableField {
+	tableFields, err := d.GetCore().GetDB().TableFields(ctx, table)
+	if err != nil {
+		return nil
+	}
+	var pkField *gdb.TableField
+	for _, field := range tableFields {
+		if !strings.EqualFold(field.Key, "pri") {
+			continue
+		}
+		if pkField != nil {
+			return nil
+		}
+		pkField = field
+	}
+	if pkField == nil {
+		return nil
+	}
+	for key, value := range data {
+		if !strings.EqualFold(key, pkField.Name) {
+			continue
+		}
+		switch value.(type) {
+		case gdb.Raw, *gdb.Raw:
+			return pkField
+		default:
+			return nil
+		}
+	}
+	return pkField
+}
+
 // doSave support upsert for dm
 func (d *Driver) doSave(ctx context.Context,
 	link gdb.Link, table string, list gdb.List, option gdb.DoInsertOption,
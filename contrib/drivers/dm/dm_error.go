@@ -0,0 +1,51 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package dm
+
+import (
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+)
+
+// errorMessageCodes maps the keywords of the error messages of DM to the error codes,
+// in which the messages are in English or Chinese according to the language of the server.
+var errorMessageCodes = []struct {
+	keywords []string
+	code     gcode.Code
+}{
+	{[]string{"unique constraint", "唯一性约束"}, gcode.CodeDbDuplicateKey},
+	{[]string{"reference constraint", "foreign key", "引用约束"}, gcode.CodeDbForeignKeyViolation},
+	{[]string{"deadlock", "死锁"}, gcode.CodeDbDeadlock},
+	{[]string{"serialize", "串行化"}, gcode.CodeDbSerializationFailure},
+	{[]string{"network error", "connection closed", "网络通信异常", "连接已关闭"}, gcode.CodeDbConnectionLost},
+	{[]string{"syntax", "语法"}, gcode.CodeDbSyntaxError},
+}
+
+// ClassifyError classifies the error of DM into the stable error code by its error message.
+func (d *Driver) ClassifyError(err error) gcode.Code {
+	if err == nil {
+		return gcode.CodeNil
+	}
+	if code := classifyErrorMessage(err.Error()); code != gcode.CodeNil {
+		return code
+	}
+	return d.Core.ClassifyError(err)
+}
+
+// classifyErrorMessage classifies the error message of DM into the stable error code.
+func classifyErrorMessage(message string) gcode.Code {
+	message = strings.ToLower(message)
+	for _, item := range errorMessageCodes {
+		for _, keyword := range item.keywords {
+			if strings.Contains(message, keyword) {
+				return item.code
+			}
+		}
+	}
+	return gcode.CodeNil
+}
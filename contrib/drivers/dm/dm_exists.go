@@ -0,0 +1,17 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package dm
+
+import (
+	"fmt"
+)
+
+// FormatExists returns the statement selecting whether the select statement `sql` returns any row,
+// which uses CASE WHEN from DUAL as DM does not support EXISTS as a value.
+func (d *Driver) FormatExists(sql string) string {
+	return fmt.Sprintf(`SELECT CASE WHEN EXISTS(%s) THEN 1 ELSE 0 END FROM DUAL`, sql)
+}
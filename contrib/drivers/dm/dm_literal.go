@@ -0,0 +1,40 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package dm
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// FormatLiteral returns `value` as the SQL literal of DM, in which the boolean is 1 or 0,
+// the binary is like HEXTORAW('01ab'), and the time is like TIMESTAMP '2006-01-02 15:04:05',
+// which is compatible with Oracle.
+func (d *Driver) FormatLiteral(value any) string {
+	if v, ok := value.(gdb.Value); ok {
+		value = v.Val()
+	}
+	literal := d.Core.FormatLiteral(value)
+	if literal == "NULL" {
+		return literal
+	}
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return "1"
+		}
+		return "0"
+	case []byte:
+		return "HEXTORAW('" + hex.EncodeToString(v) + "')"
+	case time.Time, *time.Time, *gtime.Time:
+		return "TIMESTAMP " + literal
+	}
+	return literal
+}
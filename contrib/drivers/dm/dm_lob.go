@@ -0,0 +1,201 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package dm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+const (
+	// defaultLobChunkSize is the size of each chunk reading from or writing to the large object,
+	// which is in bytes for BLOB and in characters for CLOB.
+	defaultLobChunkSize = 1024 * 1024
+)
+
+// lobReader is the reader streaming the large object in chunks.
+type lobReader struct {
+	ctx    context.Context
+	driver *Driver
+	sql    string // Sql retrieving a chunk of the large object.
+	args   []any  // Arguments of the where condition.
+	offset int64  // Offset of the next chunk starting from 1.
+	length int64  // Length of the large object.
+	chunk  []byte // Remaining data of current chunk.
+}
+
+// LobReader returns a reader streaming the BLOB or CLOB `column` of the record of `table` matching
+// the condition `where` and its `args` in chunks, instead of reading the whole value into memory.
+//
+// Example:
+//
+//	reader, err := driver.LobReader(ctx, "document", "content", "id=?", 1)
+//	if err != nil {
+//		return err
+//	}
+//	_, err = io.Copy(file, reader)
+func (d *Driver) LobReader(ctx context.Context, table, column, where string, args ...any) (io.Reader, error) {
+	if err := checkLobParameters(table, column, where); err != nil {
+		return nil, err
+	}
+	value, err := d.GetValue(
+		ctx,
+		fmt.Sprintf(`SELECT DBMS_LOB.GETLENGTH(%s) FROM %s WHERE %s`, column, table, where),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if value.IsNil() {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`no record or large object found in table "%s" with condition: %s`, table, where,
+		)
+	}
+	return &lobReader{
+		ctx:    ctx,
+		driver: d,
+		sql: fmt.Sprintf(
+			`SELECT DBMS_LOB.SUBSTR(%s, ?, ?) FROM %s WHERE %s`,
+			column, table, where,
+		),
+		args:   args,
+		offset: 1,
+		length: value.Int64(),
+	}, nil
+}
+
+// Read implements io.Reader, which reads the large object chunk by chunk.
+func (r *lobReader) Read(p []byte) (n int, err error) {
+	if len(r.chunk) == 0 {
+		if r.offset > r.length {
+			return 0, io.EOF
+		}
+		value, err := r.driver.GetValue(
+			r.ctx, r.sql, append([]any{defaultLobChunkSize, r.offset}, r.args...)...,
+		)
+		if err != nil {
+			return 0, err
+		}
+		r.chunk = value.Bytes()
+		if len(r.chunk) == 0 {
+			return 0, io.EOF
+		}
+		r.offset += defaultLobChunkSize
+	}
+	n = copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+// WriteLob streams the data of `reader` to the BLOB or CLOB `column` of the record of `table` matching
+// the condition `where` and its `args` in chunks, which replaces the existing value of the column.
+// The data is written in a transaction and it returns the count of bytes written.
+//
+// Example:
+//
+//	written, err := driver.WriteLob(ctx, "document", "content", file, "id=?", 1)
+func (d *Driver) WriteLob(
+	ctx context.Context, table, column string, reader io.Reader, where string, args ...any,
+) (written int64, err error) {
+	if err = checkLobParameters(table, column, where); err != nil {
+		return 0, err
+	}
+	fields, err := d.TableFields(ctx, table)
+	if err != nil {
+		return 0, err
+	}
+	field, ok := fields[column]
+	if !ok {
+		field, ok = fields[strings.ToUpper(column)]
+	}
+	if !ok {
+		return 0, gerror.NewCodef(gcode.CodeInvalidParameter, `column "%s" not found in table "%s"`, column, table)
+	}
+	var (
+		isClob   = strings.Contains(strings.ToUpper(field.Type), "CLOB") || strings.Contains(strings.ToUpper(field.Type), "TEXT")
+		emptyLob = "EMPTY_BLOB()"
+		lobType  = "BLOB"
+	)
+	if isClob {
+		emptyLob, lobType = "EMPTY_CLOB()", "CLOB"
+	}
+	err = d.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		result, err := tx.Exec(fmt.Sprintf(`UPDATE %s SET %s=%s WHERE %s`, table, column, emptyLob, where), args...)
+		if err != nil {
+			return err
+		}
+		if affected, _ := result.RowsAffected(); affected == 0 {
+			return gerror.NewCodef(
+				gcode.CodeInvalidParameter,
+				`no record found in table "%s" with condition: %s`, table, where,
+			)
+		}
+		var (
+			appendSql = fmt.Sprintf(
+				`DECLARE lob %s; BEGIN SELECT %s INTO lob FROM %s WHERE %s FOR UPDATE; DBMS_LOB.WRITEAPPEND(lob, ?, ?); END;`,
+				lobType, column, table, where,
+			)
+			buffer = make([]byte, defaultLobChunkSize)
+			remain int // Count of bytes of the incomplete character remaining at the end of buffer for CLOB.
+		)
+		for {
+			n, readErr := io.ReadFull(reader, buffer[remain:])
+			n += remain
+			if n > 0 {
+				var (
+					data   = buffer[:n]
+					amount int
+					value  any
+				)
+				remain = 0
+				if isClob {
+					// The chunk should not end with an incomplete character.
+					if readErr == nil {
+						for remain < utf8.UTFMax && remain < n && !utf8.Valid(data[:n-remain]) {
+							remain++
+						}
+						data = data[:n-remain]
+					}
+					amount, value = utf8.RuneCount(data), string(data)
+				} else {
+					amount, value = len(data), data
+				}
+				if _, err = tx.Exec(appendSql, append(append([]any{}, args...), amount, value)...); err != nil {
+					return err
+				}
+				written += int64(len(data))
+				copy(buffer, buffer[n-remain:n])
+			}
+			if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+				return nil
+			}
+			if readErr != nil {
+				return readErr
+			}
+		}
+	})
+	return written, err
+}
+
+// checkLobParameters checks the parameters for locating the large object.
+func checkLobParameters(table, column, where string) error {
+	if table == "" || column == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, `table and column should not be empty for large object`)
+	}
+	if where == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, `where condition should not be empty for large object`)
+	}
+	return nil
+}
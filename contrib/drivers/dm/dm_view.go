@@ -0,0 +1,30 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package dm
+
+import (
+	"context"
+	"strings"
+)
+
+const (
+	isViewSql = `SELECT COUNT(1) AS TOTAL FROM USER_VIEWS WHERE VIEW_NAME = ?`
+)
+
+// IsView checks and returns whether the specified table is a view of current schema.
+// The table name is converted to upper case as the unquoted identifiers are stored in upper case.
+func (d *Driver) IsView(ctx context.Context, table string, schema ...string) (bool, error) {
+	link, err := d.SlaveLink(schema...)
+	if err != nil {
+		return false, err
+	}
+	result, err := d.DoSelect(ctx, link, isViewSql, strings.ToUpper(table))
+	if err != nil {
+		return false, err
+	}
+	return len(result) > 0 && result[0]["TOTAL"].Int() > 0, nil
+}
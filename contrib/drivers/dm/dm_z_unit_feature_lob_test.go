@@ -0,0 +1,71 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package dm_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gogf/gf/contrib/drivers/dm/v2"
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Driver_Lob(t *testing.T) {
+	table := fmt.Sprintf("lob_%d", gtime.Timestamp())
+	if _, err := db.Exec(ctx, fmt.Sprintf(`
+	CREATE TABLE "%s"
+(
+"ID" BIGINT NOT NULL,
+"CONTENT" BLOB,
+"NOTE" CLOB,
+NOT CLUSTER PRIMARY KEY("ID")) ;
+	`, table)); err != nil {
+		gtest.Fatal(err)
+	}
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		driver := db.GetCore().GetDB().(*gdb.DriverWrapperDB).DB.(*dm.Driver)
+		_, err := db.Model(table).Data(g.Map{"ID": 1}).Insert()
+		t.AssertNil(err)
+
+		// Larger than one chunk.
+		blob := bytes.Repeat([]byte{0, 1, 2, 255}, 300*1024)
+		written, err := driver.WriteLob(ctx, table, "CONTENT", bytes.NewReader(blob), "ID=?", 1)
+		t.AssertNil(err)
+		t.Assert(written, len(blob))
+
+		reader, err := driver.LobReader(ctx, table, "CONTENT", "ID=?", 1)
+		t.AssertNil(err)
+		content, err := io.ReadAll(reader)
+		t.AssertNil(err)
+		t.Assert(bytes.Equal(content, blob), true)
+
+		// Multibyte characters crossing the chunks.
+		clob := strings.Repeat("GoFrame框架", 100*1024)
+		written, err = driver.WriteLob(ctx, table, "NOTE", strings.NewReader(clob), "ID=?", 1)
+		t.AssertNil(err)
+		t.Assert(written, len(clob))
+
+		reader, err = driver.LobReader(ctx, table, "NOTE", "ID=?", 1)
+		t.AssertNil(err)
+		content, err = io.ReadAll(reader)
+		t.AssertNil(err)
+		t.Assert(string(content) == clob, true)
+
+		_, err = driver.WriteLob(ctx, table, "CONTENT", bytes.NewReader(blob), "ID=?", 2)
+		t.AssertNE(err, nil)
+		_, err = driver.LobReader(ctx, table, "CONTENT", "ID=?", 2)
+		t.AssertNE(err, nil)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogf/gf/v2/database/gdb"
 	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/os/gtime"
 	"github.com/gogf/gf/v2/test/gtest"
@@ -180,6 +181,53 @@ func Test_Model_InsertAndGetId(t *testing.T) {
 		lastId, err := db.Model(table).Data(data).InsertAndGetId()
 		t.AssertNil(err)
 		t.AssertGT(lastId, 0)
+
+		nextId, err := db.Model(table).Data(g.Map{"account_name": "name_2"}).InsertAndGetId()
+		t.AssertNil(err)
+		t.Assert(nextId, lastId+1)
+
+		value, err := db.Model(table).Where("id", nextId).Value("account_name")
+		t.AssertNil(err)
+		t.Assert(value, "name_2")
 	})
 
 }
+
+func Test_Model_InsertAndGetId_Sequence(t *testing.T) {
+	var (
+		table    = fmt.Sprintf(`t_seq_%d`, gtime.TimestampNano())
+		sequence = table + "_seq"
+	)
+	_, err := db.Exec(ctx, fmt.Sprintf(`CREATE SEQUENCE %s START WITH 100 INCREMENT BY 1`, sequence))
+	gtest.AssertNil(err)
+	defer db.Exec(ctx, fmt.Sprintf(`DROP SEQUENCE %s`, sequence))
+
+	_, err = db.Exec(ctx, fmt.Sprintf(`
+	CREATE TABLE %s (
+		ID BIGINT NOT NULL,
+		NAME VARCHAR(64) DEFAULT '' NOT NULL,
+		PRIMARY KEY(ID)
+	)`, table))
+	gtest.AssertNil(err)
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		lastId, err := db.Model(table).Data(g.Map{
+			"id":   gdb.Raw(sequence + ".NEXTVAL"),
+			"name": "john",
+		}).InsertAndGetId()
+		t.AssertNil(err)
+		t.Assert(lastId, 100)
+
+		lastId, err = db.Model(table).Data(g.Map{
+			"id":   gdb.Raw(sequence + ".NEXTVAL"),
+			"name": "smith",
+		}).InsertAndGetId()
+		t.AssertNil(err)
+		t.Assert(lastId, 101)
+
+		value, err := db.Model(table).Where("id", 101).Value("name")
+		t.AssertNil(err)
+		t.Assert(value, "smith")
+	})
+}
@@ -0,0 +1,23 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gaussdb
+
+import (
+	"fmt"
+
+	"github.com/gogf/gf/v2/database/gdb"
+)
+
+// DateBucketFunction returns the SQL expression truncating the quoted `column` to the start of the date `bucket`.
+func (d *Driver) DateBucketFunction(column string, bucket gdb.DateBucket) string {
+	switch bucket {
+	case gdb.BucketHour, gdb.BucketMonth, gdb.BucketYear:
+		return fmt.Sprintf(`date_trunc('%s',%s)`, bucket, column)
+	default:
+		return fmt.Sprintf(`date_trunc('day',%s)`, column)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gaussdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+// DistributionType is the distribution strategy of the table in distributed GaussDB.
+type DistributionType string
+
+const (
+	DistributionHash        DistributionType = "HASH"
+	DistributionReplication DistributionType = "REPLICATION"
+	DistributionRoundRobin  DistributionType = "ROUNDROBIN"
+	DistributionModulo      DistributionType = "MODULO"
+	DistributionRange       DistributionType = "RANGE"
+	DistributionList        DistributionType = "LIST"
+)
+
+// TableDistribution is the distribution metadata of the table in distributed GaussDB,
+// which is declared by the "DISTRIBUTE BY" and "TO GROUP" clauses of the table DDL.
+type TableDistribution struct {
+	Type      DistributionType // Distribution strategy of the table.
+	Columns   []string         // Distribution columns, which is empty for REPLICATION and ROUNDROBIN.
+	NodeGroup string           // Node group storing the table, which is empty for the default node group.
+}
+
+var (
+	tableDistributionSqlTmp = `
+SELECT
+	p.pclocatortype::text AS locator_type,
+	p.pcattnum::text      AS column_numbers,
+	COALESCE(p.pgroup::text, '') AS node_group
+FROM pgxc_class p
+WHERE p.pcrelid = '%s'::regclass`
+
+	tableColumnNumbersSqlTmp = `
+SELECT
+	a.attnum  AS number,
+	a.attname AS name
+FROM pg_attribute a
+WHERE a.attrelid = '%s'::regclass
+	AND a.attisdropped IS FALSE
+	AND a.attnum > 0`
+
+	// distributionLocatorTypes maps the locator type of pgxc_class to the distribution type.
+	distributionLocatorTypes = map[string]DistributionType{
+		"H": DistributionHash,
+		"R": DistributionReplication,
+		"N": DistributionRoundRobin,
+		"M": DistributionModulo,
+		"G": DistributionRange,
+		"L": DistributionList,
+	}
+)
+
+func init() {
+	var err error
+	if tableDistributionSqlTmp, err = gdb.FormatMultiLineSqlToSingle(tableDistributionSqlTmp); err != nil {
+		panic(err)
+	}
+	if tableColumnNumbersSqlTmp, err = gdb.FormatMultiLineSqlToSingle(tableColumnNumbersSqlTmp); err != nil {
+		panic(err)
+	}
+}
+
+// String returns the DDL clause of the distribution, which can be appended to the
+// "CREATE TABLE" statement, eg: DISTRIBUTE BY HASH("id") TO GROUP "group1".
+func (t TableDistribution) String() string {
+	if t.Type == "" {
+		return ""
+	}
+	var clause = "DISTRIBUTE BY " + string(t.Type)
+	if len(t.Columns) > 0 {
+		columns := make([]string, len(t.Columns))
+		for i, column := range t.Columns {
+			columns[i] = quoteChar + column + quoteChar
+		}
+		clause += "(" + strings.Join(columns, ", ") + ")"
+	}
+	if t.NodeGroup != "" {
+		clause += " TO GROUP " + quoteChar + t.NodeGroup + quoteChar
+	}
+	return clause
+}
+
+// TableDistribution retrieves and returns the distribution metadata of specified table.
+// It returns nil if the table is not distributed, eg: in centralized deployment of GaussDB.
+func (d *Driver) TableDistribution(ctx context.Context, table string) (*TableDistribution, error) {
+	link, err := d.SlaveLink()
+	if err != nil {
+		return nil, err
+	}
+	result, err := d.DoSelect(ctx, link, fmt.Sprintf(tableDistributionSqlTmp, table))
+	if err != nil {
+		return nil, err
+	}
+	if result.IsEmpty() {
+		return nil, nil
+	}
+	var (
+		record       = result[0]
+		locatorType  = record["locator_type"].String()
+		distribution = &TableDistribution{
+			Type:      distributionLocatorTypes[locatorType],
+			NodeGroup: record["node_group"].String(),
+		}
+	)
+	if distribution.Type == "" {
+		distribution.Type = DistributionType(locatorType)
+	}
+	// The column numbers are in format of int2vector, eg: "1 3".
+	columnNumbers := gstr.SplitAndTrim(record["column_numbers"].String(), " ")
+	if len(columnNumbers) == 0 {
+		return distribution, nil
+	}
+	columns, err := d.DoSelect(ctx, link, fmt.Sprintf(tableColumnNumbersSqlTmp, table))
+	if err != nil {
+		return nil, err
+	}
+	columnNames := make(map[string]string, len(columns))
+	for _, column := range columns {
+		columnNames[column["number"].String()] = column["name"].String()
+	}
+	for _, number := range columnNumbers {
+		if name, ok := columnNames[number]; ok {
+			distribution.Columns = append(distribution.Columns, name)
+		}
+	}
+	return distribution, nil
+}
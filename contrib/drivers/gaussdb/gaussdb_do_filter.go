@@ -56,6 +56,7 @@ func (d *Driver) DoFilter(
 		newSql = "INSERT" + newSql[len(gdb.InsertOperationIgnore):]
 	}
 
+	newSql = injectHints(ctx, newSql)
 	newArgs = args
 
 	return d.Core.DoFilter(ctx, link, newSql, newArgs)
@@ -0,0 +1,59 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gaussdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gogf/gf/v2/os/gctx"
+	"github.com/gogf/gf/v2/text/gregex"
+)
+
+const (
+	ctxKeyForHints gctx.StrKey = "CtxKeyForGaussDBHints"
+)
+
+// WithHints returns a new context containing the plan hints, which are injected as the
+// "/*+ ... */" comment into the statements committed with the context.
+//
+// Example:
+//
+//	db.Model("user").Ctx(gaussdb.WithHints(ctx, "leading((u o))", "nestloop(u o)")).All()
+func WithHints(ctx context.Context, hints ...string) context.Context {
+	if len(hints) == 0 {
+		return ctx
+	}
+	if existing, ok := ctx.Value(ctxKeyForHints).([]string); ok {
+		hints = append(append([]string{}, existing...), hints...)
+	}
+	return context.WithValue(ctx, ctxKeyForHints, hints)
+}
+
+// WithNodeGroup returns a new context containing the hint specifying the node group
+// in which the statements committed with the context are computed, in distributed GaussDB.
+func WithNodeGroup(ctx context.Context, nodeGroup string) context.Context {
+	return WithHints(ctx, fmt.Sprintf(`nodegroup(%s)`, nodeGroup))
+}
+
+// injectHints injects the hints of the context into the sql after its leading keyword,
+// eg: SELECT /*+ nodegroup(group1) */ * FROM user.
+func injectHints(ctx context.Context, sql string) string {
+	if ctx == nil {
+		return sql
+	}
+	hints, ok := ctx.Value(ctxKeyForHints).([]string)
+	if !ok || len(hints) == 0 {
+		return sql
+	}
+	match, _ := gregex.MatchString(`^(\s*(?i:SELECT|INSERT|UPDATE|DELETE|MERGE))\b`, sql)
+	if len(match) < 2 {
+		return sql
+	}
+	return fmt.Sprintf(`%s /*+ %s */%s`, match[1], strings.Join(hints, " "), sql[len(match[1]):])
+}
@@ -49,7 +49,9 @@ func configNodeToSource(config *gdb.ConfigNode) (string, error) {
 	if config.Namespace != "" {
 		source = fmt.Sprintf("%s search_path=%s", source, config.Namespace)
 	}
-	if config.Timezone != "" {
+	if config.SessionTimezone != "" {
+		source = fmt.Sprintf("%s timezone=%s", source, config.SessionTimezone)
+	} else if config.Timezone != "" {
 		source = fmt.Sprintf("%s timezone=%s", source, config.Timezone)
 	}
 	if config.Extra != "" {
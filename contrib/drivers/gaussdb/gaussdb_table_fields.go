@@ -9,8 +9,10 @@ package gaussdb
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/text/gstr"
 )
 
 var (
@@ -23,7 +25,8 @@ SELECT
     ic.column_default                                                                    AS default_value,
     b.description                                                                        AS comment,
     COALESCE(character_maximum_length, numeric_precision, -1)                            AS length,
-    numeric_scale                                                                        AS scale
+    numeric_scale                                                                        AS scale,
+    COALESCE(array_to_string(c.reloptions, ','), '')                                     AS table_options
 FROM pg_attribute a
     LEFT JOIN pg_class c                 ON a.attrelid = c.oid
     LEFT JOIN pg_constraint d            ON d.conrelid = c.oid AND a.attnum = d.conkey[1]
@@ -100,9 +103,29 @@ func (d *Driver) TableFields(
 			Null:    !m["null"].Bool(),
 			Key:     m["key"].String(),
 			Default: m["default_value"].Val(),
+			Extra:   getStorageOrientationExtra(m["table_options"].String()),
 			Comment: m["comment"].String(),
 		}
 		index++
 	}
 	return fields, nil
 }
+
+// getStorageOrientationExtra returns the extra information of the storage orientation from the
+// storage options of the table, eg: "orientation=column,compression=low".
+// It returns empty for row-store tables, which is the default orientation of GaussDB,
+// or else "orientation=column" like extra for column-store and other hybrid storage tables.
+func getStorageOrientationExtra(tableOptions string) string {
+	for _, option := range gstr.SplitAndTrim(tableOptions, ",") {
+		key, value, _ := strings.Cut(option, "=")
+		if !strings.EqualFold(strings.TrimSpace(key), "orientation") {
+			continue
+		}
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" || value == "row" {
+			return ""
+		}
+		return "orientation=" + value
+	}
+	return ""
+}
@@ -0,0 +1,113 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gaussdb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/gctx"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/test/gtest"
+
+	"github.com/gogf/gf/contrib/drivers/gaussdb/v2"
+)
+
+func Test_TableDistribution_String(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gaussdb.TableDistribution{}.String(), "")
+		t.Assert(
+			gaussdb.TableDistribution{Type: gaussdb.DistributionReplication}.String(),
+			`DISTRIBUTE BY REPLICATION`,
+		)
+		t.Assert(
+			gaussdb.TableDistribution{
+				Type:      gaussdb.DistributionHash,
+				Columns:   []string{"id", "tenant_id"},
+				NodeGroup: "group1",
+			}.String(),
+			`DISTRIBUTE BY HASH("id", "tenant_id") TO GROUP "group1"`,
+		)
+	})
+}
+
+func Test_TableDistribution(t *testing.T) {
+	table := createTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		driver := db.GetCore().GetDB().(*gdb.DriverWrapperDB).DB.(*gaussdb.Driver)
+		distribution, err := driver.TableDistribution(ctx, table)
+		t.AssertNil(err)
+		// The table is not distributed in centralized deployment.
+		if distribution != nil {
+			t.AssertNE(distribution.Type, "")
+			t.AssertNE(distribution.String(), "")
+		}
+	})
+}
+
+func Test_TableFields_ColumnStore(t *testing.T) {
+	table := fmt.Sprintf(`%s_%d`, TablePrefix+"cstore", gtime.TimestampNano())
+	_, err := db.Exec(ctx, fmt.Sprintf(`
+		CREATE TABLE %s (
+			id int8 NOT NULL,
+			name varchar(45) NOT NULL,
+			amount numeric(10, 2)
+		) WITH (ORIENTATION = COLUMN)`, table,
+	))
+	gtest.AssertNil(err)
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		fields, err := db.TableFields(ctx, table)
+		t.AssertNil(err)
+		t.Assert(len(fields), 3)
+		t.Assert(fields["id"].Extra, "orientation=column")
+		t.Assert(fields["name"].Type, "varchar(45)")
+		t.Assert(fields["amount"].Type, "numeric(10)")
+
+		_, err = db.Model(table).Data(gdb.Map{"id": 1, "name": "john", "amount": 1.5}).Insert()
+		t.AssertNil(err)
+		one, err := db.Model(table).Where("id", 1).One()
+		t.AssertNil(err)
+		t.Assert(one["name"], "john")
+		t.Assert(one["amount"].Float64(), 1.5)
+	})
+
+	gtest.C(t, func(t *gtest.T) {
+		rowTable := createTable()
+		defer dropTable(rowTable)
+
+		fields, err := db.TableFields(ctx, rowTable)
+		t.AssertNil(err)
+		t.Assert(fields["id"].Extra, "")
+	})
+}
+
+func Test_DoFilter_Hints(t *testing.T) {
+	var driver = gaussdb.Driver{}
+
+	gtest.C(t, func(t *gtest.T) {
+		ctx := gaussdb.WithNodeGroup(gctx.New(), "group1")
+		newSql, _, err := driver.DoFilter(ctx, nil, "SELECT * FROM users WHERE id = ?", nil)
+		t.AssertNil(err)
+		t.Assert(newSql, "SELECT /*+ nodegroup(group1) */ * FROM users WHERE id = $1")
+
+		ctx = gaussdb.WithHints(ctx, "nestloop(u o)")
+		newSql, _, err = driver.DoFilter(ctx, nil, "update users SET name = ?", nil)
+		t.AssertNil(err)
+		t.Assert(newSql, "update /*+ nodegroup(group1) nestloop(u o) */ users SET name = $1")
+	})
+
+	gtest.C(t, func(t *gtest.T) {
+		newSql, _, err := driver.DoFilter(gctx.New(), nil, "SELECT * FROM users", nil)
+		t.AssertNil(err)
+		t.Assert(newSql, "SELECT * FROM users")
+	})
+}
@@ -0,0 +1,33 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"io"
+
+	"github.com/gogf/gf/contrib/drivers/mysql/v2"
+)
+
+// LoadDataOption is the option for LoadData, which is the same as the option of mysql driver.
+type LoadDataOption = mysql.LoadDataOption
+
+// LoadData imports the data of `reader` into `table` in CSV like format by the "LOAD DATA LOCAL INFILE"
+// statement, which is much faster than batched INSERT for bulk imports.
+// MariaDB supports the same statement as MySQL, so it uses the implementation of mysql driver.
+// Note that the "local_infile" variable of the server should be enabled.
+//
+// Example:
+//
+//	result, err := driver.LoadData(ctx, "user", file, mariadb.LoadDataOption{
+//		Columns:     []string{"id", "-", "name"},
+//		IgnoreLines: 1,
+//	})
+func (d *Driver) LoadData(ctx context.Context, table string, reader io.Reader, option LoadDataOption) (sql.Result, error) {
+	return d.Driver.LoadData(ctx, table, reader, option)
+}
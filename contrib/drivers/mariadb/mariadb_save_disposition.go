@@ -0,0 +1,20 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mariadb
+
+import (
+	"database/sql"
+
+	"github.com/gogf/gf/v2/database/gdb"
+)
+
+// GetSaveDisposition returns the disposition of the saved row from the result of a single-row Save statement.
+// MariaDB reports the same affected rows count as MySQL for "INSERT ... ON DUPLICATE KEY UPDATE" statement,
+// which is 1 if the row is inserted, 2 if the existing row is updated, and 0 if the existing row is not changed.
+func (d *Driver) GetSaveDisposition(result sql.Result) gdb.SaveDisposition {
+	return d.Driver.GetSaveDisposition(result)
+}
@@ -0,0 +1,27 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mariadb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatStatementTimeout returns the select statement `sql` prefixed with "SET STATEMENT max_statement_time=N FOR",
+// as MariaDB does not support the optimizer hint MAX_EXECUTION_TIME of MySQL, and its max_statement_time is in seconds.
+func (d *Driver) FormatStatementTimeout(sql string, timeout time.Duration) string {
+	const selectKeyword = "SELECT "
+	if timeout.Milliseconds() <= 0 || len(sql) < len(selectKeyword) || !strings.EqualFold(sql[:len(selectKeyword)], selectKeyword) {
+		return sql
+	}
+	return fmt.Sprintf(
+		`SET STATEMENT max_statement_time=%s FOR %s`,
+		strconv.FormatFloat(float64(timeout.Milliseconds())/1000, 'f', -1, 64), sql,
+	)
+}
@@ -9,6 +9,7 @@ package mariadb
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/gogf/gf/v2/database/gdb"
 	"github.com/gogf/gf/v2/util/gutil"
@@ -25,7 +26,8 @@ SELECT
 	( CASE WHEN c.COLUMN_DEFAULT = 'NULL' OR c.COLUMN_DEFAULT IS NULL THEN NULL ELSE c.COLUMN_DEFAULT END) AS 'Default',
 	c.EXTRA AS 'Extra',
 	c.PRIVILEGES AS 'Privileges',
-	c.COLUMN_COMMENT AS 'Comment' 
+	c.COLUMN_COMMENT AS 'Comment',
+	c.IS_GENERATED AS 'Generated'
 FROM
 	information_schema.COLUMNS AS c
 	LEFT JOIN information_schema.CHECK_CONSTRAINTS AS ch ON c.TABLE_NAME = ch.TABLE_NAME 
@@ -69,14 +71,16 @@ func (d *Driver) TableFields(
 	fields = make(map[string]*gdb.TableField)
 	for i, m := range result {
 		fields[m["Field"].String()] = &gdb.TableField{
-			Index:   i,
-			Name:    m["Field"].String(),
-			Type:    m["Type"].String(),
-			Null:    m["Null"].Bool(),
-			Key:     m["Key"].String(),
-			Default: m["Default"].Val(),
-			Extra:   m["Extra"].String(),
-			Comment: m["Comment"].String(),
+			Index:     i,
+			Name:      m["Field"].String(),
+			Type:      m["Type"].String(),
+			Null:      m["Null"].Bool(),
+			Key:       m["Key"].String(),
+			Default:   m["Default"].Val(),
+			Extra:     m["Extra"].String(),
+			Comment:   m["Comment"].String(),
+			Generated: strings.EqualFold(m["Generated"].String(), "ALWAYS"),
+			Invisible: strings.Contains(strings.ToUpper(m["Extra"].String()), "INVISIBLE"),
 		}
 	}
 	return fields, nil
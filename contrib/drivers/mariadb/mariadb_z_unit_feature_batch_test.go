@@ -335,3 +335,31 @@ func Test_Model_Batch_WithTransaction(t *testing.T) {
 		t.Assert(count, 0)
 	})
 }
+
+// Test_Model_Batch_SaveWithDisposition tests batch save operation returning row dispositions
+func Test_Model_Batch_SaveWithDisposition(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		dispositions, err := db.Model(table).Data(g.Slice{
+			g.Map{"id": 1, "passport": "user_1"},
+			g.Map{"id": 2, "passport": "saved_2"},
+			g.Map{"id": 100, "passport": "saved_100", "password": "pass_100", "nickname": "name_100"},
+		}).SaveWithDisposition()
+		t.AssertNil(err)
+		t.Assert(dispositions, []gdb.SaveDisposition{
+			gdb.SaveDispositionUnchanged,
+			gdb.SaveDispositionUpdated,
+			gdb.SaveDispositionInserted,
+		})
+
+		value, err := db.Model(table).Where("id", 2).Value("passport")
+		t.AssertNil(err)
+		t.Assert(value, "saved_2")
+
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize+1)
+	})
+}
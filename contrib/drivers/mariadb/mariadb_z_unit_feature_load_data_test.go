@@ -0,0 +1,65 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mariadb_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gogf/gf/contrib/drivers/mariadb/v2"
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Driver_LoadData(t *testing.T) {
+	table := createTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Exec(ctx, "SET GLOBAL local_infile = 1")
+		t.AssertNil(err)
+
+		var (
+			driver = db.GetCore().GetDB().(*gdb.DriverWrapperDB).DB.(*mariadb.Driver)
+			data   = strings.Join([]string{
+				"id,ignored,passport,password",
+				`1,x,user_1,pass_1`,
+				`2,x,"user,2",pass_2`,
+				`3,x,user_3,pass_3`,
+			}, "\n")
+			readBytes int64
+		)
+		result, err := driver.LoadData(ctx, table, strings.NewReader(data), mariadb.LoadDataOption{
+			Columns:     []string{"id", "-", "passport", "password"},
+			Set:         map[string]string{"nickname": "CONCAT('name_', id)"},
+			IgnoreLines: 1,
+			Progress: func(n int64) {
+				readBytes = n
+			},
+		})
+		t.AssertNil(err)
+		n, _ := result.RowsAffected()
+		t.Assert(n, 3)
+		t.Assert(readBytes, len(data))
+
+		one, err := db.Model(table).WherePri(2).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "user,2")
+		t.Assert(one["nickname"], "name_2")
+
+		// The duplicate rows are replaced.
+		result, err = driver.LoadData(ctx, table, strings.NewReader("1\tuser_new\n"), mariadb.LoadDataOption{
+			Columns:            []string{"id", "passport"},
+			FieldsTerminatedBy: "\t",
+			Replace:            true,
+		})
+		t.AssertNil(err)
+		value, err := db.Model(table).WherePri(1).Value("passport")
+		t.AssertNil(err)
+		t.Assert(value, "user_new")
+	})
+}
@@ -0,0 +1,27 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mssql
+
+import (
+	"fmt"
+
+	"github.com/gogf/gf/v2/database/gdb"
+)
+
+// DateBucketFunction returns the SQL expression truncating the quoted `column` to the start of the date `bucket`.
+func (d *Driver) DateBucketFunction(column string, bucket gdb.DateBucket) string {
+	switch bucket {
+	case gdb.BucketHour:
+		return fmt.Sprintf(`DATEADD(HOUR,DATEDIFF(HOUR,0,%s),0)`, column)
+	case gdb.BucketMonth:
+		return fmt.Sprintf(`DATEFROMPARTS(YEAR(%s),MONTH(%s),1)`, column, column)
+	case gdb.BucketYear:
+		return fmt.Sprintf(`DATEFROMPARTS(YEAR(%s),1,1)`, column)
+	default:
+		return fmt.Sprintf(`CAST(%s AS DATE)`, column)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mssql
+
+import (
+	"errors"
+	"strings"
+
+	mssqldb "github.com/microsoft/go-mssqldb"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+)
+
+// ClassifyError classifies the error of SQL Server into the stable error code by its error number.
+func (d *Driver) ClassifyError(err error) gcode.Code {
+	var mssqlErr mssqldb.Error
+	if errors.As(err, &mssqlErr) {
+		return classifyErrorNumber(mssqlErr.Number, mssqlErr.Message)
+	}
+	return d.Core.ClassifyError(err)
+}
+
+// classifyErrorNumber classifies the error number of SQL Server into the stable error code.
+func classifyErrorNumber(number int32, message string) gcode.Code {
+	switch number {
+	case 2601, 2627: // Duplicate key row in unique index, violation of PRIMARY KEY or UNIQUE KEY constraint.
+		return gcode.CodeDbDuplicateKey
+	case 547:
+		// The error number is shared by FOREIGN KEY and CHECK constraints.
+		if strings.Contains(message, "FOREIGN KEY") || strings.Contains(message, "REFERENCE") {
+			return gcode.CodeDbForeignKeyViolation
+		}
+	case 1205: // Transaction was deadlocked and has been chosen as the deadlock victim.
+		return gcode.CodeDbDeadlock
+	case 3960: // Snapshot isolation transaction aborted due to update conflict.
+		return gcode.CodeDbSerializationFailure
+	case 102, 156: // Incorrect syntax near, incorrect syntax near the keyword.
+		return gcode.CodeDbSyntaxError
+	}
+	return gcode.CodeNil
+}
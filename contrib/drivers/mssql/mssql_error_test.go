@@ -0,0 +1,33 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mssql
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_classifyErrorNumber(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(classifyErrorNumber(2627, "Violation of PRIMARY KEY constraint"), gcode.CodeDbDuplicateKey)
+		t.Assert(classifyErrorNumber(2601, "Cannot insert duplicate key row"), gcode.CodeDbDuplicateKey)
+		t.Assert(
+			classifyErrorNumber(547, `The INSERT statement conflicted with the FOREIGN KEY constraint "FK_user"`),
+			gcode.CodeDbForeignKeyViolation,
+		)
+		t.Assert(
+			classifyErrorNumber(547, `The INSERT statement conflicted with the CHECK constraint "CK_age"`),
+			gcode.CodeNil,
+		)
+		t.Assert(classifyErrorNumber(1205, "Transaction was deadlocked"), gcode.CodeDbDeadlock)
+		t.Assert(classifyErrorNumber(3960, "Snapshot isolation transaction aborted"), gcode.CodeDbSerializationFailure)
+		t.Assert(classifyErrorNumber(102, "Incorrect syntax near"), gcode.CodeDbSyntaxError)
+		t.Assert(classifyErrorNumber(208, "Invalid object name"), gcode.CodeNil)
+	})
+}
@@ -0,0 +1,35 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mssql
+
+import (
+	"encoding/hex"
+
+	"github.com/gogf/gf/v2/database/gdb"
+)
+
+// FormatLiteral returns `value` as the SQL literal of SQL Server, in which the boolean is 1 or 0,
+// the binary is in hexadecimal like 0x01ab, and the string is unicode string like N'abc'.
+func (d *Driver) FormatLiteral(value any) string {
+	if v, ok := value.(gdb.Value); ok {
+		value = v.Val()
+	}
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return "1"
+		}
+		return "0"
+	case []byte:
+		if v != nil {
+			return "0x" + hex.EncodeToString(v)
+		}
+	case string:
+		return "N" + d.Core.FormatLiteral(v)
+	}
+	return d.Core.FormatLiteral(value)
+}
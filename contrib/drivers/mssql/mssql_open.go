@@ -9,11 +9,14 @@ package mssql
 import (
 	"database/sql"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/gogf/gf/v2/database/gdb"
 	"github.com/gogf/gf/v2/errors/gcode"
 	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gconv"
 )
 
 // Open creates and returns an underlying sql.DB object for mssql.
@@ -33,6 +36,25 @@ func (d *Driver) Open(config *gdb.ConfigNode) (db *sql.DB, err error) {
 	return
 }
 
+// The documented keys of SQL Server specific connection features in the Extra configuration,
+// which are case-insensitive and converted to the connection parameters of the underlying driver.
+// The other keys of Extra configuration are passed to the underlying driver as they are.
+// Eg: mssql:sa:12345678@tcp(127.0.0.1:1433)/test?columnEncryption=true&applicationIntent=ReadOnly
+const (
+	// extraKeyColumnEncryption enables the column decryption of Always Encrypted if it is true,
+	// which requires the column master key providers registered in the underlying driver.
+	extraKeyColumnEncryption = "columnencryption"
+
+	// extraKeyApplicationIntent declares the application workload type, which is "ReadWrite" in default.
+	// It routes the connections to the readable secondary replicas of Availability Groups if it is "ReadOnly".
+	extraKeyApplicationIntent = "applicationintent"
+
+	// extraKeyMultipleActiveResultSets is the MARS (Multiple Active Result Sets) option, which is not
+	// supported by the underlying driver. It is only accepted as false for the compatibility with
+	// the connection strings of ADO.NET.
+	extraKeyMultipleActiveResultSets = "multipleactiveresultsets"
+)
+
 func configNodeToSource(config *gdb.ConfigNode) (string, error) {
 	var source string
 	source = fmt.Sprintf(
@@ -54,8 +76,38 @@ func configNodeToSource(config *gdb.ConfigNode) (string, error) {
 				`invalid extra configuration: %s`, config.Extra,
 			)
 		}
-		for k, v := range extraMap {
-			source += fmt.Sprintf(`;%s=%s`, k, v)
+		// The keys are sorted for stable connection string.
+		keys := make([]string, 0, len(extraMap))
+		for k := range extraMap {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			v := gconv.String(extraMap[k])
+			switch strings.ToLower(k) {
+			case extraKeyColumnEncryption:
+				source += fmt.Sprintf(`;columnencryption=%t`, gconv.Bool(v))
+
+			case extraKeyApplicationIntent:
+				if !strings.EqualFold(v, "ReadOnly") && !strings.EqualFold(v, "ReadWrite") {
+					return "", gerror.NewCodef(
+						gcode.CodeInvalidParameter,
+						`invalid applicationIntent "%s", it should be "ReadOnly" or "ReadWrite"`, v,
+					)
+				}
+				source += fmt.Sprintf(`;ApplicationIntent=%s`, v)
+
+			case extraKeyMultipleActiveResultSets:
+				if gconv.Bool(v) {
+					return "", gerror.NewCode(
+						gcode.CodeNotSupported,
+						`multipleActiveResultSets is not supported by the underlying driver`,
+					)
+				}
+
+			default:
+				source += fmt.Sprintf(`;%s=%s`, k, v)
+			}
 		}
 	}
 	return source, nil
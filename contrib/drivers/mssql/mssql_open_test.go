@@ -0,0 +1,58 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mssql
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_configNodeToSource(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		source, err := configNodeToSource(&gdb.ConfigNode{
+			Host: "127.0.0.1",
+			Port: "1433",
+			User: "sa",
+			Pass: "password",
+			Name: "test",
+		})
+		t.AssertNil(err)
+		t.Assert(source, "user id=sa;password=password;server=127.0.0.1;encrypt=disable;database=test;port=1433")
+	})
+	// SQL Server specific connection features.
+	gtest.C(t, func(t *gtest.T) {
+		source, err := configNodeToSource(&gdb.ConfigNode{
+			Host:  "127.0.0.1",
+			User:  "sa",
+			Pass:  "password",
+			Extra: "columnEncryption=true&applicationIntent=ReadOnly&multipleActiveResultSets=false&encrypt=true",
+		})
+		t.AssertNil(err)
+		t.Assert(
+			source,
+			"user id=sa;password=password;server=127.0.0.1;encrypt=disable;"+
+				"ApplicationIntent=ReadOnly;columnencryption=true;encrypt=true",
+		)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		_, err := configNodeToSource(&gdb.ConfigNode{
+			Host:  "127.0.0.1",
+			Extra: "applicationIntent=Invalid",
+		})
+		t.Assert(gerror.Code(err), gcode.CodeInvalidParameter)
+
+		_, err = configNodeToSource(&gdb.ConfigNode{
+			Host:  "127.0.0.1",
+			Extra: "multipleActiveResultSets=true",
+		})
+		t.Assert(gerror.Code(err), gcode.CodeNotSupported)
+	})
+}
@@ -17,6 +17,7 @@ import (
 // Driver is the driver for mysql database.
 type Driver struct {
 	*gdb.Core
+	gtidOption *gtidConsistencyOption // GTID consistency option, which is nil if it is not enabled.
 }
 
 const (
@@ -45,7 +46,8 @@ func New() gdb.Driver {
 // It implements the interface of gdb.Driver for extra database driver installation.
 func (d *Driver) New(core *gdb.Core, node *gdb.ConfigNode) (gdb.DB, error) {
 	return &Driver{
-		Core: core,
+		Core:       core,
+		gtidOption: newGTIDConsistencyOption(node),
 	}, nil
 }
 
@@ -0,0 +1,50 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mysql
+
+import (
+	"errors"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+)
+
+// errorNumberCodes maps the error numbers of MySQL and its compatible databases to the error codes.
+var errorNumberCodes = map[uint16]gcode.Code{
+	1022: gcode.CodeDbDuplicateKey,         // ER_DUP_KEY
+	1062: gcode.CodeDbDuplicateKey,         // ER_DUP_ENTRY
+	1586: gcode.CodeDbDuplicateKey,         // ER_DUP_ENTRY_WITH_KEY_NAME
+	1216: gcode.CodeDbForeignKeyViolation,  // ER_NO_REFERENCED_ROW
+	1217: gcode.CodeDbForeignKeyViolation,  // ER_ROW_IS_REFERENCED
+	1451: gcode.CodeDbForeignKeyViolation,  // ER_ROW_IS_REFERENCED_2
+	1452: gcode.CodeDbForeignKeyViolation,  // ER_NO_REFERENCED_ROW_2
+	1213: gcode.CodeDbDeadlock,             // ER_LOCK_DEADLOCK
+	9007: gcode.CodeDbSerializationFailure, // Write conflict of the optimistic transaction of TiDB.
+	1053: gcode.CodeDbConnectionLost,       // ER_SERVER_SHUTDOWN
+	1927: gcode.CodeDbConnectionLost,       // ER_CONNECTION_KILLED
+	2006: gcode.CodeDbConnectionLost,       // CR_SERVER_GONE_ERROR
+	2013: gcode.CodeDbConnectionLost,       // CR_SERVER_LOST
+	4031: gcode.CodeDbConnectionLost,       // ER_CLIENT_INTERACTION_TIMEOUT
+	1064: gcode.CodeDbSyntaxError,          // ER_PARSE_ERROR
+	1149: gcode.CodeDbSyntaxError,          // ER_SYNTAX_ERROR
+}
+
+// ClassifyError classifies the error of MySQL into the stable error code by its error number.
+func (d *Driver) ClassifyError(err error) gcode.Code {
+	var mysqlErr *mysqldriver.MySQLError
+	if errors.As(err, &mysqlErr) {
+		if code, ok := errorNumberCodes[mysqlErr.Number]; ok {
+			return code
+		}
+		return gcode.CodeNil
+	}
+	if errors.Is(err, mysqldriver.ErrInvalidConn) {
+		return gcode.CodeDbConnectionLost
+	}
+	return d.Core.ClassifyError(err)
+}
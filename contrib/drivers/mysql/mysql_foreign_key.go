@@ -0,0 +1,15 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mysql
+
+// FormatForeignKeyChecks returns the statement setting FOREIGN_KEY_CHECKS of current session.
+func (d *Driver) FormatForeignKeyChecks(enabled bool) string {
+	if enabled {
+		return `SET FOREIGN_KEY_CHECKS = 1`
+	}
+	return `SET FOREIGN_KEY_CHECKS = 0`
+}
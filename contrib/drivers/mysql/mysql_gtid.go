@@ -0,0 +1,214 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/gctx"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+// The driver options in the Extra configuration for GTID based read-after-write consistency,
+// which are consumed by the driver and are not passed to the underlying driver, eg:
+// mysql:root:12345678@tcp(127.0.0.1:3306)/test?gtidConsistency=session&gtidWaitTimeout=2
+const (
+	// extraKeyGTIDConsistency specifies the scope of GTID consistency, which is "session" or "global".
+	// In "session" scope, the read statements on slave nodes wait for the writes executed in the
+	// contexts created by WithGTIDSession. In "global" scope, they wait for all the writes of the DB object.
+	extraKeyGTIDConsistency = "gtidConsistency"
+
+	// extraKeyGTIDWaitTimeout specifies the timeout in seconds waiting for the GTID set on slave nodes,
+	// which is 1 second in default. The read statement falls back to master node if it times out.
+	extraKeyGTIDWaitTimeout = "gtidWaitTimeout"
+)
+
+const (
+	gtidConsistencySession = "session"
+	gtidConsistencyGlobal  = "global"
+
+	defaultGTIDWaitTimeout                = 1
+	ctxKeyGTIDSession         gctx.StrKey = `CtxKeyGTIDSession`
+	executedGTIDSetSql                    = `SELECT @@GLOBAL.gtid_executed AS gtid_executed`
+	waitForExecutedGTIDSetSql             = `SELECT WAIT_FOR_EXECUTED_GTID_SET(?, ?) AS timeout`
+)
+
+// gtidConsistencyOption is the GTID consistency option of the driver.
+type gtidConsistencyOption struct {
+	Scope       string
+	WaitTimeout int
+	Global      *gtidSession // Session of all the writes in "global" scope.
+}
+
+// gtidSession tracks the GTID set that the read statements on slave nodes should wait for.
+type gtidSession struct {
+	mu    sync.Mutex
+	gtid  string // The GTID set executed on master node.
+	dirty bool   // Whether there're writes after the GTID set is retrieved.
+}
+
+// WithGTIDSession returns a new context that the read statements executed on slave nodes in the
+// context wait until the writes executed in the context are replicated, using the GTID of MySQL.
+// It works for the DB objects configured with "gtidConsistency=session" in Extra configuration.
+//
+// The context is usually created once per request or session and passed all through the
+// logic procedure.
+func WithGTIDSession(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxKeyGTIDSession, &gtidSession{})
+}
+
+// WithGTIDSet returns a new context like WithGTIDSession, of which the read statements on slave
+// nodes wait for the given GTID set, which is usually retrieved by Driver.ExecutedGTIDSet after
+// writes and propagated from other services or requests.
+func WithGTIDSet(ctx context.Context, gtidSet string) context.Context {
+	return context.WithValue(ctx, ctxKeyGTIDSession, &gtidSession{gtid: gtidSet})
+}
+
+// ExecutedGTIDSet retrieves and returns the GTID set executed on master node, which contains
+// all the writes committed before the calling.
+func (d *Driver) ExecutedGTIDSet(ctx context.Context) (string, error) {
+	link, err := d.MasterLink()
+	if err != nil {
+		return "", err
+	}
+	result, err := d.Core.DoQuery(ctx, link, executedGTIDSetSql)
+	if err != nil {
+		return "", err
+	}
+	if len(result) == 0 {
+		return "", nil
+	}
+	return result[0]["gtid_executed"].String(), nil
+}
+
+// DoQuery commits the sql string and its arguments to underlying driver
+// through given link object and returns the execution result.
+// It waits for the GTID set on slave nodes if GTID consistency is enabled.
+func (d *Driver) DoQuery(ctx context.Context, link gdb.Link, sql string, args ...any) (gdb.Result, error) {
+	if d.gtidOption != nil {
+		var err error
+		if link, err = d.getGTIDConsistentLink(ctx, link); err != nil {
+			return nil, err
+		}
+	}
+	return d.Core.DoQuery(ctx, link, sql, args...)
+}
+
+// DoExec commits the sql string and its arguments to underlying driver
+// through given link object and returns the execution result.
+// It marks the GTID session after writes if GTID consistency is enabled.
+func (d *Driver) DoExec(ctx context.Context, link gdb.Link, sql string, args ...any) (sql.Result, error) {
+	result, err := d.Core.DoExec(ctx, link, sql, args...)
+	if err == nil && d.gtidOption != nil {
+		if session := d.getGTIDSession(ctx); session != nil {
+			session.mu.Lock()
+			session.dirty = true
+			session.mu.Unlock()
+		}
+	}
+	return result, err
+}
+
+// getGTIDSession returns the GTID session of the scope of the driver, which is nil if there's none.
+func (d *Driver) getGTIDSession(ctx context.Context) *gtidSession {
+	if session, ok := ctx.Value(ctxKeyGTIDSession).(*gtidSession); ok {
+		return session
+	}
+	if d.gtidOption.Scope == gtidConsistencyGlobal {
+		return d.gtidOption.Global
+	}
+	return nil
+}
+
+// getGTIDConsistentLink waits for the GTID set of the session on the slave link, and returns the link
+// for the read statement, which is the master link if it times out.
+func (d *Driver) getGTIDConsistentLink(ctx context.Context, link gdb.Link) (gdb.Link, error) {
+	session := d.getGTIDSession(ctx)
+	if session == nil || gdb.TXFromCtx(ctx, d.GetGroup()) != nil || gdb.IsMasterRead(ctx) {
+		return link, nil
+	}
+	if link == nil {
+		slaveLink, err := d.SlaveLink()
+		if err != nil {
+			return nil, err
+		}
+		link = slaveLink
+	}
+	if link.IsOnMaster() || link.IsTransaction() {
+		return link, nil
+	}
+	gtid, err := d.getSessionGTIDSet(ctx, session)
+	if err != nil || gtid == "" {
+		return link, err
+	}
+	result, err := d.Core.DoQuery(ctx, link, waitForExecutedGTIDSetSql, gtid, d.gtidOption.WaitTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) > 0 && result[0]["timeout"].Int() == 0 {
+		return link, nil
+	}
+	// The slave node lags behind, it reads from master node.
+	return d.MasterLink()
+}
+
+// getSessionGTIDSet returns the GTID set of `session`, which is retrieved from master node if
+// there're writes after last retrieving.
+func (d *Driver) getSessionGTIDSet(ctx context.Context, session *gtidSession) (string, error) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.dirty {
+		gtid, err := d.ExecutedGTIDSet(ctx)
+		if err != nil {
+			return "", err
+		}
+		session.gtid = gtid
+		session.dirty = false
+	}
+	return session.gtid, nil
+}
+
+// newGTIDConsistencyOption creates and returns the GTID consistency option from the Extra configuration,
+// which is nil if it is not enabled.
+func newGTIDConsistencyOption(node *gdb.ConfigNode) *gtidConsistencyOption {
+	if node == nil || node.Extra == "" {
+		return nil
+	}
+	extraMap, _ := gstr.Parse(node.Extra)
+	scope := strings.ToLower(gconv.String(extraMap[extraKeyGTIDConsistency]))
+	if scope != gtidConsistencySession && scope != gtidConsistencyGlobal {
+		return nil
+	}
+	option := &gtidConsistencyOption{
+		Scope:       scope,
+		WaitTimeout: gconv.Int(extraMap[extraKeyGTIDWaitTimeout]),
+		Global:      &gtidSession{},
+	}
+	if option.WaitTimeout <= 0 {
+		option.WaitTimeout = defaultGTIDWaitTimeout
+	}
+	return option
+}
+
+// removeDriverExtraOptions removes the driver options from the Extra configuration,
+// which are not supported by the underlying driver.
+func removeDriverExtraOptions(extra string) string {
+	var params []string
+	for _, param := range strings.Split(extra, "&") {
+		switch strings.SplitN(param, "=", 2)[0] {
+		case extraKeyGTIDConsistency, extraKeyGTIDWaitTimeout:
+		default:
+			params = append(params, param)
+		}
+	}
+	return strings.Join(params, "&")
+}
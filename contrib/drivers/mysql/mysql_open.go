@@ -53,8 +53,20 @@ func configNodeToSource(config *gdb.ConfigNode) string {
 		}
 		source = fmt.Sprintf("%s&loc=%s", source, config.Timezone)
 	}
-	if config.Extra != "" {
-		source = fmt.Sprintf("%s&%s", source, config.Extra)
+	if config.SessionTimezone != "" {
+		// The session variable value is quoted as string literal, eg: time_zone='+08:00'.
+		source = fmt.Sprintf("%s&time_zone=%s", source, url.QueryEscape("'"+config.SessionTimezone+"'"))
+	}
+	if milliseconds := config.StatementTimeout.Milliseconds(); milliseconds > 0 {
+		// The server-side execution timeout of select statements, which is in seconds for mariadb.
+		if config.Type == "mariadb" {
+			source = fmt.Sprintf("%s&max_statement_time=%g", source, config.StatementTimeout.Seconds())
+		} else {
+			source = fmt.Sprintf("%s&max_execution_time=%d", source, milliseconds)
+		}
+	}
+	if extra := removeDriverExtraOptions(config.Extra); extra != "" {
+		source = fmt.Sprintf("%s&%s", source, extra)
 	}
 	return source
 }
@@ -0,0 +1,41 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+// AnalyzeQueryPlan explains the select statement `sql` and returns the issues found in its query plan.
+// The steps of type ALL are reported as full scans, and the steps with Extra "Using filesort"
+// are reported as filesorts.
+func (d *Driver) AnalyzeQueryPlan(ctx context.Context, link gdb.Link, sql string, args ...any) ([]gdb.QueryPlanIssue, error) {
+	result, err := d.DoSelect(ctx, link, "EXPLAIN "+sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	var issues []gdb.QueryPlanIssue
+	for _, record := range result {
+		var (
+			table  = record["table"].String()
+			extra  = record["Extra"].String()
+			detail = fmt.Sprintf(`type: %s, rows: %s, Extra: %s`, record["type"].String(), record["rows"].String(), extra)
+		)
+		if strings.EqualFold(record["type"].String(), "ALL") {
+			issues = append(issues, gdb.QueryPlanIssue{Type: gdb.QueryPlanIssueFullScan, Table: table, Detail: detail})
+		}
+		if gstr.ContainsI(extra, "Using filesort") {
+			issues = append(issues, gdb.QueryPlanIssue{Type: gdb.QueryPlanIssueFileSort, Table: table, Detail: detail})
+		}
+	}
+	return issues, nil
+}
@@ -0,0 +1,37 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/gogf/gf/v2/database/gdb"
+)
+
+// GetSaveDisposition returns the disposition of the saved row from the result of a single-row Save statement.
+// The affected rows count of "INSERT ... ON DUPLICATE KEY UPDATE" statement is 1 if the row is inserted,
+// 2 if the existing row is updated, and 0 if the existing row is not changed.
+// Note that it cannot tell the unchanged row from the inserted one if the "clientFoundRows" option is enabled.
+func (d *Driver) GetSaveDisposition(result sql.Result) gdb.SaveDisposition {
+	if result == nil {
+		return gdb.SaveDispositionUnknown
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return gdb.SaveDispositionUnknown
+	}
+	switch affected {
+	case 0:
+		return gdb.SaveDispositionUnchanged
+	case 1:
+		return gdb.SaveDispositionInserted
+	case 2:
+		return gdb.SaveDispositionUpdated
+	default:
+		return gdb.SaveDispositionUnknown
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mysql
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FormatStatementTimeout returns the select statement `sql` with the optimizer hint MAX_EXECUTION_TIME
+// of MySQL 5.7+, which kills the statement server-side if it runs longer than `timeout`.
+// The hint only takes effect for the top level SELECT statement, so other statements are returned as they are.
+func (d *Driver) FormatStatementTimeout(sql string, timeout time.Duration) string {
+	const selectKeyword = "SELECT "
+	milliseconds := timeout.Milliseconds()
+	if milliseconds <= 0 || len(sql) < len(selectKeyword) || !strings.EqualFold(sql[:len(selectKeyword)], selectKeyword) {
+		return sql
+	}
+	return fmt.Sprintf(
+		`%s/*+ MAX_EXECUTION_TIME(%d) */ %s`,
+		sql[:len(selectKeyword)], milliseconds, sql[len(selectKeyword):],
+	)
+}
@@ -9,6 +9,7 @@ package mysql
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/gogf/gf/v2/database/gdb"
 	"github.com/gogf/gf/v2/util/gutil"
@@ -89,6 +90,7 @@ func (d *Driver) TableFields(ctx context.Context, table string, schema ...string
 	}
 	fields = make(map[string]*gdb.TableField)
 	for i, m := range result {
+		extra := strings.ToUpper(m["Extra"].String())
 		fields[m["Field"].String()] = &gdb.TableField{
 			Index:   i,
 			Name:    m["Field"].String(),
@@ -98,6 +100,11 @@ func (d *Driver) TableFields(ctx context.Context, table string, schema ...string
 			Default: m["Default"].Val(),
 			Extra:   m["Extra"].String(),
 			Comment: m["Comment"].String(),
+			// Note that "DEFAULT_GENERATED" is the column with expression default value instead of generated column.
+			Generated: strings.Contains(extra, "VIRTUAL GENERATED") ||
+				strings.Contains(extra, "STORED GENERATED") ||
+				strings.Contains(extra, "PERSISTENT GENERATED"),
+			Invisible: strings.Contains(extra, "INVISIBLE"),
 		}
 	}
 	return fields, nil
@@ -0,0 +1,46 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mysql
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// DoXA performs the `action` of the XA transaction branch `xid` in the transaction `tx`
+// using the XA statements of MySQL.
+func (d *Driver) DoXA(ctx context.Context, tx gdb.TX, action gdb.XAAction, xid string) error {
+	var (
+		quotedXid  = d.FormatLiteral(xid)
+		statements []string
+	)
+	switch action {
+	case gdb.XAActionStart:
+		// The XA transaction cannot be started in the local transaction started by BEGIN,
+		// which is ended in advance as there's nothing done in it.
+		statements = []string{"COMMIT", "XA START " + quotedXid}
+	case gdb.XAActionPrepare:
+		statements = []string{"XA END " + quotedXid, "XA PREPARE " + quotedXid}
+	case gdb.XAActionCommit:
+		statements = []string{"XA COMMIT " + quotedXid}
+	case gdb.XAActionRollback:
+		statements = []string{"XA END " + quotedXid, "XA ROLLBACK " + quotedXid}
+	case gdb.XAActionRollbackPrepared:
+		statements = []string{"XA ROLLBACK " + quotedXid}
+	default:
+		return gerror.NewCodef(gcode.CodeInvalidParameter, `invalid XA action "%s"`, action)
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mysql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_AnalyzeQueryPlan(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		issues, err := db.AnalyzeQueryPlan(ctx, nil, fmt.Sprintf("SELECT * FROM %s WHERE id=?", table), 1)
+		t.AssertNil(err)
+		t.Assert(len(issues), 0)
+
+		issues, err = db.AnalyzeQueryPlan(ctx, nil, fmt.Sprintf("SELECT * FROM %s WHERE nickname=? ORDER BY passport", table), "name_1")
+		t.AssertNil(err)
+		t.Assert(len(issues), 2)
+		t.Assert(issues[0].Type, gdb.QueryPlanIssueFullScan)
+		t.Assert(issues[0].Table, table)
+		t.Assert(issues[1].Type, gdb.QueryPlanIssueFileSort)
+		t.Assert(issues[1].Table, table)
+	})
+}
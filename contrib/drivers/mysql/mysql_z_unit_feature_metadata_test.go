@@ -7,8 +7,11 @@
 package mysql_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
 	"github.com/gogf/gf/v2/test/gtest"
 )
 
@@ -113,3 +116,54 @@ func Test_QuoteWord_AlreadyQuoted(t *testing.T) {
 		t.Assert(quoted, "`user`")
 	})
 }
+
+// Test_TableFields_GeneratedAndInvisible tests the generated and invisible column attributes,
+// and the generated columns are skipped automatically on writing.
+func Test_TableFields_GeneratedAndInvisible(t *testing.T) {
+	table := fmt.Sprintf(`%s_%d`, TableName+"_generated", gtime.TimestampNano())
+	_, err := db.Exec(ctx, fmt.Sprintf(`
+		CREATE TABLE %s (
+			id int(10) unsigned NOT NULL AUTO_INCREMENT,
+			price int(10) NOT NULL,
+			quantity int(10) NOT NULL,
+			total int(10) AS (price * quantity) VIRTUAL,
+			total_stored int(10) AS (price * quantity) STORED,
+			secret varchar(45) NULL INVISIBLE,
+			create_time datetime DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (id)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8;`, table,
+	))
+	if err != nil {
+		gtest.Fatal(err)
+	}
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		fields, err := db.TableFields(ctx, table)
+		t.AssertNil(err)
+		t.Assert(fields["total"].Generated, true)
+		t.Assert(fields["total_stored"].Generated, true)
+		t.Assert(fields["price"].Generated, false)
+		t.Assert(fields["create_time"].Generated, false)
+		t.Assert(fields["secret"].Invisible, true)
+		t.Assert(fields["price"].Invisible, false)
+
+		_, err = db.Model(table).Data(g.Map{
+			"id":           1,
+			"price":        2,
+			"quantity":     3,
+			"total":        100,
+			"total_stored": 100,
+			"secret":       "s",
+		}).Insert()
+		t.AssertNil(err)
+		_, err = db.Model(table).Data(g.Map{"quantity": 4, "total": 100}).WherePri(1).Update()
+		t.AssertNil(err)
+
+		one, err := db.Model(table).Fields("total", "total_stored", "secret").WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["total"], 8)
+		t.Assert(one["total_stored"], 8)
+		t.Assert(one["secret"], "s")
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package mysql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Saga_RunXA(t *testing.T) {
+	var (
+		table1 = createTableWithDb(db)
+		table2 = createTableWithDb(db2)
+	)
+	defer dropTableWithDb(db, table1)
+	defer dropTableWithDb(db2, table2)
+
+	newStep := func(db gdb.DB, table string, id int, fail bool) gdb.SagaStep {
+		return gdb.SagaStep{
+			Name: table,
+			DB:   db,
+			Action: func(ctx context.Context, tx gdb.TX) error {
+				if _, err := tx.Model(table).Data(g.Map{"id": id, "passport": table}).Insert(); err != nil {
+					return err
+				}
+				if fail {
+					return errors.New("failed")
+				}
+				return nil
+			},
+		}
+	}
+	gtest.C(t, func(t *gtest.T) {
+		err := gdb.NewSaga(newStep(db, table1, 1, false), newStep(db2, table2, 1, false)).RunXA(ctx)
+		t.AssertNil(err)
+		count, err := db.Model(table1).Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+		count, err = db2.Model(table2).Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+	})
+	// All branches are rolled back if any action fails.
+	gtest.C(t, func(t *gtest.T) {
+		err := gdb.NewSaga(newStep(db, table1, 2, false), newStep(db2, table2, 2, true)).RunXA(ctx)
+		t.Assert(err, `saga step "`+table2+`" failed: failed`)
+		count, err := db.Model(table1).Where("id", 2).Count()
+		t.AssertNil(err)
+		t.Assert(count, 0)
+		count, err = db2.Model(table2).Where("id", 2).Count()
+		t.AssertNil(err)
+		t.Assert(count, 0)
+	})
+}
@@ -7,9 +7,15 @@
 package mysql
 
 import (
+	"database/sql/driver"
 	"testing"
+	"time"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
 
 	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/test/gtest"
 )
 
@@ -28,3 +34,141 @@ func Test_configNodeToSource(t *testing.T) {
 		t.Assert(source, "username:password@unix(/tmp/mysql.sock)/dbname?charset=")
 	})
 }
+
+func Test_Driver_formatLoadDataSql(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		db, err := gdb.New(gdb.ConfigNode{
+			Link: "mysql:username:password@tcp(127.0.0.1:3306)/dbname",
+		})
+		t.AssertNil(err)
+		driver := db.(*gdb.DriverWrapperDB).DB.(*Driver)
+
+		t.Assert(
+			driver.formatLoadDataSql("user", "handler", LoadDataOption{}),
+			"LOAD DATA LOCAL INFILE 'Reader::handler' IGNORE INTO TABLE `user` CHARACTER SET utf8 "+
+				`FIELDS TERMINATED BY ',' OPTIONALLY ENCLOSED BY '"' LINES TERMINATED BY '\n'`,
+		)
+		t.Assert(
+			driver.formatLoadDataSql("user", "handler", LoadDataOption{
+				Columns:            []string{"id", "-", "@name"},
+				Set:                map[string]string{"nickname": "UPPER(@name)", "create_time": "NOW()"},
+				FieldsTerminatedBy: "\t",
+				FieldsEnclosedBy:   "'",
+				LinesTerminatedBy:  "\r\n",
+				IgnoreLines:        1,
+				Replace:            true,
+				Charset:            "utf8mb4",
+			}),
+			"LOAD DATA LOCAL INFILE 'Reader::handler' REPLACE INTO TABLE `user` CHARACTER SET utf8mb4 "+
+				`FIELDS TERMINATED BY '\t' OPTIONALLY ENCLOSED BY '\'' LINES TERMINATED BY '\r\n' IGNORE 1 LINES `+
+				"(`id`,@gf_ignored_1,@name) SET `create_time`=NOW(),`nickname`=UPPER(@name)",
+		)
+	})
+}
+
+func Test_configNodeToSource_DriverExtraOptions(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		configNode := &gdb.ConfigNode{
+			Host:     "127.0.0.1",
+			Port:     "3306",
+			User:     "username",
+			Pass:     "password",
+			Name:     "dbname",
+			Type:     "mysql",
+			Protocol: "tcp",
+			Charset:  "utf8",
+			Extra:    "gtidConsistency=session&parseTime=true&gtidWaitTimeout=2",
+		}
+		source := configNodeToSource(configNode)
+		t.Assert(source, "username:password@tcp(127.0.0.1:3306)/dbname?charset=utf8&parseTime=true")
+	})
+}
+
+func Test_newGTIDConsistencyOption(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(newGTIDConsistencyOption(nil), nil)
+		t.Assert(newGTIDConsistencyOption(&gdb.ConfigNode{Extra: "parseTime=true"}), nil)
+		t.Assert(newGTIDConsistencyOption(&gdb.ConfigNode{Extra: "gtidConsistency=invalid"}), nil)
+
+		option := newGTIDConsistencyOption(&gdb.ConfigNode{Extra: "gtidConsistency=Session"})
+		t.AssertNE(option, nil)
+		t.Assert(option.Scope, gtidConsistencySession)
+		t.Assert(option.WaitTimeout, defaultGTIDWaitTimeout)
+
+		option = newGTIDConsistencyOption(&gdb.ConfigNode{Extra: "gtidConsistency=global&gtidWaitTimeout=3"})
+		t.AssertNE(option, nil)
+		t.Assert(option.Scope, gtidConsistencyGlobal)
+		t.Assert(option.WaitTimeout, 3)
+	})
+}
+
+func Test_Driver_ClassifyError(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var d = &Driver{Core: &gdb.Core{}}
+		t.Assert(d.ClassifyError(&mysqldriver.MySQLError{Number: 1062}), gcode.CodeDbDuplicateKey)
+		t.Assert(d.ClassifyError(&mysqldriver.MySQLError{Number: 1452}), gcode.CodeDbForeignKeyViolation)
+		t.Assert(d.ClassifyError(&mysqldriver.MySQLError{Number: 1213}), gcode.CodeDbDeadlock)
+		t.Assert(d.ClassifyError(&mysqldriver.MySQLError{Number: 1064}), gcode.CodeDbSyntaxError)
+		t.Assert(d.ClassifyError(&mysqldriver.MySQLError{Number: 1146}), gcode.CodeNil)
+		t.Assert(d.ClassifyError(mysqldriver.ErrInvalidConn), gcode.CodeDbConnectionLost)
+		t.Assert(d.ClassifyError(driver.ErrBadConn), gcode.CodeDbConnectionLost)
+		t.Assert(d.ClassifyError(gerror.Wrap(&mysqldriver.MySQLError{Number: 1062}, "wrapped")), gcode.CodeDbDuplicateKey)
+		t.Assert(d.ClassifyError(nil), gcode.CodeNil)
+	})
+}
+
+func Test_configNodeToSource_StatementTimeout(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		configNode := &gdb.ConfigNode{
+			Host:             "127.0.0.1",
+			Port:             "3306",
+			User:             "username",
+			Pass:             "password",
+			Name:             "dbname",
+			Protocol:         "tcp",
+			StatementTimeout: 1500 * time.Millisecond,
+		}
+		t.Assert(
+			configNodeToSource(configNode),
+			"username:password@tcp(127.0.0.1:3306)/dbname?charset=&max_execution_time=1500",
+		)
+		configNode.Type = "mariadb"
+		t.Assert(
+			configNodeToSource(configNode),
+			"username:password@tcp(127.0.0.1:3306)/dbname?charset=&max_statement_time=1.5",
+		)
+	})
+}
+
+func Test_Driver_FormatLiteral(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		db, err := gdb.New(gdb.ConfigNode{
+			Link: "mysql:username:password@tcp(127.0.0.1:3306)/dbname",
+		})
+		t.AssertNil(err)
+		t.Assert(db.FormatLiteral(nil), "NULL")
+		t.Assert(db.FormatLiteral(1), "1")
+		t.Assert(db.FormatLiteral([]byte{0x01, 0xab}), "X'01ab'")
+		t.Assert(db.FormatLiteral(`it's\`), `'it''s\\'`)
+		t.Assert(db.FormatForeignKeyChecks(false), "SET FOREIGN_KEY_CHECKS = 0")
+	})
+}
+
+func Test_Driver_FormatStatementTimeout(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		d := &Driver{}
+		t.Assert(
+			d.FormatStatementTimeout("SELECT * FROM `user`", 2*time.Second),
+			"SELECT /*+ MAX_EXECUTION_TIME(2000) */ * FROM `user`",
+		)
+		t.Assert(
+			d.FormatStatementTimeout("select id FROM `user`", 500*time.Millisecond),
+			"select /*+ MAX_EXECUTION_TIME(500) */ id FROM `user`",
+		)
+		t.Assert(
+			d.FormatStatementTimeout("WITH t AS (SELECT 1) SELECT * FROM t", time.Second),
+			"WITH t AS (SELECT 1) SELECT * FROM t",
+		)
+		t.Assert(d.FormatStatementTimeout("SELECT 1", time.Microsecond), "SELECT 1")
+	})
+}
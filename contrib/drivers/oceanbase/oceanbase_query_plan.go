@@ -0,0 +1,18 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package oceanbase
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/database/gdb"
+)
+
+// AnalyzeQueryPlan is not supported by OceanBase, as its EXPLAIN output differs from MySQL's.
+func (d *Driver) AnalyzeQueryPlan(ctx context.Context, link gdb.Link, sql string, args ...any) ([]gdb.QueryPlanIssue, error) {
+	return d.Core.AnalyzeQueryPlan(ctx, link, sql, args...)
+}
@@ -0,0 +1,27 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package oceanbase
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FormatStatementTimeout returns the select statement `sql` with the hint QUERY_TIMEOUT of OceanBase,
+// which is in microseconds, instead of the optimizer hint MAX_EXECUTION_TIME of MySQL.
+func (d *Driver) FormatStatementTimeout(sql string, timeout time.Duration) string {
+	const selectKeyword = "SELECT "
+	microseconds := timeout.Microseconds()
+	if microseconds <= 0 || len(sql) < len(selectKeyword) || !strings.EqualFold(sql[:len(selectKeyword)], selectKeyword) {
+		return sql
+	}
+	return fmt.Sprintf(
+		`%s/*+ QUERY_TIMEOUT(%d) */ %s`,
+		sql[:len(selectKeyword)], microseconds, sql[len(selectKeyword):],
+	)
+}
@@ -0,0 +1,51 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package oracle
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+)
+
+var (
+	// oracleErrorRegex matches the error number of Oracle from the error message, eg: ORA-00001.
+	oracleErrorRegex = regexp.MustCompile(`ORA-(\d{5})`)
+
+	// errorNumberCodes maps the error numbers of Oracle to the error codes.
+	errorNumberCodes = map[int]gcode.Code{
+		1:     gcode.CodeDbDuplicateKey,         // Unique constraint violated.
+		2291:  gcode.CodeDbForeignKeyViolation,  // Integrity constraint violated - parent key not found.
+		2292:  gcode.CodeDbForeignKeyViolation,  // Integrity constraint violated - child record found.
+		60:    gcode.CodeDbDeadlock,             // Deadlock detected while waiting for resource.
+		8177:  gcode.CodeDbSerializationFailure, // Can't serialize access for this transaction.
+		3113:  gcode.CodeDbConnectionLost,       // End-of-file on communication channel.
+		3114:  gcode.CodeDbConnectionLost,       // Not connected to ORACLE.
+		3135:  gcode.CodeDbConnectionLost,       // Connection lost contact.
+		12537: gcode.CodeDbConnectionLost,       // TNS:connection closed.
+		900:   gcode.CodeDbSyntaxError,          // Invalid SQL statement.
+		907:   gcode.CodeDbSyntaxError,          // Missing right parenthesis.
+		933:   gcode.CodeDbSyntaxError,          // SQL command not properly ended.
+		936:   gcode.CodeDbSyntaxError,          // Missing expression.
+	}
+)
+
+// ClassifyError classifies the error of Oracle into the stable error code by its ORA error number.
+func (d *Driver) ClassifyError(err error) gcode.Code {
+	if err == nil {
+		return gcode.CodeNil
+	}
+	if match := oracleErrorRegex.FindStringSubmatch(err.Error()); len(match) > 1 {
+		number, _ := strconv.Atoi(match[1])
+		if code, ok := errorNumberCodes[number]; ok {
+			return code
+		}
+		return gcode.CodeNil
+	}
+	return d.Core.ClassifyError(err)
+}
@@ -25,8 +25,10 @@ SELECT
     WHEN c.DATA_TYPE='FLOAT' THEN c.DATA_TYPE||'('||c.DATA_PRECISION||','||c.DATA_SCALE||')' 
     ELSE c.DATA_TYPE||'('||c.DATA_LENGTH||')' END AS TYPE,
     c.NULLABLE,
-    CASE WHEN pk.COLUMN_NAME IS NOT NULL THEN 'PRI' ELSE '' END AS KEY
+    CASE WHEN pk.COLUMN_NAME IS NOT NULL THEN 'PRI' ELSE '' END AS KEY,
+    cc.COMMENTS
 FROM USER_TAB_COLUMNS c
+LEFT JOIN USER_COL_COMMENTS cc ON c.TABLE_NAME = cc.TABLE_NAME AND c.COLUMN_NAME = cc.COLUMN_NAME
 LEFT JOIN (
     SELECT cols.COLUMN_NAME 
     FROM USER_CONSTRAINTS cons 
@@ -73,11 +75,12 @@ func (d *Driver) TableFields(ctx context.Context, table string, schema ...string
 		}
 
 		fields[m["FIELD"].String()] = &gdb.TableField{
-			Index: i,
-			Name:  m["FIELD"].String(),
-			Type:  m["TYPE"].String(),
-			Null:  isNull,
-			Key:   m["KEY"].String(),
+			Index:   i,
+			Name:    m["FIELD"].String(),
+			Type:    m["TYPE"].String(),
+			Null:    isNull,
+			Key:     m["KEY"].String(),
+			Comment: m["COMMENTS"].String(),
 		}
 	}
 	return fields, nil
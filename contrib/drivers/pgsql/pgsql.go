@@ -10,6 +10,8 @@ package pgsql
 import (
 	_ "github.com/lib/pq"
 
+	"github.com/gogf/gf/v2/container/gmap"
+	"github.com/gogf/gf/v2/container/gset"
 	"github.com/gogf/gf/v2/database/gdb"
 	"github.com/gogf/gf/v2/os/gctx"
 )
@@ -17,12 +19,17 @@ import (
 // Driver is the driver for postgresql database.
 type Driver struct {
 	*gdb.Core
+	autoPartitions    *gmap.StrAnyMap // Automatic partition creation configurations of tables.
+	createdPartitions *gset.StrSet    // Partitions created automatically, which are not created again.
 }
 
 const (
 	internalPrimaryKeyInCtx gctx.StrKey = "primary_key"
 	defaultSchema           string      = "public"
 	quoteChar               string      = `"`
+
+	// internalSaveInsertedField is the returned field telling whether the row is inserted in upsert operation.
+	internalSaveInsertedField = "gf_save_inserted"
 )
 
 func init() {
@@ -40,7 +47,9 @@ func New() gdb.Driver {
 // It implements the interface of gdb.Driver for extra database driver installation.
 func (d *Driver) New(core *gdb.Core, node *gdb.ConfigNode) (gdb.DB, error) {
 	return &Driver{
-		Core: core,
+		Core:              core,
+		autoPartitions:    gmap.NewStrAnyMap(true),
+		createdPartitions: gset.NewStrSet(true),
 	}, nil
 }
 
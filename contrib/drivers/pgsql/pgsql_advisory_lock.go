@@ -0,0 +1,161 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"hash/fnv"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// AdvisoryLock is the session scoped advisory lock of PostgreSQL, which holds a dedicated
+// connection until it is unlocked, as the lock belongs to the database session.
+type AdvisoryLock struct {
+	driver *Driver
+	conn   *sql.Conn
+	key    int64
+}
+
+// advisoryLockLink is the link of dedicated connection for session scoped advisory lock.
+type advisoryLockLink struct {
+	*sql.Conn
+}
+
+// AdvisoryLockKey returns the key of advisory lock for the lock `name`,
+// which is usually used for readable lock names, eg: "migration", "leader:job-scheduler".
+func AdvisoryLockKey(name string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	return int64(h.Sum64())
+}
+
+// AdvisoryLock acquires the session scoped advisory lock of `key`, which waits until the lock is
+// available. The returned lock should be unlocked by AdvisoryLock.Unlock.
+//
+// Example:
+//
+//	lock, err := driver.AdvisoryLock(ctx, pgsql.AdvisoryLockKey("migration"))
+//	if err != nil {
+//		return err
+//	}
+//	defer lock.Unlock(ctx)
+func (d *Driver) AdvisoryLock(ctx context.Context, key int64) (*AdvisoryLock, error) {
+	lock, _, err := d.doAdvisoryLock(ctx, key, false)
+	return lock, err
+}
+
+// TryAdvisoryLock tries acquiring the session scoped advisory lock of `key` without waiting.
+// It returns false if the lock is held by other sessions.
+func (d *Driver) TryAdvisoryLock(ctx context.Context, key int64) (lock *AdvisoryLock, ok bool, err error) {
+	return d.doAdvisoryLock(ctx, key, true)
+}
+
+// AdvisoryXactLock acquires the transaction scoped advisory lock of `key` in transaction `tx`,
+// which waits until the lock is available and is released automatically when the transaction ends.
+func (d *Driver) AdvisoryXactLock(ctx context.Context, tx gdb.TX, key int64) error {
+	_, err := tx.Ctx(ctx).Query(`SELECT pg_advisory_xact_lock($1)`, key)
+	return err
+}
+
+// TryAdvisoryXactLock tries acquiring the transaction scoped advisory lock of `key` in transaction
+// `tx` without waiting. It returns false if the lock is held by other sessions.
+func (d *Driver) TryAdvisoryXactLock(ctx context.Context, tx gdb.TX, key int64) (bool, error) {
+	value, err := tx.Ctx(ctx).GetValue(`SELECT pg_try_advisory_xact_lock($1)`, key)
+	if err != nil {
+		return false, err
+	}
+	return value.Bool(), nil
+}
+
+// doAdvisoryLock acquires the session scoped advisory lock of `key` on a dedicated connection.
+func (d *Driver) doAdvisoryLock(ctx context.Context, key int64, try bool) (*AdvisoryLock, bool, error) {
+	// The lock should be acquired on the dedicated connection instead of the transaction in context.
+	ctx = gdb.WithoutTX(ctx, d.GetGroup())
+	master, err := d.Master()
+	if err != nil {
+		return nil, false, err
+	}
+	conn, err := master.Conn(ctx)
+	if err != nil {
+		return nil, false, gerror.WrapCode(gcode.CodeDbOperationError, err, `retrieving connection failed`)
+	}
+	var (
+		link    = &advisoryLockLink{conn}
+		sqlStr  = `SELECT pg_advisory_lock($1)`
+		locked  = true
+		result  gdb.Result
+		release = func() {
+			_ = conn.Close()
+		}
+	)
+	if try {
+		sqlStr = `SELECT pg_try_advisory_lock($1) AS locked`
+	}
+	if result, err = d.DoSelect(ctx, link, sqlStr, key); err != nil {
+		release()
+		return nil, false, err
+	}
+	if try {
+		locked = len(result) > 0 && result[0]["locked"].Bool()
+	}
+	if !locked {
+		release()
+		return nil, false, nil
+	}
+	return &AdvisoryLock{
+		driver: d,
+		conn:   conn,
+		key:    key,
+	}, true, nil
+}
+
+// Key returns the key of the advisory lock.
+func (l *AdvisoryLock) Key() int64 {
+	return l.key
+}
+
+// Unlock releases the session scoped advisory lock and its dedicated connection.
+// The unlocking is not cancelled along with `ctx`, and the connection is discarded instead of being put
+// back to the pool if the unlocking fails, as the lock is held until the database session ends.
+func (l *AdvisoryLock) Unlock(ctx context.Context) error {
+	if l == nil || l.conn == nil {
+		return nil
+	}
+	conn := l.conn
+	l.conn = nil
+	result, err := l.driver.DoSelect(
+		gdb.WithoutTX(context.WithoutCancel(ctx), l.driver.GetGroup()),
+		&advisoryLockLink{conn}, `SELECT pg_advisory_unlock($1) AS unlocked`, l.key,
+	)
+	if err != nil {
+		// Returning driver.ErrBadConn makes the connection closed instead of being reused.
+		_ = conn.Raw(func(any) error {
+			return driver.ErrBadConn
+		})
+		return err
+	}
+	_ = conn.Close()
+	if len(result) == 0 || !result[0]["unlocked"].Bool() {
+		return gerror.NewCodef(gcode.CodeInvalidOperation, `advisory lock "%d" is not held`, l.key)
+	}
+	return nil
+}
+
+// IsOnMaster implements gdb.Link, the dedicated connection is always retrieved from master node.
+func (l *advisoryLockLink) IsOnMaster() bool {
+	return true
+}
+
+// IsTransaction implements gdb.Link.
+func (l *advisoryLockLink) IsTransaction() bool {
+	return false
+}
@@ -53,8 +53,15 @@ func (d *Driver) DoExec(ctx context.Context, link gdb.Link, sql string, args ...
 		isUseCoreDoExec = true
 	}
 
+	// Check if it is an upsert operation requiring the disposition of the saved row,
+	// in which the system column "xmax" is 0 only for the inserted row.
+	isSaveDisposition := gdb.IsSaveDispositionRequired(ctx) &&
+		strings.Contains(sql, "INSERT INTO") && strings.Contains(sql, "DO UPDATE")
+
 	// check if it is an insert operation.
-	if !isUseCoreDoExec && pkField.Name != "" && strings.Contains(sql, "INSERT INTO") {
+	if isSaveDisposition {
+		sql += fmt.Sprintf(` RETURNING (xmax = 0) AS "%s"`, internalSaveInsertedField)
+	} else if !isUseCoreDoExec && pkField.Name != "" && strings.Contains(sql, "INSERT INTO") {
 		primaryKey = pkField.Name
 		sql += fmt.Sprintf(` RETURNING "%s"`, primaryKey)
 	} else {
@@ -62,7 +69,8 @@ func (d *Driver) DoExec(ctx context.Context, link gdb.Link, sql string, args ...
 		return d.Core.DoExec(ctx, link, sql, args...)
 	}
 
-	// Only the insert operation with primary key can execute the following code
+	// Only the insert operation with primary key or the upsert operation requiring
+	// disposition can execute the following code
 
 	// Sql filtering.
 	sql, args = d.FormatSqlBeforeExecuting(sql, args)
@@ -86,6 +94,24 @@ func (d *Driver) DoExec(ctx context.Context, link gdb.Link, sql string, args ...
 		return nil, err
 	}
 	affected := len(out.Records)
+	if isSaveDisposition {
+		result := Result{
+			affected:        int64(affected),
+			saveDisposition: gdb.SaveDispositionUnknown,
+		}
+		switch affected {
+		case 0:
+			// No row is returned if the conflicting row is not touched by the upsert statement.
+			result.saveDisposition = gdb.SaveDispositionUnchanged
+		case 1:
+			if out.Records[0][internalSaveInsertedField].Bool() {
+				result.saveDisposition = gdb.SaveDispositionInserted
+			} else {
+				result.saveDisposition = gdb.SaveDispositionUpdated
+			}
+		}
+		return result, nil
+	}
 	if affected > 0 {
 		if !strings.Contains(pkField.Type, "int") {
 			return Result{
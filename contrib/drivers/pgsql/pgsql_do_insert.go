@@ -80,5 +80,8 @@ func (d *Driver) DoInsert(
 
 	default:
 	}
+	if err = d.createAutoPartitions(ctx, link, table, list); err != nil {
+		return nil, err
+	}
 	return d.Core.DoInsert(ctx, link, table, list, option)
 }
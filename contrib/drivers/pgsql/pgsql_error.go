@@ -0,0 +1,48 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package pgsql
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+)
+
+// ClassifyError classifies the error of PostgreSQL into the stable error code by its SQLSTATE.
+func (d *Driver) ClassifyError(err error) gcode.Code {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return classifySqlState(string(pqErr.Code))
+	}
+	return d.Core.ClassifyError(err)
+}
+
+// classifySqlState classifies the SQLSTATE of PostgreSQL into the stable error code.
+// See: https://www.postgresql.org/docs/current/errcodes-appendix.html
+func classifySqlState(state string) gcode.Code {
+	switch state {
+	case "23505": // unique_violation
+		return gcode.CodeDbDuplicateKey
+	case "23503": // foreign_key_violation
+		return gcode.CodeDbForeignKeyViolation
+	case "40P01": // deadlock_detected
+		return gcode.CodeDbDeadlock
+	case "40001": // serialization_failure
+		return gcode.CodeDbSerializationFailure
+	case "42601": // syntax_error
+		return gcode.CodeDbSyntaxError
+	case "57P01", "57P02", "57P03": // admin_shutdown, crash_shutdown, cannot_connect_now
+		return gcode.CodeDbConnectionLost
+	}
+	// Class 08: connection_exception.
+	if len(state) == 5 && state[:2] == "08" {
+		return gcode.CodeDbConnectionLost
+	}
+	return gcode.CodeNil
+}
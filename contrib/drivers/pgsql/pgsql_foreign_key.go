@@ -0,0 +1,16 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package pgsql
+
+// FormatForeignKeyChecks returns the statement setting the checks of the deferrable constraints of current
+// transaction, as PostgreSQL can not disable the checks of the constraints that are not deferrable.
+func (d *Driver) FormatForeignKeyChecks(enabled bool) string {
+	if enabled {
+		return `SET CONSTRAINTS ALL IMMEDIATE`
+	}
+	return `SET CONSTRAINTS ALL DEFERRED`
+}
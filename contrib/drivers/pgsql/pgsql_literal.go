@@ -0,0 +1,25 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package pgsql
+
+import (
+	"encoding/hex"
+
+	"github.com/gogf/gf/v2/database/gdb"
+)
+
+// FormatLiteral returns `value` as the SQL literal of PostgreSQL, in which the binary is like '\x01ab'::bytea,
+// as X'01ab' is the bit string of PostgreSQL.
+func (d *Driver) FormatLiteral(value any) string {
+	if v, ok := value.(gdb.Value); ok {
+		value = v.Val()
+	}
+	if v, ok := value.([]byte); ok && v != nil {
+		return `'\x` + hex.EncodeToString(v) + `'::bytea`
+	}
+	return d.Core.FormatLiteral(value)
+}
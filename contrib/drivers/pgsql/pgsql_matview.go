@@ -0,0 +1,83 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package pgsql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/util/gutil"
+)
+
+const (
+	matViewsSql = `SELECT matviewname FROM pg_matviews WHERE schemaname = $1 ORDER BY matviewname`
+)
+
+// MaterializedViews retrieves and returns the materialized views of current schema.
+func (d *Driver) MaterializedViews(ctx context.Context, schema ...string) (views []string, err error) {
+	var (
+		result     gdb.Result
+		usedSchema = gutil.GetOrDefaultStr(d.GetConfig().Namespace, schema...)
+	)
+	if usedSchema == "" {
+		usedSchema = defaultSchema
+	}
+	// DO NOT use `usedSchema` as parameter for function `SlaveLink`.
+	link, err := d.SlaveLink(schema...)
+	if err != nil {
+		return nil, err
+	}
+	if result, err = d.DoSelect(ctx, link, matViewsSql, usedSchema); err != nil {
+		return nil, err
+	}
+	for _, record := range result {
+		views = append(views, record["matviewname"].String())
+	}
+	return
+}
+
+// CreateMaterializedView creates materialized view `name` with select statement `query`.
+// The view is created without data if `withData` is false, which can be populated by
+// RefreshMaterializedView later.
+func (d *Driver) CreateMaterializedView(ctx context.Context, name, query string, withData bool) error {
+	if name == "" || query == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, `materialized view name and query should not be empty`)
+	}
+	sql := fmt.Sprintf(`CREATE MATERIALIZED VIEW %s AS %s`, d.QuoteWord(name), query)
+	if !withData {
+		sql += ` WITH NO DATA`
+	}
+	_, err := d.Exec(ctx, sql)
+	return err
+}
+
+// RefreshMaterializedView refreshes the data of materialized view `name`.
+// It refreshes the view without locking out concurrent selects if `concurrently` is true,
+// which requires at least one unique index on the view.
+func (d *Driver) RefreshMaterializedView(ctx context.Context, name string, concurrently bool) error {
+	if name == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, `materialized view name should not be empty`)
+	}
+	sql := `REFRESH MATERIALIZED VIEW `
+	if concurrently {
+		sql += `CONCURRENTLY `
+	}
+	_, err := d.Exec(ctx, sql+d.QuoteWord(name))
+	return err
+}
+
+// DropMaterializedView drops materialized view `name` if it exists.
+func (d *Driver) DropMaterializedView(ctx context.Context, name string) error {
+	if name == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, `materialized view name should not be empty`)
+	}
+	_, err := d.Exec(ctx, fmt.Sprintf(`DROP MATERIALIZED VIEW IF EXISTS %s`, d.QuoteWord(name)))
+	return err
+}
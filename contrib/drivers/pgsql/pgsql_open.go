@@ -49,9 +49,14 @@ func configNodeToSource(config *gdb.ConfigNode) (string, error) {
 	if config.Namespace != "" {
 		source = fmt.Sprintf("%s search_path=%s", source, config.Namespace)
 	}
-	if config.Timezone != "" {
+	if config.SessionTimezone != "" {
+		source = fmt.Sprintf("%s timezone=%s", source, config.SessionTimezone)
+	} else if config.Timezone != "" {
 		source = fmt.Sprintf("%s timezone=%s", source, config.Timezone)
 	}
+	if milliseconds := config.StatementTimeout.Milliseconds(); milliseconds > 0 {
+		source = fmt.Sprintf("%s statement_timeout=%d", source, milliseconds)
+	}
 	if config.Extra != "" {
 		extraMap, err := gstr.Parse(config.Extra)
 		if err != nil {
@@ -62,6 +67,9 @@ func configNodeToSource(config *gdb.ConfigNode) (string, error) {
 			)
 		}
 		for k, v := range extraMap {
+			if isDriverExtraKey(k) {
+				continue
+			}
 			source += fmt.Sprintf(` %s=%s`, k, v)
 		}
 	}
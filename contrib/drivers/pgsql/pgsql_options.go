@@ -0,0 +1,41 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package pgsql
+
+import (
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+// The driver options in the Extra configuration, which are consumed by the driver itself
+// and are not passed to the underlying driver, eg:
+// pgsql:postgres:12345678@tcp(127.0.0.1:5432)/test?includeMatViews=true
+const (
+	// extraKeyIncludeMatViews specifies including the materialized views in Tables.
+	extraKeyIncludeMatViews = "includeMatViews"
+)
+
+// driverExtraKeys is the set of the driver options in the Extra configuration.
+var driverExtraKeys = map[string]struct{}{
+	extraKeyIncludeMatViews: {},
+}
+
+// isDriverExtraKey checks whether `key` is a driver option in the Extra configuration.
+func isDriverExtraKey(key string) bool {
+	_, ok := driverExtraKeys[key]
+	return ok
+}
+
+// getExtraOption retrieves and returns the value of driver option `key` from the Extra configuration.
+func (d *Driver) getExtraOption(key string) string {
+	config := d.GetConfig()
+	if config == nil || config.Extra == "" {
+		return ""
+	}
+	extraMap, _ := gstr.Parse(config.Extra)
+	return gconv.String(extraMap[key])
+}
@@ -0,0 +1,194 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package pgsql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+// PartitionInterval is the interval of the range partitions created automatically on insert.
+type PartitionInterval int
+
+const (
+	PartitionByDay   PartitionInterval = iota + 1 // Partition named like "order_p20240115".
+	PartitionByMonth                              // Partition named like "order_p202401".
+	PartitionByYear                               // Partition named like "order_p2024".
+)
+
+// autoPartition is the configuration of automatic partition creation of a table.
+type autoPartition struct {
+	Column   string
+	Interval PartitionInterval
+}
+
+// CreateRangePartition creates partition `partition` of the range partitioned table `table`
+// for the values from `from` (inclusive) to `to` (exclusive) if it does not exist.
+// The bound values can be gdb.Raw("MINVALUE") or gdb.Raw("MAXVALUE") for unbounded range.
+//
+// Example:
+//
+//	driver.CreateRangePartition(ctx, "order", "order_p202401", "2024-01-01", "2024-02-01")
+func (d *Driver) CreateRangePartition(ctx context.Context, table, partition string, from, to any) error {
+	return d.doCreateRangePartition(ctx, nil, table, partition, from, to)
+}
+
+// CreateListPartition creates partition `partition` of the list partitioned table `table`
+// for the given `values` if it does not exist.
+//
+// Example:
+//
+//	driver.CreateListPartition(ctx, "order", "order_cn", "CN", "HK")
+func (d *Driver) CreateListPartition(ctx context.Context, table, partition string, values ...any) error {
+	if len(values) == 0 {
+		return gerror.NewCode(gcode.CodeMissingParameter, `values of list partition should not be empty`)
+	}
+	literals := make([]string, len(values))
+	for i, value := range values {
+		literals[i] = partitionBoundLiteral(value)
+	}
+	_, err := d.Exec(ctx, fmt.Sprintf(
+		`CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES IN (%s)`,
+		d.QuoteWord(partition), d.QuoteWord(table), strings.Join(literals, ","),
+	))
+	return err
+}
+
+// CreateDefaultPartition creates the default partition `partition` of `table` if it does not exist,
+// which stores the rows not matching any other partition.
+func (d *Driver) CreateDefaultPartition(ctx context.Context, table, partition string) error {
+	_, err := d.Exec(ctx, fmt.Sprintf(
+		`CREATE TABLE IF NOT EXISTS %s PARTITION OF %s DEFAULT`,
+		d.QuoteWord(partition), d.QuoteWord(table),
+	))
+	return err
+}
+
+// SetAutoPartition enables automatic creation of the range partitions of `table` by `column` on insert,
+// which creates the missing partition of `interval` for the inserted rows before inserting them,
+// eg: the monthly partition "order_p202401" for the value "2024-01-15 10:00:00" of `column`.
+// The `table` should be created with "PARTITION BY RANGE (column)" in advance.
+//
+// Note that the configuration is bound to the driver instance.
+func (d *Driver) SetAutoPartition(table, column string, interval PartitionInterval) {
+	d.autoPartitions.Set(table, autoPartition{
+		Column:   column,
+		Interval: interval,
+	})
+}
+
+// createAutoPartitions creates the missing partitions for the inserting `list` of `table`
+// if automatic partition creation is enabled for the table.
+func (d *Driver) createAutoPartitions(ctx context.Context, link gdb.Link, table string, list gdb.List) error {
+	if d.autoPartitions == nil || d.autoPartitions.IsEmpty() {
+		return nil
+	}
+	table = strings.ReplaceAll(table, quoteChar, "")
+	value := d.autoPartitions.Get(table)
+	if value == nil {
+		return nil
+	}
+	var (
+		config = value.(autoPartition)
+		// The created partitions are not cached in transaction, as they are discarded if it rolls back.
+		inTransaction = (link != nil && link.IsTransaction()) || gdb.TXFromCtx(ctx, d.GetGroup()) != nil
+	)
+	for _, item := range list {
+		var columnValue any
+		for k, v := range item {
+			if strings.EqualFold(k, config.Column) {
+				columnValue = v
+				break
+			}
+		}
+		if columnValue == nil {
+			continue
+		}
+		t := gtime.New(columnValue)
+		if t == nil || t.IsZero() {
+			return gerror.NewCodef(
+				gcode.CodeInvalidParameter,
+				`invalid partition value "%v" of column "%s" for table "%s"`,
+				columnValue, config.Column, table,
+			)
+		}
+		partition, from, to := getAutoPartitionRange(table, t.Time, config.Interval)
+		if d.createdPartitions.Contains(partition) {
+			continue
+		}
+		if err := d.doCreateRangePartition(ctx, link, table, partition, from, to); err != nil {
+			return err
+		}
+		if !inTransaction {
+			d.createdPartitions.Add(partition)
+		}
+	}
+	return nil
+}
+
+// doCreateRangePartition creates the range partition through `link`.
+func (d *Driver) doCreateRangePartition(ctx context.Context, link gdb.Link, table, partition string, from, to any) error {
+	_, err := d.DoExec(ctx, link, fmt.Sprintf(
+		`CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM (%s) TO (%s)`,
+		d.QuoteWord(partition), d.QuoteWord(table), partitionBoundLiteral(from), partitionBoundLiteral(to),
+	))
+	return err
+}
+
+// getAutoPartitionRange returns the name and range of the partition of `interval` containing time `t`.
+func getAutoPartitionRange(table string, t time.Time, interval PartitionInterval) (partition string, from, to string) {
+	var (
+		start  time.Time
+		end    time.Time
+		suffix string
+	)
+	switch interval {
+	case PartitionByDay:
+		start = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		end = start.AddDate(0, 0, 1)
+		suffix = start.Format("20060102")
+	case PartitionByYear:
+		start = time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
+		end = start.AddDate(1, 0, 0)
+		suffix = start.Format("2006")
+	default:
+		start = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+		end = start.AddDate(0, 1, 0)
+		suffix = start.Format("200601")
+	}
+	const layout = "2006-01-02 15:04:05"
+	return fmt.Sprintf("%s_p%s", table, suffix), start.Format(layout), end.Format(layout)
+}
+
+// partitionBoundLiteral converts `value` to the literal of partition bound,
+// as the bounds of partition cannot be given as parameters.
+func partitionBoundLiteral(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "NULL"
+	case gdb.Raw:
+		return string(v)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return gconv.String(v)
+	case time.Time:
+		return `'` + v.Format("2006-01-02 15:04:05") + `'`
+	case *gtime.Time:
+		return `'` + v.String() + `'`
+	case gtime.Time:
+		return `'` + v.String() + `'`
+	default:
+		return `'` + strings.ReplaceAll(gconv.String(v), `'`, `''`) + `'`
+	}
+}
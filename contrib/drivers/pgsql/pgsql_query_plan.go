@@ -0,0 +1,54 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package pgsql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/text/gregex"
+)
+
+// AnalyzeQueryPlan explains the select statement `sql` and returns the issues found in its query plan.
+// The "Seq Scan" nodes are reported as full scans, and the "Sort" nodes are reported as filesorts.
+func (d *Driver) AnalyzeQueryPlan(ctx context.Context, link gdb.Link, sql string, args ...any) ([]gdb.QueryPlanIssue, error) {
+	result, err := d.DoSelect(ctx, link, "EXPLAIN "+sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		issues []gdb.QueryPlanIssue
+		lines  = make([]string, 0, len(result))
+	)
+	for _, record := range result {
+		// Eg: ->  Seq Scan on user u  (cost=0.00..1.10 rows=1 width=100)
+		line := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(record["QUERY PLAN"].String()), "->"))
+		lines = append(lines, line)
+	}
+	for i, line := range lines {
+		if match, _ := gregex.MatchString(`^(?:Parallel )?Seq Scan on (\S+)`, line); len(match) > 0 {
+			issues = append(issues, gdb.QueryPlanIssue{
+				Type:   gdb.QueryPlanIssueFullScan,
+				Table:  match[1],
+				Detail: line,
+			})
+			continue
+		}
+		if line == "Sort" || strings.HasPrefix(line, "Sort ") {
+			detail := line
+			if i+1 < len(lines) && strings.HasPrefix(lines[i+1], "Sort Key:") {
+				detail += ", " + lines[i+1]
+			}
+			issues = append(issues, gdb.QueryPlanIssue{
+				Type:   gdb.QueryPlanIssueFileSort,
+				Detail: detail,
+			})
+		}
+	}
+	return issues, nil
+}
@@ -6,13 +6,18 @@
 
 package pgsql
 
-import "database/sql"
+import (
+	"database/sql"
+
+	"github.com/gogf/gf/v2/database/gdb"
+)
 
 type Result struct {
 	sql.Result
 	affected          int64
 	lastInsertId      int64
 	lastInsertIdError error
+	saveDisposition   gdb.SaveDisposition
 }
 
 func (pgr Result) RowsAffected() (int64, error) {
@@ -22,3 +27,8 @@ func (pgr Result) RowsAffected() (int64, error) {
 func (pgr Result) LastInsertId() (int64, error) {
 	return pgr.lastInsertId, pgr.lastInsertIdError
 }
+
+// SaveDisposition returns the disposition of the saved row, which implements gdb.SaveDispositionResult.
+func (pgr Result) SaveDisposition() gdb.SaveDisposition {
+	return pgr.saveDisposition
+}
@@ -0,0 +1,18 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package pgsql
+
+import (
+	"time"
+)
+
+// FormatStatementTimeout returns the select statement `sql` as it is, as PostgreSQL has no statement hint.
+// The statement is canceled server-side by the cancel request of the driver when the context deadline
+// of the timeout exceeds, and the timeout of the sessions is configured by ConfigNode.StatementTimeout.
+func (d *Driver) FormatStatementTimeout(sql string, timeout time.Duration) string {
+	return sql
+}
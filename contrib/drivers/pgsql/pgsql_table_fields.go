@@ -46,6 +46,7 @@ func init() {
 }
 
 // TableFields retrieves and returns the fields' information of specified table of current schema.
+// It also supports the materialized views.
 func (d *Driver) TableFields(ctx context.Context, table string, schema ...string) (fields map[string]*gdb.TableField, err error) {
 	var (
 		result     gdb.Result
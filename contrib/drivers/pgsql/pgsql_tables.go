@@ -14,6 +14,7 @@ import (
 	"github.com/gogf/gf/v2/database/gdb"
 	"github.com/gogf/gf/v2/text/gregex"
 	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gconv"
 	"github.com/gogf/gf/v2/util/gutil"
 )
 
@@ -27,7 +28,7 @@ INNER JOIN pg_namespace n ON
 	c.relnamespace = n.oid
 WHERE
 	n.nspname = '%s'
-	AND c.relkind IN ('r', 'p')
+	AND c.relkind IN (%s)
 	%s
 ORDER BY
 	c.relname
@@ -46,6 +47,7 @@ func init() {
 
 // Tables retrieves and returns the tables of current schema.
 // It's mainly used in cli tool chain for automatically generating the models.
+// The materialized views are also returned if the driver option "includeMatViews" is enabled in Extra configuration.
 func (d *Driver) Tables(ctx context.Context, schema ...string) (tables []string, err error) {
 	var (
 		result     gdb.Result
@@ -65,9 +67,15 @@ func (d *Driver) Tables(ctx context.Context, schema ...string) (tables []string,
 		useRelpartbound = "AND c.relpartbound IS NULL"
 	}
 
+	relKinds := "'r', 'p'"
+	if gconv.Bool(d.getExtraOption(extraKeyIncludeMatViews)) {
+		relKinds += ", 'm'"
+	}
+
 	var query = fmt.Sprintf(
 		tablesSqlTmp,
 		usedSchema,
+		relKinds,
 		useRelpartbound,
 	)
 
@@ -0,0 +1,37 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package pgsql
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/util/gutil"
+)
+
+const (
+	// isViewSql checks the relation kind of views and materialized views.
+	isViewSql = `SELECT COUNT(1) AS total FROM pg_class c INNER JOIN pg_namespace n ON c.relnamespace = n.oid ` +
+		`WHERE n.nspname = ? AND c.relname = ? AND c.relkind IN ('v', 'm')`
+)
+
+// IsView checks and returns whether the specified table is a view or materialized view of current schema.
+func (d *Driver) IsView(ctx context.Context, table string, schema ...string) (bool, error) {
+	usedSchema := gutil.GetOrDefaultStr(d.GetConfig().Namespace, schema...)
+	if usedSchema == "" {
+		usedSchema = defaultSchema
+	}
+	// DO NOT use `usedSchema` as parameter for function `SlaveLink`.
+	link, err := d.SlaveLink()
+	if err != nil {
+		return false, err
+	}
+	result, err := d.DoSelect(ctx, link, isViewSql, usedSchema, table)
+	if err != nil {
+		return false, err
+	}
+	return len(result) > 0 && result[0]["total"].Int() > 0, nil
+}
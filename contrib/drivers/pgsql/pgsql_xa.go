@@ -0,0 +1,39 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package pgsql
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// DoXA performs the `action` of the XA transaction branch `xid` in the transaction `tx`
+// using the two-phase commit statements of PostgreSQL, which requires max_prepared_transactions > 0.
+//
+// Note that the transaction is ended by PREPARE TRANSACTION, after which the prepared branch is
+// committed or rolled back out of the transaction block on the same connection.
+func (d *Driver) DoXA(ctx context.Context, tx gdb.TX, action gdb.XAAction, xid string) error {
+	var statement string
+	switch action {
+	case gdb.XAActionStart, gdb.XAActionRollback:
+		// The branch is the transaction itself, which is started by BEGIN and rolled back by ROLLBACK.
+		return nil
+	case gdb.XAActionPrepare:
+		statement = "PREPARE TRANSACTION " + d.FormatLiteral(xid)
+	case gdb.XAActionCommit:
+		statement = "COMMIT PREPARED " + d.FormatLiteral(xid)
+	case gdb.XAActionRollbackPrepared:
+		statement = "ROLLBACK PREPARED " + d.FormatLiteral(xid)
+	default:
+		return gerror.NewCodef(gcode.CodeInvalidParameter, `invalid XA action "%s"`, action)
+	}
+	_, err := tx.Exec(statement)
+	return err
+}
@@ -0,0 +1,91 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package pgsql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogf/gf/contrib/drivers/pgsql/v2"
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Driver_AdvisoryLock(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			driver = getDriver(db)
+			key    = pgsql.AdvisoryLockKey("Test_Driver_AdvisoryLock")
+		)
+		t.Assert(key, pgsql.AdvisoryLockKey("Test_Driver_AdvisoryLock"))
+
+		lock, err := driver.AdvisoryLock(ctx, key)
+		t.AssertNil(err)
+		t.Assert(lock.Key(), key)
+
+		// It is held by another session.
+		lock2, ok, err := driver.TryAdvisoryLock(ctx, key)
+		t.AssertNil(err)
+		t.Assert(ok, false)
+		t.Assert(lock2, nil)
+
+		t.AssertNil(lock.Unlock(ctx))
+		// Unlocking again does nothing.
+		t.AssertNil(lock.Unlock(ctx))
+
+		lock2, ok, err = driver.TryAdvisoryLock(ctx, key)
+		t.AssertNil(err)
+		t.Assert(ok, true)
+		t.AssertNil(lock2.Unlock(ctx))
+	})
+	// It is unlocked with cancelled context.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			driver = getDriver(db)
+			key    = pgsql.AdvisoryLockKey("Test_Driver_AdvisoryLock_Cancelled")
+		)
+		lock, err := driver.AdvisoryLock(ctx, key)
+		t.AssertNil(err)
+
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		t.AssertNil(lock.Unlock(cancelledCtx))
+
+		lock2, ok, err := driver.TryAdvisoryLock(ctx, key)
+		t.AssertNil(err)
+		t.Assert(ok, true)
+		t.AssertNil(lock2.Unlock(ctx))
+	})
+}
+
+func Test_Driver_AdvisoryXactLock(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			driver = getDriver(db)
+			key    = pgsql.AdvisoryLockKey("Test_Driver_AdvisoryXactLock")
+		)
+		err := db.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+			if err := driver.AdvisoryXactLock(ctx, tx, key); err != nil {
+				return err
+			}
+			// It is held by the transaction.
+			_, ok, err := driver.TryAdvisoryLock(ctx, key)
+			t.AssertNil(err)
+			t.Assert(ok, false)
+			return nil
+		})
+		t.AssertNil(err)
+
+		// It is released after the transaction.
+		err = db.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+			ok, err := driver.TryAdvisoryXactLock(ctx, tx, key)
+			t.Assert(ok, true)
+			return err
+		})
+		t.AssertNil(err)
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package pgsql_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Error_Classification(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data(g.Map{
+			"id":          1,
+			"passport":    "user_1",
+			"password":    "pass_1",
+			"nickname":    "name_1",
+			"create_time": CreateTime,
+		}).Insert()
+		t.AssertNE(err, nil)
+		t.Assert(gerror.HasCode(err, gcode.CodeDbDuplicateKey), true)
+		t.Assert(gerror.HasCode(err, gcode.CodeDbOperationError), true)
+		t.Assert(gdb.IsRetryableError(err), false)
+	})
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Query(ctx, "SELEC * FROM "+table)
+		t.AssertNE(err, nil)
+		t.Assert(gerror.HasCode(err, gcode.CodeDbSyntaxError), true)
+	})
+}
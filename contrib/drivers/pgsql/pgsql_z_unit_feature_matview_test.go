@@ -0,0 +1,83 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package pgsql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Driver_MaterializedView(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			view   = table + "_mv"
+			driver = getDriver(db)
+		)
+		err := driver.CreateMaterializedView(ctx, view, fmt.Sprintf(`SELECT id, passport FROM %s`, table), false)
+		t.AssertNil(err)
+		defer driver.DropMaterializedView(ctx, view)
+
+		views, err := driver.MaterializedViews(ctx)
+		t.AssertNil(err)
+		t.AssertIN(view, views)
+
+		err = driver.RefreshMaterializedView(ctx, view, false)
+		t.AssertNil(err)
+		count, err := db.Model(view).Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize)
+
+		_, err = db.Exec(ctx, fmt.Sprintf(`CREATE UNIQUE INDEX %s_id ON %s (id)`, view, view))
+		t.AssertNil(err)
+		_, err = db.Model(table).Data(g.Map{"passport": "updated"}).Where("id", 1).Update()
+		t.AssertNil(err)
+		err = driver.RefreshMaterializedView(ctx, view, true)
+		t.AssertNil(err)
+		value, err := db.Model(view).Where("id", 1).Value("passport")
+		t.AssertNil(err)
+		t.Assert(value, "updated")
+
+		fields, err := db.TableFields(ctx, view)
+		t.AssertNil(err)
+		t.Assert(len(fields), 2)
+		t.AssertNE(fields["passport"], nil)
+	})
+}
+
+func Test_Driver_Tables_IncludeMatViews(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		var view = table + "_mv"
+		_, err := db.Exec(ctx, fmt.Sprintf(`CREATE MATERIALIZED VIEW %s AS SELECT id FROM %s`, view, table))
+		t.AssertNil(err)
+		defer db.Exec(ctx, fmt.Sprintf(`DROP MATERIALIZED VIEW IF EXISTS %s`, view))
+
+		tables, err := db.Tables(ctx)
+		t.AssertNil(err)
+		t.AssertNI(view, tables)
+
+		node := configNode
+		node.Name = SchemaName
+		node.Extra = "includeMatViews=true"
+		matViewDB, err := gdb.New(node)
+		t.AssertNil(err)
+		defer matViewDB.Close(ctx)
+
+		tables, err = matViewDB.Tables(ctx)
+		t.AssertNil(err)
+		t.AssertIN(view, tables)
+	})
+}
@@ -0,0 +1,123 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package pgsql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/contrib/drivers/pgsql/v2"
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func createPartitionedTable(partitionBy string) string {
+	name := fmt.Sprintf(`%s_%d`, TablePrefix+"partition", gtime.TimestampNano())
+	if _, err := db.Exec(ctx, fmt.Sprintf(`
+		CREATE TABLE %s (
+			id bigint NOT NULL,
+			region varchar(8) NOT NULL,
+			create_time timestamp NOT NULL
+		) PARTITION BY %s;`, name, partitionBy,
+	)); err != nil {
+		gtest.Fatal(err)
+	}
+	return name
+}
+
+func Test_Driver_CreatePartition(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			table  = createPartitionedTable("RANGE (create_time)")
+			driver = getDriver(db)
+		)
+		defer dropTable(table)
+
+		err := driver.CreateRangePartition(ctx, table, table+"_p2024", "2024-01-01", "2025-01-01")
+		t.AssertNil(err)
+		// It does nothing if the partition exists.
+		err = driver.CreateRangePartition(ctx, table, table+"_p2024", "2024-01-01", "2025-01-01")
+		t.AssertNil(err)
+		err = driver.CreateRangePartition(ctx, table, table+"_history", gdb.Raw("MINVALUE"), "2024-01-01")
+		t.AssertNil(err)
+
+		_, err = db.Model(table).Data(g.List{
+			{"id": 1, "region": "CN", "create_time": "2023-06-01 00:00:00"},
+			{"id": 2, "region": "US", "create_time": "2024-06-01 00:00:00"},
+		}).Insert()
+		t.AssertNil(err)
+		count, err := db.Model(table + "_p2024").Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+
+		// Partitions are not returned by Tables.
+		tables, err := db.Tables(ctx)
+		t.AssertNil(err)
+		t.AssertIN(table, tables)
+		t.AssertNI(table+"_p2024", tables)
+	})
+
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			table  = createPartitionedTable("LIST (region)")
+			driver = getDriver(db)
+		)
+		defer dropTable(table)
+
+		err := driver.CreateListPartition(ctx, table, table+"_asia", "CN", "JP")
+		t.AssertNil(err)
+		err = driver.CreateDefaultPartition(ctx, table, table+"_default")
+		t.AssertNil(err)
+
+		_, err = db.Model(table).Data(g.List{
+			{"id": 1, "region": "CN", "create_time": CreateTime},
+			{"id": 2, "region": "JP", "create_time": CreateTime},
+			{"id": 3, "region": "US", "create_time": CreateTime},
+		}).Insert()
+		t.AssertNil(err)
+		count, err := db.Model(table + "_asia").Count()
+		t.AssertNil(err)
+		t.Assert(count, 2)
+		count, err = db.Model(table + "_default").Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+	})
+}
+
+func Test_Driver_AutoPartition(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			table  = createPartitionedTable("RANGE (create_time)")
+			driver = getDriver(db)
+		)
+		defer dropTable(table)
+
+		driver.SetAutoPartition(table, "create_time", pgsql.PartitionByMonth)
+		_, err := db.Model(table).Data(g.List{
+			{"id": 1, "region": "CN", "create_time": "2024-01-15 10:00:00"},
+			{"id": 2, "region": "CN", "create_time": "2024-01-31 23:59:59"},
+			{"id": 3, "region": "CN", "create_time": "2024-02-01 00:00:00"},
+		}).Insert()
+		t.AssertNil(err)
+		_, err = db.Model(table).Data(g.Map{
+			"id": 4, "region": "CN", "create_time": gtime.NewFromStr("2024-02-10 00:00:00"),
+		}).Insert()
+		t.AssertNil(err)
+
+		count, err := db.Model(table + "_p202401").Count()
+		t.AssertNil(err)
+		t.Assert(count, 2)
+		count, err = db.Model(table + "_p202402").Count()
+		t.AssertNil(err)
+		t.Assert(count, 2)
+
+		_, err = db.Model(table).Data(g.Map{"id": 5, "region": "CN", "create_time": "invalid"}).Insert()
+		t.AssertNE(err, nil)
+	})
+}
@@ -144,3 +144,50 @@ func Test_Model_UnionAll(t *testing.T) {
 		t.Assert(r["id"], 3)
 	})
 }
+
+func Test_Model_Intersect(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		r, err := db.Model(table).Intersect(
+			db.Model(table).WhereIn("id", g.Slice{1, 2, 3}),
+			db.Model(table).WhereIn("id", g.Slice{2, 3, 4}),
+		).OrderDesc("id").All()
+
+		t.AssertNil(err)
+
+		t.Assert(len(r), 2)
+		t.Assert(r[0]["id"], 3)
+		t.Assert(r[1]["id"], 2)
+	})
+
+	gtest.C(t, func(t *gtest.T) {
+		count, err := db.Intersect(
+			db.Model(table).WhereIn("id", g.Slice{1, 2, 3}),
+			db.Model(table).WhereIn("id", g.Slice{2, 3, 4}),
+		).Count()
+
+		t.AssertNil(err)
+
+		t.Assert(count, 2)
+	})
+}
+
+func Test_Model_Except(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		r, err := db.Model(table).Except(
+			db.Model(table).WhereIn("id", g.Slice{1, 2, 3, 4}),
+			db.Model(table).Where("id", 2),
+		).OrderDesc("id").Page(1, 2).All()
+
+		t.AssertNil(err)
+
+		t.Assert(len(r), 2)
+		t.Assert(r[0]["id"], 4)
+		t.Assert(r[1]["id"], 3)
+	})
+}
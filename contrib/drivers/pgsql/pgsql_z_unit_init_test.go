@@ -11,7 +11,7 @@ import (
 	"fmt"
 	"strings"
 
-	_ "github.com/gogf/gf/contrib/drivers/pgsql/v2"
+	"github.com/gogf/gf/contrib/drivers/pgsql/v2"
 
 	"github.com/gogf/gf/v2/container/garray"
 	"github.com/gogf/gf/v2/database/gdb"
@@ -341,3 +341,8 @@ func createInitAllTypesTableWithDb(db gdb.DB, table ...string) (name string) {
 	}
 	return
 }
+
+// getDriver returns the pgsql driver of `db` for the driver specific features.
+func getDriver(db gdb.DB) *pgsql.Driver {
+	return db.GetCore().GetDB().(*gdb.DriverWrapperDB).DB.(*pgsql.Driver)
+}
@@ -8,6 +8,7 @@ package pgsql_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/gogf/gf/v2/database/gdb"
 	"github.com/gogf/gf/v2/test/gtest"
@@ -57,6 +58,27 @@ func Test_Open_WithTimezone(t *testing.T) {
 	})
 }
 
+// Test_Open_WithStatementTimeout tests Open with statement timeout configuration
+func Test_Open_WithStatementTimeout(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		driver := pgsql.Driver{}
+		config := &gdb.ConfigNode{
+			User:             "postgres",
+			Pass:             "12345678",
+			Host:             "127.0.0.1",
+			Port:             "5432",
+			Name:             "test",
+			StatementTimeout: 3 * time.Second,
+		}
+		db, err := driver.Open(config)
+		t.AssertNil(err)
+		t.AssertNE(db, nil)
+		if db != nil {
+			db.Close()
+		}
+	})
+}
+
 // Test_Open_WithExtra tests Open with extra configuration
 func Test_Open_WithExtra(t *testing.T) {
 	gtest.C(t, func(t *gtest.T) {
@@ -265,3 +265,55 @@ func Test_FormatUpsert_MultipleConflictKeys(t *testing.T) {
 		t.Assert(one["nickname"].String(), "newnick")
 	})
 }
+
+// Test_Model_SaveWithDisposition tests Save returning the dispositions of rows by RETURNING xmax
+func Test_Model_SaveWithDisposition(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		dispositions, err := db.Model(table).Data(g.Slice{
+			g.Map{"id": 1, "passport": "saved_1", "password": "pass_1", "nickname": "name_1", "create_time": CreateTime},
+			g.Map{"id": 100, "passport": "saved_100", "password": "pass_100", "nickname": "name_100", "create_time": CreateTime},
+		}).OnConflict(g.Map{"pgsql": "id", "*": "passport"}).SaveWithDisposition()
+		t.AssertNil(err)
+		t.Assert(dispositions, []gdb.SaveDisposition{
+			gdb.SaveDispositionUpdated,
+			gdb.SaveDispositionInserted,
+		})
+
+		value, err := db.Model(table).Where("id", 1).Value("passport")
+		t.AssertNil(err)
+		t.Assert(value, "saved_1")
+
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize+1)
+	})
+}
+
+// Test_Model_ReplaceMode_DeleteInsert tests Replace emulated by deleting and inserting
+func Test_Model_ReplaceMode_DeleteInsert(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).ReplaceMode(gdb.ReplaceModeDeleteInsert).Data(g.Map{
+			"id":          1,
+			"passport":    "replaced_1",
+			"password":    "pass_1",
+			"nickname":    "replaced_name_1",
+			"create_time": CreateTime,
+		}).Replace()
+		t.AssertNil(err)
+
+		one, err := db.Model(table).Where("id", 1).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "replaced_1")
+		t.Assert(one["nickname"], "replaced_name_1")
+
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize)
+	})
+}
@@ -16,6 +16,7 @@ import (
 // Driver is the driver for sqlite database.
 type Driver struct {
 	*gdb.Core
+	queue *writeQueue // Single-writer queue, which is nil if write queue mode is not enabled.
 }
 
 const (
@@ -0,0 +1,27 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite
+
+import (
+	"fmt"
+
+	"github.com/gogf/gf/v2/database/gdb"
+)
+
+// DateBucketFunction returns the SQL expression truncating the quoted `column` to the start of the date `bucket`.
+func (d *Driver) DateBucketFunction(column string, bucket gdb.DateBucket) string {
+	switch bucket {
+	case gdb.BucketHour:
+		return fmt.Sprintf(`strftime('%%Y-%%m-%%d %%H:00:00',%s)`, column)
+	case gdb.BucketMonth:
+		return fmt.Sprintf(`strftime('%%Y-%%m-01',%s)`, column)
+	case gdb.BucketYear:
+		return fmt.Sprintf(`strftime('%%Y-01-01',%s)`, column)
+	default:
+		return fmt.Sprintf(`strftime('%%Y-%%m-%%d',%s)`, column)
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite
+
+// FormatForeignKeyChecks returns the statement deferring the foreign key checks of current transaction
+// to its committing. It returns empty string for enabling the checks, as SQLite switches the deferring
+// off automatically when the transaction is committed or rolled back.
+func (d *Driver) FormatForeignKeyChecks(enabled bool) string {
+	if enabled {
+		return ""
+	}
+	return `PRAGMA defer_foreign_keys = ON`
+}
@@ -0,0 +1,53 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/text/gregex"
+)
+
+// AnalyzeQueryPlan explains the select statement `sql` using EXPLAIN QUERY PLAN and returns the issues
+// found in its query plan. The steps of "SCAN" without using index are reported as full scans, and the
+// steps of "USE TEMP B-TREE" for ORDER BY, GROUP BY or DISTINCT are reported as filesorts.
+func (d *Driver) AnalyzeQueryPlan(ctx context.Context, link gdb.Link, sql string, args ...any) ([]gdb.QueryPlanIssue, error) {
+	result, err := d.DoSelect(ctx, link, "EXPLAIN QUERY PLAN "+sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		issues    []gdb.QueryPlanIssue
+		lastTable string
+	)
+	for _, record := range result {
+		detail := record["detail"].String()
+		// Eg: SCAN user, SCAN TABLE user AS u, SEARCH user USING INDEX idx_name (name=?)
+		match, _ := gregex.MatchString(`^(SCAN|SEARCH)\s+(?:TABLE\s+)?(\S+)`, detail)
+		if len(match) > 0 {
+			lastTable = match[2]
+			if match[1] == "SCAN" && !strings.Contains(detail, " USING ") {
+				issues = append(issues, gdb.QueryPlanIssue{
+					Type:   gdb.QueryPlanIssueFullScan,
+					Table:  lastTable,
+					Detail: detail,
+				})
+			}
+			continue
+		}
+		if strings.HasPrefix(detail, "USE TEMP B-TREE") {
+			issues = append(issues, gdb.QueryPlanIssue{
+				Type:   gdb.QueryPlanIssueFileSort,
+				Table:  lastTable,
+				Detail: detail,
+			})
+		}
+	}
+	return issues, nil
+}
@@ -9,13 +9,26 @@ package sqlite
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/gogf/gf/v2/database/gdb"
 	"github.com/gogf/gf/v2/util/gutil"
 )
 
+const (
+	tableSqlTmp = `SELECT sql FROM SQLITE_MASTER WHERE TYPE='table' AND NAME=?`
+)
+
 // TableFields retrieves and returns the fields' information of specified table of current schema.
 //
+// SQLite has no column comment, so the comments of the fields are parsed from the SQL comments
+// following the column definitions in the CREATE TABLE statement, eg:
+//
+//	CREATE TABLE user(
+//	    id   INTEGER PRIMARY KEY, -- User ID
+//	    name TEXT                 /* User Name */
+//	)
+//
 // Also see DriverMysql.TableFields.
 func (d *Driver) TableFields(ctx context.Context, table string, schema ...string) (fields map[string]*gdb.TableField, err error) {
 	var (
@@ -30,6 +43,10 @@ func (d *Driver) TableFields(ctx context.Context, table string, schema ...string
 	if err != nil {
 		return nil, err
 	}
+	comments, err := d.getColumnComments(ctx, link, table)
+	if err != nil {
+		return nil, err
+	}
 	fields = make(map[string]*gdb.TableField)
 	for i, m := range result {
 		mKey := ""
@@ -43,7 +60,163 @@ func (d *Driver) TableFields(ctx context.Context, table string, schema ...string
 			Key:     mKey,
 			Default: m["dflt_value"].Val(),
 			Null:    !m["notnull"].Bool(),
+			Comment: comments[m["name"].String()],
 		}
 	}
 	return fields, nil
 }
+
+// getColumnComments retrieves the CREATE TABLE statement of `table` and returns the column comments
+// parsed from it, which is keyed by the column name.
+func (d *Driver) getColumnComments(ctx context.Context, link gdb.Link, table string) (map[string]string, error) {
+	result, err := d.DoSelect(ctx, link, tableSqlTmp, table)
+	if err != nil {
+		return nil, err
+	}
+	if result.IsEmpty() {
+		return nil, nil
+	}
+	return parseColumnComments(result[0]["sql"].String()), nil
+}
+
+// parseColumnComments parses and returns the column comments from the CREATE TABLE statement.
+//
+// A comment belongs to the column definition it follows. The comment right after the comma of a
+// column definition on the same line also belongs to that column, and the comment on its own lines
+// belongs to the next column definition.
+func parseColumnComments(createSql string) map[string]string {
+	start := strings.IndexByte(createSql, '(')
+	if start == -1 {
+		return nil
+	}
+	var (
+		comments      = make(map[string]string)
+		definition    strings.Builder // Current column definition without comments.
+		definitionCmt []string        // Comments of current column definition.
+		lastColumn    string          // Column name of the last column definition.
+		lineBreak     bool            // Whether there's line break after the last column definition.
+		depth         = 0
+		runes         = []rune(createSql[start+1:])
+	)
+	// flush ends current column definition.
+	flush := func() {
+		if column := parseColumnName(definition.String()); column != "" {
+			if len(definitionCmt) > 0 {
+				comments[column] = strings.Join(definitionCmt, " ")
+			}
+			lastColumn = column
+		} else {
+			lastColumn = ""
+		}
+		definition.Reset()
+		definitionCmt = nil
+		lineBreak = false
+	}
+	// addComment adds comment to the column definition it belongs to.
+	addComment := func(comment string) {
+		comment = strings.TrimSpace(comment)
+		if comment == "" {
+			return
+		}
+		if strings.TrimSpace(definition.String()) == "" && !lineBreak && lastColumn != "" {
+			if comments[lastColumn] != "" {
+				comment = comments[lastColumn] + " " + comment
+			}
+			comments[lastColumn] = comment
+			return
+		}
+		definitionCmt = append(definitionCmt, comment)
+	}
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; {
+		case c == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			end := i + 2
+			for end < len(runes) && runes[end] != '\n' {
+				end++
+			}
+			addComment(string(runes[i+2 : end]))
+			i = end - 1
+
+		case c == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			end := i + 2
+			for end+1 < len(runes) && !(runes[end] == '*' && runes[end+1] == '/') {
+				end++
+			}
+			if end+1 >= len(runes) {
+				end = len(runes)
+			}
+			addComment(string(runes[i+2 : end]))
+			i = end + 1
+
+		case c == '\'' || c == '"' || c == '`' || c == '[':
+			quote := c
+			if quote == '[' {
+				quote = ']'
+			}
+			end := i + 1
+			for end < len(runes) && runes[end] != quote {
+				end++
+			}
+			if end >= len(runes) {
+				end = len(runes) - 1
+			}
+			definition.WriteString(string(runes[i : end+1]))
+			i = end
+
+		case c == '(':
+			depth++
+			definition.WriteRune(c)
+
+		case c == ')' && depth == 0:
+			flush()
+			return comments
+
+		case c == ')':
+			depth--
+			definition.WriteRune(c)
+
+		case c == ',' && depth == 0:
+			flush()
+
+		case c == '\n':
+			if strings.TrimSpace(definition.String()) == "" {
+				lineBreak = true
+			}
+			definition.WriteRune(c)
+
+		default:
+			definition.WriteRune(c)
+		}
+	}
+	flush()
+	return comments
+}
+
+// parseColumnName returns the column name of the column definition,
+// or empty string if it is a table constraint.
+func parseColumnName(definition string) string {
+	definition = strings.TrimSpace(definition)
+	if definition == "" {
+		return ""
+	}
+	var name string
+	switch definition[0] {
+	case '\'', '"', '`', '[':
+		quote := definition[0]
+		if quote == '[' {
+			quote = ']'
+		}
+		end := strings.IndexByte(definition[1:], quote)
+		if end == -1 {
+			return ""
+		}
+		return definition[1 : end+1]
+	default:
+		name = strings.Fields(definition)[0]
+	}
+	switch strings.ToUpper(name) {
+	case "CONSTRAINT", "PRIMARY", "UNIQUE", "CHECK", "FOREIGN":
+		return ""
+	}
+	return name
+}
@@ -0,0 +1,368 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// WriteQueueOption is the option for write queue mode.
+type WriteQueueOption struct {
+	// QueueSize is the max count of pending write statements, default is 1000.
+	QueueSize int
+
+	// MaxBatchSize is the max count of write statements committed in one transaction, default is 100.
+	MaxBatchSize int
+
+	// BatchWindow is the duration waiting for more write statements before committing a batch.
+	// It commits the statements already queued without waiting in default.
+	BatchWindow time.Duration
+
+	// FailFast specifies returning error of code gcode.CodeServerBusy immediately if the queue
+	// is full, or else the write statement waits until the queue has room or its context is done.
+	FailFast bool
+}
+
+// WriteQueueStats is the statistics of write queue.
+type WriteQueueStats struct {
+	Pending    int   // Count of write statements waiting in queue.
+	Capacity   int   // Capacity of the queue.
+	Batches    int64 // Count of committed batches.
+	Statements int64 // Count of executed write statements.
+	Rejected   int64 // Count of write statements rejected for full queue.
+}
+
+// writeQueue is the single-writer queue serializing the write statements.
+type writeQueue struct {
+	driver     *Driver
+	option     WriteQueueOption
+	items      chan *writeQueueItem
+	closed     chan struct{}
+	done       chan struct{}
+	closeOnce  sync.Once
+	batches    atomic.Int64
+	statements atomic.Int64
+	rejected   atomic.Int64
+}
+
+// writeQueueItem is the queued write statement along with its result channel.
+type writeQueueItem struct {
+	ctx    context.Context
+	in     gdb.DoCommitInput
+	result chan writeQueueResult
+}
+
+type writeQueueResult struct {
+	out gdb.DoCommitOutput
+	err error
+}
+
+// writeQueueLink is the transaction link of write queue batch.
+type writeQueueLink struct {
+	*sql.Tx
+}
+
+const (
+	defaultWriteQueueSize        = 1000
+	defaultWriteQueueMaxBatch    = 100
+	writeQueueSavepoint          = "gf_write_queue"
+	writeQueueSqlSavepoint       = "SAVEPOINT " + writeQueueSavepoint
+	writeQueueSqlRelease         = "RELEASE " + writeQueueSavepoint
+	writeQueueSqlRollbackToPoint = "ROLLBACK TO " + writeQueueSavepoint
+)
+
+// EnableWriteQueue enables the write queue mode for sqlite database `db`, see Driver.EnableWriteQueue.
+//
+// Example:
+//
+//	err := sqlite.EnableWriteQueue(g.DB(), sqlite.WriteQueueOption{QueueSize: 10000})
+func EnableWriteQueue(db gdb.DB, option ...WriteQueueOption) error {
+	driver, err := driverOf(db)
+	if err != nil {
+		return err
+	}
+	return driver.EnableWriteQueue(option...)
+}
+
+// GetWriteQueueStats returns the statistics of write queue of sqlite database `db`.
+func GetWriteQueueStats(db gdb.DB) (WriteQueueStats, error) {
+	driver, err := driverOf(db)
+	if err != nil {
+		return WriteQueueStats{}, err
+	}
+	return driver.WriteQueueStats(), nil
+}
+
+// driverOf retrieves the sqlite driver from `db`, which might be wrapped by gdb.DriverWrapperDB.
+func driverOf(db gdb.DB) (*Driver, error) {
+	if wrapper, ok := db.(*gdb.DriverWrapperDB); ok {
+		db = wrapper.DB
+	}
+	if driver, ok := db.(*Driver); ok {
+		return driver, nil
+	}
+	return nil, gerror.NewCodef(gcode.CodeInvalidParameter, `database of type "%T" is not sqlite`, db)
+}
+
+// EnableWriteQueue enables the write queue mode, which serializes all write statements executed
+// outside transactions by a single writer, and commits them in batches of transactions. It
+// eliminates the SQLITE_BUSY errors of concurrent writers, and improves the write throughput
+// as the statements in a batch share one transaction commit.
+//
+// Each statement in a batch is isolated by savepoint, so a failed statement does not affect the
+// others in the same batch. The statements executed in transactions are not queued.
+func (d *Driver) EnableWriteQueue(option ...WriteQueueOption) error {
+	if d.queue != nil {
+		return gerror.NewCode(gcode.CodeInvalidOperation, `write queue is already enabled`)
+	}
+	var opt WriteQueueOption
+	if len(option) > 0 {
+		opt = option[0]
+	}
+	if opt.QueueSize <= 0 {
+		opt.QueueSize = defaultWriteQueueSize
+	}
+	if opt.MaxBatchSize <= 0 {
+		opt.MaxBatchSize = defaultWriteQueueMaxBatch
+	}
+	d.queue = &writeQueue{
+		driver: d,
+		option: opt,
+		items:  make(chan *writeQueueItem, opt.QueueSize),
+		closed: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go d.queue.run()
+	return nil
+}
+
+// WriteQueueStats returns the statistics of write queue, which is empty if write queue mode is not enabled.
+func (d *Driver) WriteQueueStats() WriteQueueStats {
+	if d.queue == nil {
+		return WriteQueueStats{}
+	}
+	return WriteQueueStats{
+		Pending:    len(d.queue.items),
+		Capacity:   cap(d.queue.items),
+		Batches:    d.queue.batches.Load(),
+		Statements: d.queue.statements.Load(),
+		Rejected:   d.queue.rejected.Load(),
+	}
+}
+
+// DoCommit commits the sql to underlying driver, which queues the write statement outside
+// transaction if write queue mode is enabled.
+func (d *Driver) DoCommit(ctx context.Context, in gdb.DoCommitInput) (out gdb.DoCommitOutput, err error) {
+	if d.queue == nil || in.IsTransaction || in.Type != gdb.SqlTypeExecContext {
+		return d.Core.DoCommit(ctx, in)
+	}
+	return d.queue.commit(ctx, in)
+}
+
+// Close stops the write queue and closes the database.
+func (d *Driver) Close(ctx context.Context) error {
+	if d.queue != nil {
+		d.queue.close()
+	}
+	return d.Core.Close(ctx)
+}
+
+// commit queues the write statement and waits for its result.
+func (q *writeQueue) commit(ctx context.Context, in gdb.DoCommitInput) (gdb.DoCommitOutput, error) {
+	item := &writeQueueItem{
+		ctx:    ctx,
+		in:     in,
+		result: make(chan writeQueueResult, 1),
+	}
+	select {
+	case <-q.closed:
+		return gdb.DoCommitOutput{}, gerror.NewCode(gcode.CodeInvalidOperation, `write queue is closed`)
+	default:
+	}
+	if q.option.FailFast {
+		select {
+		case q.items <- item:
+		default:
+			q.rejected.Add(1)
+			return gdb.DoCommitOutput{}, gerror.NewCodef(
+				gcode.CodeServerBusy, `write queue is full with %d pending statements`, cap(q.items),
+			)
+		}
+	} else {
+		select {
+		case q.items <- item:
+		case <-q.closed:
+			return gdb.DoCommitOutput{}, gerror.NewCode(gcode.CodeInvalidOperation, `write queue is closed`)
+		case <-ctx.Done():
+			q.rejected.Add(1)
+			return gdb.DoCommitOutput{}, gerror.WrapCode(gcode.CodeServerBusy, ctx.Err(), `wait for write queue failed`)
+		}
+	}
+	select {
+	case result := <-item.result:
+		return result.out, result.err
+	case <-q.done:
+		// The writer stops, the statement might be queued after the pending ones are rejected.
+		select {
+		case result := <-item.result:
+			return result.out, result.err
+		default:
+			return gdb.DoCommitOutput{}, gerror.NewCode(gcode.CodeInvalidOperation, `write queue is closed`)
+		}
+	}
+}
+
+// close stops the writer, and the pending statements fail.
+func (q *writeQueue) close() {
+	q.closeOnce.Do(func() {
+		close(q.closed)
+		<-q.done
+	})
+}
+
+// run is the single writer collecting and committing batches.
+func (q *writeQueue) run() {
+	defer close(q.done)
+	for {
+		var first *writeQueueItem
+		select {
+		case first = <-q.items:
+		case <-q.closed:
+			q.rejectPending()
+			return
+		}
+		batch := q.collect(first)
+		q.commitBatch(batch)
+	}
+}
+
+// collect collects the batch beginning with `first`, which waits BatchWindow for more statements.
+func (q *writeQueue) collect(first *writeQueueItem) []*writeQueueItem {
+	batch := []*writeQueueItem{first}
+	if q.option.BatchWindow <= 0 {
+		for len(batch) < q.option.MaxBatchSize {
+			select {
+			case item := <-q.items:
+				batch = append(batch, item)
+			default:
+				return batch
+			}
+		}
+		return batch
+	}
+	timer := time.NewTimer(q.option.BatchWindow)
+	defer timer.Stop()
+	for len(batch) < q.option.MaxBatchSize {
+		select {
+		case item := <-q.items:
+			batch = append(batch, item)
+		case <-timer.C:
+			return batch
+		case <-q.closed:
+			return batch
+		}
+	}
+	return batch
+}
+
+// commitBatch executes the statements of batch in one transaction, of which each statement is
+// isolated by savepoint.
+func (q *writeQueue) commitBatch(batch []*writeQueueItem) {
+	var (
+		ctx     = context.Background()
+		results = make([]writeQueueResult, len(batch))
+		pending = make([]int, 0, len(batch))
+	)
+	master, err := q.driver.Master()
+	if err != nil {
+		q.finish(batch, nil, err)
+		return
+	}
+	tx, err := master.BeginTx(ctx, nil)
+	if err != nil {
+		q.finish(batch, nil, gerror.WrapCode(gcode.CodeDbOperationError, err, `begin write queue batch failed`))
+		return
+	}
+	link := &writeQueueLink{Tx: tx}
+	for i, item := range batch {
+		if err = item.ctx.Err(); err != nil {
+			results[i].err = gerror.WrapCode(gcode.CodeServerBusy, err, `wait for write queue failed`)
+			continue
+		}
+		if _, err = tx.ExecContext(ctx, writeQueueSqlSavepoint); err != nil {
+			results[i].err = gerror.WrapCode(gcode.CodeDbOperationError, err, `create savepoint failed`)
+			continue
+		}
+		in := item.in
+		in.Link = link
+		in.IsTransaction = true
+		results[i].out, results[i].err = q.driver.Core.DoCommit(item.ctx, in)
+		if results[i].err != nil {
+			if _, err = tx.ExecContext(ctx, writeQueueSqlRollbackToPoint); err != nil {
+				q.driver.GetLogger().Errorf(ctx, `%+v`, err)
+			}
+		}
+		if _, err = tx.ExecContext(ctx, writeQueueSqlRelease); err != nil {
+			q.driver.GetLogger().Errorf(ctx, `%+v`, err)
+		}
+		if results[i].err == nil {
+			pending = append(pending, i)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		// All the statements of the batch are lost if the commit fails.
+		err = gerror.WrapCode(gcode.CodeDbOperationError, err, `commit write queue batch failed`)
+		for _, i := range pending {
+			results[i] = writeQueueResult{err: err}
+		}
+	}
+	q.batches.Add(1)
+	q.finish(batch, results, nil)
+}
+
+// finish sends the results to the waiting statements, or `err` to all of them if it is not nil.
+func (q *writeQueue) finish(batch []*writeQueueItem, results []writeQueueResult, err error) {
+	q.statements.Add(int64(len(batch)))
+	for i, item := range batch {
+		if err != nil {
+			item.result <- writeQueueResult{err: err}
+		} else {
+			item.result <- results[i]
+		}
+	}
+}
+
+// rejectPending fails all the pending statements in queue.
+func (q *writeQueue) rejectPending() {
+	err := gerror.NewCode(gcode.CodeInvalidOperation, `write queue is closed`)
+	for {
+		select {
+		case item := <-q.items:
+			item.result <- writeQueueResult{err: err}
+		default:
+			return
+		}
+	}
+}
+
+// IsOnMaster implements interface gdb.Link.
+func (l *writeQueueLink) IsOnMaster() bool {
+	return true
+}
+
+// IsTransaction implements interface gdb.Link.
+func (l *writeQueueLink) IsTransaction() bool {
+	return true
+}
@@ -440,7 +440,15 @@ func Test_DB_Save(t *testing.T) {
 			"create_time": gtime.Now().String(),
 		}
 		_, err := db.Save(ctx, "t_user", data, 10)
-		gtest.AssertNE(err, nil)
+		t.AssertNil(err)
+
+		// The primary key is used as the conflict key as OnConflict is not specified.
+		data["nickname"] = "T10_saved"
+		_, err = db.Save(ctx, "t_user", data, 10)
+		t.AssertNil(err)
+		value, err := db.Model("t_user").Where("id", i).Value("nickname")
+		t.AssertNil(err)
+		t.Assert(value, "T10_saved")
 	})
 }
 
@@ -0,0 +1,65 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_Aggregate(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		sum, err := db.Model(table).SumInt64("id")
+		t.AssertNil(err)
+		t.Assert(sum, 55)
+
+		decimal, err := db.Model(table).Where("id<?", 3).SumDecimal("id")
+		t.AssertNil(err)
+		t.Assert(decimal, "3")
+
+		decimal, err = db.Model(table).Where("id>?", 100).SumDecimal("id")
+		t.AssertNil(err)
+		t.Assert(decimal, "0")
+	})
+	// Big integer out of the precision of float64.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data(g.Map{"id": int64(1) << 60, "passport": "big"}).Insert()
+		t.AssertNil(err)
+
+		sum, err := db.Model(table).Where("id>?", 1).SumInt64("id")
+		t.AssertNil(err)
+		t.Assert(sum, int64(1)<<60+54)
+
+		maxId, err := gdb.Aggregate[uint64](db.Model(table), gdb.AggregateMax, "id")
+		t.AssertNil(err)
+		t.Assert(maxId, uint64(1)<<60)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data("create_time", "2020-01-02 03:04:05").Where("id", 2).Update()
+		t.AssertNil(err)
+
+		maxTime, err := db.Model(table).MaxTime("create_time")
+		t.AssertNil(err)
+		t.Assert(maxTime, gtime.NewFromStr("2020-01-02 03:04:05"))
+
+		minTime, err := gdb.Aggregate[time.Time](db.Model(table), gdb.AggregateMin, "create_time")
+		t.AssertNil(err)
+		t.Assert(minTime.Format(time.DateTime), CreateTime)
+
+		minTime2, err := db.Model(table).Where("id>?", 1<<61).MinTime("create_time")
+		t.AssertNil(err)
+		t.Assert(minTime2, nil)
+	})
+}
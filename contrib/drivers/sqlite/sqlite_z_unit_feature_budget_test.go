@@ -0,0 +1,50 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gctx"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_Budget(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	node := configNode
+	node.BudgetMinTimeout = 500 * time.Millisecond
+	newDb, err := gdb.New(node)
+	gtest.AssertNil(err)
+	defer newDb.Close(ctx)
+
+	gtest.C(t, func(t *gtest.T) {
+		// The remaining budget is less than the minimum timeout.
+		budgetCtx, cancel := gctx.WithBudget(ctx, 100*time.Millisecond)
+		defer cancel()
+		_, err := newDb.Model(table).Ctx(budgetCtx).WherePri(1).One()
+		t.Assert(gerror.Is(err, gctx.ErrBudgetExhausted), true)
+		_, err = newDb.Model(table).Ctx(budgetCtx).Data("passport", "budget").WherePri(1).Update()
+		t.Assert(gerror.Is(err, gctx.ErrBudgetExhausted), true)
+
+		budgetCtx, cancel = gctx.WithBudget(ctx, 2*time.Second)
+		defer cancel()
+		one, err := newDb.Model(table).Ctx(budgetCtx).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["id"], 1)
+	})
+	// The statements without budget are not affected.
+	gtest.C(t, func(t *gtest.T) {
+		count, err := newDb.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize)
+	})
+}
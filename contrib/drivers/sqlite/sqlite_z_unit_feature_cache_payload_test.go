@@ -0,0 +1,81 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_Cache_Payload(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		cacheDB, err := gdb.New(configNode)
+		t.AssertNil(err)
+		defer cacheDB.Close(ctx)
+
+		var (
+			oldKey = gdb.CacheKey{Id: "old", Key: bytes.Repeat([]byte{1}, 32)}
+			newKey = gdb.CacheKey{Id: "new", Key: bytes.Repeat([]byte{2}, 32)}
+			option = gdb.CacheOption{Duration: time.Minute, Name: "cache_payload"}
+		)
+		t.AssertNil(cacheDB.GetCore().SetCachePayloadOption(gdb.CachePayloadOption{
+			Compress: true,
+			Keys:     []gdb.CacheKey{oldKey},
+		}))
+		one, err := cacheDB.Model(table).Cache(option).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "user_1")
+
+		// The payload in the cache adapter is encrypted.
+		keys, err := cacheDB.GetCache().KeyStrings(ctx)
+		t.AssertNil(err)
+		t.Assert(len(keys), 1)
+		payload, err := cacheDB.GetCache().Get(ctx, keys[0])
+		t.AssertNil(err)
+		t.Assert(bytes.Contains(payload.Bytes(), []byte("user_1")), false)
+
+		// The cached result is still returned after the record changes.
+		_, err = db.Model(table).Data("passport", "user_changed").WherePri(1).Update()
+		t.AssertNil(err)
+		one, err = cacheDB.Model(table).Cache(option).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "user_1")
+
+		// The cached payload of the old key is still valid after rotating.
+		t.AssertNil(cacheDB.GetCore().SetCachePayloadOption(gdb.CachePayloadOption{
+			Keys: []gdb.CacheKey{newKey, oldKey},
+		}))
+		one, err = cacheDB.Model(table).Cache(option).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "user_1")
+
+		// The cached payload of the removed key is cache missing.
+		t.AssertNil(cacheDB.GetCore().SetCachePayloadOption(gdb.CachePayloadOption{
+			Keys: []gdb.CacheKey{newKey},
+		}))
+		one, err = cacheDB.Model(table).Cache(option).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "user_changed")
+
+		// Value and Count are cached with the first result column.
+		for i := 0; i < 2; i++ {
+			value, err := cacheDB.Model(table).Cache(gdb.CacheOption{Duration: time.Minute}).WherePri(2).Value("passport")
+			t.AssertNil(err)
+			t.Assert(value, "user_2")
+			count, err := cacheDB.Model(table).Cache(gdb.CacheOption{Duration: time.Minute}).Count()
+			t.AssertNil(err)
+			t.Assert(count, TableSize)
+		}
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Model_Cache_Jitter(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		name := guid.S()
+		_, err := db.Model(table).Cache(gdb.CacheOption{
+			Duration: time.Hour,
+			Name:     name,
+			Jitter:   0.5,
+		}).WherePri(1).One()
+		t.AssertNil(err)
+
+		keys, err := db.GetCache().KeyStrings(ctx)
+		t.AssertNil(err)
+		var found bool
+		for _, key := range keys {
+			if key == "SelectCache:"+name {
+				found = true
+				expire, err := db.GetCache().GetExpire(ctx, key)
+				t.AssertNil(err)
+				t.AssertLE(expire, time.Hour)
+				t.AssertGE(expire, 29*time.Minute)
+			}
+		}
+		t.Assert(found, true)
+	})
+}
+
+func Test_Model_Cache_EarlyRefresh(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			name  = guid.S()
+			model = func(earlyRefresh float64) *gdb.Model {
+				return db.Model(table).Cache(gdb.CacheOption{
+					Duration:     time.Hour,
+					Name:         name,
+					EarlyRefresh: earlyRefresh,
+				}).WherePri(1)
+			}
+		)
+		one, err := model(0).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "user_1")
+
+		_, err = db.Model(table).Data("passport", "user_changed").WherePri(1).Update()
+		t.AssertNil(err)
+
+		// It is not refreshed as the remaining TTL is far more than the query cost.
+		one, err = model(1).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "user_1")
+
+		// It is refreshed early with extremely eager refreshing.
+		one, err = model(1e12).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "user_changed")
+	})
+}
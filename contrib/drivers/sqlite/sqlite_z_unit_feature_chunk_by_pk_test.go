@@ -0,0 +1,97 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_ChunkByPk(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			ids   []int
+			sizes []int
+		)
+		err := db.Model(table).Where("id>?", 1).Order("id desc").ChunkByPk(4, func(result gdb.Result) error {
+			sizes = append(sizes, len(result))
+			ids = append(ids, result.Array("id")[0].Int())
+			return nil
+		})
+		t.AssertNil(err)
+		t.Assert(sizes, []int{4, 4, 1})
+		t.Assert(ids, []int{2, 6, 10})
+	})
+	// The batches are sought with the last primary key.
+	gtest.C(t, func(t *gtest.T) {
+		var chunks int
+		sqlArray, err := gdb.CatchSQL(ctx, func(ctx context.Context) error {
+			return db.Model(table).Ctx(ctx).Fields("id").ChunkByPk(5, func(result gdb.Result) error {
+				chunks++
+				return nil
+			})
+		})
+		t.AssertNil(err)
+		t.Assert(chunks, 2)
+		t.Assert(sqlArray[len(sqlArray)-2], fmt.Sprintf("SELECT `id` FROM `%s` WHERE `id`>5 ORDER BY `id` LIMIT 0,5", table))
+		t.Assert(sqlArray[len(sqlArray)-1], fmt.Sprintf("SELECT `id` FROM `%s` WHERE `id`>10 ORDER BY `id` LIMIT 0,5", table))
+	})
+	// It stops on error.
+	gtest.C(t, func(t *gtest.T) {
+		var chunks int
+		err := db.Model(table).ChunkByPk(3, func(result gdb.Result) error {
+			chunks++
+			return gerror.New("stop")
+		})
+		t.Assert(err, "stop")
+		t.Assert(chunks, 1)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		err := db.Model(table).Fields("passport").ChunkByPk(3, func(result gdb.Result) error {
+			return nil
+		})
+		t.Assert(gerror.Code(err), gcode.CodeInvalidParameter)
+	})
+	// The existing OR conditions are grouped.
+	gtest.C(t, func(t *gtest.T) {
+		var ids []int
+		sqlArray, err := gdb.CatchSQL(ctx, func(ctx context.Context) error {
+			return db.Model(table).Ctx(ctx).Fields("id").Where("id", 1).WhereOr("id>?", 7).ChunkByPk(2, func(result gdb.Result) error {
+				for _, v := range result.Array("id") {
+					ids = append(ids, v.Int())
+				}
+				return nil
+			})
+		})
+		t.AssertNil(err)
+		t.Assert(ids, []int{1, 8, 9, 10})
+		t.Assert(sqlArray[len(sqlArray)-1], fmt.Sprintf("SELECT `id` FROM `%s` WHERE ((`id`=1) OR (id>7)) AND (`id`>10) ORDER BY `id` LIMIT 0,2", table))
+	})
+}
+
+func Test_Model_ChunkByPk_CompositeKey(t *testing.T) {
+	table := "chunk_by_pk_composite"
+	_, err := db.Exec(ctx, fmt.Sprintf(`CREATE TABLE %s (uid INTEGER NOT NULL, oid INTEGER NOT NULL, PRIMARY KEY (uid, oid))`, table))
+	gtest.AssertNil(err)
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		err := db.Model(table).ChunkByPk(3, func(result gdb.Result) error {
+			return nil
+		})
+		t.Assert(gerror.Code(err), gcode.CodeNotSupported)
+	})
+}
@@ -0,0 +1,46 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_TableFields_Comment(t *testing.T) {
+	table := fmt.Sprintf(`%s_%d`, TableName, gtime.TimestampNano())
+	_, err := db.Exec(ctx, fmt.Sprintf(`
+	CREATE TABLE %s (
+		id       INTEGER PRIMARY KEY, -- User ID
+		passport VARCHAR(45) NOT NULL DEFAULT 'a,b', /* User Passport */
+		-- User Nickname,
+		-- which is optional.
+		"nickname" VARCHAR(45),
+		score    DECIMAL(10, 2) -- Score
+		                        -- in points
+		,
+		remark   TEXT,
+		UNIQUE (passport) -- Unique constraint
+	);
+	`, db.GetCore().QuoteWord(table)))
+	gtest.AssertNil(err)
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		fields, err := db.TableFields(ctx, table)
+		t.AssertNil(err)
+		t.Assert(len(fields), 5)
+		t.Assert(fields["id"].Comment, "User ID")
+		t.Assert(fields["passport"].Comment, "User Passport")
+		t.Assert(fields["nickname"].Comment, "User Nickname, which is optional.")
+		t.Assert(fields["score"].Comment, "Score in points")
+		t.Assert(fields["remark"].Comment, "")
+	})
+}
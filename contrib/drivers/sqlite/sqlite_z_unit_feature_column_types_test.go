@@ -0,0 +1,136 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_AllWithColumns(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.Model(table).Fields("id", "passport", "nickname").OrderAsc("id").AllWithColumns()
+		t.AssertNil(err)
+		t.Assert(len(all.Result), TableSize)
+
+		columnTypes := all.Columns
+		t.Assert(len(columnTypes), 3)
+		t.Assert(columnTypes[0].Name, "id")
+		t.Assert(strings.ToUpper(columnTypes[0].Type), "INTEGER")
+		t.Assert(columnTypes[1].Name, "passport")
+		t.Assert(strings.ToUpper(columnTypes[1].Type), "VARCHAR(45)")
+		t.Assert(columnTypes[2].Name, "nickname")
+
+		// The exported columns are in order of the query.
+		buffer := bytes.NewBuffer(nil)
+		t.AssertNil(all.Result[:1].ToCSV(buffer))
+		t.Assert(buffer.String(), "id,nickname,passport\n1,name_1,user_1\n")
+		buffer.Reset()
+		t.AssertNil((&gdb.ResultWithColumns{Result: all.Result[:1], Columns: all.Columns}).ToCSV(buffer))
+		t.Assert(buffer.String(), "id,passport,nickname\n1,user_1,name_1\n")
+	})
+	// The column types are available for empty result.
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.Model(table).Fields("id", "passport").Where("id", -1).AllWithColumns()
+		t.AssertNil(err)
+		t.Assert(all.IsEmpty(), true)
+		t.Assert(len(all.Columns), 2)
+		t.Assert(all.Columns[1].Name, "passport")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.GetCore().GetAllWithColumns(ctx, fmt.Sprintf("SELECT id, nickname AS name FROM %s WHERE id<3", table))
+		t.AssertNil(err)
+		t.Assert(len(all.Result), 2)
+		t.Assert(len(all.Columns), 2)
+		t.Assert(all.Columns[1].Name, "name")
+	})
+}
+
+func Test_Result_ColumnTypes(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.Model(table).Fields("id", "passport", "nickname").OrderAsc("id").All()
+		t.AssertNil(err)
+		t.Assert(len(all), TableSize)
+
+		columnTypes := all.ColumnTypes()
+		t.Assert(len(columnTypes), 3)
+		t.Assert(columnTypes[0].Name, "id")
+		t.Assert(strings.ToUpper(columnTypes[0].Type), "INTEGER")
+		t.Assert(columnTypes[1].Name, "passport")
+		t.Assert(columnTypes[2].Name, "nickname")
+
+		// The exported columns are in order of the query.
+		buffer := bytes.NewBuffer(nil)
+		t.AssertNil(all.ToCSV(buffer))
+		t.Assert(strings.Split(buffer.String(), "\n")[0], "id,passport,nickname")
+
+		// Truncated result has no column types.
+		t.Assert(len(all[1:].ColumnTypes()), 3)
+		t.Assert(all[:1].ColumnTypes(), nil)
+
+		// Empty result of All is nil without column types.
+		all, err = db.Model(table).Where("id", -1).All()
+		t.AssertNil(err)
+		t.Assert(all, nil)
+		t.Assert(all.ColumnTypes(), nil)
+	})
+	// The column types are not affected by the other queries of the same context.
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.Model(table).Fields("id", "passport").Where("id", -1).Hook(gdb.HookHandler{
+			Select: func(ctx context.Context, in *gdb.HookSelectInput) (gdb.Result, error) {
+				result, err := in.Next(ctx)
+				if err != nil {
+					return nil, err
+				}
+				_, err = db.GetAll(ctx, "SELECT 1 AS a")
+				return result, err
+			},
+		}).AllWithColumns()
+		t.AssertNil(err)
+		t.Assert(all.IsEmpty(), true)
+		t.Assert(len(all.Columns), 2)
+		t.Assert(all.Columns[1].Name, "passport")
+	})
+}
+
+func Test_Result_ColumnTypes_Cache(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	newDb, err := gdb.New(configNode)
+	gtest.AssertNil(err)
+	defer newDb.Close(ctx)
+
+	for _, compress := range []bool{false, true} {
+		gtest.C(t, func(t *gtest.T) {
+			t.AssertNil(newDb.GetCore().SetCachePayloadOption(gdb.CachePayloadOption{Compress: compress}))
+			cacheOption := gdb.CacheOption{Duration: time.Hour, Name: fmt.Sprintf("column_types_%t", compress)}
+			for i := 0; i < 2; i++ {
+				all, err := newDb.Model(table).Fields("id", "passport").Cache(cacheOption).Where("id<?", 3).All()
+				t.AssertNil(err)
+				t.Assert(len(all), 2)
+				columnTypes := all.ColumnTypes()
+				t.Assert(len(columnTypes), 2)
+				t.Assert(columnTypes[1].Name, "passport")
+				t.Assert(strings.ToUpper(columnTypes[1].Type), "VARCHAR(45)")
+			}
+		})
+	}
+}
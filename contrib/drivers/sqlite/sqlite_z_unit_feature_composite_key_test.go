@@ -0,0 +1,121 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func createCompositeKeyTable() string {
+	tableName := "composite_" + gtime.Now().TimestampNanoStr()
+	if _, err := db.Exec(ctx, fmt.Sprintf(`
+	CREATE TABLE %s (
+		type     varchar(45) NOT NULL,
+		uid      INTEGER NOT NULL,
+		nickname varchar(45) NULL,
+		PRIMARY KEY (uid, type)
+	);
+	`, tableName,
+	)); err != nil {
+		gtest.Fatal(err)
+	}
+	for i := 1; i <= 3; i++ {
+		for _, typ := range []string{"a", "b"} {
+			_, err := db.Model(tableName).Data(g.Map{
+				"uid":      i,
+				"type":     typ,
+				"nickname": fmt.Sprintf("name_%d_%s", i, typ),
+			}).Insert()
+			if err != nil {
+				gtest.Fatal(err)
+			}
+		}
+	}
+	return tableName
+}
+
+func Test_Model_WherePri_CompositeKey(t *testing.T) {
+	table := createCompositeKeyTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		primaryKeys, err := db.GetCore().GetPrimaryKeys(ctx, table)
+		t.AssertNil(err)
+		t.Assert(primaryKeys, g.SliceStr{"type", "uid"})
+	})
+	// Single key values in order of the primary key fields.
+	gtest.C(t, func(t *gtest.T) {
+		one, err := db.Model(table).WherePri(g.Slice{g.Slice{"b", 2}}).One()
+		t.AssertNil(err)
+		t.Assert(one["nickname"], "name_2_b")
+	})
+	// Flat slice is the values of the first primary key field, even if its length equals
+	// the number of the primary key fields.
+	gtest.C(t, func(t *gtest.T) {
+		count, err := db.Model(table).WherePri(g.Slice{"a", "b"}).Count()
+		t.AssertNil(err)
+		t.Assert(count, 6)
+	})
+	// Multiple key values.
+	gtest.C(t, func(t *gtest.T) {
+		array, err := db.Model(table).Fields("nickname").
+			WherePri(g.Slice{g.Slice{"a", 1}, g.Slice{"b", 3}, g.Slice{"c", 1}}).Order("uid").Array()
+		t.AssertNil(err)
+		t.Assert(array, g.Slice{"name_1_a", "name_3_b"})
+	})
+	// Map and struct key values.
+	gtest.C(t, func(t *gtest.T) {
+		type Key struct {
+			Uid  int
+			Type string
+		}
+		array, err := db.Model(table).Fields("nickname").WherePri(g.Slice{
+			g.Map{"uid": 1, "type": "b"},
+			Key{Uid: 2, Type: "a"},
+		}).Order("uid").Array()
+		t.AssertNil(err)
+		t.Assert(array, g.Slice{"name_1_b", "name_2_a"})
+
+		one, err := db.Model(table).WherePri(Key{Uid: 3, Type: "a"}).One()
+		t.AssertNil(err)
+		t.Assert(one["nickname"], "name_3_a")
+	})
+	// Single value is the value of the first primary key field.
+	gtest.C(t, func(t *gtest.T) {
+		count, err := db.Model(table).WherePri("a").Count()
+		t.AssertNil(err)
+		t.Assert(count, 3)
+	})
+}
+
+func Test_Model_Save_CompositeKey(t *testing.T) {
+	table := createCompositeKeyTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data(g.List{
+			{"uid": 1, "type": "a", "nickname": "updated_1_a"},
+			{"uid": 4, "type": "a", "nickname": "name_4_a"},
+		}).Save()
+		t.AssertNil(err)
+
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, 7)
+		one, err := db.Model(table).WherePri(g.Map{"type": "a", "uid": 1}).One()
+		t.AssertNil(err)
+		t.Assert(one["nickname"], "updated_1_a")
+		one, err = db.Model(table).WherePri(g.Map{"type": "a", "uid": 4}).One()
+		t.AssertNil(err)
+		t.Assert(one["nickname"], "name_4_a")
+	})
+}
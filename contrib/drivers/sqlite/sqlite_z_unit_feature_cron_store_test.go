@@ -0,0 +1,160 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/container/gtype"
+	"github.com/gogf/gf/v2/os/gcron"
+	"github.com/gogf/gf/v2/os/gcron/gcrondb"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func createCronStoreTables() {
+	for _, sql := range []string{
+		`CREATE TABLE gcron_job (
+			name      VARCHAR(128) NOT NULL PRIMARY KEY,
+			pattern   VARCHAR(128) NOT NULL,
+			handler   VARCHAR(128) NOT NULL,
+			singleton INTEGER      NOT NULL DEFAULT 0,
+			disabled  INTEGER      NOT NULL DEFAULT 0
+		)`,
+		`CREATE TABLE gcron_lock (
+			name     VARCHAR(128) NOT NULL,
+			run_time INTEGER      NOT NULL,
+			node     VARCHAR(128) NOT NULL,
+			PRIMARY KEY (name, run_time)
+		)`,
+		`CREATE TABLE gcron_history (
+			id         INTEGER PRIMARY KEY AUTOINCREMENT,
+			name       VARCHAR(128) NOT NULL,
+			node       VARCHAR(128) NOT NULL,
+			start_time DATETIME     NOT NULL,
+			end_time   DATETIME     NOT NULL,
+			error      TEXT
+		)`,
+	} {
+		if _, err := db.Exec(ctx, sql); err != nil {
+			gtest.Fatal(err)
+		}
+	}
+}
+
+func Test_Cron_Store_Db(t *testing.T) {
+	createCronStoreTables()
+	defer dropTable("gcron_job")
+	defer dropTable("gcron_lock")
+	defer dropTable("gcron_history")
+
+	store := gcrondb.New(db)
+	// Job definitions.
+	gtest.C(t, func(t *gtest.T) {
+		err := store.SaveJob(ctx, &gcron.StoreJob{Name: "job1", Pattern: "* * * * * *", Handler: "h1"})
+		t.AssertNil(err)
+		err = store.SaveJob(ctx, &gcron.StoreJob{Name: "job2", Pattern: "@every 1h", Handler: "h2", Singleton: true})
+		t.AssertNil(err)
+		err = store.SaveJob(ctx, &gcron.StoreJob{Name: "job1", Pattern: "*/2 * * * * *", Handler: "h1", Disabled: true})
+		t.AssertNil(err)
+
+		jobs, err := store.Jobs(ctx)
+		t.AssertNil(err)
+		t.Assert(len(jobs), 2)
+		t.Assert(*jobs[0], gcron.StoreJob{Name: "job1", Pattern: "*/2 * * * * *", Handler: "h1", Disabled: true})
+		t.Assert(*jobs[1], gcron.StoreJob{Name: "job2", Pattern: "@every 1h", Handler: "h2", Singleton: true})
+
+		t.AssertNil(store.RemoveJob(ctx, "job1"))
+		jobs, err = store.Jobs(ctx)
+		t.AssertNil(err)
+		t.Assert(len(jobs), 1)
+		t.AssertNil(store.RemoveJob(ctx, "job2"))
+	})
+	// Run locks.
+	gtest.C(t, func(t *gtest.T) {
+		runTime := time.Now().Truncate(time.Second)
+		locked, err := store.Lock(ctx, "job", runTime.Add(-time.Second), "node1")
+		t.AssertNil(err)
+		t.Assert(locked, true)
+		locked, err = store.Lock(ctx, "job", runTime, "node1")
+		t.AssertNil(err)
+		t.Assert(locked, true)
+		locked, err = store.Lock(ctx, "job", runTime, "node2")
+		t.AssertNil(err)
+		t.Assert(locked, false)
+
+		// Expired locks are deleted.
+		count, err := db.Model("gcron_lock").Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+
+		// The lock is acquired even if deleting expired locks fails.
+		_, err = db.Exec(ctx, `CREATE TRIGGER gcron_lock_no_delete BEFORE DELETE ON gcron_lock
+			BEGIN SELECT RAISE(FAIL, 'deleting denied'); END`)
+		t.AssertNil(err)
+		defer db.Exec(ctx, `DROP TRIGGER gcron_lock_no_delete`)
+		locked, err = store.Lock(ctx, "job", runTime.Add(time.Second), "node1")
+		t.AssertNil(err)
+		t.Assert(locked, true)
+	})
+	// Run history.
+	gtest.C(t, func(t *gtest.T) {
+		startTime := time.Now().Add(-time.Minute).Truncate(time.Second)
+		for i := 0; i < 3; i++ {
+			err := store.AddHistory(ctx, &gcron.StoreHistory{
+				Name:      "job",
+				Node:      "node1",
+				StartTime: startTime.Add(time.Duration(i) * time.Second),
+				EndTime:   startTime.Add(time.Duration(i)*time.Second + time.Millisecond),
+			})
+			t.AssertNil(err)
+		}
+		histories, err := store.Histories(ctx, "job", 2)
+		t.AssertNil(err)
+		t.Assert(len(histories), 2)
+		t.Assert(histories[0].StartTime.Unix(), startTime.Add(2*time.Second).Unix())
+		t.Assert(histories[0].Node, "node1")
+	})
+}
+
+func Test_Cron_Store_Db_Cron(t *testing.T) {
+	createCronStoreTables()
+	defer dropTable("gcron_job")
+	defer dropTable("gcron_lock")
+	defer dropTable("gcron_history")
+
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			store = gcrondb.New(db)
+			count = gtype.NewInt()
+			cron1 = gcron.New()
+			cron2 = gcron.New()
+		)
+		defer cron1.Close()
+		defer cron2.Close()
+		for _, cron := range []*gcron.Cron{cron1, cron2} {
+			cron.SetStore(store)
+			cron.RegisterHandler("count", func(ctx context.Context) {
+				count.Add(1)
+			})
+		}
+		cron1.SetNode("node1")
+		cron2.SetNode("node2")
+
+		err := cron1.AddStoreJob(ctx, gcron.StoreJob{Name: "job", Pattern: "* * * * * *", Handler: "count"})
+		t.AssertNil(err)
+		t.AssertNil(cron2.SyncStore(ctx))
+		t.Assert(cron2.Size(), 1)
+
+		time.Sleep(2500 * time.Millisecond)
+		histories, err := store.Histories(ctx, "job", 10)
+		t.AssertNil(err)
+		t.Assert(count.Val(), len(histories))
+		t.AssertGE(count.Val(), 2)
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_WithCTE(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.Model("u").
+			WithCTE("u", db.Model(table).Where("id>?", 5)).
+			Where("id<?", 9).
+			Order("id").
+			All()
+		t.AssertNil(err)
+		t.Assert(len(all), 3)
+		t.Assert(all[0]["id"], 6)
+		t.Assert(all[2]["id"], 8)
+
+		count, err := db.Model("u").
+			WithCTE("u", db.Model(table).Where("id>?", 5)).
+			Where("id<?", 9).
+			Count()
+		t.AssertNil(err)
+		t.Assert(count, 3)
+	})
+	// Multiple CTEs with join.
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.Model("a").
+			WithCTE("a(aid, passport)", db.Model(table).Fields("id", "passport").Where("id<=?", 5)).
+			WithCTE("b", db.Model(table).Fields("id").Where("id>=?", 4)).
+			InnerJoin("b", "a.aid=b.id").
+			Fields("a.aid", "a.passport").
+			Order("a.aid").
+			All()
+		t.AssertNil(err)
+		t.Assert(len(all), 2)
+		t.Assert(all[0]["aid"], 4)
+		t.Assert(all[0]["passport"], "user_4")
+		t.Assert(all[1]["aid"], 5)
+	})
+	// Sub query using CTE.
+	gtest.C(t, func(t *gtest.T) {
+		value, err := db.Model(table).
+			Fields("passport").
+			Where("id IN(?)", db.Model("u").WithCTE("u", db.Model(table).Where("id", 3)).Fields("id")).
+			Value()
+		t.AssertNil(err)
+		t.Assert(value, "user_3")
+	})
+}
+
+func Test_Model_WithCTE_Cache(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		// The arguments of the CTEs are part of the cache key.
+		for _, id := range []int{3, 4} {
+			value, err := db.Model("u").
+				WithCTE("u", db.Model(table).Where("id", id)).
+				Cache(gdb.CacheOption{Duration: time.Hour}).
+				Fields("passport").
+				Value()
+			t.AssertNil(err)
+			t.Assert(value, fmt.Sprintf("user_%d", id))
+		}
+	})
+}
+
+func Test_Model_WithRecursive(t *testing.T) {
+	table := createTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		// Tree: 1 -> 2 -> 3 -> 4, 1 -> 5, 6.
+		for i, parent := range []int{0, 1, 2, 3, 1, 0} {
+			_, err := db.Model(table).Data(g.Map{
+				"id":       i + 1,
+				"passport": fmt.Sprintf(`user_%d`, i+1),
+				"nickname": fmt.Sprintf(`%d`, parent),
+			}).Insert()
+			t.AssertNil(err)
+		}
+		all, err := db.Model("tree").WithRecursive(
+			"tree",
+			db.Model(table).Fields("id", "nickname").Where("id", 2),
+			db.Model(table+" c").InnerJoin("tree t", "c.nickname=t.id").Fields("c.id", "c.nickname"),
+		).Order("id").All()
+		t.AssertNil(err)
+		t.Assert(len(all), 3)
+		t.Assert(all[0]["id"], 2)
+		t.Assert(all[1]["id"], 3)
+		t.Assert(all[2]["id"], 4)
+	})
+	// Number sequence with column names and raw sql anchor.
+	gtest.C(t, func(t *gtest.T) {
+		array, err := db.Model("seq").WithRecursive(
+			"seq(n)",
+			db.Raw("SELECT ?", 1),
+			db.Model("seq").Fields("n+1 AS n").Where("n<?", 5),
+		).Array("n")
+		t.AssertNil(err)
+		t.Assert(array, []int{1, 2, 3, 4, 5})
+	})
+}
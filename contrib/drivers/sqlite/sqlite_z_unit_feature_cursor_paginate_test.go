@@ -0,0 +1,111 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_CursorPaginate(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	// Ordered by primary key in default.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			ids    []int
+			cursor gdb.Cursor
+			pages  int
+		)
+		for {
+			result, next, err := db.Model(table).CursorPaginate(cursor, 4)
+			t.AssertNil(err)
+			for _, record := range result {
+				ids = append(ids, record["id"].Int())
+			}
+			pages++
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+		t.Assert(pages, 3)
+		t.Assert(ids, g.Slice{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	})
+	// Mixed directions with primary key as tie-breaker.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data("nickname", "same").WhereIn("id", g.Slice{3, 4, 5}).Update()
+		t.AssertNil(err)
+
+		model := func() *gdb.Model {
+			return db.Model(table).Where("id<?", 7).OrderDesc("nickname")
+		}
+		result, next, err := model().CursorPaginate("", 3)
+		t.AssertNil(err)
+		t.Assert(result.Array("id"), g.Slice{5, 4, 3})
+		t.AssertNE(next, "")
+
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, _, err := model().Ctx(ctx).CursorPaginate(next, 3)
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf(
+			"SELECT * FROM `%s` WHERE (id<7) AND "+
+				"((`nickname` < 'same') OR (`nickname` = 'same' AND `id` < 3)) "+
+				"ORDER BY `nickname` DESC,`id` DESC LIMIT 4",
+			table,
+		))
+
+		result, next, err = model().CursorPaginate(next, 3)
+		t.AssertNil(err)
+		t.Assert(result.Array("id"), g.Slice{6, 2, 1})
+		t.Assert(next, "")
+	})
+	// The existing OR conditions are grouped.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			ids    []int
+			cursor gdb.Cursor
+		)
+		for {
+			result, next, err := db.Model(table).Where("id", 1).WhereOr("id>?", 6).CursorPaginate(cursor, 2)
+			t.AssertNil(err)
+			for _, record := range result {
+				ids = append(ids, record["id"].Int())
+			}
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+		t.Assert(ids, g.Slice{1, 7, 8, 9, 10})
+
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, _, err := db.Model(table).Ctx(ctx).Where("id", 1).WhereOr("id>?", 6).CursorPaginate(cursor, 2)
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf(
+			"SELECT * FROM `%s` WHERE ((`id`=1) OR (id>6)) AND ((`id` > 9)) ORDER BY `id` ASC LIMIT 3",
+			table,
+		))
+	})
+	gtest.C(t, func(t *gtest.T) {
+		_, _, err := db.Model(table).CursorPaginate("invalid", 3)
+		t.AssertNE(err, nil)
+
+		_, _, err = db.Model(table).OrderRandom().CursorPaginate("", 3)
+		t.AssertNE(err, nil)
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_WhereLastDays(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data(g.Map{"create_time": gtime.Now().Add(-time.Hour)}).WhereIn("id", g.Slice{1, 2}).Update()
+		t.AssertNil(err)
+		_, err = db.Model(table).Data(g.Map{"create_time": gtime.Now().AddDate(0, 0, -3)}).Where("id", 3).Update()
+		t.AssertNil(err)
+
+		count, err := db.Model(table).WhereLastDays("create_time", 1).Count()
+		t.AssertNil(err)
+		t.Assert(count, 2)
+
+		count, err = db.Model(table).WhereLastDays("create_time", 7).Count()
+		t.AssertNil(err)
+		t.Assert(count, 3)
+	})
+}
+
+func Test_Model_WhereDateBetween(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		// The time values are stored in the same format as the time parameters, as sqlite compares them as text.
+		_, err := db.Model(table).Data(g.Map{"create_time": gtime.NewFromStr("2024-01-01 00:00:00")}).Where("id", 1).Update()
+		t.AssertNil(err)
+		_, err = db.Model(table).Data(g.Map{"create_time": gtime.NewFromStr("2024-01-31 23:59:59")}).Where("id", 2).Update()
+		t.AssertNil(err)
+		_, err = db.Model(table).Data(g.Map{"create_time": gtime.NewFromStr("2024-02-01 00:00:00")}).Where("id", 3).Update()
+		t.AssertNil(err)
+
+		array, err := db.Model(table).Fields("id").WhereDateBetween("create_time", "2024-01-01", "2024-01-31").Array()
+		t.AssertNil(err)
+		t.Assert(array, g.Slice{1, 2})
+
+		array, err = db.Model(table).Fields("id").WhereDateBetween(
+			"create_time", gtime.NewFromStr("2024-01-31 12:00:00"), gtime.NewFromStr("2024-02-01 12:00:00"),
+		).Array()
+		t.AssertNil(err)
+		t.Assert(array, g.Slice{2, 3})
+	})
+	// The invalid date is returned as error by the statements.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).WhereDateBetween("create_time", "invalid", "2024-01-31").All()
+		t.Assert(gerror.Code(err), gcode.CodeInvalidParameter)
+
+		_, err = db.Model(table).WhereDateBetween("create_time", "2024-01-01", "").Count()
+		t.Assert(gerror.Code(err), gcode.CodeInvalidParameter)
+
+		_, err = db.Model(table).Where(
+			db.Model(table).Builder().WhereDateBetween("create_time", "2024-01-01", "invalid"),
+		).Data(g.Map{"passport": "updated"}).Update()
+		t.Assert(gerror.Code(err), gcode.CodeInvalidParameter)
+
+		_, err = db.Model(table).WhereDateBetween("create_time", "invalid", "invalid").Where("id", 1).Delete()
+		t.Assert(gerror.Code(err), gcode.CodeInvalidParameter)
+
+		count, err := db.Model(table).Where("passport", "updated").Count()
+		t.AssertNil(err)
+		t.Assert(count, 0)
+	})
+}
+
+func Test_Model_GroupByDate(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data(g.Map{"create_time": "2024-01-01 10:00:00"}).WhereIn("id", g.Slice{1, 2}).Update()
+		t.AssertNil(err)
+		_, err = db.Model(table).Data(g.Map{"create_time": "2024-01-02 10:00:00"}).WhereIn("id", g.Slice{3, 4, 5}).Update()
+		t.AssertNil(err)
+		_, err = db.Model(table).Data(g.Map{"create_time": "2024-03-02 11:00:00"}).WhereGT("id", 5).Update()
+		t.AssertNil(err)
+
+		result, err := db.Model(table).Fields("COUNT(1) total").
+			GroupByDate("create_time", gdb.BucketDay, "day").Order("day").All()
+		t.AssertNil(err)
+		t.Assert(len(result), 3)
+		t.Assert(result[0]["day"], "2024-01-01")
+		t.Assert(result[0]["total"], 2)
+		t.Assert(result[1]["day"], "2024-01-02")
+		t.Assert(result[1]["total"], 3)
+		t.Assert(result[2]["day"], "2024-03-02")
+		t.Assert(result[2]["total"], 5)
+
+		result, err = db.Model(table).Fields("COUNT(1) total").
+			GroupByDate("create_time", gdb.BucketMonth, "").Order("create_time").All()
+		t.AssertNil(err)
+		t.Assert(len(result), 2)
+		t.Assert(result[0]["create_time"], "2024-01-01")
+		t.Assert(result[0]["total"], 5)
+		t.Assert(result[1]["create_time"], "2024-03-01")
+		t.Assert(result[1]["total"], 5)
+	})
+}
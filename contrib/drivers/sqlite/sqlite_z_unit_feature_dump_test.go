@@ -0,0 +1,159 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+func Test_Dump_Load_SQL(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data(g.Map{
+			"id":       TableSize + 1,
+			"passport": "it's;\n--quoted",
+			"password": `back\slash`,
+			"nickname": nil,
+		}).Insert()
+		t.AssertNil(err)
+
+		var buffer bytes.Buffer
+		err = gdb.Dump(ctx, db, []string{table}, &buffer, gdb.DumpOption{BatchSize: 4})
+		t.AssertNil(err)
+		content := buffer.String()
+		t.Assert(gstr.Count(content, "INSERT INTO"), 3)
+		t.Assert(gstr.Contains(content, `'it''s;`), true)
+		t.Assert(gstr.Contains(content, `NULL`), true)
+
+		expected, err := db.Model(table).OrderAsc("id").All()
+		t.AssertNil(err)
+
+		// Load with cleaning existing records.
+		err = gdb.Load(ctx, db, bytes.NewReader(buffer.Bytes()), gdb.LoadOption{Clean: true})
+		t.AssertNil(err)
+		actual, err := db.Model(table).OrderAsc("id").All()
+		t.AssertNil(err)
+		t.Assert(actual, expected)
+
+		// Load into another table of the same schema.
+		anotherTable := createTable()
+		defer dropTable(anotherTable)
+		err = gdb.Load(ctx, db, bytes.NewReader(bytes.ReplaceAll(buffer.Bytes(), []byte(table), []byte(anotherTable))))
+		t.AssertNil(err)
+		actual, err = db.Model(anotherTable).OrderAsc("id").All()
+		t.AssertNil(err)
+		t.Assert(actual, expected)
+	})
+	// Invalid statement.
+	gtest.C(t, func(t *gtest.T) {
+		err := gdb.Load(ctx, db, bytes.NewReader([]byte("DELETE FROM `"+table+"`;")))
+		t.AssertNE(err, nil)
+		err = gdb.Load(ctx, db, bytes.NewReader([]byte("INSERT INTO `"+table+"`(`id`) VALUES(100")))
+		t.AssertNE(err, nil)
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize+1)
+	})
+}
+
+func Test_Dump_Load_CSV(t *testing.T) {
+	table1 := createInitTable()
+	defer dropTable(table1)
+	table2 := createTable()
+	defer dropTable(table2)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table2).Data(g.Map{
+			"id":       1,
+			"passport": "a,\"b\"",
+			"nickname": nil,
+		}).Insert()
+		t.AssertNil(err)
+
+		var buffer bytes.Buffer
+		err = gdb.Dump(ctx, db, []string{table1, table2}, &buffer, gdb.DumpOption{Format: gdb.DumpFormatCSV})
+		t.AssertNil(err)
+		t.Assert(gstr.Count(buffer.String(), "#table,"), 2)
+
+		expected1, err := db.Model(table1).OrderAsc("id").All()
+		t.AssertNil(err)
+		expected2, err := db.Model(table2).OrderAsc("id").All()
+		t.AssertNil(err)
+
+		err = gdb.Load(ctx, db, bytes.NewReader(buffer.Bytes()), gdb.LoadOption{
+			Format: gdb.DumpFormatCSV,
+			Clean:  true,
+		})
+		t.AssertNil(err)
+		actual1, err := db.Model(table1).OrderAsc("id").All()
+		t.AssertNil(err)
+		t.Assert(actual1, expected1)
+		actual2, err := db.Model(table2).OrderAsc("id").All()
+		t.AssertNil(err)
+		t.Assert(actual2, expected2)
+		t.Assert(actual2[0]["nickname"].IsNil(), true)
+	})
+}
+
+func Test_Dump_Load_ForeignKey(t *testing.T) {
+	node := configNode
+	node.Extra = "foreign_keys=1"
+	fkDb, err := gdb.New(node)
+	gtest.AssertNil(err)
+	defer fkDb.Close(ctx)
+
+	dropFkTables := func() {
+		_, _ = fkDb.Exec(ctx, "DROP TABLE IF EXISTS dump_fk_child")
+		_, _ = fkDb.Exec(ctx, "DROP TABLE IF EXISTS dump_fk_parent")
+	}
+	dropFkTables()
+	defer dropFkTables()
+	_, err = fkDb.Exec(ctx, "CREATE TABLE dump_fk_parent (id INTEGER PRIMARY KEY, name TEXT)")
+	gtest.AssertNil(err)
+	_, err = fkDb.Exec(ctx, `CREATE TABLE dump_fk_child (
+	id INTEGER PRIMARY KEY, parent_id INTEGER NOT NULL REFERENCES dump_fk_parent(id)
+)`)
+	gtest.AssertNil(err)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := fkDb.Model("dump_fk_parent").Data(g.List{{"id": 1, "name": `a\b`}, {"id": 2, "name": "c"}}).Insert()
+		t.AssertNil(err)
+		_, err = fkDb.Model("dump_fk_child").Data(g.List{{"id": 1, "parent_id": 1}, {"id": 2, "parent_id": 2}}).Insert()
+		t.AssertNil(err)
+		// The foreign key is enforced.
+		_, err = fkDb.Model("dump_fk_child").Data(g.Map{"id": 3, "parent_id": 3}).Insert()
+		t.AssertNE(err, nil)
+
+		// The child table is dumped and loaded before its parent table.
+		var buffer bytes.Buffer
+		err = gdb.Dump(ctx, fkDb, []string{"dump_fk_child", "dump_fk_parent"}, &buffer)
+		t.AssertNil(err)
+		t.Assert(gstr.Contains(buffer.String(), "INSERT INTO `dump_fk_child`(`id`,`parent_id`)"), true)
+		t.Assert(gstr.Contains(buffer.String(), `'a\b'`), true)
+
+		err = gdb.Load(ctx, fkDb, bytes.NewReader(buffer.Bytes()), gdb.LoadOption{Clean: true})
+		t.AssertNil(err)
+		count, err := fkDb.Model("dump_fk_child").Count()
+		t.AssertNil(err)
+		t.Assert(count, 2)
+		value, err := fkDb.Model("dump_fk_parent").WherePri(1).Value("name")
+		t.AssertNil(err)
+		t.Assert(value, `a\b`)
+
+		// The foreign key is still checked when the transaction is committed.
+		err = gdb.Load(ctx, fkDb, bytes.NewReader([]byte("INSERT INTO `dump_fk_child`(`id`,`parent_id`) VALUES(3,3);")))
+		t.AssertNE(err, nil)
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+func Test_Error_Classification(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data(g.Map{"id": 1, "passport": "user_1"}).Insert()
+		t.AssertNE(err, nil)
+		t.Assert(gerror.HasCode(err, gcode.CodeDbDuplicateKey), true)
+		t.Assert(gerror.HasCode(err, gcode.CodeDbOperationError), true)
+		t.Assert(gerror.Code(err), gcode.CodeDbOperationError)
+		t.Assert(gdb.IsRetryableError(err), false)
+		t.Assert(gstr.Contains(err.Error(), "UNIQUE constraint failed"), true)
+	})
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Query(ctx, "SELEC * FROM "+table)
+		t.AssertNE(err, nil)
+		t.Assert(gerror.HasCode(err, gcode.CodeDbSyntaxError), true)
+		t.Assert(gerror.HasCode(err, gcode.CodeDbDuplicateKey), false)
+	})
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Query(ctx, "SELECT * FROM not_exist_table")
+		t.AssertNE(err, nil)
+		t.Assert(gerror.HasCode(err, gcode.CodeDbOperationError), true)
+		t.Assert(gerror.HasCode(err, gcode.CodeDbSyntaxError), false)
+	})
+}
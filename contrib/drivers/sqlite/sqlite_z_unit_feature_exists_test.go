@@ -0,0 +1,76 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_Exists(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		exists, err := db.Model(table).Exists("id", 1)
+		t.AssertNil(err)
+		t.Assert(exists, true)
+
+		exists, err = db.Model(table).Where("id>?", TableSize).Exists()
+		t.AssertNil(err)
+		t.Assert(exists, false)
+
+		// The fields and order are replaced.
+		exists, err = db.Model(table).Fields("id", "passport").Order("id desc").Exists("passport", "user_2")
+		t.AssertNil(err)
+		t.Assert(exists, true)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table).Ctx(ctx).Fields("id").Order("id").Exists("id>?", 5)
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM `%s` WHERE id>5)", table))
+	})
+	// Exist keeps selecting records.
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table).Ctx(ctx).Exist("id>?", 5)
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf("SELECT 1 FROM `%s` WHERE id>5 LIMIT 1", table))
+	})
+	// The fields and aliases are kept for GROUP BY and HAVING.
+	gtest.C(t, func(t *gtest.T) {
+		for _, f := range []func(*gdb.Model) (bool, error){
+			func(m *gdb.Model) (bool, error) { return m.Exist() },
+			func(m *gdb.Model) (bool, error) { return m.Exists() },
+		} {
+			exists, err := f(db.Model(table).Fields("nickname").FieldSum("id", "total").
+				Group("nickname").Having("total>?", 5))
+			t.AssertNil(err)
+			t.Assert(exists, true)
+
+			exists, err = f(db.Model(table).Fields("nickname").FieldSum("id", "total").
+				Group("nickname").Having(g.Map{"total >": 5}))
+			t.AssertNil(err)
+			t.Assert(exists, true)
+
+			exists, err = f(db.Model(table).Fields("nickname").FieldSum("id", "total").
+				Group("nickname").Having("total>?", TableSize))
+			t.AssertNil(err)
+			t.Assert(exists, false)
+		}
+	})
+}
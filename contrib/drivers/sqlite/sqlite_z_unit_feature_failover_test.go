@@ -0,0 +1,109 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Model_Failover(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		// The primary database does not have the table, which acts as down.
+		primaryNode := configNode
+		primaryNode.Link = fmt.Sprintf(`sqlite::@file(%s)`, gfile.Join(dbDir, guid.S()+".db"))
+		primaryGroup := "failover_" + guid.S()
+		gdb.AddConfigNode(primaryGroup, primaryNode)
+		primaryDB, err := gdb.Instance(primaryGroup)
+		t.AssertNil(err)
+
+		var (
+			events []gdb.FailoverEvent
+			option = gdb.FailoverOption{
+				DB:            db,
+				Threshold:     2,
+				ProbeInterval: 100 * time.Millisecond,
+				Checker: func(err error) bool {
+					return err != nil
+				},
+				OnEvent: func(ctx context.Context, event gdb.FailoverEvent) {
+					events = append(events, event)
+				},
+			}
+		)
+		// Reads fall back before the threshold is reached.
+		count, err := primaryDB.Model(table).Failover(option).Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize)
+		t.Assert(len(events), 0)
+		t.Assert(gdb.GetFailoverState(primaryDB, db), gdb.FailoverStateClosed)
+
+		one, err := primaryDB.Model(table).Failover(option).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["id"], 1)
+		t.Assert(len(events), 1)
+		t.Assert(events[0].Primary, primaryGroup)
+		t.Assert(events[0].State, gdb.FailoverStateOpen)
+		t.AssertNE(events[0].Error, nil)
+		t.Assert(gdb.GetFailoverState(primaryDB, db), gdb.FailoverStateOpen)
+
+		// The primary database recovers, and it is closed after probing.
+		_, err = primaryDB.Exec(ctx, fmt.Sprintf(
+			"CREATE TABLE `%s` (`id` INTEGER PRIMARY KEY, `passport` VARCHAR(45))", table,
+		))
+		t.AssertNil(err)
+		count, err = primaryDB.Model(table).Failover(option).Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize)
+		t.Assert(len(events), 1)
+
+		time.Sleep(150 * time.Millisecond)
+		count, err = primaryDB.Model(table).Failover(option).Count()
+		t.AssertNil(err)
+		t.Assert(count, 0)
+		t.Assert(len(events), 3)
+		t.Assert(events[1].State, gdb.FailoverStateHalfOpen)
+		t.Assert(events[2].State, gdb.FailoverStateClosed)
+		t.Assert(gdb.GetFailoverState(primaryDB, db), gdb.FailoverStateClosed)
+	})
+}
+
+func Test_Model_Failover_Transaction(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		primaryNode := configNode
+		primaryNode.Link = fmt.Sprintf(`sqlite::@file(%s)`, gfile.Join(dbDir, guid.S()+".db"))
+		primaryGroup := "failover_" + guid.S()
+		gdb.AddConfigNode(primaryGroup, primaryNode)
+		primaryDB, err := gdb.Instance(primaryGroup)
+		t.AssertNil(err)
+
+		// No failover in transaction.
+		err = primaryDB.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+			_, err := tx.Model(table).Failover(gdb.FailoverOption{
+				DB: db,
+				Checker: func(err error) bool {
+					return err != nil
+				},
+			}).Count()
+			return err
+		})
+		t.AssertNE(err, nil)
+	})
+}
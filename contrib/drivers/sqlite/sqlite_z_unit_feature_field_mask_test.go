@@ -0,0 +1,75 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+type fieldMaskPaths []string
+
+func (m fieldMaskPaths) GetPaths() []string {
+	return m
+}
+
+func Test_Model_FieldMask(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	type User struct {
+		Id       int
+		Passport string
+		Password string
+		Nickname string
+	}
+	gtest.C(t, func(t *gtest.T) {
+		user := User{
+			Passport: "new_passport",
+			Password: "new_password",
+			Nickname: "",
+		}
+		// The empty nickname is written even if OmitEmpty is set.
+		_, err := db.Model(table).Data(user).OmitEmpty().FieldMask("nickname", "Password").WherePri(1).Update()
+		t.AssertNil(err)
+		one, err := db.Model(table).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "user_1")
+		t.Assert(one["password"], "new_password")
+		t.Assert(one["nickname"], "")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data(g.Map{
+			"passport": "new_passport",
+			"nickname": "new_nickname",
+		}).FieldMask("passport,password").WherePri(2).Update()
+		t.AssertNil(err)
+		one, err := db.Model(table).WherePri(2).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "new_passport")
+		t.Assert(one["password"], "pass_2")
+		t.Assert(one["nickname"], "name_2")
+	})
+	// Field mask of protobuf.
+	gtest.C(t, func(t *gtest.T) {
+		user := User{Nickname: "new_nickname"}
+		_, err := db.Model(table).Data(user).FieldMask(fieldMaskPaths{"nickname"}).WherePri(3).Update()
+		t.AssertNil(err)
+		one, err := db.Model(table).WherePri(3).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "user_3")
+		t.Assert(one["nickname"], "new_nickname")
+	})
+	// Unknown field.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data(User{}).FieldMask("nickname", "not_exist").WherePri(4).Update()
+		t.AssertNE(err, nil)
+		t.Assert(err.Error(), `field "not_exist" of field mask does not exist in table "`+table+`"`)
+	})
+}
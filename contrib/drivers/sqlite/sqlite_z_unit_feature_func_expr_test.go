@@ -0,0 +1,106 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_FuncExpr_Where(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		one, err := db.Model(table).Where(gdb.Upper("passport"), "USER_2").One()
+		t.AssertNil(err)
+		t.Assert(one["id"], 2)
+
+		one, err = db.Model(table).Where(gdb.Func("LOWER", gdb.Upper("passport")), "user_3").One()
+		t.AssertNil(err)
+		t.Assert(one["id"], 3)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		array, err := db.Model(table).Fields("id").
+			Where(gdb.Lower("passport"), g.Slice{"user_1", "user_2"}).
+			OrderAsc("id").Array()
+		t.AssertNil(err)
+		t.Assert(array, g.Slice{1, 2})
+
+		count, err := db.Model(table).WhereOr(gdb.Lower("passport"), "user_1").WhereOr(gdb.Lower("passport"), "user_4").Count()
+		t.AssertNil(err)
+		t.Assert(count, 2)
+
+		count, err = db.Model(table).Where(gdb.Length("passport"), nil).Count()
+		t.AssertNil(err)
+		t.Assert(count, 0)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table).Ctx(ctx).Where(gdb.Func("SUBSTR", "passport", 1, 4), "user").All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf("SELECT * FROM `%s` WHERE SUBSTR(`passport`,1,4)='user'", table))
+	})
+}
+
+func Test_Model_FuncExpr_Order_Fields(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data(g.Map{"passport": "u"}).Where("id", 5).Update()
+		t.AssertNil(err)
+
+		one, err := db.Model(table).Order(gdb.Length("passport"), "ASC").OrderAsc("id").One()
+		t.AssertNil(err)
+		t.Assert(one["id"], 5)
+
+		one, err = db.Model(table).Order(gdb.Length("passport")).Order("id DESC").One()
+		t.AssertNil(err)
+		t.Assert(one["id"], 5)
+
+		value, err := db.Model(table).Fields(gdb.Func("MAX", gdb.Length("passport"))).Value()
+		t.AssertNil(err)
+		t.Assert(value.Int(), len("user_10"))
+	})
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table).Ctx(ctx).Fields("id").Order(gdb.Func("COALESCE", "nickname", gdb.Raw("''"), 0)).All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf("SELECT `id` FROM `%s` ORDER BY COALESCE(`nickname`,'',0)", table))
+	})
+}
+
+func Test_Model_FuncExpr_BoundArgs(t *testing.T) {
+	type text string
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		count, err := db.Model(table).Where(gdb.Func("REPLACE", "passport", gdb.Raw("'user_'"), text("x' OR '1'='1")), "x' OR '1'='11").Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+
+		all, err := db.Model(table).
+			Fields(gdb.Func("REPLACE", "passport", gdb.Raw("'user_'"), text("u'"))).
+			Where("id<?", 3).
+			Order(gdb.Func("COALESCE", "nickname", text("z'"))).
+			Order("id DESC").
+			Array()
+		t.AssertNil(err)
+		t.Assert(all, g.Slice{"u'1", "u'2"})
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_Having_AggregateAlias(t *testing.T) {
+	table := createTable()
+	defer dropTable(table)
+
+	var data g.List
+	for i, nickname := range []string{"a", "a", "a", "b", "b", "c"} {
+		data = append(data, g.Map{
+			"id":       i + 1,
+			"passport": fmt.Sprintf(`user_%d`, i+1),
+			"nickname": nickname,
+		})
+	}
+	_, err := db.Model(table).Data(data).Insert()
+	gtest.AssertNil(err)
+
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.Model(table).Fields("nickname").FieldCount("id", "cnt").FieldSum("id", "total").
+			Group("nickname").Having(g.Map{"cnt >": 1}).Order("nickname").All()
+		t.AssertNil(err)
+		t.Assert(len(all), 2)
+		t.Assert(all[0]["nickname"], "a")
+		t.Assert(all[0]["cnt"], 3)
+		t.Assert(all[1]["total"], 9)
+	})
+	// The aliases are not prefixed with table in join queries.
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.Model(table, "u").LeftJoin(table, "u2", "u.id=u2.id").
+			Fields("u.nickname").FieldCount("u.id", "cnt").
+			Group("u.nickname").Having(g.Map{"cnt >=": 2}).Order("u.nickname").All()
+		t.AssertNil(err)
+		t.Assert(len(all), 2)
+		t.Assert(all[1]["nickname"], "b")
+	})
+	// WhereBuilder.
+	gtest.C(t, func(t *gtest.T) {
+		m := db.Model(table).Fields("nickname").FieldCount("id", "cnt")
+		all, err := m.Group("nickname").Having(
+			m.Builder().Where("cnt > ?", 2).WhereOr("SUM(id) > ?", 8),
+		).Order("nickname").All()
+		t.AssertNil(err)
+		t.Assert(len(all), 2)
+		t.Assert(all[0]["nickname"], "a")
+		t.Assert(all[1]["nickname"], "b")
+	})
+	// Grouped columns and expressions.
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.Model(table).Fields("nickname").FieldCount("id", "cnt").
+			Group("nickname").Having(g.Map{"nickname": "c", "MAX(id) >": 5}).All()
+		t.AssertNil(err)
+		t.Assert(len(all), 1)
+		t.Assert(all[0]["cnt"], 1)
+	})
+	// Invalid reference.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Fields("nickname").FieldCount("id", "cnt").
+			Group("nickname").Having(g.Map{"cnts >": 1}).All()
+		t.AssertNE(err, nil)
+		t.Assert(gerror.Code(err), gcode.CodeInvalidParameter)
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+func Test_Model_Hook_Mode_Enforcing(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Hook(gdb.HookHandler{
+			Select: func(ctx context.Context, in *gdb.HookSelectInput) (gdb.Result, error) {
+				return nil, errors.New("hook error")
+			},
+		}).All()
+		t.Assert(err, "hook error")
+	})
+	// Panic is not recovered.
+	gtest.C(t, func(t *gtest.T) {
+		defer func() {
+			t.Assert(recover(), "hook panic")
+		}()
+		_, _ = db.Model(table).Hook(gdb.HookHandler{
+			Select: func(ctx context.Context, in *gdb.HookSelectInput) (gdb.Result, error) {
+				panic("hook panic")
+			},
+		}).All()
+		t.Error("unreachable")
+	})
+}
+
+func Test_Model_Hook_Mode_Observational(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	// Error before the operation.
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.Model(table).Hook(gdb.HookHandler{
+			Select: func(ctx context.Context, in *gdb.HookSelectInput) (gdb.Result, error) {
+				return nil, errors.New("hook error")
+			},
+			Mode: gdb.HookModeObservational,
+		}).All()
+		t.AssertNil(err)
+		t.Assert(len(all), TableSize)
+	})
+	// Panic before the operation.
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.Model(table).Hook(gdb.HookHandler{
+			Select: func(ctx context.Context, in *gdb.HookSelectInput) (gdb.Result, error) {
+				panic("hook panic")
+			},
+			Mode: gdb.HookModeObservational,
+		}).All()
+		t.AssertNil(err)
+		t.Assert(len(all), TableSize)
+	})
+	// Error after the operation.
+	gtest.C(t, func(t *gtest.T) {
+		result, err := db.Model(table).Hook(gdb.HookHandler{
+			Update: func(ctx context.Context, in *gdb.HookUpdateInput) (sql.Result, error) {
+				if _, err := in.Next(ctx); err != nil {
+					return nil, err
+				}
+				return nil, errors.New("hook error")
+			},
+			Mode: gdb.HookModeObservational,
+		}).Data(g.Map{"nickname": "updated"}).Where("id", 1).Update()
+		t.AssertNil(err)
+		n, _ := result.RowsAffected()
+		t.Assert(n, 1)
+
+		value, err := db.Model(table).Where("id", 1).Value("nickname")
+		t.AssertNil(err)
+		t.Assert(value, "updated")
+	})
+	// Error of the operation itself is returned.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Hook(gdb.HookHandler{
+			Delete: func(ctx context.Context, in *gdb.HookDeleteInput) (sql.Result, error) {
+				in.Condition = "unknown_field=1"
+				return in.Next(ctx)
+			},
+			Mode: gdb.HookModeObservational,
+		}).Where("id", 1).Delete()
+		t.AssertNE(err, nil)
+	})
+	// Error of the operation itself is returned instead of the error of hook.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Hook(gdb.HookHandler{
+			Delete: func(ctx context.Context, in *gdb.HookDeleteInput) (sql.Result, error) {
+				in.Condition = "unknown_field=1"
+				if _, err := in.Next(ctx); err != nil {
+					return nil, errors.New("hook error")
+				}
+				return nil, nil
+			},
+			Mode: gdb.HookModeObservational,
+		}).Where("id", 1).Delete()
+		t.AssertNE(err, nil)
+		t.Assert(gstr.Contains(err.Error(), "unknown_field"), true)
+		t.Assert(gstr.Contains(err.Error(), "hook error"), false)
+	})
+}
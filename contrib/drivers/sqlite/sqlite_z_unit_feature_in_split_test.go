@@ -0,0 +1,110 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_InSplit(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	ids := []int{1, 2, 3, 4, 5, 6, 7, 3, 100}
+	// All.
+	gtest.C(t, func(t *gtest.T) {
+		var result gdb.Result
+		sqlArray, err := gdb.CatchSQL(ctx, func(ctx context.Context) (err error) {
+			result, err = db.Model(table).Ctx(ctx).InSplit(3).WhereIn("id", ids).WhereGT("id", 1).All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(len(sqlArray), 3)
+		t.Assert(len(result), 6)
+		t.Assert(result.Array("id"), []int{2, 3, 4, 5, 6, 7})
+	})
+	// Array.
+	gtest.C(t, func(t *gtest.T) {
+		array, err := db.Model(table).InSplit(2).Fields("id").WhereIn("id", ids).Array()
+		t.AssertNil(err)
+		t.Assert(array, []int{1, 2, 3, 4, 5, 6, 7})
+	})
+	// Not exceeding the split size.
+	gtest.C(t, func(t *gtest.T) {
+		sqlArray, err := gdb.CatchSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table).Ctx(ctx).InSplit(100).WhereIn("id", ids).All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(len(sqlArray), 1)
+	})
+	// NOT IN and OR conditions are not split.
+	gtest.C(t, func(t *gtest.T) {
+		sqlArray, err := gdb.CatchSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table).Ctx(ctx).InSplit(2).WhereNotIn("id", ids).WhereOrIn("id", ids).All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(len(sqlArray), 1)
+	})
+	// Statements that cannot be merged.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).InSplit(2).WhereIn("id", ids).OrderDesc("id").All()
+		t.AssertNE(err, nil)
+		t.Assert(gerror.Code(err), gcode.CodeNotSupported)
+
+		_, err = db.Model(table).InSplit(2).WhereIn("id", ids).Limit(2).All()
+		t.AssertNE(err, nil)
+	})
+	// One is not split.
+	gtest.C(t, func(t *gtest.T) {
+		one, err := db.Model(table).InSplit(2).WhereIn("id", ids).One()
+		t.AssertNil(err)
+		t.Assert(one["id"], 1)
+	})
+}
+
+func Test_ConfigNode_ModelInSplitSize(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	node := configNode
+	node.ModelInSplitSize = 2
+	newDb, err := gdb.New(node)
+	gtest.AssertNil(err)
+	defer newDb.Close(ctx)
+
+	gtest.C(t, func(t *gtest.T) {
+		// Warm up the table fields cache.
+		_, err := newDb.Model(table).WherePri(1).One()
+		t.AssertNil(err)
+
+		var result gdb.Result
+		sqlArray, err := gdb.CatchSQL(ctx, func(ctx context.Context) (err error) {
+			result, err = newDb.Model(table).Ctx(ctx).WhereIn("id", []int{1, 2, 3, 4, 5}).All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(len(sqlArray), 3)
+		t.Assert(len(result), 5)
+
+		// Disabled by negative size.
+		sqlArray, err = gdb.CatchSQL(ctx, func(ctx context.Context) (err error) {
+			result, err = newDb.Model(table).Ctx(ctx).InSplit(-1).WhereIn("id", []int{1, 2, 3, 4, 5}).All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(len(sqlArray), 1)
+		t.Assert(len(result), 5)
+	})
+}
@@ -0,0 +1,90 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/glog"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+func Test_AnalyzeQueryPlan(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		issues, err := db.AnalyzeQueryPlan(ctx, nil, fmt.Sprintf("SELECT * FROM %s WHERE id=?", table), 1)
+		t.AssertNil(err)
+		t.Assert(len(issues), 0)
+
+		issues, err = db.AnalyzeQueryPlan(ctx, nil, fmt.Sprintf("SELECT * FROM %s WHERE nickname=? ORDER BY passport", table), "name_1")
+		t.AssertNil(err)
+		t.Assert(len(issues), 2)
+		t.Assert(issues[0].Type, gdb.QueryPlanIssueFullScan)
+		t.Assert(issues[0].Table, table)
+		t.Assert(issues[1].Type, gdb.QueryPlanIssueFileSort)
+		t.Assert(issues[1].Table, table)
+	})
+}
+
+func Test_IndexAdvise(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	node := configNode
+	node.Debug = true
+	node.IndexAdvise = true
+	node.IndexAdviseThreshold = time.Nanosecond
+	newDb, err := gdb.New(node)
+	gtest.AssertNil(err)
+	defer newDb.Close(ctx)
+
+	buffer := bytes.NewBuffer(nil)
+	logger := glog.New()
+	logger.SetWriter(buffer)
+	logger.SetStdoutPrint(false)
+	newDb.SetLogger(logger)
+
+	// Statement using primary key is not advised.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := newDb.Model(table).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(gstr.Contains(buffer.String(), "index advice"), false)
+	})
+	// Statement with full scan and filesort is advised with the caller.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := newDb.Model(table).Where("nickname", "name_1").OrderAsc("passport").All()
+		t.AssertNil(err)
+		content := buffer.String()
+		t.Assert(gstr.Contains(content, "index advice"), true)
+		t.Assert(gstr.Contains(content, fmt.Sprintf(`full scan on table "%s"`, table)), true)
+		t.Assert(gstr.Contains(content, fmt.Sprintf(`filesort on table "%s"`, table)), true)
+		t.Assert(gstr.Contains(content, "sqlite_z_unit_feature_index_advise_test.go"), true)
+	})
+	// The same statement is advised only once in the interval.
+	gtest.C(t, func(t *gtest.T) {
+		buffer.Reset()
+		_, err := newDb.Model(table).Where("nickname", "name_1").OrderAsc("passport").All()
+		t.AssertNil(err)
+		t.Assert(gstr.Contains(buffer.String(), "index advice"), false)
+	})
+	// It is disabled without debug mode.
+	gtest.C(t, func(t *gtest.T) {
+		buffer.Reset()
+		newDb.SetDebug(false)
+		defer newDb.SetDebug(true)
+		_, err := newDb.Model(table).Where("nickname", "name_2").OrderAsc("passport").All()
+		t.AssertNil(err)
+		t.Assert(gstr.Contains(buffer.String(), "index advice"), false)
+	})
+}
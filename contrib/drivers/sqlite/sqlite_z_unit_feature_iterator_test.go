@@ -0,0 +1,105 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_Iterator(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	type User struct {
+		Id       int
+		Passport string
+		Nickname string
+	}
+	gtest.C(t, func(t *gtest.T) {
+		it, err := db.Model(table).Order("id").Iterator()
+		t.AssertNil(err)
+		defer it.Close()
+		var users []*User
+		for it.Next() {
+			var user *User
+			t.AssertNil(it.Scan(&user))
+			users = append(users, user)
+		}
+		t.AssertNil(it.Err())
+		t.Assert(len(users), TableSize)
+		t.Assert(users[0].Id, 1)
+		t.Assert(users[0].Passport, "user_1")
+		t.Assert(users[TableSize-1].Id, TableSize)
+		// It is closed automatically after all rows iterated.
+		t.Assert(it.Next(), false)
+		t.AssertNil(it.Close())
+	})
+
+	gtest.C(t, func(t *gtest.T) {
+		it, err := db.Model(table).Fields("id").Order("id desc").Iterator("id>?", 8)
+		t.AssertNil(err)
+		t.Assert(it.Next(), true)
+		t.Assert(it.Record()["id"], 10)
+		// Close before all rows iterated.
+		t.AssertNil(it.Close())
+		t.Assert(it.Next(), false)
+		t.AssertNil(it.Err())
+	})
+
+	gtest.C(t, func(t *gtest.T) {
+		it, err := db.Model(table).Where("id<0").Iterator()
+		t.AssertNil(err)
+		t.Assert(it.Next(), false)
+		t.AssertNil(it.Err())
+	})
+
+	// Transaction.
+	gtest.C(t, func(t *gtest.T) {
+		err := db.Transaction(context.TODO(), func(ctx context.Context, tx gdb.TX) error {
+			_, err := tx.Model(table).Data("nickname", "iterator").Where("id", 1).Update()
+			t.AssertNil(err)
+			it, err := tx.Model(table).Where("id", 1).Iterator()
+			t.AssertNil(err)
+			defer it.Close()
+			t.Assert(it.Next(), true)
+			t.Assert(it.Record()["nickname"], "iterator")
+			return it.Err()
+		})
+		t.AssertNil(err)
+	})
+
+}
+
+func Test_Model_Iterator_QueryTimeout(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	node := configNode
+	node.QueryTimeout = 50 * time.Millisecond
+	newDb, err := gdb.New(node)
+	gtest.AssertNil(err)
+	defer newDb.Close(ctx)
+
+	// The query timeout is not applied to streaming rows.
+	gtest.C(t, func(t *gtest.T) {
+		it, err := newDb.Model(table).Order("id").Iterator()
+		t.AssertNil(err)
+		defer it.Close()
+		var count int
+		for it.Next() {
+			count++
+			time.Sleep(30 * time.Millisecond)
+		}
+		t.AssertNil(it.Err())
+		t.Assert(count, TableSize)
+	})
+}
@@ -0,0 +1,62 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+// The lateral join and apply are not supported by sqlite, which are only checked with the sql.
+func Test_Model_JoinLateral(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table+" u").Ctx(ctx).Fields("u.id, o.passport").Where("u.id>?", 8).LeftJoinLateral(
+				db.Model(table+" o").Where("o.id<u.id").Where("o.id>?", 1).OrderDesc("o.id").Limit(2), "o",
+			).All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf(
+			"SELECT u.id,o.passport FROM `%s` u LEFT JOIN LATERAL (SELECT * FROM `%s` o WHERE (o.id<u.id) AND (o.id>1) ORDER BY `o`.`id` DESC LIMIT 2) `o` ON (TRUE) WHERE u.id>8",
+			table, table,
+		))
+
+		sql, err = gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table+" u").Ctx(ctx).InnerJoinLateral(
+				db.Model(table).Fields("id").Where("id>?", 1), "o", "o.id=u.id",
+			).All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf(
+			"SELECT * FROM `%s` u INNER JOIN LATERAL (SELECT `id` FROM `%s` WHERE id>1) `o` ON (o.id=u.id)",
+			table, table,
+		))
+	})
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table+" u").Ctx(ctx).Where("u.id", 1).
+				CrossApply(db.Model(table+" o").Where("o.id>?", 5), "o").
+				OuterApply(db.Model(table+" x").Where("x.id<?", 3), "x").
+				All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf(
+			"SELECT * FROM `%s` u CROSS APPLY (SELECT * FROM `%s` o WHERE o.id>5) `o` OUTER APPLY (SELECT * FROM `%s` x WHERE x.id<3) `x` WHERE `u`.`id`=1",
+			table, table, table,
+		))
+	})
+}
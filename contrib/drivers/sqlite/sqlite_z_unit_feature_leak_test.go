@@ -0,0 +1,118 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/glog"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+func Test_LeakDetect(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	node := configNode
+	node.LeakDetect = true
+	node.LeakTimeout = 200 * time.Millisecond
+	newDb, err := gdb.New(node)
+	gtest.AssertNil(err)
+	defer newDb.Close(ctx)
+
+	buffer := bytes.NewBuffer(nil)
+	logger := glog.New()
+	logger.SetWriter(buffer)
+	logger.SetStdoutPrint(false)
+	newDb.SetLogger(logger)
+
+	// The rows of model operations are closed.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := newDb.Model(table).All()
+		t.AssertNil(err)
+		t.Assert(newDb.GetCore().CursorStats(), gdb.CursorStats{})
+	})
+	// Leaked statement and rows.
+	gtest.C(t, func(t *gtest.T) {
+		stmt, err := newDb.Prepare(ctx, fmt.Sprintf("SELECT * FROM %s WHERE id>?", table))
+		t.AssertNil(err)
+		rows, err := stmt.Query(0)
+		t.AssertNil(err)
+		t.Assert(rows.Next(), true)
+
+		stats := newDb.GetCore().CursorStats()
+		t.Assert(stats.OpenRows, 1)
+		t.Assert(stats.OpenStmts, 1)
+		cursors := newDb.GetCore().OpenCursors()
+		t.Assert(len(cursors), 2)
+		t.Assert(cursors[0].Type, gdb.CursorTypeStmt)
+		t.Assert(cursors[1].Type, gdb.CursorTypeRows)
+		t.Assert(gstr.Contains(cursors[1].Stack, "sqlite_z_unit_feature_leak_test.go"), true)
+
+		time.Sleep(500 * time.Millisecond)
+		stats = newDb.GetCore().CursorStats()
+		t.Assert(stats.Leaked, 2)
+		content := buffer.String()
+		t.Assert(gstr.Contains(content, "possible rows leak"), true)
+		t.Assert(gstr.Contains(content, "possible stmt leak"), true)
+		t.Assert(gstr.Contains(content, "sqlite_z_unit_feature_leak_test.go"), true)
+
+		t.AssertNil(rows.Close())
+		t.AssertNil(stmt.Close())
+		stats = newDb.GetCore().CursorStats()
+		t.Assert(stats.OpenRows, 0)
+		t.Assert(stats.OpenStmts, 0)
+		t.Assert(gstr.Contains(buffer.String(), "leaked stmt is closed"), true)
+	})
+	// The rows closed by the caller are not reported.
+	gtest.C(t, func(t *gtest.T) {
+		buffer.Reset()
+		stmt, err := newDb.Prepare(ctx, fmt.Sprintf("SELECT * FROM %s WHERE id>?", table))
+		t.AssertNil(err)
+		defer stmt.Close()
+		rows, err := stmt.Query(0)
+		t.AssertNil(err)
+		for rows.Next() {
+		}
+		time.Sleep(500 * time.Millisecond)
+		t.Assert(newDb.GetCore().CursorStats().OpenRows, 0)
+		t.Assert(gstr.Contains(buffer.String(), "possible rows leak"), false)
+	})
+}
+
+func Test_Stmt_Query_WithQueryTimeout(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	node := configNode
+	node.QueryTimeout = time.Minute
+	newDb, err := gdb.New(node)
+	gtest.AssertNil(err)
+	defer newDb.Close(ctx)
+
+	gtest.C(t, func(t *gtest.T) {
+		stmt, err := newDb.Prepare(ctx, fmt.Sprintf("SELECT id FROM %s WHERE id>?", table))
+		t.AssertNil(err)
+		defer stmt.Close()
+		rows, err := stmt.Query(0)
+		t.AssertNil(err)
+		defer rows.Close()
+		// The rows would be closed in background if the timeout context was canceled.
+		time.Sleep(100 * time.Millisecond)
+		count := 0
+		for rows.Next() {
+			count++
+		}
+		t.AssertNil(rows.Err())
+		t.Assert(count, TableSize)
+	})
+}
@@ -0,0 +1,32 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_LockUpdate_Option_NotSupported(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).LockUpdate(gdb.LockOption{SkipLocked: true}).All()
+		t.Assert(gerror.Code(err), gcode.CodeNotSupported)
+
+		_, err = db.Model(table).LockShared(gdb.LockOption{NoWait: true}).One()
+		t.Assert(gerror.Code(err), gcode.CodeNotSupported)
+
+		_, err = db.Model(table).LockUpdate(gdb.LockOption{Of: []string{table}}).Iterator()
+		t.Assert(gerror.Code(err), gcode.CodeNotSupported)
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_Mapping(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	type User struct {
+		Id   int
+		Name string `db:"passport"`
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var users []User
+		err := db.Model(table).Fields("id,passport").OrderAsc("id").
+			Mapping(gdb.MappingOption{PriorityTags: []string{"db"}, Strict: true}).
+			Scan(&users)
+		t.AssertNil(err)
+		t.Assert(len(users), TableSize)
+		t.Assert(users[0].Id, 1)
+		t.Assert(users[0].Name, "user_1")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var user *User
+		err := db.Model(table).Fields("id,passport,nickname").Where("id", 1).
+			Mapping(gdb.MappingOption{PriorityTags: []string{"db"}, Strict: true}).
+			Scan(&user)
+		t.AssertNE(err, nil)
+		t.Assert(user, nil)
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gmode"
+)
+
+func Test_Model_Mask(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		rules := []gdb.MaskRule{
+			{Columns: []string{"pass*"}},
+			{Columns: []string{"nickname"}, Type: gdb.MaskTypeFaker, Faker: gdb.MaskFakerName, Salt: "salt"},
+			{Tables: []string{"not_exist"}, Columns: []string{"id"}},
+		}
+		all, err := db.Model(table).Mask(rules...).OrderAsc("id").All()
+		t.AssertNil(err)
+		t.Assert(len(all), TableSize)
+		t.Assert(all[0]["id"], 1)
+		t.Assert(all[0]["passport"], "******")
+		t.Assert(all[0]["password"], "******")
+		t.AssertNE(all[0]["nickname"], "name_1")
+		t.Assert(len(gstr.Split(all[0]["nickname"].String(), " ")), 2)
+
+		// The column types are kept for the masked result.
+		withColumns, err := db.Model(table).Mask(rules...).OrderAsc("id").AllWithColumns()
+		t.AssertNil(err)
+		t.Assert(withColumns.Result, all)
+		t.Assert(len(withColumns.Columns), 5)
+
+		// The masked values are deterministic.
+		var user struct {
+			Id       int
+			Nickname string
+		}
+		err = db.Model(table).Mask(rules...).Where("id", 1).Scan(&user)
+		t.AssertNil(err)
+		t.Assert(user.Nickname, all[0]["nickname"])
+
+		// The original values are not affected.
+		one, err := db.Model(table).Where("id", 1).One()
+		t.AssertNil(err)
+		t.Assert(one["nickname"], "name_1")
+	})
+}
+
+func Test_DB_SetMaskRules(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		mode := gmode.Mode()
+		defer gmode.Set(mode)
+		db.GetCore().SetMaskRules(gdb.MaskRule{
+			Tables:  []string{table},
+			Columns: []string{"passport"},
+			Type:    gdb.MaskTypeHash,
+		})
+		defer db.GetCore().SetMaskRules()
+
+		gmode.SetDevelop()
+		value, err := db.Model(table).Where("id", 1).Value("passport")
+		t.AssertNil(err)
+		t.Assert(len(value.String()), 64)
+
+		gmode.SetProduct()
+		value, err = db.Model(table).Where("id", 1).Value("passport")
+		t.AssertNil(err)
+		t.Assert(value, "user_1")
+	})
+}
+
+func Test_Dump_Mask(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		var buffer bytes.Buffer
+		err := gdb.Dump(ctx, db, []string{table}, &buffer, gdb.DumpOption{
+			Format: gdb.DumpFormatCSV,
+			MaskRules: []gdb.MaskRule{{
+				Columns: []string{"nickname"},
+				Type:    gdb.MaskTypeFaker,
+				Faker:   gdb.MaskFakerEmail,
+			}},
+		})
+		t.AssertNil(err)
+		content := buffer.String()
+		t.Assert(gstr.Contains(content, "user_1,pass_1"), true)
+		t.Assert(gstr.Contains(content, "name_1"), false)
+		t.Assert(gstr.Count(content, "@example.com"), TableSize)
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/glog"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+func Test_Model_MaxRows(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		result, err := db.Model(table).MaxRows(TableSize).All()
+		t.AssertNil(err)
+		t.Assert(len(result), TableSize)
+
+		result, err = db.Model(table).MaxRows(5).All()
+		t.AssertNE(err, nil)
+		t.Assert(gerror.HasCode(err, gcode.CodeInvalidOperation), true)
+		t.Assert(len(result), 0)
+
+		result, err = db.Model(table).MaxRows(5, true).Order("id").All()
+		t.AssertNil(err)
+		t.Assert(len(result), 5)
+		t.Assert(result[4]["id"], 5)
+
+		count, err := db.Model(table).MaxRows(5).Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize)
+	})
+}
+
+func Test_Model_ResultLimit_MaxBytes(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		// The passport values are like "user_1", which are 6 bytes.
+		_, err := db.Model(table).Fields("passport").ResultLimit(gdb.ResultLimit{MaxBytes: 30}).All()
+		t.AssertNE(err, nil)
+
+		var truncatedRows int
+		array, err := db.Model(table).Fields("passport").Order("id").ResultLimit(gdb.ResultLimit{
+			MaxBytes: 30,
+			Truncate: true,
+			OnTruncate: func(ctx context.Context, rows int) {
+				truncatedRows = rows
+			},
+		}).Array()
+		t.AssertNil(err)
+		t.Assert(len(array), 5)
+		t.Assert(truncatedRows, 5)
+	})
+	// The truncation is logged as warning.
+	gtest.C(t, func(t *gtest.T) {
+		buffer := bytes.NewBuffer(nil)
+		logger := glog.New()
+		logger.SetWriter(buffer)
+		logger.SetStdoutPrint(false)
+		newDb, err := gdb.New(configNode)
+		t.AssertNil(err)
+		defer newDb.Close(ctx)
+		newDb.SetLogger(logger)
+
+		var called bool
+		array, err := newDb.Model(table).Fields("passport").ResultLimit(gdb.ResultLimit{
+			MaxRows:  3,
+			Truncate: true,
+			OnTruncate: func(ctx context.Context, rows int) {
+				called = true
+			},
+		}).Array()
+		t.AssertNil(err)
+		t.Assert(len(array), 3)
+		t.Assert(called, true)
+		t.Assert(gstr.Contains(buffer.String(), "result set is truncated to 3 rows by the limit of max rows"), true)
+
+		// It is not called if the result is in limit.
+		called = false
+		_, err = newDb.Model(table).Fields("passport").ResultLimit(gdb.ResultLimit{
+			MaxRows:  TableSize,
+			Truncate: true,
+			OnTruncate: func(ctx context.Context, rows int) {
+				called = true
+			},
+		}).Array()
+		t.AssertNil(err)
+		t.Assert(called, false)
+	})
+}
+
+func Test_Model_MaxRows_Config(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		node := configNode
+		node.MaxResultRows = 5
+		limitedDB, err := gdb.New(node)
+		t.AssertNil(err)
+		defer limitedDB.Close(ctx)
+
+		_, err = limitedDB.Model(table).All()
+		t.AssertNE(err, nil)
+
+		one, err := limitedDB.Model(table).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["id"], 1)
+
+		result, err := limitedDB.Model(table).MaxRows(TableSize).All()
+		t.AssertNil(err)
+		t.Assert(len(result), TableSize)
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_ConfigNode_ModelDefaults(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	node := configNode
+	node.ModelSafe = true
+	node.ModelOmitEmptyWhere = true
+	node.ModelCacheDuration = time.Minute
+	node.QuoteDisabled = true
+	newDb, err := gdb.New(node)
+	gtest.AssertNil(err)
+	defer newDb.Close(ctx)
+
+	// OmitEmptyWhere.
+	gtest.C(t, func(t *gtest.T) {
+		count, err := newDb.Model(table).Where("id", []int{}).Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize)
+
+		count, err = db.Model(table).Where("id", []int{}).Count()
+		t.AssertNil(err)
+		t.Assert(count, 0)
+	})
+	// Safe.
+	gtest.C(t, func(t *gtest.T) {
+		model := newDb.Model(table)
+		model.Where("id", 1)
+		count, err := model.Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize)
+	})
+	// Cache duration.
+	gtest.C(t, func(t *gtest.T) {
+		model := newDb.Model(table).Cache(gdb.CacheOption{Name: "model_default_test"})
+		one, err := model.Where("id", 1).One()
+		t.AssertNil(err)
+		t.Assert(one["id"], 1)
+
+		ttl, err := newDb.GetCache().GetExpire(ctx, "SelectCache:model_default_test")
+		t.AssertNil(err)
+		t.AssertGT(ttl, 0)
+		t.AssertLE(ttl, time.Minute)
+	})
+	// Quote disabled.
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := newDb.Model(table).Ctx(ctx).Fields("id").Where(g.Map{"passport": "user_1"}).All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, "SELECT id FROM "+table+" WHERE passport='user_1'")
+	})
+}
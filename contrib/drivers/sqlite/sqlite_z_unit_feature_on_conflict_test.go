@@ -0,0 +1,83 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_OnConflictDoNothing(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table).Ctx(ctx).Data(g.Map{"id": 1, "passport": "new_1"}).OnConflictDoNothing().Insert()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf("INSERT OR IGNORE INTO `%s`(`id`,`passport`) VALUES(1,'new_1') ", table))
+	})
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data(g.List{
+			{"id": 1, "passport": "new_1"},
+			{"id": 100, "passport": "new_100"},
+		}).OnConflictDoNothing().Insert()
+		t.AssertNil(err)
+
+		one, err := db.Model(table).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "user_1")
+
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize+1)
+	})
+}
+
+func Test_Model_OnConflictDoUpdate(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	// All inserting columns are updated in default.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data(g.Map{
+			"id":       1,
+			"passport": "new_1",
+			"nickname": "new_name_1",
+		}).OnConflict("id").OnConflictDoUpdate().Insert()
+		t.AssertNil(err)
+
+		one, err := db.Model(table).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "new_1")
+		t.Assert(one["nickname"], "new_name_1")
+	})
+	// Specified updating columns with conflict keys inferred from data.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data(g.List{
+			{"id": 2, "passport": "new_2", "nickname": "new_name_2"},
+			{"id": 100, "passport": "new_100", "nickname": "new_name_100"},
+		}).OnConflictDoUpdate("nickname").Insert()
+		t.AssertNil(err)
+
+		one, err := db.Model(table).WherePri(2).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "user_2")
+		t.Assert(one["nickname"], "new_name_2")
+
+		one, err = db.Model(table).WherePri(100).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "new_100")
+	})
+}
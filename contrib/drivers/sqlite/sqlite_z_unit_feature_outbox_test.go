@@ -0,0 +1,118 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func createOutboxTable() {
+	if _, err := db.Exec(ctx, `CREATE TABLE gf_outbox (
+		id         INTEGER PRIMARY KEY AUTOINCREMENT,
+		topic      VARCHAR(255) NOT NULL,
+		payload    TEXT         NOT NULL,
+		created_at DATETIME     NOT NULL
+	)`); err != nil {
+		gtest.Fatal(err)
+	}
+}
+
+func Test_Outbox(t *testing.T) {
+	createOutboxTable()
+	defer dropTable(gdb.DefaultOutboxTable)
+	table := createTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		err := db.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+			if _, err := tx.Model(table).Data(g.Map{"id": 1, "passport": "user_1"}).Insert(); err != nil {
+				return err
+			}
+			return tx.Outbox(ctx, "user.created", g.Map{"id": 1})
+		})
+		t.AssertNil(err)
+
+		// The message is rolled back with the transaction.
+		err = db.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+			if err := tx.Outbox(ctx, "user.created", "2"); err != nil {
+				return err
+			}
+			return errors.New("rollback")
+		})
+		t.AssertNE(err, nil)
+
+		err = db.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+			return tx.Outbox(ctx, "user.deleted", []byte("3"))
+		})
+		t.AssertNil(err)
+
+		count, err := db.Model(gdb.DefaultOutboxTable).Count()
+		t.AssertNil(err)
+		t.Assert(count, 2)
+	})
+	// Relaying stops at the failed message and retries it in the next round.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			published []*gdb.OutboxMessage
+			failed    = true
+		)
+		relay := gdb.NewOutboxRelay(db, gdb.OutboxPublisherFunc(func(ctx context.Context, message *gdb.OutboxMessage) error {
+			if message.Topic == "user.deleted" && failed {
+				failed = false
+				return errors.New("publish error")
+			}
+			published = append(published, message)
+			return nil
+		}))
+		count, err := relay.RelayOnce(ctx)
+		t.AssertNE(err, nil)
+		t.Assert(count, 1)
+		t.Assert(len(published), 1)
+		t.Assert(published[0].Topic, "user.created")
+		t.Assert(published[0].Payload, `{"id":1}`)
+
+		count, err = relay.RelayOnce(ctx)
+		t.AssertNil(err)
+		t.Assert(count, 1)
+		t.Assert(len(published), 2)
+		t.Assert(published[1].Payload, "3")
+
+		n, err := db.Model(gdb.DefaultOutboxTable).Count()
+		t.AssertNil(err)
+		t.Assert(n, 0)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			relayCtx, cancel = context.WithCancel(ctx)
+			publishedChan    = make(chan string, 1)
+			relay            = gdb.NewOutboxRelay(db, gdb.OutboxPublisherFunc(func(ctx context.Context, message *gdb.OutboxMessage) error {
+				publishedChan <- message.Payload
+				return nil
+			}), gdb.OutboxRelayOption{Interval: 10 * time.Millisecond})
+		)
+		defer cancel()
+		go relay.Run(relayCtx)
+
+		err := db.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+			return tx.Outbox(ctx, "user.created", "4")
+		})
+		t.AssertNil(err)
+		select {
+		case payload := <-publishedChan:
+			t.Assert(payload, "4")
+		case <-time.After(time.Second):
+			t.Error("message not relayed")
+		}
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_Paginate(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	type User struct {
+		Id       int
+		Passport string
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var users []*User
+		pageResult, err := db.Model(table).Where("id>?", 1).Order("id").Paginate(2, 4, &users)
+		t.AssertNil(err)
+		t.Assert(pageResult.Total, TableSize-1)
+		t.Assert(pageResult.Pages, 3)
+		t.Assert(pageResult.Page, 2)
+		t.Assert(pageResult.Size, 4)
+		t.Assert(pageResult.HasNext(), true)
+		t.Assert(len(users), 4)
+		t.Assert(users[0].Id, 6)
+		t.Assert(users[3].Passport, "user_9")
+
+		users = nil
+		pageResult, err = db.Model(table).Where("id>?", 1).Order("id").Paginate(3, 4, &users)
+		t.AssertNil(err)
+		t.Assert(pageResult.HasNext(), false)
+		t.Assert(len(users), 1)
+	})
+	// No record.
+	gtest.C(t, func(t *gtest.T) {
+		var users []*User
+		pageResult, err := db.Model(table).Where("id>?", 100).Paginate(0, 4, &users)
+		t.AssertNil(err)
+		t.Assert(pageResult.Total, 0)
+		t.Assert(pageResult.Pages, 0)
+		t.Assert(pageResult.Page, 1)
+		t.Assert(len(users), 0)
+	})
+}
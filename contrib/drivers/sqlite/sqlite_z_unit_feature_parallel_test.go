@@ -0,0 +1,56 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Parallel(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		results, err := gdb.Parallel(ctx,
+			db.Model(table).Where("id", 1),
+			db.Model(table).WhereIn("id", []int{2, 3}).Order("id"),
+			db.Model(table).Where("id", 0),
+		)
+		t.AssertNil(err)
+		t.Assert(len(results), 3)
+		t.Assert(len(results[0]), 1)
+		t.Assert(results[0][0]["id"], 1)
+		t.Assert(len(results[1]), 2)
+		t.Assert(results[1][1]["id"], 3)
+		t.Assert(len(results[2]), 0)
+	})
+
+	gtest.C(t, func(t *gtest.T) {
+		models := make([]*gdb.Model, TableSize)
+		for i := range models {
+			models[i] = db.Model(table).Where("id", i+1)
+		}
+		results, err := gdb.ParallelWithLimit(ctx, 2, models...)
+		t.AssertNil(err)
+		t.Assert(len(results), TableSize)
+		for i, result := range results {
+			t.Assert(result[0]["id"], i+1)
+		}
+	})
+
+	gtest.C(t, func(t *gtest.T) {
+		results, err := gdb.Parallel(ctx,
+			db.Model(table).Where("id", 1),
+			db.Model("none_exist_table"),
+		)
+		t.AssertNE(err, nil)
+		t.Assert(results, nil)
+	})
+}
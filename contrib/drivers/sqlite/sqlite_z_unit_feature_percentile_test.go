@@ -0,0 +1,33 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+// The percentile field is not supported by sqlite, which returns error by select statements.
+func Test_Model_FieldPercentile_NotSupported(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).FieldPercentile("id", 0.95, "p95").All()
+		t.Assert(gerror.Code(err), gcode.CodeNotSupported)
+
+		_, err = db.Model(table).FieldPercentile("id", 0.95).Iterator()
+		t.Assert(gerror.Code(err), gcode.CodeNotSupported)
+
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize)
+	})
+}
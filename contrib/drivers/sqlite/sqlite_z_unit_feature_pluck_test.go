@@ -0,0 +1,54 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_Pluck(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		values, err := db.Model(table).Fields("id", "nickname").Order("id").Pluck("passport", "id<?", 4)
+		t.AssertNil(err)
+		t.Assert(len(values), 3)
+		t.Assert(values[0], "user_1")
+		t.Assert(values[2], "user_3")
+
+		values, err = db.Model(table).Pluck("passport", "id>?", TableSize)
+		t.AssertNil(err)
+		t.Assert(len(values), 0)
+	})
+}
+
+func Test_Model_PluckKeyValue(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		data, err := db.Model(table).PluckKeyValue("id", "nickname", "id", g.Slice{1, 2})
+		t.AssertNil(err)
+		t.Assert(len(data), 2)
+		t.Assert(data["1"], "name_1")
+		t.Assert(data["2"], "name_2")
+
+		data, err = db.Model(table+" u").Where("u.id<?", 3).PluckKeyValue("u.passport", "u.id AS uid")
+		t.AssertNil(err)
+		t.Assert(len(data), 2)
+		t.Assert(data["user_1"].Int(), 1)
+		t.Assert(data["user_2"].Int(), 2)
+
+		data, err = db.Model(table).Where("id", 3).PluckKeyValue("passport", "passport")
+		t.AssertNil(err)
+		t.Assert(data, g.Map{"user_3": "user_3"})
+	})
+}
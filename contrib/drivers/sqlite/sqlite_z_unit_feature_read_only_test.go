@@ -0,0 +1,81 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+func Test_Model_ReadOnly(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		model := db.Model(table).ReadOnly().Safe()
+		t.Assert(model.IsReadOnly(), true)
+
+		_, err := model.Data(g.Map{"id": 100, "passport": "user_100"}).Insert()
+		t.AssertNE(err, nil)
+		t.Assert(gerror.Code(err), gcode.CodeInvalidOperation)
+		t.Assert(gstr.Contains(err.Error(), "Insert operation is not allowed on read-only model"), true)
+
+		_, err = model.Data(g.Map{"id": 1, "passport": "user_100"}).Save()
+		t.AssertNE(err, nil)
+		_, err = model.Data(g.Map{"passport": "user_100"}).Where("id", 1).Update()
+		t.AssertNE(err, nil)
+		_, err = model.Where("id", 1).Delete()
+		t.AssertNE(err, nil)
+
+		// Select is allowed.
+		count, err := model.Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize)
+		value, err := model.Where("id", 1).Value("passport")
+		t.AssertNil(err)
+		t.Assert(value, "user_1")
+	})
+}
+
+func Test_Model_ReadOnly_View(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+	view := table + "_view"
+	_, err := db.Exec(ctx, fmt.Sprintf(`CREATE VIEW %s AS SELECT * FROM %s WHERE id <= 5`, view, table))
+	gtest.AssertNil(err)
+	defer db.Exec(ctx, fmt.Sprintf(`DROP VIEW IF EXISTS %s`, view))
+
+	gtest.C(t, func(t *gtest.T) {
+		isView, err := db.IsView(ctx, view)
+		t.AssertNil(err)
+		t.Assert(isView, true)
+		isView, err = db.IsView(ctx, table)
+		t.AssertNil(err)
+		t.Assert(isView, false)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(db.Model(view).IsReadOnly(), true)
+		t.Assert(db.Model(table).IsReadOnly(), false)
+		t.Assert(db.Model(view).ReadOnly(false).IsReadOnly(), false)
+
+		_, err := db.Model(view).Data(g.Map{"passport": "user_100"}).Where("id", 1).Update()
+		t.AssertNE(err, nil)
+		t.Assert(gstr.Contains(err.Error(), "which is a database view"), true)
+		_, err = db.Model(view+" v").Where("v.id", 1).Delete()
+		t.AssertNE(err, nil)
+
+		count, err := db.Model(view).Count()
+		t.AssertNil(err)
+		t.Assert(count, 5)
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/glog"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+func Test_Redaction(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	node := configNode
+	node.Debug = true
+	node.LogRedactColumns = "password"
+	newDb, err := gdb.New(node)
+	gtest.AssertNil(err)
+	defer newDb.Close(ctx)
+
+	buffer := bytes.NewBuffer(nil)
+	logger := glog.New()
+	logger.SetWriter(buffer)
+	logger.SetStdoutPrint(false)
+	newDb.SetLogger(logger)
+
+	// Logging.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := newDb.Model(table).Data(g.Map{"id": 100, "passport": "user_100", "password": "secret_pass"}).Insert()
+		t.AssertNil(err)
+		_, err = newDb.Model(table).Where("password", "secret_pass").One()
+		t.AssertNil(err)
+
+		content := buffer.String()
+		t.Assert(gstr.Contains(content, "user_100"), true)
+		t.Assert(gstr.Contains(content, "******"), true)
+		t.Assert(gstr.Contains(content, "secret_pass"), false)
+	})
+	// Error message with context rules.
+	gtest.C(t, func(t *gtest.T) {
+		ctx := gdb.WithRedaction(ctx, gdb.RedactionRule{Columns: []string{"*token*"}, Replacement: "<hidden>"})
+		_, err := newDb.Model(table).Ctx(ctx).Where("access_token", "secret_token").Where("no_such_column", 1).All()
+		t.AssertNE(err, nil)
+		t.Assert(gstr.Contains(err.Error(), "<hidden>"), true)
+		t.Assert(gstr.Contains(err.Error(), "secret_token"), false)
+	})
+}
@@ -0,0 +1,74 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_ReplaceMode_DeleteInsert(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		result, err := db.Model(table).ReplaceMode(gdb.ReplaceModeDeleteInsert).Data(g.List{
+			{"id": 1, "passport": "replaced_1", "password": "pass_1"},
+			{"id": 100, "passport": "replaced_100", "password": "pass_100"},
+		}).Replace()
+		t.AssertNil(err)
+		n, err := result.RowsAffected()
+		t.AssertNil(err)
+		t.Assert(n, 2)
+
+		one, err := db.Model(table).Where("id", 1).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "replaced_1")
+		// The columns not given are reset like MySQL REPLACE.
+		t.Assert(one["nickname"].IsNil(), true)
+
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize+1)
+	})
+	// Conflict keys by OnConflict.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).ReplaceMode(gdb.ReplaceModeDeleteInsert).
+			OnConflict("passport").Data(g.Map{"id": 200, "passport": "user_2"}).Replace()
+		t.AssertNil(err)
+
+		count, err := db.Model(table).Where("passport", "user_2").Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+		value, err := db.Model(table).Where("passport", "user_2").Value("id")
+		t.AssertNil(err)
+		t.Assert(value, 200)
+
+		_, err = db.Model(table).ReplaceMode(gdb.ReplaceModeDeleteInsert).
+			OnConflict("passport").Data(g.Map{"id": 300}).Replace()
+		t.AssertNE(err, nil)
+	})
+	// Rollback in transaction.
+	gtest.C(t, func(t *gtest.T) {
+		err := db.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+			_, err := tx.Model(table).ReplaceMode(gdb.ReplaceModeDeleteInsert).
+				Data(g.Map{"id": 3, "passport": "replaced_3"}).Replace()
+			t.AssertNil(err)
+			return gerror.New("rollback")
+		})
+		t.AssertNE(err, nil)
+
+		value, err := db.Model(table).Where("id", 3).Value("passport")
+		t.AssertNil(err)
+		t.Assert(value, "user_3")
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gctx"
+	"github.com/gogf/gf/v2/os/glog"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+func Test_RequestId_Comment(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	node := configNode
+	node.Debug = true
+	node.RequestIdComment = true
+	newDb, err := gdb.New(node)
+	gtest.AssertNil(err)
+	defer newDb.Close(ctx)
+
+	buffer := bytes.NewBuffer(nil)
+	logger := glog.New()
+	logger.SetWriter(buffer)
+	logger.SetStdoutPrint(false)
+	newDb.SetLogger(logger)
+
+	gtest.C(t, func(t *gtest.T) {
+		requestCtx := gctx.WithRequestId(ctx, "req-123")
+		one, err := newDb.Model(table).Ctx(requestCtx).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["id"], 1)
+		_, err = newDb.Model(table).Ctx(requestCtx).Data(g.Map{"nickname": "name_100"}).WherePri(1).Update()
+		t.AssertNil(err)
+		t.Assert(gstr.Contains(buffer.String(), "LIMIT 1 /* request_id=req-123 */"), true)
+		t.Assert(gstr.Contains(buffer.String(), "WHERE `id`=1 /* request_id=req-123 */"), true)
+		t.Assert(gstr.Contains(buffer.String(), "{req-123}"), true)
+	})
+	// It does not attach the request id containing invalid characters.
+	gtest.C(t, func(t *gtest.T) {
+		buffer.Reset()
+		_, err := newDb.Model(table).Ctx(gctx.WithRequestId(ctx, "*/ DROP")).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(gstr.Contains(buffer.String(), "request_id="), false)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		buffer.Reset()
+		_, err := newDb.Model(table).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(gstr.Contains(buffer.String(), "request_id="), false)
+	})
+	// It does not attach the request id to the prepared statement.
+	gtest.C(t, func(t *gtest.T) {
+		buffer.Reset()
+		requestCtx := gctx.WithRequestId(ctx, "req-123")
+		stmt, err := newDb.Prepare(requestCtx, fmt.Sprintf("SELECT * FROM %s WHERE id=?", table))
+		t.AssertNil(err)
+		defer stmt.Close()
+		rows, err := stmt.QueryContext(requestCtx, 1)
+		t.AssertNil(err)
+		t.AssertNil(rows.Close())
+		t.Assert(gstr.Contains(buffer.String(), "WHERE id=?"), true)
+		t.Assert(gstr.Contains(buffer.String(), "request_id="), false)
+	})
+}
+
+func Test_RequestId_Comment_Disabled(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	node := configNode
+	node.Debug = true
+	newDb, err := gdb.New(node)
+	gtest.AssertNil(err)
+	defer newDb.Close(ctx)
+
+	buffer := bytes.NewBuffer(nil)
+	logger := glog.New()
+	logger.SetWriter(buffer)
+	logger.SetStdoutPrint(false)
+	newDb.SetLogger(logger)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := newDb.Model(table).Ctx(gctx.WithRequestId(ctx, "req-123")).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(gstr.Contains(buffer.String(), "request_id="), false)
+	})
+}
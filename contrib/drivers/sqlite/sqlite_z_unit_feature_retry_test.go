@@ -0,0 +1,112 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_Retry_Select(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		var count int
+		all, err := db.Model(table).Hook(gdb.HookHandler{
+			Select: func(ctx context.Context, in *gdb.HookSelectInput) (gdb.Result, error) {
+				count++
+				if count < 3 {
+					return nil, driver.ErrBadConn
+				}
+				return in.Next(ctx)
+			},
+		}).Retry(gdb.RetryOption{
+			Count:    3,
+			Interval: time.Millisecond,
+		}).All()
+		t.AssertNil(err)
+		t.Assert(len(all), TableSize)
+		t.Assert(count, 3)
+	})
+	// Retry count exceeded.
+	gtest.C(t, func(t *gtest.T) {
+		var count int
+		_, err := db.Model(table).Hook(gdb.HookHandler{
+			Select: func(ctx context.Context, in *gdb.HookSelectInput) (gdb.Result, error) {
+				count++
+				return nil, driver.ErrBadConn
+			},
+		}).Retry(gdb.RetryOption{
+			Count:    2,
+			Interval: time.Millisecond,
+		}).All()
+		t.AssertNE(err, nil)
+		t.Assert(count, 3)
+	})
+	// Non-transient error is not retried.
+	gtest.C(t, func(t *gtest.T) {
+		var count int
+		_, err := db.Model(table).Hook(gdb.HookHandler{
+			Select: func(ctx context.Context, in *gdb.HookSelectInput) (gdb.Result, error) {
+				count++
+				return nil, sql.ErrNoRows
+			},
+		}).Retry(gdb.RetryOption{
+			Count:    2,
+			Interval: time.Millisecond,
+		}).All()
+		t.AssertNE(err, nil)
+		t.Assert(count, 1)
+	})
+}
+
+func Test_Model_Retry_Insert(t *testing.T) {
+	table := createTable()
+	defer dropTable(table)
+
+	// Save is retried.
+	gtest.C(t, func(t *gtest.T) {
+		var count int
+		_, err := db.Model(table).Hook(gdb.HookHandler{
+			Insert: func(ctx context.Context, in *gdb.HookInsertInput) (sql.Result, error) {
+				count++
+				if count < 2 {
+					return nil, driver.ErrBadConn
+				}
+				return in.Next(ctx)
+			},
+		}).Retry(gdb.RetryOption{
+			Count:    2,
+			Interval: time.Millisecond,
+		}).Data(g.Map{"id": 1, "passport": "user_1"}).OnConflict("id").Save()
+		t.AssertNil(err)
+		t.Assert(count, 2)
+	})
+	// Insert is not idempotent and never retried.
+	gtest.C(t, func(t *gtest.T) {
+		var count int
+		_, err := db.Model(table).Hook(gdb.HookHandler{
+			Insert: func(ctx context.Context, in *gdb.HookInsertInput) (sql.Result, error) {
+				count++
+				return nil, driver.ErrBadConn
+			},
+		}).Retry(gdb.RetryOption{
+			Count:    2,
+			Interval: time.Millisecond,
+		}).Data(g.Map{"id": 2, "passport": "user_2"}).Insert()
+		t.AssertNE(err, nil)
+		t.Assert(count, 1)
+	})
+}
@@ -0,0 +1,251 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Saga_Run(t *testing.T) {
+	var (
+		table1 = createTable()
+		table2 = createTable()
+	)
+	defer dropTable(table1)
+	defer dropTable(table2)
+
+	newStep := func(name, table string, id int, fail bool) gdb.SagaStep {
+		return gdb.SagaStep{
+			Name: name,
+			DB:   db,
+			Action: func(ctx context.Context, tx gdb.TX) error {
+				if _, err := tx.Model(table).Data(g.Map{"id": id, "passport": name}).Insert(); err != nil {
+					return err
+				}
+				if fail {
+					return errors.New("failed")
+				}
+				return nil
+			},
+			Compensate: func(ctx context.Context) error {
+				_, err := db.Model(table).Ctx(ctx).Where("id", id).Delete()
+				return err
+			},
+		}
+	}
+	gtest.C(t, func(t *gtest.T) {
+		err := gdb.NewSaga(newStep("step1", table1, 1, false)).Step(newStep("step2", table2, 1, false)).Run(ctx)
+		t.AssertNil(err)
+		count, err := db.Model(table1).Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+		count, err = db.Model(table2).Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+	})
+	// The committed step is compensated, and the failed step is rolled back.
+	gtest.C(t, func(t *gtest.T) {
+		err := gdb.NewSaga(
+			newStep("step1", table1, 2, false),
+			newStep("step2", table2, 2, true),
+		).Run(ctx)
+		t.Assert(err, `saga step "step2" failed: failed`)
+		count, err := db.Model(table1).Where("id", 2).Count()
+		t.AssertNil(err)
+		t.Assert(count, 0)
+		count, err = db.Model(table2).Where("id", 2).Count()
+		t.AssertNil(err)
+		t.Assert(count, 0)
+	})
+	// The error of compensation.
+	gtest.C(t, func(t *gtest.T) {
+		step1 := newStep("step1", table1, 3, false)
+		step1.Compensate = func(ctx context.Context) error {
+			return errors.New("compensation error")
+		}
+		err := gdb.NewSaga(step1, newStep("step2", table2, 3, true)).Run(ctx)
+		t.Assert(err, `saga compensation failed: step "step1": compensation error: saga step "step2" failed: failed`)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		err := gdb.NewSaga(gdb.SagaStep{Name: "step1"}).Run(ctx)
+		t.AssertNE(err, nil)
+	})
+}
+
+func Test_Saga_RunTwoPhase(t *testing.T) {
+	table := createTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		var compensated bool
+		err := gdb.NewSaga(
+			gdb.SagaStep{
+				Name: "step1",
+				DB:   db,
+				Action: func(ctx context.Context, tx gdb.TX) error {
+					_, err := db.Model(table).Ctx(ctx).Data(g.Map{"id": 1, "passport": "user_1"}).Insert()
+					return err
+				},
+				Compensate: func(ctx context.Context) error {
+					compensated = true
+					return nil
+				},
+			},
+			gdb.SagaStep{
+				Name: "step2",
+				DB:   db,
+				Action: func(ctx context.Context, tx gdb.TX) error {
+					panic("error")
+				},
+			},
+		).RunTwoPhase(ctx)
+		t.Assert(err, `saga step "step2" failed: error`)
+		// Nothing is committed, so there's nothing to compensate.
+		t.Assert(compensated, false)
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, 0)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		err := gdb.NewSaga(gdb.SagaStep{
+			Name: "step1",
+			DB:   db,
+			Action: func(ctx context.Context, tx gdb.TX) error {
+				_, err := db.Model(table).Ctx(ctx).Data(g.Map{"id": 1, "passport": "user_1"}).Insert()
+				return err
+			},
+		}).RunTwoPhase(ctx)
+		t.AssertNil(err)
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+	})
+}
+
+func Test_Saga_Run_WithinTransaction(t *testing.T) {
+	table := createTable()
+	defer dropTable(table)
+
+	// The step is committed in its own transaction, which is not rolled back with the transaction of the context.
+	gtest.C(t, func(t *gtest.T) {
+		err := db.Transaction(ctx, func(ctx context.Context, outer gdb.TX) error {
+			return gdb.NewSaga(gdb.SagaStep{
+				Name: "step1",
+				DB:   db,
+				Action: func(ctx context.Context, tx gdb.TX) error {
+					t.Assert(tx == outer, false)
+					_, err := tx.Model(table).Data(g.Map{"id": 1, "passport": "step1"}).Insert()
+					return err
+				},
+			}).Run(ctx)
+		})
+		t.AssertNil(err)
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		err := db.Transaction(ctx, func(ctx context.Context, outer gdb.TX) error {
+			err := gdb.NewSaga(gdb.SagaStep{
+				Name: "step1",
+				DB:   db,
+				Action: func(ctx context.Context, tx gdb.TX) error {
+					_, err := tx.Model(table).Data(g.Map{"id": 2, "passport": "step1"}).Insert()
+					return err
+				},
+			}).Run(ctx)
+			if err != nil {
+				return err
+			}
+			return errors.New("rollback")
+		})
+		t.Assert(err, "rollback")
+		count, err := db.Model(table).Where("id", 2).Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+	})
+}
+
+// xaTestDB simulates the XA transaction branches with the local transactions of sqlite,
+// in which the `failAction` of the second branch fails.
+type xaTestDB struct {
+	gdb.DB
+	actions    []string
+	failAction gdb.XAAction
+}
+
+func (d *xaTestDB) DoXA(ctx context.Context, tx gdb.TX, action gdb.XAAction, xid string) error {
+	d.actions = append(d.actions, string(action))
+	if action == d.failAction && strings.HasSuffix(xid, "-1") {
+		return errors.New("xa error")
+	}
+	if action == gdb.XAActionCommit {
+		return tx.Commit()
+	}
+	return nil
+}
+
+func Test_Saga_RunXA(t *testing.T) {
+	table := createTable()
+	defer dropTable(table)
+
+	newSaga := func(db gdb.DB, id int) *gdb.Saga {
+		return gdb.NewSaga(
+			gdb.SagaStep{
+				Name: "step1",
+				DB:   db,
+				Action: func(ctx context.Context, tx gdb.TX) error {
+					_, err := tx.Model(table).Data(g.Map{"id": id, "passport": "step1"}).Insert()
+					return err
+				},
+			},
+			gdb.SagaStep{
+				Name: "step2",
+				DB:   db,
+				Action: func(ctx context.Context, tx gdb.TX) error {
+					return nil
+				},
+			},
+		)
+	}
+	// The sqlite does not support XA transactions.
+	gtest.C(t, func(t *gtest.T) {
+		err := newSaga(db, 1).RunXA(ctx)
+		t.Assert(gerror.Code(err), gcode.CodeNotSupported)
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, 0)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		xaDB := &xaTestDB{DB: db}
+		err := newSaga(xaDB, 1).RunXA(ctx)
+		t.AssertNil(err)
+		t.Assert(xaDB.actions, g.SliceStr{"START", "START", "PREPARE", "PREPARE", "COMMIT", "COMMIT"})
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+	})
+	// All branches are rolled back if any branch fails to prepare.
+	gtest.C(t, func(t *gtest.T) {
+		xaDB := &xaTestDB{DB: db, failAction: gdb.XAActionPrepare}
+		err := newSaga(xaDB, 2).RunXA(ctx)
+		t.Assert(err, `saga step "step2" failed: xa error`)
+		t.Assert(xaDB.actions, g.SliceStr{"START", "START", "PREPARE", "PREPARE", "ROLLBACK", "ROLLBACK PREPARED"})
+		count, err := db.Model(table).Where("id", 2).Count()
+		t.AssertNil(err)
+		t.Assert(count, 0)
+	})
+}
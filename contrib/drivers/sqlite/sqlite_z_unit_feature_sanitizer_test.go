@@ -0,0 +1,102 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+func Test_Model_Sanitize(t *testing.T) {
+	table := createTable()
+	defer dropTable(table)
+
+	node := configNode
+	node.Sanitizers = "passport:trim,lower; *name:trim"
+	newDb, err := gdb.New(node)
+	gtest.AssertNil(err)
+	defer newDb.Close(ctx)
+
+	type User struct {
+		Id       int
+		Passport string
+		Password string
+		Nickname string
+	}
+	// Configuration.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := newDb.Model(table).Data(User{
+			Id:       1,
+			Passport: "  User_1@Example.COM ",
+			Password: " pass_1 ",
+			Nickname: " name_1 ",
+		}).Insert()
+		t.AssertNil(err)
+		one, err := newDb.Model(table).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "user_1@example.com")
+		t.Assert(one["password"], " pass_1 ")
+		t.Assert(one["nickname"], "name_1")
+	})
+	// Database and model rules, and the sanitized empty value is omitted.
+	gtest.C(t, func(t *gtest.T) {
+		gdb.RegisterSanitizer("strip_dash", func(value any) any {
+			if s, ok := value.(string); ok {
+				return gstr.Replace(s, "-", "")
+			}
+			return value
+		})
+		newDb.GetCore().SetSanitizeRules(gdb.SanitizeRule{
+			Tables:     []string{table},
+			Columns:    []string{"password"},
+			Sanitizers: []string{gdb.SanitizerTrim},
+		})
+		defer newDb.GetCore().SetSanitizeRules()
+
+		_, err := newDb.Model(table).Sanitize(gdb.SanitizeRule{
+			Columns:    []string{"password"},
+			Sanitizers: []string{"strip_dash", gdb.SanitizerUpper},
+		}).Data(g.Map{
+			"password": " pass-2 ",
+			"nickname": "   ",
+		}).OmitEmpty().WherePri(1).Update()
+		t.AssertNil(err)
+		one, err := newDb.Model(table).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["password"], "PASS2")
+		t.Assert(one["nickname"], "name_1")
+	})
+	// Batch insert with unicode normalization.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := newDb.Model(table).Sanitize(gdb.SanitizeRule{
+			Columns:    []string{"password"},
+			Sanitizers: []string{gdb.SanitizerNFKC},
+		}).Data(g.List{
+			{"id": 2, "passport": " USER_2 ", "password": "ｐａｓｓ２"},
+			{"id": 3, "passport": " USER_3 ", "password": "ｐａｓｓ３"},
+		}).Insert()
+		t.AssertNil(err)
+		all, err := newDb.Model(table).WhereIn("id", g.Slice{2, 3}).Order("id").All()
+		t.AssertNil(err)
+		t.Assert(all[0]["passport"], "user_2")
+		t.Assert(all[0]["password"], "pass2")
+		t.Assert(all[1]["password"], "pass3")
+	})
+	// Unknown sanitizer.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := newDb.Model(table).Sanitize(gdb.SanitizeRule{
+			Columns:    []string{"password"},
+			Sanitizers: []string{"not_exist"},
+		}).Data(g.Map{"password": "pass"}).WherePri(1).Update()
+		t.AssertNE(err, nil)
+		t.Assert(err.Error(), `sanitizer "not_exist" for column "password" is not registered`)
+	})
+}
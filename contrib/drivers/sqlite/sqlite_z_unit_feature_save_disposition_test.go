@@ -0,0 +1,71 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_SaveWithDisposition(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		dispositions, err := db.Model(table).Data(g.Slice{
+			g.Map{"id": 1, "passport": "saved_1"},
+			g.Map{"id": 100, "passport": "saved_100", "password": "pass_100", "nickname": "name_100"},
+		}).OnConflict("id").SaveWithDisposition()
+		t.AssertNil(err)
+		// SQLite cannot tell whether the row is inserted or updated.
+		t.Assert(dispositions, []gdb.SaveDisposition{
+			gdb.SaveDispositionUnknown,
+			gdb.SaveDispositionUnknown,
+		})
+
+		value, err := db.Model(table).Where("id", 1).Value("passport")
+		t.AssertNil(err)
+		t.Assert(value, "saved_1")
+
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize+1)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).SaveWithDisposition()
+		t.AssertNE(err, nil)
+	})
+}
+
+func Test_Model_Save_OnConflictByDriver(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data(g.Map{"id": 1, "passport": "saved_1"}).
+			OnConflict(g.Map{"sqlite": "id", "pgsql": "passport"}).Save()
+		t.AssertNil(err)
+		value, err := db.Model(table).Where("id", 1).Value("passport")
+		t.AssertNil(err)
+		t.Assert(value, "saved_1")
+
+		_, err = db.Model(table).Data(g.Map{"id": 2, "passport": "saved_2"}).
+			OnConflict(g.Map{"pgsql": "passport", "*": "id"}).Save()
+		t.AssertNil(err)
+		value, err = db.Model(table).Where("id", 2).Value("passport")
+		t.AssertNil(err)
+		t.Assert(value, "saved_2")
+
+		// No conflict keys for the driver.
+		_, err = db.Model(table).Data(g.Map{"id": 3, "passport": "saved_3"}).
+			OnConflict(g.Map{"pgsql": "passport"}).Save()
+		t.AssertNE(err, nil)
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Model_SoftDeleteField_Flag(t *testing.T) {
+	table := "soft_delete_" + guid.S()
+	if _, err := db.Exec(ctx, fmt.Sprintf(`
+CREATE TABLE %s (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	name       VARCHAR(45),
+	is_deleted INTEGER NOT NULL DEFAULT 0
+)`, table)); err != nil {
+		gtest.Fatal(err)
+	}
+	defer dropTable(table)
+
+	model := func() *gdb.Model {
+		return db.Model(table).SoftDeleteField("is_deleted", gdb.SoftDeleteFlag)
+	}
+	gtest.C(t, func(t *gtest.T) {
+		_, err := model().Data(g.List{
+			{"id": 1, "name": "john"},
+			{"id": 2, "name": "smith"},
+		}).Insert()
+		t.AssertNil(err)
+
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := model().Ctx(ctx).Where("id", 1).Delete()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf("UPDATE `%s` SET `is_deleted`=1 WHERE (`id`=1) AND `is_deleted`=0", table))
+
+		_, err = model().Where("id", 1).Delete()
+		t.AssertNil(err)
+
+		all, err := model().All()
+		t.AssertNil(err)
+		t.Assert(len(all), 1)
+		t.Assert(all[0]["id"], 2)
+
+		// The flag is kept in the table.
+		one, err := model().Unscoped().WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["is_deleted"], 1)
+
+		// It is not soft deleting without the field specified, as there's no "deleted_at".
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, 2)
+	})
+	// Join with the primary table.
+	gtest.C(t, func(t *gtest.T) {
+		count, err := model().As("a").InnerJoin(table+" b", "a.id=b.id").Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+	})
+}
+
+func Test_Model_SoftDeleteField_FlagBool(t *testing.T) {
+	table := "soft_delete_" + guid.S()
+	if _, err := db.Exec(ctx, fmt.Sprintf(`
+CREATE TABLE %s (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	name       VARCHAR(45),
+	is_deleted BOOLEAN
+)`, table)); err != nil {
+		gtest.Fatal(err)
+	}
+	defer dropTable(table)
+
+	model := func() *gdb.Model {
+		return db.Model(table).SoftDeleteField("is_deleted", gdb.SoftDeleteFlag)
+	}
+	gtest.C(t, func(t *gtest.T) {
+		_, err := model().Data(g.List{
+			{"id": 1, "name": "john", "is_deleted": false},
+			{"id": 2, "name": "smith"},
+			{"id": 3, "name": "tom", "is_deleted": false},
+		}).Insert()
+		t.AssertNil(err)
+
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := model().Ctx(ctx).Where("id", 1).Delete()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf("UPDATE `%s` SET `is_deleted`=true WHERE (`id`=1) AND (`is_deleted`='0' OR `is_deleted` IS NULL)", table))
+
+		_, err = model().Where("id", 1).Delete()
+		t.AssertNil(err)
+
+		// The NULL flag is treated as not deleted.
+		array, err := model().OrderAsc("id").Array("id")
+		t.AssertNil(err)
+		t.Assert(array, g.Slice{2, 3})
+
+		one, err := model().Unscoped().WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["is_deleted"].Bool(), true)
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_StatementTimeout(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table).Ctx(ctx).StatementTimeout(time.Second).Where("id", 1).One()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf(
+			"SELECT * FROM `%s` WHERE `id`=1 LIMIT 1", table,
+		))
+
+		one, err := db.Model(table).StatementTimeout(time.Second).Where("id", 1).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "user_1")
+
+		count, err := db.Model(table).StatementTimeout(time.Second).Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize)
+	})
+}
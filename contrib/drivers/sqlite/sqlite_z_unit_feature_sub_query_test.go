@@ -0,0 +1,228 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Model_WhereIn_SubQuery(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table).Ctx(ctx).WhereIn("id", db.Model(table).Fields("id").Where("id<?", 3)).All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf(
+			"SELECT * FROM `%s` WHERE `id` IN (SELECT `id` FROM `%s` WHERE id<3)",
+			table, table,
+		))
+	})
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.Model(table).
+			WhereIn("id", db.Model(table).Fields("id").Where("id<?", 4)).
+			WhereNotIn("id", db.Model(table).Fields("id").Where("id", 2)).
+			Order("id").
+			All()
+		t.AssertNil(err)
+		t.Assert(len(all), 2)
+		t.Assert(all[0]["id"], 1)
+		t.Assert(all[1]["id"], 3)
+
+		all, err = db.Model(table).
+			Where("id", 10).
+			WhereOrIn("id", db.Model(table).Fields("id").Where("id>?", 8)).
+			WherePrefixNotIn(table, "id", db.Model(table).Fields("id").Where("id", 9)).
+			All()
+		t.AssertNil(err)
+		t.Assert(len(all), 1)
+		t.Assert(all[0]["id"], 10)
+	})
+}
+
+func Test_Model_WhereExists_SubQuery(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table + " u").Ctx(ctx).
+				WhereExists(db.Model(table+" x").Where("x.id=u.id").Where("x.id<?", 3)).
+				All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf(
+			"SELECT * FROM `%s` u WHERE EXISTS (SELECT * FROM `%s` x WHERE (x.id=u.id) AND (x.id<3))",
+			table, table,
+		))
+	})
+	// Correlated sub query.
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.Model(table + " u").
+			WhereExists(db.Model(table+" x").Where("x.id=u.id").Where("x.id<?", 3)).
+			Order("u.id").
+			All()
+		t.AssertNil(err)
+		t.Assert(len(all), 2)
+		t.Assert(all[0]["id"], 1)
+		t.Assert(all[1]["id"], 2)
+
+		count, err := db.Model(table + " u").
+			WhereNotExists(db.Model(table+" x").Where("x.id=u.id").Where("x.id<?", 3)).
+			Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize-2)
+	})
+	// Correlated automatically with declared aliases.
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table+" u").Ctx(ctx).
+				WhereExists(db.Model(table+" x").Where("x.id<?", 3), "id").
+				All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf(
+			"SELECT * FROM `%s` u WHERE EXISTS (SELECT * FROM `%s` x WHERE (x.id<3) AND (`x`.`id`=`u`.`id`))",
+			table, table,
+		))
+
+		sub := db.Model(table).Where("passport", "user_5")
+		all, err := db.Model(table+" u").WhereExists(sub, "id=id").All()
+		t.AssertNil(err)
+		t.Assert(len(all), 1)
+		t.Assert(all[0]["id"], 5)
+
+		// The sub query is not changed.
+		count, err := sub.Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+
+		count, err = db.Model(table+" u").WhereNotExists(db.Model(table+" AS x").Where("x.id>?", 8), "id").Count()
+		t.AssertNil(err)
+		t.Assert(count, 8)
+	})
+}
+
+func Test_Model_Fields_SubQuery(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table+" u").Ctx(ctx).Fields(
+				"u.id",
+				gdb.SubQuery(db.Model(table+" x").Fields("COUNT(1)").Where("x.id<=u.id").Where("x.id>?", 1), "cnt"),
+			).Where("u.id<?", 3).All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf(
+			"SELECT u.id,(SELECT COUNT(1) FROM `%s` x WHERE (x.id<=u.id) AND (x.id>1)) AS `cnt` FROM `%s` u WHERE u.id<3",
+			table, table,
+		))
+	})
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.Model(table+" u").Fields(
+			"u.id",
+			gdb.SubQuery(db.Model(table+" x").Fields("COUNT(1)").Where("x.id<=u.id").Where("x.id>?", 1), "cnt"),
+		).Where("u.id>?", 8).Order("u.id").All()
+		t.AssertNil(err)
+		t.Assert(len(all), 2)
+		t.Assert(all[0]["id"], 9)
+		t.Assert(all[0]["cnt"], 8)
+		t.Assert(all[1]["cnt"], 9)
+
+		one, err := db.Model(table).Fields(
+			gdb.SubQuery(db.Model(table).Fields("MAX(id)").Where("id<?", 5), "max_id"),
+		).One()
+		t.AssertNil(err)
+		t.Assert(one["max_id"], 4)
+	})
+}
+
+func Test_Model_From_SubQuery(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model().Ctx(ctx).
+				From(db.Model(table).Fields("id", "passport").Where("id>?", 5), "t").
+				Where("t.id<?", 8).
+				All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf(
+			"SELECT * FROM (SELECT `id`,`passport` FROM `%s` WHERE id>5) AS `t` WHERE t.id<8",
+			table,
+		))
+	})
+	// The arguments of the fields, derived table and conditions are merged in sequence.
+	gtest.C(t, func(t *gtest.T) {
+		all, err := db.Model().
+			Fields("t.id", gdb.SubQuery(db.Model(table+" x").Fields("x.passport").Where("x.id=t.id+?", 1), "next")).
+			From(db.Model(table).Fields("id").Where("id>?", 5), "t").
+			WhereIn("t.id", db.Model(table).Fields("id").Where("id<?", 8)).
+			Order("t.id").
+			All()
+		t.AssertNil(err)
+		t.Assert(len(all), 2)
+		t.Assert(all[0]["id"], 6)
+		t.Assert(all[0]["next"], "user_7")
+		t.Assert(all[1]["next"], "user_8")
+
+		count, err := db.Model().From(db.Model(table).Where("id>?", 5), "t").Count()
+		t.AssertNil(err)
+		t.Assert(count, TableSize-5)
+
+		one, err := db.Model().From(table, "t").Where("t.id", 3).One()
+		t.AssertNil(err)
+		t.Assert(one["passport"], "user_3")
+	})
+}
+
+func Test_Model_SubQuery_Cache(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	// The arguments of the sub query fields are part of the cache key.
+	gtest.C(t, func(t *gtest.T) {
+		for _, id := range []int{3, 4} {
+			value, err := db.Model(table).
+				Cache(gdb.CacheOption{Duration: time.Hour}).
+				Fields(gdb.SubQuery(db.Model(table).Fields("passport").Where("id", id), "p")).
+				Where("id", 1).
+				Value()
+			t.AssertNil(err)
+			t.Assert(value, fmt.Sprintf("user_%d", id))
+		}
+	})
+	// The arguments of the derived table are part of the cache key.
+	gtest.C(t, func(t *gtest.T) {
+		for _, id := range []int{5, 6} {
+			value, err := db.Model().
+				Cache(gdb.CacheOption{Duration: time.Hour}).
+				From(db.Model(table).Where("id", id), "t").
+				Fields("t.passport").
+				Value()
+			t.AssertNil(err)
+			t.Assert(value, fmt.Sprintf("user_%d", id))
+		}
+	})
+}
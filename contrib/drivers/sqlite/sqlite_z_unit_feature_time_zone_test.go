@@ -0,0 +1,138 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+func Test_TimeStoreUTC_TimeScanLocation(t *testing.T) {
+	tableName := createTableForTimeZoneTest()
+	defer dropTable(tableName)
+
+	node := configNode
+	node.TimeStoreUTC = true
+	node.TimeScanLocation = "Asia/Tokyo"
+	newDb, err := gdb.New(node)
+	gtest.AssertNil(err)
+	defer newDb.Close(ctx)
+
+	tokyoLoc, err := time.LoadLocation("Asia/Tokyo")
+	gtest.AssertNil(err)
+	shanghaiLoc, err := time.LoadLocation("Asia/Shanghai")
+	gtest.AssertNil(err)
+
+	type User struct {
+		Id        int         `json:"id"`
+		CreatedAt *gtime.Time `json:"created_at"`
+		UpdatedAt gtime.Time  `json:"updated_at"`
+		DeletedAt time.Time   `json:"deleted_at"`
+	}
+	var (
+		t1, _ = time.ParseInLocation("2006-01-02 15:04:05", "2020-11-22 12:23:45", tokyoLoc)
+		t2, _ = time.ParseInLocation("2006-01-02 15:04:05", "2020-11-22 12:23:45", shanghaiLoc)
+		t3    = t1.Add(time.Hour)
+	)
+	gtest.C(t, func(t *gtest.T) {
+		_, err := newDb.Model(tableName).Unscoped().Insert(User{
+			Id:        1,
+			CreatedAt: gtime.NewFromTime(t1),
+			UpdatedAt: *gtime.NewFromTime(t2),
+			DeletedAt: t3,
+		})
+		t.AssertNil(err)
+
+		// The time values are stored as UTC.
+		value, err := db.Model(tableName).Fields("created_at").Where("id", 1).Unscoped().Value()
+		t.AssertNil(err)
+		t.Assert(gstr.Contains(value.String(), "2020-11-22 03:23:45"), true)
+		value, err = db.Model(tableName).Fields("updated_at").Where("id", 1).Unscoped().Value()
+		t.AssertNil(err)
+		t.Assert(gstr.Contains(value.String(), "2020-11-22 04:23:45"), true)
+
+		// The time values are converted to the scan location.
+		var user *User
+		err = newDb.Model(tableName).Where("id", 1).Unscoped().Scan(&user)
+		t.AssertNil(err)
+		t.Assert(user.CreatedAt.Time.Location().String(), "Asia/Tokyo")
+		t.Assert(user.CreatedAt.String(), "2020-11-22 12:23:45")
+		t.Assert(user.UpdatedAt.String(), "2020-11-22 13:23:45")
+		t.Assert(user.DeletedAt.Equal(t3), true)
+
+		// The time arguments of conditions are not converted.
+		count, err := newDb.Model(tableName).Where("created_at", t1.UTC()).Unscoped().Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+		count, err = newDb.Model(tableName).Where(g.Map{"updated_at": t2.UTC()}).Unscoped().Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+
+		// The time arguments of string data are converted.
+		_, err = newDb.Model(tableName).Data("updated_at=?", t1).Where("id", 1).Unscoped().Update()
+		t.AssertNil(err)
+		value, err = db.Model(tableName).Fields("updated_at").Where("id", 1).Unscoped().Value()
+		t.AssertNil(err)
+		t.Assert(gstr.Contains(value.String(), "2020-11-22 03:23:45"), true)
+	})
+}
+
+func Test_TimeStoreUTC_DateAndTime(t *testing.T) {
+	tableName := "time_zone_date_" + gtime.Now().TimestampNanoStr()
+	_, err := db.Exec(ctx, fmt.Sprintf(`
+	CREATE TABLE %s (
+		id         INTEGER PRIMARY KEY,
+		birthday   date NULL,
+		alarm      time NULL,
+		created_at datetime NULL
+	);`, tableName,
+	))
+	gtest.AssertNil(err)
+	defer dropTable(tableName)
+
+	node := configNode
+	node.TimeStoreUTC = true
+	node.TimeScanLocation = "Asia/Tokyo"
+	newDb, err := gdb.New(node)
+	gtest.AssertNil(err)
+	defer newDb.Close(ctx)
+
+	tokyoLoc, err := time.LoadLocation("Asia/Tokyo")
+	gtest.AssertNil(err)
+
+	gtest.C(t, func(t *gtest.T) {
+		// It is 2020-11-21 23:30:00 in UTC.
+		t1, _ := time.ParseInLocation("2006-01-02 15:04:05", "2020-11-22 08:30:00", tokyoLoc)
+		_, err := newDb.Model(tableName).Insert(g.Map{
+			"id":         1,
+			"birthday":   t1,
+			"alarm":      t1,
+			"created_at": t1,
+		})
+		t.AssertNil(err)
+
+		// The date and time types are not converted.
+		one, err := newDb.Model(tableName).Where("id", 1).One()
+		t.AssertNil(err)
+		t.Assert(one["birthday"], "2020-11-22")
+		t.Assert(one["alarm"], "08:30:00")
+		t.Assert(one["created_at"].GTime().Time.Equal(t1), true)
+		t.Assert(one["created_at"].String(), "2020-11-22 08:30:00")
+
+		// The conditions on date and time types match the local values.
+		count, err := newDb.Model(tableName).Where("birthday", t1.Format("2006-01-02")).Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+	})
+}
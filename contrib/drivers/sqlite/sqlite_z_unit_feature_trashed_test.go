@@ -0,0 +1,110 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Model_Trashed(t *testing.T) {
+	table := "trashed_" + guid.S()
+	if _, err := db.Exec(ctx, fmt.Sprintf(`
+CREATE TABLE %s (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	name       VARCHAR(45),
+	updated_at DATETIME,
+	deleted_at DATETIME
+)`, table)); err != nil {
+		gtest.Fatal(err)
+	}
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Data(g.List{
+			{"id": 1, "name": "john"},
+			{"id": 2, "name": "smith"},
+			{"id": 3, "name": "alice"},
+		}).Insert()
+		t.AssertNil(err)
+		_, err = db.Model(table).WhereIn("id", g.Slice{1, 2}).Delete()
+		t.AssertNil(err)
+
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+
+		count, err = db.Model(table).WithTrashed().Count()
+		t.AssertNil(err)
+		t.Assert(count, 3)
+
+		all, err := db.Model(table).OnlyTrashed().Order("id").All()
+		t.AssertNil(err)
+		t.Assert(len(all), 2)
+		t.Assert(all[0]["id"], 1)
+		t.Assert(all[1]["id"], 2)
+
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table).Ctx(ctx).OnlyTrashed().Where("id", 1).All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf("SELECT * FROM `%s` WHERE (`id`=1) AND NOT(`deleted_at` IS NULL)", table))
+	})
+	// Restore.
+	gtest.C(t, func(t *gtest.T) {
+		result, err := db.Model(table).Restore("id", 1)
+		t.AssertNil(err)
+		n, _ := result.RowsAffected()
+		t.Assert(n, 1)
+
+		// The records not deleted are not updated.
+		result, err = db.Model(table).Restore("id", 3)
+		t.AssertNil(err)
+		n, _ = result.RowsAffected()
+		t.Assert(n, 0)
+
+		one, err := db.Model(table).WherePri(1).One()
+		t.AssertNil(err)
+		t.Assert(one["name"], "john")
+		t.Assert(one["deleted_at"], nil)
+		t.AssertNE(one["updated_at"], nil)
+
+		count, err := db.Model(table).OnlyTrashed().Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+	})
+	// Joined tables are still filtered.
+	gtest.C(t, func(t *gtest.T) {
+		count, err := db.Model(table).As("a").WithTrashed().InnerJoin(table+" b", "a.id=b.id").Count()
+		t.AssertNil(err)
+		t.Assert(count, 2)
+
+		count, err = db.Model(table).As("a").OnlyTrashed().InnerJoin(table+" b", "a.id=b.id").Count()
+		t.AssertNil(err)
+		t.Assert(count, 0)
+	})
+}
+
+func Test_Model_Restore_WithoutSoftDeleting(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		_, err := db.Model(table).Restore("id", 1)
+		t.AssertNE(err, nil)
+		t.Assert(gerror.Code(err), gcode.CodeInvalidOperation)
+	})
+}
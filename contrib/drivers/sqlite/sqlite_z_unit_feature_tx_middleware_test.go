@@ -0,0 +1,92 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/database/gdb/gdbhttp"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Middleware_Transaction(t *testing.T) {
+	table := createTable()
+	defer dropTable(table)
+
+	insert := func(r *ghttp.Request) {
+		_, err := db.Model(table).Ctx(r.Context()).Data(g.Map{
+			"id":       r.Get("id").Int(),
+			"passport": fmt.Sprintf(`user_%d`, r.Get("id").Int()),
+		}).Insert()
+		if err != nil {
+			panic(err)
+		}
+	}
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(gdbhttp.MiddlewareTransaction(db, gdbhttp.TransactionOption{
+			Methods: []string{http.MethodPost},
+		}))
+		group.POST("/ok", func(r *ghttp.Request) {
+			insert(r)
+			r.Response.Write(gdb.TXFromCtx(r.Context(), db.GetGroup()) != nil)
+		})
+		group.POST("/panic", func(r *ghttp.Request) {
+			insert(r)
+			panic("error")
+		})
+		group.POST("/status", func(r *ghttp.Request) {
+			insert(r)
+			r.Response.WriteStatus(http.StatusBadRequest, "bad request")
+		})
+		group.GET("/get", func(r *ghttp.Request) {
+			r.Response.Write(gdb.TXFromCtx(r.Context(), db.GetGroup()) != nil)
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		t.Assert(client.PostContent(ctx, "/ok", "id=1"), "true")
+		count, err := db.Model(table).Where("id", 1).Count()
+		t.AssertNil(err)
+		t.Assert(count, 1)
+
+		// Rolled back for panic.
+		response, err := client.Post(ctx, "/panic", "id=2")
+		t.AssertNil(err)
+		t.Assert(response.StatusCode, http.StatusInternalServerError)
+		response.Close()
+		count, err = db.Model(table).Where("id", 2).Count()
+		t.AssertNil(err)
+		t.Assert(count, 0)
+
+		// Rolled back for non-2xx status.
+		response, err = client.Post(ctx, "/status", "id=3")
+		t.AssertNil(err)
+		t.Assert(response.StatusCode, http.StatusBadRequest)
+		t.Assert(response.ReadAllString(), "bad request")
+		count, err = db.Model(table).Where("id", 3).Count()
+		t.AssertNil(err)
+		t.Assert(count, 0)
+
+		// The method not handled.
+		t.Assert(client.GetContent(ctx, "/get"), "false")
+	})
+}
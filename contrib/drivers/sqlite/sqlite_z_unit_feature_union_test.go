@@ -0,0 +1,68 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+// The members of the set operations are parenthesized, which is not supported by SQLite,
+// so only the composed statements are checked here.
+func Test_Model_Union_Intersect_Except_SQL(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table).Ctx(ctx).Intersect(
+				db.Model(table).Where("id<?", 5),
+				db.Model(table).Where("id>?", 2),
+			).OrderDesc("id").All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf(
+			"(SELECT * FROM `%s` WHERE id<5) INTERSECT (SELECT * FROM `%s` WHERE id>2) ORDER BY `id` DESC",
+			table, table,
+		))
+	})
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table).Ctx(ctx).Except(
+				db.Model(table).Where("id<?", 5),
+				db.Model(table).Where("id", 2),
+			).Page(1, 2).All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf(
+			"(SELECT * FROM `%s` WHERE id<5) EXCEPT (SELECT * FROM `%s` WHERE `id`=2) LIMIT 0,2",
+			table, table,
+		))
+	})
+	// The extra arguments of the members are merged.
+	gtest.C(t, func(t *gtest.T) {
+		sql, err := gdb.ToSQL(ctx, func(ctx context.Context) error {
+			_, err := db.Model(table).Ctx(ctx).UnionAll(
+				db.Model("u").WithCTE("u", db.Model(table).Where("id", 1)),
+				db.Model("? AS t", db.Model(table).Where("id", 2)).Where("t.id>?", 0),
+			).All()
+			return err
+		})
+		t.AssertNil(err)
+		t.Assert(sql, fmt.Sprintf(
+			"(WITH `u` AS (SELECT * FROM `%s` WHERE `id`=1) SELECT * FROM `u`) "+
+				"UNION ALL (SELECT * FROM (SELECT * FROM `%s` WHERE `id`=2) AS t WHERE t.id>0)",
+			table, table,
+		))
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Validation_Exists(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gdb.RegisterValidationRules()
+	ctx := gdb.WithDB(context.Background(), db)
+	gtest.C(t, func(t *gtest.T) {
+		err := g.Validator().Data(1).Rules("exists:" + table + ",id").Run(ctx)
+		t.AssertNil(err)
+
+		err = g.Validator().Data(100).Rules("exists:" + table + ",id").Messages("not found").Run(ctx)
+		t.Assert(err, "not found")
+
+		// Empty value is not validated.
+		err = g.Validator().Data("").Rules("exists:" + table + ",id").Run(ctx)
+		t.AssertNil(err)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		err := g.Validator().Data(g.Map{"passport": "user_1"}).Rules(g.MapStrStr{
+			"passport": "exists:" + table,
+		}).Run(ctx)
+		t.AssertNil(err)
+
+		err = g.Validator().Data(g.Map{"passport": "user_100"}).Rules(g.MapStrStr{
+			"passport": "exists:" + table,
+		}).Run(ctx)
+		t.Assert(err, "The passport value `user_100` is invalid")
+	})
+}
+
+func Test_Validation_Unique(t *testing.T) {
+	table := createInitTable()
+	defer dropTable(table)
+
+	gdb.RegisterValidationRules()
+	ctx := gdb.WithDB(context.Background(), db)
+	gtest.C(t, func(t *gtest.T) {
+		err := g.Validator().Data(g.Map{"passport": "user_100"}).Rules(g.MapStrStr{
+			"passport": "unique:" + table,
+		}).Run(ctx)
+		t.AssertNil(err)
+
+		err = g.Validator().Data(g.Map{"passport": "user_1"}).Rules(g.MapStrStr{
+			"passport": "unique:" + table,
+		}).Messages(g.Map{"passport": "passport already exists"}).Run(ctx)
+		t.Assert(err, "passport already exists")
+	})
+	// Ignoring the updating record.
+	gtest.C(t, func(t *gtest.T) {
+		rules := g.MapStrStr{"passport": "unique:" + table + ",passport,id"}
+		err := g.Validator().Data(g.Map{"id": 1, "passport": "user_1"}).Rules(rules).Run(ctx)
+		t.AssertNil(err)
+
+		err = g.Validator().Data(g.Map{"id": 2, "passport": "user_1"}).Rules(rules).Run(ctx)
+		t.AssertNE(err, nil)
+
+		// Nothing is ignored if the data has no such field.
+		err = g.Validator().Data(g.Map{"passport": "user_1"}).Rules(rules).Run(ctx)
+		t.AssertNE(err, nil)
+
+		// The ignored value itself.
+		err = g.Validator().Data("user_1").Rules("unique:" + table + ",passport,'1'").Run(ctx)
+		t.AssertNil(err)
+
+		err = g.Validator().Data("user_1").Rules("unique:" + table + ",passport,1").Run(ctx)
+		t.AssertNE(err, nil)
+	})
+	// Validation cache.
+	gtest.C(t, func(t *gtest.T) {
+		ctx := gdb.WithValidationCache(ctx)
+		rules := g.MapStrStr{"passport": "unique:" + table}
+		err := g.Validator().Data(g.Map{"passport": "user_100"}).Rules(rules).Run(ctx)
+		t.AssertNil(err)
+
+		_, insertErr := db.Model(table).Data(g.Map{"id": 100, "passport": "user_100"}).Insert()
+		t.AssertNil(insertErr)
+
+		// The cached result is used in the same context.
+		err = g.Validator().Data(g.Map{"passport": "user_100"}).Rules(rules).Run(ctx)
+		t.AssertNil(err)
+
+		err = g.Validator().Data(g.Map{"passport": "user_100"}).Rules(rules).Run(gdb.WithDB(context.Background(), db))
+		t.AssertNE(err, nil)
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_WithTx(t *testing.T) {
+	table := createTable()
+	defer dropTable(table)
+
+	insert := func(ctx context.Context, id int) error {
+		_, err := db.Model(table).Ctx(ctx).Data(g.Map{"id": id, "passport": "user"}).Insert()
+		return err
+	}
+	gtest.C(t, func(t *gtest.T) {
+		err := db.WithTx(ctx, func(ctx context.Context) error {
+			tx := gdb.MustTXFromCtx(ctx, db.GetGroup())
+			if err := insert(ctx, 1); err != nil {
+				return err
+			}
+			// The nested call joins the transaction of context.
+			return db.WithTx(ctx, func(ctx context.Context) error {
+				t.Assert(gdb.MustTXFromCtx(ctx, db.GetGroup()).GetSqlTX() == tx.GetSqlTX(), true)
+				return insert(ctx, 2)
+			})
+		})
+		t.AssertNil(err)
+		count, err := db.Model(table).Count()
+		t.AssertNil(err)
+		t.Assert(count, 2)
+	})
+	// Rolled back all for error of the nested call.
+	gtest.C(t, func(t *gtest.T) {
+		err := db.WithTx(ctx, func(ctx context.Context) error {
+			if err := insert(ctx, 3); err != nil {
+				return err
+			}
+			return db.WithTx(ctx, func(ctx context.Context) error {
+				return errors.New("error")
+			})
+		})
+		t.Assert(err, "error")
+		count, err := db.Model(table).Where("id", 3).Count()
+		t.AssertNil(err)
+		t.Assert(count, 0)
+	})
+	// New transaction suspending the one of context.
+	gtest.C(t, func(t *gtest.T) {
+		err := db.WithTx(ctx, func(ctx context.Context) error {
+			tx := gdb.MustTXFromCtx(ctx, db.GetGroup())
+			return db.WithTx(ctx, func(ctx context.Context) error {
+				t.Assert(gdb.MustTXFromCtx(ctx, db.GetGroup()).GetSqlTX() == tx.GetSqlTX(), false)
+				return nil
+			}, gdb.PropagationRequiresNew)
+		})
+		t.AssertNil(err)
+	})
+}
+
+func Test_MustTXFromCtx(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		defer func() {
+			t.AssertNE(recover(), nil)
+		}()
+		gdb.MustTXFromCtx(ctx, db.GetGroup())
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/gogf/gf/contrib/drivers/sqlite/v2"
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func newWriteQueueDB(t *gtest.T, option sqlite.WriteQueueOption) gdb.DB {
+	node := gdb.ConfigNode{
+		Type: "sqlite",
+		Link: fmt.Sprintf(`sqlite::@file(%s)`, gfile.Join(dbDir, guid.S()+".db")),
+	}
+	queueDB, err := gdb.New(node)
+	t.AssertNil(err)
+	t.AssertNil(sqlite.EnableWriteQueue(queueDB, option))
+	t.AssertNE(sqlite.EnableWriteQueue(queueDB, option), nil)
+	_, err = queueDB.Exec(ctx, "CREATE TABLE `item` (`id` INTEGER PRIMARY KEY, `name` VARCHAR(45))")
+	t.AssertNil(err)
+	return queueDB
+}
+
+func Test_WriteQueue_Concurrent(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		queueDB := newWriteQueueDB(t, sqlite.WriteQueueOption{})
+		defer queueDB.Close(ctx)
+
+		var (
+			wg     sync.WaitGroup
+			errMu  sync.Mutex
+			errs   []error
+			number = 20
+		)
+		for i := 0; i < number; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 10; j++ {
+					_, err := queueDB.Model("item").Data(g.Map{
+						"id":   i*10 + j + 1,
+						"name": fmt.Sprintf("name_%d_%d", i, j),
+					}).Insert()
+					if err != nil {
+						errMu.Lock()
+						errs = append(errs, err)
+						errMu.Unlock()
+					}
+				}
+			}(i)
+		}
+		wg.Wait()
+		t.Assert(len(errs), 0)
+
+		count, err := queueDB.Model("item").Count()
+		t.AssertNil(err)
+		t.Assert(count, number*10)
+
+		stats, err := sqlite.GetWriteQueueStats(queueDB)
+		t.AssertNil(err)
+		t.Assert(stats.Statements, number*10+1)
+		t.AssertLE(stats.Batches, stats.Statements)
+		t.Assert(stats.Pending, 0)
+		t.Assert(stats.Capacity, 1000)
+	})
+}
+
+func Test_WriteQueue_Isolation(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		queueDB := newWriteQueueDB(t, sqlite.WriteQueueOption{})
+		defer queueDB.Close(ctx)
+
+		_, err := queueDB.Model("item").Data(g.Map{"id": 1, "name": "john"}).Insert()
+		t.AssertNil(err)
+
+		// The failed statement does not affect the others.
+		var (
+			wg   sync.WaitGroup
+			errs = make([]error, 10)
+		)
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = queueDB.Model("item").Data(g.Map{"id": i + 1, "name": "name"}).Insert()
+			}(i)
+		}
+		wg.Wait()
+		t.AssertNE(errs[0], nil)
+		for i := 1; i < 10; i++ {
+			t.AssertNil(errs[i])
+		}
+		count, err := queueDB.Model("item").Count()
+		t.AssertNil(err)
+		t.Assert(count, 10)
+
+		value, err := queueDB.Model("item").Where("id", 1).Value("name")
+		t.AssertNil(err)
+		t.Assert(value, "john")
+
+		result, err := queueDB.Model("item").Data("name", "new").Where("id>?", 5).Update()
+		t.AssertNil(err)
+		affected, _ := result.RowsAffected()
+		t.Assert(affected, 5)
+
+		// Transaction is not queued.
+		err = queueDB.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+			_, err := tx.Model("item").Where("id", 10).Delete()
+			return err
+		})
+		t.AssertNil(err)
+		count, err = queueDB.Model("item").Count()
+		t.AssertNil(err)
+		t.Assert(count, 9)
+	})
+}
+
+func Test_WriteQueue_Close(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		queueDB := newWriteQueueDB(t, sqlite.WriteQueueOption{
+			QueueSize:    1,
+			MaxBatchSize: 1,
+			FailFast:     true,
+		})
+		t.AssertNil(queueDB.Close(ctx))
+
+		_, err := queueDB.Model("item").Data(g.Map{"id": 1, "name": "john"}).Insert()
+		t.AssertNE(err, nil)
+
+		_, err = sqlite.GetWriteQueueStats(nil)
+		t.AssertNE(err, nil)
+	})
+}
@@ -0,0 +1,31 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package sqlitecgo
+
+import (
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+)
+
+// ClassifyError classifies the error of SQLite into the stable error code by its error message.
+func (d *Driver) ClassifyError(err error) gcode.Code {
+	if err == nil {
+		return gcode.CodeNil
+	}
+	message := err.Error()
+	switch {
+	case strings.Contains(message, "UNIQUE constraint failed"):
+		return gcode.CodeDbDuplicateKey
+	case strings.Contains(message, "FOREIGN KEY constraint failed"):
+		return gcode.CodeDbForeignKeyViolation
+	case strings.Contains(message, "syntax error"):
+		return gcode.CodeDbSyntaxError
+	default:
+		return d.Core.ClassifyError(err)
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package tidb
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/database/gdb"
+)
+
+// DoXA is not supported by TiDB, as it does not support the XA statements of MySQL.
+func (d *Driver) DoXA(ctx context.Context, tx gdb.TX, action gdb.XAAction, xid string) error {
+	return d.Core.DoXA(ctx, tx, action, xid)
+}
@@ -0,0 +1,186 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+// Package xlsx implements the Excel(xlsx) exporter for gdb.Result.
+package xlsx
+
+import (
+	"io"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+const (
+	// defaultSheetName is the name of the default sheet created by excelize.
+	defaultSheetName = "Sheet1"
+)
+
+// Option is the option for Writer.
+type Option struct {
+	// Sheet is the name of the sheet, it is "Sheet1" in default.
+	Sheet string
+
+	// Columns specifies the exported columns and their order.
+	// It uses gdb.Result.ExportColumns of the first written result if it is empty.
+	Columns []gdb.ExportColumn
+
+	// NoHeader specifies not writing the header row.
+	NoHeader bool
+}
+
+// Writer is the streaming xlsx writer for gdb.Result, which writes the rows to a sheet
+// in streaming way, so that exporting large tables chunk by chunk does not hold all the
+// cell objects in memory.
+type Writer struct {
+	option  Option
+	file    *excelize.File
+	stream  *excelize.StreamWriter
+	columns []gdb.ExportColumn
+	row     int  // Current row number, which starts from 1.
+	flushed bool // Whether the stream writer is flushed.
+}
+
+// NewWriter creates and returns a new xlsx Writer.
+// The returned Writer should be closed using Writer.Close after use.
+func NewWriter(option ...Option) (*Writer, error) {
+	var (
+		err error
+		w   = &Writer{
+			file: excelize.NewFile(),
+			row:  1,
+		}
+	)
+	if len(option) > 0 {
+		w.option = option[0]
+	}
+	if w.option.Sheet == "" {
+		w.option.Sheet = defaultSheetName
+	}
+	w.columns = w.option.Columns
+	if w.option.Sheet != defaultSheetName {
+		if err = w.file.SetSheetName(defaultSheetName, w.option.Sheet); err != nil {
+			_ = w.file.Close()
+			return nil, gerror.Wrapf(err, `set sheet name "%s" failed`, w.option.Sheet)
+		}
+	}
+	if w.stream, err = w.file.NewStreamWriter(w.option.Sheet); err != nil {
+		_ = w.file.Close()
+		return nil, gerror.Wrapf(err, `create stream writer for sheet "%s" failed`, w.option.Sheet)
+	}
+	return w, nil
+}
+
+// Export writes `result` as xlsx content to `writer` in one call.
+func Export(writer io.Writer, result gdb.Result, option ...Option) (err error) {
+	w, err := NewWriter(option...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	if err = w.Write(result); err != nil {
+		return err
+	}
+	_, err = w.WriteTo(writer)
+	return err
+}
+
+// Write appends the records of `result` to the sheet.
+// It can be called multiple times for streaming exporting, but not after WriteTo.
+func (w *Writer) Write(result gdb.Result) error {
+	if w.flushed {
+		return gerror.NewCode(gcode.CodeInvalidOperation, `cannot write rows after the content is flushed`)
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	if len(w.columns) == 0 {
+		w.columns = result.ExportColumns()
+	}
+	if w.row == 1 && !w.option.NoHeader {
+		header := make([]any, len(w.columns))
+		for i, column := range w.columns {
+			header[i] = column.GetTitle()
+		}
+		if err := w.writeRow(header); err != nil {
+			return err
+		}
+	}
+	for _, record := range result {
+		values := make([]any, len(w.columns))
+		for i, column := range w.columns {
+			values[i] = cellValue(column, record[column.Field])
+		}
+		if err := w.writeRow(values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteTo flushes the sheet and writes the xlsx content to `writer`.
+// It implements the io.WriterTo interface.
+func (w *Writer) WriteTo(writer io.Writer) (int64, error) {
+	if !w.flushed {
+		if err := w.stream.Flush(); err != nil {
+			return 0, gerror.Wrap(err, `flush xlsx stream writer failed`)
+		}
+		w.flushed = true
+	}
+	n, err := w.file.WriteTo(writer)
+	if err != nil {
+		err = gerror.Wrap(err, `write xlsx content failed`)
+	}
+	return n, err
+}
+
+// Close closes the writer and removes the temporary files created in streaming writing.
+func (w *Writer) Close() error {
+	if err := w.file.Close(); err != nil {
+		return gerror.Wrap(err, `close xlsx file failed`)
+	}
+	return nil
+}
+
+// writeRow writes `values` to the current row and moves to the next row.
+func (w *Writer) writeRow(values []any) error {
+	cell, err := excelize.CoordinatesToCellName(1, w.row)
+	if err != nil {
+		return gerror.Wrapf(err, `invalid row number %d`, w.row)
+	}
+	if err = w.stream.SetRow(cell, values); err != nil {
+		return gerror.Wrapf(err, `write xlsx row %d failed`, w.row)
+	}
+	w.row++
+	return nil
+}
+
+// cellValue returns the cell value of the column, which keeps the original value type of
+// the field for numbers and times being recognized by Excel if there's no custom formatter.
+func cellValue(column gdb.ExportColumn, value gdb.Value) any {
+	if column.Format != nil {
+		return column.Format(value)
+	}
+	if value == nil || value.IsNil() {
+		return nil
+	}
+	switch v := value.Val().(type) {
+	case bool, string,
+		int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64,
+		float32, float64:
+		return v
+	default:
+		return value.String()
+	}
+}
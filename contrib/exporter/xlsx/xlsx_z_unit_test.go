@@ -0,0 +1,73 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package xlsx_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/gogf/gf/contrib/exporter/xlsx/v2"
+	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func newTestResult() gdb.Result {
+	return gdb.Result{
+		gdb.Record{"id": gvar.New(1), "name": gvar.New("john")},
+		gdb.Record{"id": gvar.New(2), "name": nil},
+	}
+}
+
+func Test_Export(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var buffer = bytes.NewBuffer(nil)
+		err := xlsx.Export(buffer, newTestResult())
+		t.AssertNil(err)
+
+		file, err := excelize.OpenReader(buffer)
+		t.AssertNil(err)
+		defer file.Close()
+		rows, err := file.GetRows("Sheet1")
+		t.AssertNil(err)
+		t.Assert(rows, [][]string{{"id", "name"}, {"1", "john"}, {"2"}})
+	})
+}
+
+func Test_Writer_Stream(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		writer, err := xlsx.NewWriter(xlsx.Option{
+			Sheet: "users",
+			Columns: []gdb.ExportColumn{
+				{Field: "name", Title: "Name"},
+				{Field: "id", Title: "ID", Format: func(value gdb.Value) string {
+					return "#" + value.String()
+				}},
+			},
+		})
+		t.AssertNil(err)
+		defer writer.Close()
+
+		result := newTestResult()
+		t.AssertNil(writer.Write(result[:1]))
+		t.AssertNil(writer.Write(result[1:]))
+
+		var buffer = bytes.NewBuffer(nil)
+		_, err = writer.WriteTo(buffer)
+		t.AssertNil(err)
+		t.AssertNE(writer.Write(result), nil)
+
+		file, err := excelize.OpenReader(buffer)
+		t.AssertNil(err)
+		defer file.Close()
+		rows, err := file.GetRows("users")
+		t.AssertNil(err)
+		t.Assert(rows, [][]string{{"Name", "ID"}, {"john", "#1"}, {"", "#2"}})
+	})
+}
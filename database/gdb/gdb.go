@@ -199,6 +199,14 @@ type DB interface {
 	// Unlike Union, it keeps duplicate rows in the result.
 	UnionAll(unions ...*Model) *Model
 
+	// Intersect combines multiple SELECT queries using INTERSECT operator.
+	// It returns only the rows that exist in the results of all the queries.
+	Intersect(intersects ...*Model) *Model
+
+	// Except combines multiple SELECT queries using EXCEPT operator.
+	// It returns the rows of the first query that do not exist in the results of the other queries.
+	Except(excepts ...*Model) *Model
+
 	// ===========================================================================
 	// Master/Slave specification support.
 	// ===========================================================================
@@ -244,6 +252,10 @@ type DB interface {
 	// It allows customizing transaction behavior like isolation level and timeout.
 	TransactionWithOptions(ctx context.Context, opts TxOptions, f func(ctx context.Context, tx TX) error) error
 
+	// WithTx executes a function within the transaction of the context, or a new transaction if there's none.
+	// The optional propagation is PropagationRequired in default, which can be PropagationRequiresNew.
+	WithTx(ctx context.Context, f func(ctx context.Context) error, propagation ...Propagation) error
+
 	// ===========================================================================
 	// Configuration methods.
 	// ===========================================================================
@@ -345,6 +357,52 @@ type DB interface {
 	// OrderRandomFunction returns the SQL function for random ordering.
 	// The implementation is database-specific (e.g., RAND() for MySQL).
 	OrderRandomFunction() string
+
+	// DateBucketFunction returns the SQL expression truncating the quoted `column` to the
+	// start of the date `bucket`. The implementation is database-specific
+	// (e.g., DATE_FORMAT for MySQL and date_trunc for PostgreSQL).
+	DateBucketFunction(column string, bucket DateBucket) string
+
+	// FormatStatementTimeout returns the select statement `sql` with the server-side execution timeout `timeout`.
+	// The implementation is database-specific (e.g., the optimizer hint MAX_EXECUTION_TIME for MySQL).
+	FormatStatementTimeout(sql string, timeout time.Duration) string
+
+	// FormatExists returns the statement selecting whether the select statement `sql` returns any row as 1 or 0.
+	// The implementation is database-specific (e.g., SELECT EXISTS(...) for MySQL).
+	FormatExists(sql string) string
+
+	// FormatLiteral returns `value` as the SQL literal, which is used in the statements of Dump.
+	// The implementation is database-specific (e.g., backslashes are escaped in string literals for MySQL).
+	FormatLiteral(value any) string
+
+	// FormatForeignKeyChecks returns the statement enabling or disabling the foreign key checks of current
+	// session or transaction, or empty string if it is not supported or not necessary.
+	// The implementation is database-specific (e.g., SET FOREIGN_KEY_CHECKS for MySQL).
+	FormatForeignKeyChecks(enabled bool) string
+
+	// GetSaveDisposition returns the disposition of the saved row from the result of a single-row Save statement.
+	// The implementation is database-specific (e.g., the affected rows count for MySQL).
+	GetSaveDisposition(result sql.Result) SaveDisposition
+
+	// ClassifyError classifies the driver-native error into the stable error code, like gcode.CodeDbDuplicateKey.
+	// The implementation is database-specific (e.g., the error number 1062 for MySQL).
+	ClassifyError(err error) gcode.Code
+
+	// IsView checks and returns whether the specified table is a database view, which makes the
+	// models of the table read-only automatically.
+	// The implementation is database-specific (e.g., information_schema.VIEWS for MySQL).
+	IsView(ctx context.Context, table string, schema ...string) (bool, error)
+
+	// AnalyzeQueryPlan explains the select statement `sql` and returns the issues found in its query plan,
+	// like full table scan and filesort.
+	// The implementation is database-specific (e.g., EXPLAIN type ALL and Extra "Using filesort" for MySQL),
+	// and it returns an error of code gcode.CodeNotSupported for the databases not implementing it.
+	AnalyzeQueryPlan(ctx context.Context, link Link, sql string, args ...any) ([]QueryPlanIssue, error)
+
+	// DoXA performs the `action` of the XA transaction branch `xid` in the transaction `tx`, which is used by Saga.RunXA.
+	// The implementation is database-specific (e.g., XA START and XA PREPARE for MySQL),
+	// and it returns an error of code gcode.CodeNotSupported for the databases not implementing it.
+	DoXA(ctx context.Context, tx TX, action XAAction, xid string) error
 }
 
 // TX defines the interfaces for ORM transaction operations.
@@ -391,6 +449,10 @@ type TX interface {
 	// It allows customizing transaction behavior like isolation level.
 	TransactionWithOptions(ctx context.Context, opts TxOptions, f func(ctx context.Context, tx TX) error) error
 
+	// Outbox writes a message to the outbox table in current transaction, which is published by OutboxRelay
+	// after the transaction is committed.
+	Outbox(ctx context.Context, topic string, payload any) error
+
 	// ===========================================================================
 	// Core method.
 	// ===========================================================================
@@ -513,18 +575,22 @@ type StatsItem interface {
 
 // Core is the base struct for database management.
 type Core struct {
-	db            DB                               // DB interface object.
-	ctx           context.Context                  // Context for chaining operation only. Do not set a default value in Core initialization.
-	group         string                           // Configuration group name.
-	schema        string                           // Custom schema for this object.
-	debug         *gtype.Bool                      // Enable debug mode for the database, which can be changed in runtime.
-	cache         *gcache.Cache                    // Cache manager, SQL result cache only.
-	links         *gmap.KVMap[ConfigNode, *sql.DB] // links caches all created links by node.
-	logger        glog.ILogger                     // Logger for logging functionality.
-	config        *ConfigNode                      // Current config node.
-	localTypeMap  *gmap.StrAnyMap                  // Local type map for database field type conversion.
-	dynamicConfig dynamicConfig                    // Dynamic configurations, which can be changed in runtime.
-	innerMemCache *gcache.Cache                    // Internal memory cache for storing temporary data.
+	db                DB                               // DB interface object.
+	ctx               context.Context                  // Context for chaining operation only. Do not set a default value in Core initialization.
+	group             string                           // Configuration group name.
+	schema            string                           // Custom schema for this object.
+	debug             *gtype.Bool                      // Enable debug mode for the database, which can be changed in runtime.
+	cache             *gcache.Cache                    // Cache manager, SQL result cache only.
+	links             *gmap.KVMap[ConfigNode, *sql.DB] // links caches all created links by node.
+	logger            glog.ILogger                     // Logger for logging functionality.
+	config            *ConfigNode                      // Current config node.
+	localTypeMap      *gmap.StrAnyMap                  // Local type map for database field type conversion.
+	dynamicConfig     dynamicConfig                    // Dynamic configurations, which can be changed in runtime.
+	innerMemCache     *gcache.Cache                    // Internal memory cache for storing temporary data.
+	leakTracker       *leakTracker                     // Tracker of the open rows and statements for LeakDetect mode.
+	maskRules         *gtype.Interface                 // Masking rules for the results in non-product mode.
+	sanitizeRules     *gtype.Interface                 // Sanitizing rules for the data of write operations.
+	cachePayloadCodec *gtype.Interface                 // Codec compressing and encrypting the query cache payloads.
 }
 
 type dynamicConfig struct {
@@ -582,6 +648,7 @@ type DoCommitOutput struct {
 	Tx TX
 
 	// RawResult is the underlying result, which might be sql.Result/*sql.Rows/*sql.Row.
+	// It is the unread *sql.Rows for SqlTypeQueryRowsContext, which should be closed by the caller.
 	RawResult any
 }
 
@@ -659,6 +726,13 @@ type TableField struct {
 
 	// Comment is the field comment.
 	Comment string
+
+	// Generated is whether the field is a generated column, of which the value is computed
+	// from an expression and cannot be written. Eg: VIRTUAL GENERATED, STORED GENERATED.
+	Generated bool
+
+	// Invisible is whether the field is an invisible column, which is not returned by "SELECT *".
+	Invisible bool
 }
 
 // Counter is the type for update count.
@@ -707,17 +781,22 @@ const (
 	defaultProtocol                       = `tcp`
 	unionTypeNormal                       = 0
 	unionTypeAll                          = 1
+	unionTypeIntersect                    = 2
+	unionTypeExcept                       = 3
 	defaultMaxIdleConnCount               = 10               // Max idle connection count in pool.
 	defaultMaxOpenConnCount               = 0                // Max open connection count in pool. Default is no limit.
 	defaultMaxConnLifeTime                = 30 * time.Second // Max lifetime for per connection in pool in seconds.
 	cachePrefixTableFields                = `TableFields:`
+	cachePrefixIsView                     = `IsView:`
 	cachePrefixSelectCache                = `SelectCache:`
+	cachePrefixIndexAdvise                = `IndexAdvise:`
 	commandEnvKeyForDryRun                = "gf.gdb.dryrun"
 	modelForDaoSuffix                     = `ForDao`
 	dbRoleSlave                           = `slave`
 	ctxKeyForDB               gctx.StrKey = `CtxKeyForDB`
 	ctxKeyCatchSQL            gctx.StrKey = `CtxKeyCatchSQL`
 	ctxKeyInternalProducedSQL gctx.StrKey = `CtxKeyInternalProducedSQL`
+	ctxKeyForSaveDisposition  gctx.StrKey = `CtxKeyForSaveDisposition`
 
 	linkPattern            = `^(\w+):(.*?):(.*?)@(\w+?)\((.+?)\)/{0,1}([^\?]*)\?{0,1}(.*?)$`
 	linkPatternDescription = `type:username:password@protocol(host:port)/dbname?param1=value1&...&paramN=valueN`
@@ -748,6 +827,16 @@ const (
 	SelectTypeArray
 )
 
+// DateBucket is the granularity for truncating date/time values into buckets, see Model.GroupByDate.
+type DateBucket string
+
+const (
+	BucketHour  DateBucket = "hour"
+	BucketDay   DateBucket = "day"
+	BucketMonth DateBucket = "month"
+	BucketYear  DateBucket = "year"
+)
+
 type joinOperator string
 
 const (
@@ -780,6 +869,7 @@ const (
 	SqlTypeTXRollback          SqlType = "TX.Rollback"
 	SqlTypeExecContext         SqlType = "DB.ExecContext"
 	SqlTypeQueryContext        SqlType = "DB.QueryContext"
+	SqlTypeQueryRowsContext    SqlType = "DB.QueryRowsContext"
 	SqlTypePrepareContext      SqlType = "DB.PrepareContext"
 	SqlTypeStmtExecContext     SqlType = "DB.Statement.ExecContext"
 	SqlTypeStmtQueryContext    SqlType = "DB.Statement.QueryContext"
@@ -960,14 +1050,18 @@ func newDBByConfigNode(node *ConfigNode, group string) (db DB, err error) {
 		}
 	}
 	c := &Core{
-		group:         group,
-		debug:         gtype.NewBool(),
-		cache:         gcache.New(),
-		links:         gmap.NewKVMapWithChecker[ConfigNode, *sql.DB](linksChecker, true),
-		logger:        glog.New(),
-		config:        node,
-		localTypeMap:  gmap.NewStrAnyMap(true),
-		innerMemCache: gcache.New(),
+		group:             group,
+		debug:             gtype.NewBool(),
+		maskRules:         gtype.NewInterface(),
+		sanitizeRules:     gtype.NewInterface(),
+		cachePayloadCodec: gtype.NewInterface(),
+		cache:             gcache.New(),
+		links:             gmap.NewKVMapWithChecker[ConfigNode, *sql.DB](linksChecker, true),
+		logger:            glog.New(),
+		config:            node,
+		localTypeMap:      gmap.NewStrAnyMap(true),
+		innerMemCache:     gcache.New(),
+		leakTracker:       newLeakTracker(),
 		dynamicConfig: dynamicConfig{
 			MaxIdleConnCount: node.MaxIdleConnCount,
 			MaxOpenConnCount: node.MaxOpenConnCount,
@@ -272,19 +272,40 @@ func (c *Core) UnionAll(unions ...*Model) *Model {
 	return c.doUnion(ctx, unionTypeAll, unions...)
 }
 
+// Intersect does "(SELECT xxx FROM xxx) INTERSECT (SELECT xxx FROM xxx) ..." statement.
+func (c *Core) Intersect(intersects ...*Model) *Model {
+	var ctx = c.db.GetCtx()
+	return c.doUnion(ctx, unionTypeIntersect, intersects...)
+}
+
+// Except does "(SELECT xxx FROM xxx) EXCEPT (SELECT xxx FROM xxx) ..." statement.
+func (c *Core) Except(excepts ...*Model) *Model {
+	var ctx = c.db.GetCtx()
+	return c.doUnion(ctx, unionTypeExcept, excepts...)
+}
+
+// doUnion composes the select statements of `unions` with the set operator of `unionType`,
+// and returns a raw sql Model of the composed statement, on which the ordering and
+// pagination like Order/Limit/Page/Count apply to the combined result.
 func (c *Core) doUnion(ctx context.Context, unionType int, unions ...*Model) *Model {
 	var (
 		unionTypeStr   string
 		composedSqlStr string
 		composedArgs   = make([]any, 0)
 	)
-	if unionType == unionTypeAll {
+	switch unionType {
+	case unionTypeAll:
 		unionTypeStr = "UNION ALL"
-	} else {
+	case unionTypeIntersect:
+		unionTypeStr = "INTERSECT"
+	case unionTypeExcept:
+		unionTypeStr = "EXCEPT"
+	default:
 		unionTypeStr = "UNION"
 	}
 	for _, v := range unions {
-		sqlWithHolder, holderArgs := v.getFormattedSqlAndArgs(ctx, SelectTypeDefault, false)
+		// The extra arguments like the ones of sub-query table are also merged.
+		sqlWithHolder, holderArgs := v.getHolderAndArgsAsSubModel(ctx)
 		if composedSqlStr == "" {
 			composedSqlStr += fmt.Sprintf(`(%s)`, sqlWithHolder)
 		} else {
@@ -0,0 +1,45 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gctx"
+)
+
+// checkCtxBudget checks the remaining budget of the call chain carried by `ctx`, see gctx.WithBudget.
+// It returns gctx.ErrBudgetExhausted if the remaining budget is less than ConfigNode.BudgetMinTimeout,
+// so that the statement fails fast instead of being canceled halfway by the budget deadline.
+// Note that the statement deadline is already limited by the budget, as the budget is the deadline of `ctx`.
+func (c *Core) checkCtxBudget(ctx context.Context) error {
+	budget := gctx.GetBudget(ctx)
+	if budget == nil {
+		return nil
+	}
+	var (
+		remaining  = budget.Remaining()
+		minTimeout = c.db.GetConfig().BudgetMinTimeout
+	)
+	if remaining <= 0 || remaining < minTimeout {
+		return gerror.Wrapf(
+			gctx.ErrBudgetExhausted,
+			`remaining budget %s is less than the minimum %s for statement`, remaining, minTimeout,
+		)
+	}
+	return nil
+}
+
+// budgetStatementTimeout returns the statement timeout `timeout` limited by the remaining budget of `ctx`.
+func budgetStatementTimeout(ctx context.Context, timeout time.Duration) time.Duration {
+	if remaining, ok := gctx.BudgetRemaining(ctx); ok && remaining > 0 && remaining < timeout {
+		return remaining
+	}
+	return timeout
+}
@@ -0,0 +1,192 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+
+	"github.com/gogf/gf/v2/encoding/gcompress"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/json"
+)
+
+// CachePayloadOption is the option for the payloads of the query cache stored in the cache adapter,
+// which is usually used for the shared cache adapter like redis, as cached records might contain
+// sensitive data.
+type CachePayloadOption struct {
+	// Compress specifies compressing the payloads using gzip.
+	Compress bool
+
+	// Keys are the AES keys for encrypting the payloads using AES-GCM, which are 16, 24 or 32 bytes
+	// for AES-128, AES-192 or AES-256. The first key is used for encrypting, and all keys are used
+	// for decrypting by their ids, so that keys can be rotated by prepending a new key and removing
+	// the old one after the cached payloads of the old key expire.
+	// The payloads are not encrypted if it is empty.
+	Keys []CacheKey
+}
+
+// CacheKey is the encryption key of the query cache payloads.
+type CacheKey struct {
+	Id  string // Id of the key stored along with the payload, which is up to 255 bytes.
+	Key []byte // AES key, which is 16, 24 or 32 bytes.
+}
+
+// cachePayloadCodec encodes and decodes the query cache payloads.
+type cachePayloadCodec struct {
+	compress bool
+	aeads    map[string]cipher.AEAD // AEAD ciphers by key id.
+	keyId    string                 // Key id for encrypting, which is empty if encryption is disabled.
+}
+
+const (
+	cachePayloadFlagCompressed byte = 1 << iota
+	cachePayloadFlagEncrypted
+)
+
+// cachePayloadMagic is the prefix of the encoded payload, which distinguishes it from the plain one.
+var cachePayloadMagic = []byte("gfdbc\x01")
+
+// SetCachePayloadOption sets the option for the query cache payloads of the database, which
+// compresses and encrypts the payloads transparently. The payloads cached without the option,
+// or with the key removed, are ignored as cache missing.
+//
+// Example:
+//
+//	err := db.GetCore().SetCachePayloadOption(gdb.CachePayloadOption{
+//		Compress: true,
+//		Keys:     []gdb.CacheKey{{Id: "2024-06", Key: newKey}, {Id: "2024-01", Key: oldKey}},
+//	})
+func (c *Core) SetCachePayloadOption(option CachePayloadOption) error {
+	codec := &cachePayloadCodec{
+		compress: option.Compress,
+		aeads:    make(map[string]cipher.AEAD, len(option.Keys)),
+	}
+	for i, key := range option.Keys {
+		if len(key.Id) > 255 {
+			return gerror.NewCodef(gcode.CodeInvalidParameter, `cache key id "%s" is too long`, key.Id)
+		}
+		if _, ok := codec.aeads[key.Id]; ok {
+			return gerror.NewCodef(gcode.CodeInvalidParameter, `duplicated cache key id "%s"`, key.Id)
+		}
+		block, err := aes.NewCipher(key.Key)
+		if err != nil {
+			return gerror.WrapCodef(gcode.CodeInvalidParameter, err, `invalid cache key "%s"`, key.Id)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return gerror.WrapCodef(gcode.CodeInvalidParameter, err, `invalid cache key "%s"`, key.Id)
+		}
+		codec.aeads[key.Id] = aead
+		if i == 0 {
+			codec.keyId = key.Id
+		}
+	}
+	if !codec.compress && len(codec.aeads) == 0 {
+		codec = nil
+	}
+	c.cachePayloadCodec.Set(codec)
+	return nil
+}
+
+// getCachePayloadCodec returns the codec of the query cache payloads, which is nil if not set.
+func (c *Core) getCachePayloadCodec() *cachePayloadCodec {
+	codec, _ := c.cachePayloadCodec.Val().(*cachePayloadCodec)
+	return codec
+}
+
+// encode encodes the cache item to payload.
+func (p *cachePayloadCodec) encode(item *selectCacheItem) ([]byte, error) {
+	var flags byte
+	content, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	if p.compress {
+		if content, err = gcompress.Gzip(content); err != nil {
+			return nil, err
+		}
+		flags |= cachePayloadFlagCompressed
+	}
+	var buffer bytes.Buffer
+	if len(p.aeads) == 0 {
+		buffer.Write(cachePayloadMagic)
+		buffer.WriteByte(flags)
+		buffer.Write(content)
+		return buffer.Bytes(), nil
+	}
+	var (
+		aead  = p.aeads[p.keyId]
+		nonce = make([]byte, aead.NonceSize())
+	)
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, gerror.Wrap(err, `generate nonce for cache payload failed`)
+	}
+	flags |= cachePayloadFlagEncrypted
+	buffer.Write(cachePayloadMagic)
+	buffer.WriteByte(flags)
+	buffer.WriteByte(byte(len(p.keyId)))
+	buffer.WriteString(p.keyId)
+	buffer.Write(nonce)
+	// The header is authenticated as additional data.
+	header := buffer.Bytes()
+	return aead.Seal(header, nonce, content, header), nil
+}
+
+// decode decodes the payload to the cache item.
+// It returns nil item if the payload is not encoded with current option, like the plain one or
+// the one encrypted with removed key.
+func (p *cachePayloadCodec) decode(payload []byte) (*selectCacheItem, error) {
+	if !bytes.HasPrefix(payload, cachePayloadMagic) || len(payload) <= len(cachePayloadMagic) {
+		return nil, nil
+	}
+	var (
+		flags   = payload[len(cachePayloadMagic)]
+		content = payload[len(cachePayloadMagic)+1:]
+		err     error
+	)
+	if flags&cachePayloadFlagEncrypted > 0 {
+		if len(content) < 1 || len(content) < 1+int(content[0]) {
+			return nil, gerror.NewCode(gcode.CodeInvalidParameter, `invalid cache payload`)
+		}
+		var (
+			keyId     = string(content[1 : 1+int(content[0])])
+			aead, ok  = p.aeads[keyId]
+			headerLen = len(cachePayloadMagic) + 2 + len(keyId)
+		)
+		if !ok {
+			return nil, nil
+		}
+		if len(payload) < headerLen+aead.NonceSize() {
+			return nil, gerror.NewCode(gcode.CodeInvalidParameter, `invalid cache payload`)
+		}
+		var (
+			header = payload[:headerLen+aead.NonceSize()]
+			nonce  = payload[headerLen : headerLen+aead.NonceSize()]
+		)
+		if content, err = aead.Open(nil, nonce, payload[len(header):], header); err != nil {
+			return nil, gerror.WrapCode(gcode.CodeInvalidParameter, err, `decrypt cache payload failed`)
+		}
+	} else if len(p.aeads) > 0 {
+		// The plain payload is ignored if encryption is enabled.
+		return nil, nil
+	}
+	if flags&cachePayloadFlagCompressed > 0 {
+		if content, err = gcompress.UnGzip(content); err != nil {
+			return nil, err
+		}
+	}
+	var item *selectCacheItem
+	if err = json.Unmarshal(content, &item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
@@ -92,6 +92,24 @@ type ConfigNode struct {
 	// Optional field
 	Timezone string `json:"timezone"`
 
+	// SessionTimezone sets the time zone of the database session, eg: "UTC", "+08:00", "Asia/Shanghai",
+	// which is used by the database for time functions like NOW() and the conversion of TIMESTAMP columns
+	// Optional field, it is supported by mysql, mariadb, tidb, oceanbase, pgsql and gaussdb
+	SessionTimezone string `json:"sessionTimezone"`
+
+	// TimeStoreUTC specifies converting the time values to UTC before they are committed to database,
+	// and considering the time strings without zone information read from database as UTC,
+	// which keeps the stored time values independent of the local time zone of the deployments
+	// Optional field, it applies to the datetime and timestamp types but not the date and time types,
+	// and only the data of writes are converted but not the arguments of conditions
+	TimeStoreUTC bool `json:"timeStoreUtc"`
+
+	// TimeScanLocation specifies the location name that the time values read from database are
+	// converted to, eg: "UTC", "Local", "Asia/Shanghai"
+	// Optional field, the time values keep the location given by the driver in default,
+	// it applies to the datetime and timestamp types but not the date and time types
+	TimeScanLocation string `json:"timeScanLocation"`
+
 	// Namespace specifies the schema namespace for certain databases
 	// Optional field, e.g., in PostgreSQL, Name is the catalog and Namespace is the schema
 	Namespace string `json:"namespace"`
@@ -129,6 +147,11 @@ type ConfigNode struct {
 	// Optional field
 	PrepareTimeout time.Duration `json:"prepareTimeout"`
 
+	// BudgetMinTimeout specifies the minimum remaining budget of the context for statements, see gctx.WithBudget.
+	// The statement fails fast with gctx.ErrBudgetExhausted if the remaining budget is less than it.
+	// Optional field
+	BudgetMinTimeout time.Duration `json:"budgetMinTimeout"`
+
 	// CreatedAt specifies the field name for automatic timestamp on record creation
 	// Optional field
 	CreatedAt string `json:"createdAt"`
@@ -144,6 +167,90 @@ type ConfigNode struct {
 	// TimeMaintainDisabled controls whether automatic time maintenance is disabled
 	// Optional field
 	TimeMaintainDisabled bool `json:"timeMaintainDisabled"`
+
+	// ModelOmitEmptyWhere enables the OmitEmptyWhere option for all models created from this group
+	// Optional field
+	ModelOmitEmptyWhere bool `json:"modelOmitEmptyWhere"`
+
+	// ModelSafe enables the safe mode for all models created from this group
+	// Optional field
+	ModelSafe bool `json:"modelSafe"`
+
+	// ModelCacheDuration specifies the default cache TTL for Model.Cache/PageCache of this group,
+	// which is used if the Duration of CacheOption is 0 that means never expiring
+	// Optional field
+	ModelCacheDuration time.Duration `json:"modelCacheDuration"`
+
+	// QuoteDisabled disables quoting the table and field names with the quote chars of the driver
+	// Optional field, it should be used only if all the names are not keywords of the database
+	QuoteDisabled bool `json:"quoteDisabled"`
+
+	// ModelInSplitSize specifies the maximum count of values of the IN condition in one select statement
+	// for all models created from this group, see Model.InSplit
+	// Optional field
+	ModelInSplitSize int `json:"modelInSplitSize"`
+
+	// LogRedactColumns specifies the column name patterns separated by char ',', of which the
+	// sql arguments are redacted in logs, traces and error messages, eg: "password,*token*"
+	// Optional field
+	LogRedactColumns string `json:"logRedactColumns"`
+
+	// Sanitizers specifies the sanitizers of the column values of the data of insert/update/save operations,
+	// which are column name patterns with sanitizer names separated by char ';',
+	// eg: "email:trim,lower;*name:trim,nfc", see SanitizeRule
+	// Optional field
+	Sanitizers string `json:"sanitizers"`
+
+	// MaxResultRows specifies the default maximum count of rows of the result set for
+	// the select statements of Model, it is not limited if it is 0.
+	// Optional field
+	MaxResultRows int `json:"maxResultRows"`
+
+	// MaxResultBytes specifies the default maximum estimated bytes of the result set for
+	// the select statements of Model, it is not limited if it is 0.
+	// Optional field
+	MaxResultBytes int64 `json:"maxResultBytes"`
+
+	// MaxResultTruncate specifies truncating the result set to the limit of MaxResultRows and
+	// MaxResultBytes instead of returning error, the truncation is logged as warning.
+	// Optional field
+	MaxResultTruncate bool `json:"maxResultTruncate"`
+
+	// LeakDetect enables the debug mode tracking the rows and prepared statements opened by the
+	// statements with their stack traces, which logs the ones not closed after LeakTimeout
+	// Optional field, it should be used only for diagnosing as it captures stack for each query
+	LeakDetect bool `json:"leakDetect"`
+
+	// LeakTimeout specifies the duration after which the open rows or prepared statement is
+	// considered leaked in LeakDetect mode
+	// Optional field, defaults to 30 seconds
+	LeakTimeout time.Duration `json:"leakTimeout"`
+
+	// IndexAdvise enables the debug mode analyzer explaining the slow select statements of Model, which logs
+	// the index suggestions for full table scans and filesorts along with the caller of the statement
+	// Optional field, it works only if Debug is enabled as it executes extra EXPLAIN statements
+	IndexAdvise bool `json:"indexAdvise"`
+
+	// IndexAdviseThreshold specifies the duration after which the select statement is considered
+	// slow and analyzed in IndexAdvise mode
+	// Optional field, defaults to 200 milliseconds
+	IndexAdviseThreshold time.Duration `json:"indexAdviseThreshold"`
+
+	// StatementTimeout specifies the server-side execution timeout of the statements of the database sessions,
+	// which is the session variable max_execution_time for mysql (select statements only) and
+	// statement_timeout for pgsql, see also Model.StatementTimeout
+	// Optional field, it is not limited if it is 0
+	StatementTimeout time.Duration `json:"statementTimeout"`
+
+	// OutboxTable specifies the table of the outbox messages written by TX.Outbox and relayed by OutboxRelay
+	// Optional field, defaults to "gf_outbox"
+	OutboxTable string `json:"outboxTable"`
+
+	// RequestIdComment enables appending the request id of the context to the executed and queried
+	// statements as a comment like "/* request_id=xxx */", which correlates them with the request in database logs
+	// Optional field, note that it makes the statement text unique per request, which defeats the plan or digest
+	// caches of the database server, and it is never applied to the prepared statements
+	RequestIdComment bool `json:"requestIdComment"`
 }
 
 type Role string
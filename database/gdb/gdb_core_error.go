@@ -0,0 +1,86 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// classifiedError is the driver-native error classified by ClassifyError,
+// which keeps the message of the native error and carries the classified error code.
+type classifiedError struct {
+	error
+	code gcode.Code
+}
+
+// Code returns the classified error code.
+func (e *classifiedError) Code() gcode.Code {
+	return e.code
+}
+
+// Unwrap returns the driver-native error.
+func (e *classifiedError) Unwrap() error {
+	return e.error
+}
+
+// ClassifyError classifies the driver-native error `err` into the stable error code, like
+// gcode.CodeDbDuplicateKey, gcode.CodeDbDeadlock, etc. It returns gcode.CodeNil if the error
+// cannot be classified. The drivers override it to classify their own native errors.
+//
+// The classified code is carried by the error returned from the statements of the database,
+// which can be checked by gerror.HasCode, eg:
+//
+//	if gerror.HasCode(err, gcode.CodeDbDuplicateKey) {
+//		// ...
+//	}
+func (c *Core) ClassifyError(err error) gcode.Code {
+	return classifyCommonError(err)
+}
+
+// classifyCommonError classifies the common errors of the connection, which are shared by all drivers.
+func classifyCommonError(err error) gcode.Code {
+	if err == nil {
+		return gcode.CodeNil
+	}
+	var netErr net.Error
+	if errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.As(err, &netErr) {
+		return gcode.CodeDbConnectionLost
+	}
+	return gcode.CodeNil
+}
+
+// IsRetryableError checks and returns whether the error returned from the database is transient,
+// which is caused by deadlock, serialization failure or lost connection, so that the
+// transaction or statement can be retried. It is the default checker of Model.Retry and Model.Failover.
+//
+// The error is checked by its classified error code, and the common connection errors
+// that are not classified by the driver, like driver.ErrBadConn, are considered as lost connection.
+func IsRetryableError(err error) bool {
+	return gerror.HasCode(err, gcode.CodeDbDeadlock) ||
+		gerror.HasCode(err, gcode.CodeDbSerializationFailure) ||
+		gerror.HasCode(err, gcode.CodeDbConnectionLost) ||
+		classifyCommonError(err) == gcode.CodeDbConnectionLost
+}
+
+// classifyError classifies the driver-native error by the driver, and returns the error
+// carrying the classified code, or `err` itself if it cannot be classified.
+func (c *Core) classifyError(err error) error {
+	if code := c.db.ClassifyError(err); code != gcode.CodeNil {
+		return &classifiedError{error: err, code: code}
+	}
+	return err
+}
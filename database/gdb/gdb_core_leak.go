@@ -0,0 +1,246 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogf/gf/v2/debug/gdebug"
+)
+
+// CursorType is the type of the cursor tracked by the leak detector.
+type CursorType string
+
+const (
+	CursorTypeRows CursorType = "rows" // The rows of query statement.
+	CursorTypeStmt CursorType = "stmt" // The prepared statement.
+)
+
+const (
+	defaultLeakTimeout = 30 * time.Second
+	leakStackFilterKey = "/database/gdb/"
+)
+
+// OpenCursor is the rows or prepared statement tracked by the leak detector, which is not closed yet.
+type OpenCursor struct {
+	Type     CursorType // Type of the cursor.
+	Sql      string     // Sql statement opening the cursor.
+	Stack    string     // Stack trace of the caller opening the cursor.
+	OpenTime time.Time  // Time when the cursor is opened.
+	Leaked   bool       // Whether the cursor has been reported as leaked.
+}
+
+// CursorStats is the statistics of the cursors tracked by the leak detector.
+type CursorStats struct {
+	OpenRows  int   // Count of the open rows.
+	OpenStmts int   // Count of the open prepared statements.
+	Leaked    int64 // Total count of the cursors reported as leaked.
+}
+
+// leakTracker tracks the open rows and prepared statements for detecting leaks.
+type leakTracker struct {
+	mu      sync.Mutex
+	cursors map[any]*trackedCursor // Key is *sql.Rows or *sql.Stmt.
+	leaked  int64
+}
+
+// trackedCursor is the tracking item of an open cursor.
+type trackedCursor struct {
+	OpenCursor
+	timer    *time.Timer
+	isClosed func() bool // isClosed checks whether the cursor is closed by others, it can be nil.
+}
+
+func newLeakTracker() *leakTracker {
+	return &leakTracker{
+		cursors: make(map[any]*trackedCursor),
+	}
+}
+
+// isLeakDetectEnabled checks whether the leak detector is enabled for current database.
+func (c *Core) isLeakDetectEnabled() bool {
+	if c.leakTracker == nil {
+		return false
+	}
+	config := c.db.GetConfig()
+	return config != nil && config.LeakDetect
+}
+
+// trackRows tracks the opened `rows` of statement `sqlStr` in the leak detector.
+func (c *Core) trackRows(ctx context.Context, rows *sql.Rows, sqlStr string) {
+	if rows == nil || !c.isLeakDetectEnabled() {
+		return
+	}
+	c.trackCursor(ctx, rows, CursorTypeRows, sqlStr, func() bool {
+		// The rows is closed by either Close or the end of Next, in which cases Columns returns error.
+		_, err := rows.Columns()
+		return err != nil
+	})
+}
+
+// untrackRows removes the closed `rows` from the leak detector.
+func (c *Core) untrackRows(ctx context.Context, rows *sql.Rows) {
+	c.untrackCursor(ctx, rows)
+}
+
+// trackStmt tracks the prepared statement `stmt` of `sqlStr` in the leak detector.
+func (c *Core) trackStmt(ctx context.Context, stmt *sql.Stmt, sqlStr string) {
+	if stmt == nil || !c.isLeakDetectEnabled() {
+		return
+	}
+	c.trackCursor(ctx, stmt, CursorTypeStmt, sqlStr, nil)
+}
+
+// untrackStmt removes the closed prepared statement `stmt` from the leak detector.
+func (c *Core) untrackStmt(ctx context.Context, stmt *sql.Stmt) {
+	c.untrackCursor(ctx, stmt)
+}
+
+func (c *Core) trackCursor(ctx context.Context, key any, cursorType CursorType, sqlStr string, isClosed func() bool) {
+	var (
+		timeout = c.db.GetConfig().LeakTimeout
+		tracker = c.leakTracker
+		cursor  = &trackedCursor{
+			OpenCursor: OpenCursor{
+				Type:     cursorType,
+				Sql:      sqlStr,
+				Stack:    gdebug.StackWithFilter([]string{leakStackFilterKey}),
+				OpenTime: time.Now(),
+			},
+			isClosed: isClosed,
+		}
+	)
+	if timeout <= 0 {
+		timeout = defaultLeakTimeout
+	}
+	// The context of the statement may be canceled before the timeout, which should not
+	// be used for the logging and metrics of the leak.
+	ctx = context.WithoutCancel(ctx)
+	tracker.mu.Lock()
+	tracker.cursors[key] = cursor
+	cursor.timer = time.AfterFunc(timeout, func() {
+		c.checkLeakedCursor(ctx, key, cursor, timeout)
+	})
+	tracker.mu.Unlock()
+	metricManager.IncOpenCursor(ctx, c.db, cursorType)
+}
+
+func (c *Core) untrackCursor(ctx context.Context, key any) {
+	tracker := c.leakTracker
+	if tracker == nil {
+		return
+	}
+	tracker.mu.Lock()
+	cursor, ok := tracker.cursors[key]
+	if ok {
+		cursor.timer.Stop()
+		delete(tracker.cursors, key)
+	}
+	tracker.mu.Unlock()
+	if !ok {
+		return
+	}
+	metricManager.DecOpenCursor(ctx, c.db, cursor.Type)
+	if cursor.Leaked {
+		c.logger.Warningf(
+			ctx,
+			`[gdb] leaked %s is closed after %s: %s`,
+			cursor.Type, time.Since(cursor.OpenTime).String(), cursor.Sql,
+		)
+	}
+}
+
+// checkLeakedCursor checks the cursor after the leak timeout, and reports it as leaked if it is not closed.
+func (c *Core) checkLeakedCursor(ctx context.Context, key any, cursor *trackedCursor, timeout time.Duration) {
+	if cursor.isClosed != nil && cursor.isClosed() {
+		c.untrackCursor(ctx, key)
+		return
+	}
+	tracker := c.leakTracker
+	tracker.mu.Lock()
+	if _, ok := tracker.cursors[key]; !ok {
+		tracker.mu.Unlock()
+		return
+	}
+	cursor.Leaked = true
+	tracker.leaked++
+	tracker.mu.Unlock()
+	metricManager.IncLeakedCursor(ctx, c.db, cursor.Type)
+	c.logger.Warningf(
+		ctx,
+		"[gdb] possible %s leak, not closed after %s: %s\nOpened at:\n%s",
+		cursor.Type, timeout.String(), cursor.Sql, cursor.Stack,
+	)
+}
+
+// pruneClosedCursors removes the cursors that are already closed by others from the leak detector.
+func (c *Core) pruneClosedCursors() {
+	var (
+		tracker  = c.leakTracker
+		checkers = make(map[any]func() bool)
+	)
+	tracker.mu.Lock()
+	for key, cursor := range tracker.cursors {
+		if cursor.isClosed != nil {
+			checkers[key] = cursor.isClosed
+		}
+	}
+	tracker.mu.Unlock()
+	for key, isClosed := range checkers {
+		if isClosed() {
+			c.untrackCursor(c.db.GetCtx(), key)
+		}
+	}
+}
+
+// OpenCursors returns the rows and prepared statements that are opened but not closed yet,
+// which are ordered by their open time. It is available only if LeakDetect is enabled in
+// the configuration.
+func (c *Core) OpenCursors() []OpenCursor {
+	tracker := c.leakTracker
+	if tracker == nil {
+		return nil
+	}
+	c.pruneClosedCursors()
+	tracker.mu.Lock()
+	cursors := make([]OpenCursor, 0, len(tracker.cursors))
+	for _, cursor := range tracker.cursors {
+		cursors = append(cursors, cursor.OpenCursor)
+	}
+	tracker.mu.Unlock()
+	sort.SliceStable(cursors, func(i, j int) bool {
+		return cursors[i].OpenTime.Before(cursors[j].OpenTime)
+	})
+	return cursors
+}
+
+// CursorStats returns the statistics of the cursors tracked by the leak detector.
+// It is available only if LeakDetect is enabled in the configuration.
+func (c *Core) CursorStats() CursorStats {
+	var stats CursorStats
+	tracker := c.leakTracker
+	if tracker == nil {
+		return stats
+	}
+	c.pruneClosedCursors()
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	for _, cursor := range tracker.cursors {
+		switch cursor.Type {
+		case CursorTypeRows:
+			stats.OpenRows++
+		case CursorTypeStmt:
+			stats.OpenStmts++
+		}
+	}
+	stats.Leaked = tracker.leaked
+	return stats
+}
@@ -0,0 +1,270 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/util/gmode"
+)
+
+// MaskType is the masking type of MaskRule.
+type MaskType string
+
+const (
+	// MaskTypeRedact replaces the values with MaskRule.Replacement.
+	MaskTypeRedact MaskType = "redact"
+
+	// MaskTypeHash replaces the values with the SHA-256 hash in hex of the salted values, which keeps
+	// the same values the same after masking, so that the masked values can still be joined.
+	MaskTypeHash MaskType = "hash"
+
+	// MaskTypeFaker replaces the values with fake values of MaskRule.Faker kind, which are generated
+	// deterministically from the salted values, so that the same values are masked the same.
+	MaskTypeFaker MaskType = "faker"
+)
+
+const (
+	MaskFakerText  = "text"  // Fake text keeping the length and the letter/digit layout, which is the default faker.
+	MaskFakerName  = "name"  // Fake person name, eg: "Alice Smith".
+	MaskFakerEmail = "email" // Fake email address, eg: "user_1a2b3c4d@example.com".
+	MaskFakerPhone = "phone" // Fake phone number, eg: "555-0123-4567".
+)
+
+// MaskRule is the rule anonymizing the column values of the result, so that production data
+// can be shared with developers safely.
+type MaskRule struct {
+	// Tables specifies the case-insensitive table name patterns the rule applies to,
+	// which support wildcard "*". The rule applies to all tables if it is empty.
+	// Note that only the main table of the Model is matched for joined queries.
+	Tables []string
+
+	// Columns specifies the case-insensitive column name patterns of which the values are masked,
+	// which support wildcard "*", eg: "email", "*phone*".
+	Columns []string
+
+	// Type is the masking type, which is MaskTypeRedact in default.
+	Type MaskType
+
+	// Replacement is the replacement of MaskTypeRedact, it is "******" in default.
+	Replacement string
+
+	// Salt is the salt of MaskTypeHash and MaskTypeFaker, which prevents guessing the original
+	// values from the masked ones by hashing known values.
+	Salt string
+
+	// Faker is the fake value kind of MaskTypeFaker, which is MaskFakerText in default.
+	Faker string
+
+	// Func is the custom masking function, which takes priority over Type if it is not nil.
+	Func func(value Value) any
+}
+
+var (
+	maskFakerFirstNames = []string{
+		"Alice", "Bob", "Carol", "David", "Emma", "Frank", "Grace", "Henry",
+		"Ivy", "Jack", "Kate", "Leo", "Mia", "Noah", "Olivia", "Paul",
+	}
+	maskFakerLastNames = []string{
+		"Smith", "Johnson", "Brown", "Taylor", "Miller", "Wilson", "Moore", "Clark",
+		"Lewis", "Walker", "Hall", "Young", "King", "Wright", "Green", "Baker",
+	}
+)
+
+// SetMaskRules sets the masking rules of the database, which mask the results of the select
+// statements of Model in non-product mode of package gmode, so that developers working with
+// production snapshots never see the original sensitive values.
+func (c *Core) SetMaskRules(rules ...MaskRule) {
+	c.maskRules.Set(rules)
+}
+
+// GetMaskRules returns the masking rules of the database set by SetMaskRules.
+func (c *Core) GetMaskRules() []MaskRule {
+	if rules, ok := c.maskRules.Val().([]MaskRule); ok {
+		return rules
+	}
+	return nil
+}
+
+// Mask sets the masking rules for the model, which mask the results of its select statements
+// in any mode, usually for exporting data.
+//
+// Example:
+//
+//	db.Model("user").Mask(gdb.MaskRule{
+//		Columns: []string{"email"},
+//		Type:    gdb.MaskTypeFaker,
+//		Faker:   gdb.MaskFakerEmail,
+//	}).All()
+func (m *Model) Mask(rules ...MaskRule) *Model {
+	model := m.getModel()
+	model.maskRules = append(append([]MaskRule{}, m.maskRules...), rules...)
+	return model
+}
+
+// maskResult masks the result with the rules of the model and the rules of the database.
+func (m *Model) maskResult(result Result) Result {
+	rules := m.maskRules
+	if !gmode.IsProduct() {
+		if dbRules := m.db.GetCore().GetMaskRules(); len(dbRules) > 0 {
+			rules = append(append([]MaskRule{}, rules...), dbRules...)
+		}
+	}
+	if len(rules) == 0 || len(result) == 0 {
+		return result
+	}
+	return maskResult(m.getMaskTable(), result, rules)
+}
+
+// getMaskTable returns the main table name of the model for matching masking rules.
+func (m *Model) getMaskTable() string {
+	table := strings.TrimSpace(strings.Split(m.tablesInit, ",")[0])
+	if fields := strings.Fields(table); len(fields) > 0 {
+		table = fields[0]
+	}
+	if i := strings.LastIndex(table, "."); i >= 0 {
+		table = table[i+1:]
+	}
+	return strings.Trim(table, "`\"[]")
+}
+
+// maskResult returns the masked copy of `result` of `table` with `rules`.
+// It returns `result` itself if there's nothing masked.
+func maskResult(table string, result Result, rules []MaskRule) Result {
+	if len(result) == 0 {
+		return result
+	}
+	var (
+		lowerTable = strings.ToLower(table)
+		fieldRules = make(map[string]*MaskRule)
+	)
+	for field := range result[0] {
+		lowerField := strings.ToLower(field)
+		for i := range rules {
+			if rules[i].matches(lowerTable, lowerField) {
+				fieldRules[field] = &rules[i]
+				break
+			}
+		}
+	}
+	if len(fieldRules) == 0 {
+		return result
+	}
+	masked := make(Result, len(result))
+	for i, record := range result {
+		newRecord := make(Record, len(record))
+		for field, value := range record {
+			if rule, ok := fieldRules[field]; ok {
+				value = rule.mask(value)
+			}
+			newRecord[field] = value
+		}
+		masked[i] = newRecord
+	}
+	if columnTypes := result.ColumnTypes(); len(columnTypes) > 0 {
+		masked = append(masked, newColumnTypesRecord(columnTypes))[:len(masked)]
+	}
+	return masked
+}
+
+// matches checks whether the lowercase `table` and `column` match the rule.
+func (r *MaskRule) matches(table, column string) bool {
+	if len(r.Tables) > 0 {
+		var tableMatched bool
+		for _, pattern := range r.Tables {
+			if ok, _ := path.Match(strings.ToLower(pattern), table); ok {
+				tableMatched = true
+				break
+			}
+		}
+		if !tableMatched {
+			return false
+		}
+	}
+	for _, pattern := range r.Columns {
+		if ok, _ := path.Match(strings.ToLower(pattern), column); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// mask returns the masked value, in which NULL value is kept as NULL.
+func (r *MaskRule) mask(value Value) Value {
+	if value == nil || value.IsNil() {
+		return value
+	}
+	if r.Func != nil {
+		return gvar.New(r.Func(value))
+	}
+	switch r.Type {
+	case MaskTypeHash:
+		sum := sha256.Sum256([]byte(r.Salt + value.String()))
+		return gvar.New(hex.EncodeToString(sum[:]))
+
+	case MaskTypeFaker:
+		return gvar.New(r.fake(value.String()))
+
+	default:
+		if r.Replacement != "" {
+			return gvar.New(r.Replacement)
+		}
+		return gvar.New(defaultRedactionMask)
+	}
+}
+
+// fake generates the fake value for `s` deterministically.
+func (r *MaskRule) fake(s string) string {
+	var (
+		sum  = sha256.Sum256([]byte(r.Salt + s))
+		seed = binary.BigEndian.Uint64(sum[:8])
+	)
+	switch r.Faker {
+	case MaskFakerName:
+		return maskFakerFirstNames[seed%uint64(len(maskFakerFirstNames))] + " " +
+			maskFakerLastNames[(seed>>32)%uint64(len(maskFakerLastNames))]
+
+	case MaskFakerEmail:
+		return fmt.Sprintf("user_%s@example.com", hex.EncodeToString(sum[:4]))
+
+	case MaskFakerPhone:
+		return fmt.Sprintf("555-%04d-%04d", seed%10000, (seed>>32)%10000)
+
+	default:
+		var (
+			runes = []rune(s)
+			index = 0
+		)
+		for i, c := range runes {
+			// It rehashes for the next bytes if the bytes of the hash are used up.
+			if index > 0 && index%len(sum) == 0 {
+				sum = sha256.Sum256(sum[:])
+			}
+			b := sum[index%len(sum)]
+			switch {
+			case c >= 'a' && c <= 'z':
+				runes[i] = rune('a' + b%26)
+			case c >= 'A' && c <= 'Z':
+				runes[i] = rune('A' + b%26)
+			case c >= '0' && c <= '9':
+				runes[i] = rune('0' + b%10)
+			case c > 0x7f:
+				runes[i] = rune('x')
+			default:
+				continue
+			}
+			index++
+		}
+		return string(runes)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gogf/gf/v2/os/gctx"
+)
+
+// masterReadTracker tracks the last write time in a context,
+// which is used for the read-your-writes feature.
+type masterReadTracker struct {
+	duration  time.Duration // Duration of pinning reads to master after a write.
+	lastWrite atomic.Int64  // Last write timestamp in nanoseconds.
+}
+
+const (
+	ctxKeyMasterRead        gctx.StrKey = `CtxKeyMasterRead`
+	ctxKeyMasterReadTracker gctx.StrKey = `CtxKeyMasterReadTracker`
+)
+
+// WithMasterRead returns a new context that forces all read statements in the context
+// to be executed on master node, which is used for reading the just written data that
+// might not be synchronized to slave nodes yet.
+func WithMasterRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxKeyMasterRead, true)
+}
+
+// WithReadYourWrites returns a new context that pins the read statements to master node
+// for `duration` after any write statement executed in the context, so that pages rendered
+// right after a write won't read stale data from slave nodes.
+//
+// The context is usually created once per request or session and passed all through the
+// logic procedure.
+func WithReadYourWrites(ctx context.Context, duration time.Duration) context.Context {
+	return context.WithValue(ctx, ctxKeyMasterReadTracker, &masterReadTracker{
+		duration: duration,
+	})
+}
+
+// IsMasterRead checks and returns whether the read statements in given context
+// should be executed on master node.
+func IsMasterRead(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	if v, ok := ctx.Value(ctxKeyMasterRead).(bool); ok && v {
+		return true
+	}
+	if tracker, ok := ctx.Value(ctxKeyMasterReadTracker).(*masterReadTracker); ok {
+		lastWrite := tracker.lastWrite.Load()
+		if lastWrite == 0 {
+			return false
+		}
+		return time.Now().UnixNano()-lastWrite < int64(tracker.duration)
+	}
+	return false
+}
+
+// markWriteInCtx marks a write statement executed in the context for the read-your-writes feature.
+func markWriteInCtx(ctx context.Context) {
+	if tracker, ok := ctx.Value(ctxKeyMasterReadTracker).(*masterReadTracker); ok {
+		tracker.lastWrite.Store(time.Now().UnixNano())
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2"
+	"github.com/gogf/gf/v2/os/gmetric"
+)
+
+type localMetricManager struct {
+	DbClientStatementRetryTotal gmetric.Counter
+	DbClientCursorOpen          gmetric.UpDownCounter
+	DbClientCursorLeakTotal     gmetric.Counter
+}
+
+const (
+	metricAttrKeyDbType      = "db.type"
+	metricAttrKeyDbGroup     = "db.group"
+	metricAttrKeyDbOperation = "db.operation"
+	metricAttrKeyCursorType  = "db.cursor.type"
+)
+
+var (
+	// metricManager for database client metrics.
+	metricManager = newMetricManager()
+)
+
+func newMetricManager() *localMetricManager {
+	meter := gmetric.GetGlobalProvider().Meter(gmetric.MeterOption{
+		Instrument:        traceInstrumentName,
+		InstrumentVersion: gf.VERSION,
+	})
+	mm := &localMetricManager{
+		DbClientStatementRetryTotal: meter.MustCounter(
+			"db.client.statement.retry.total",
+			gmetric.MetricOption{
+				Help:       "Total retried statement number for transient errors.",
+				Unit:       "",
+				Attributes: gmetric.Attributes{},
+			},
+		),
+		DbClientCursorOpen: meter.MustUpDownCounter(
+			"db.client.cursor.open",
+			gmetric.MetricOption{
+				Help:       "Number of the open rows and prepared statements tracked in LeakDetect mode.",
+				Unit:       "",
+				Attributes: gmetric.Attributes{},
+			},
+		),
+		DbClientCursorLeakTotal: meter.MustCounter(
+			"db.client.cursor.leak.total",
+			gmetric.MetricOption{
+				Help:       "Total number of the rows and prepared statements reported as leaked in LeakDetect mode.",
+				Unit:       "",
+				Attributes: gmetric.Attributes{},
+			},
+		),
+	}
+	return mm
+}
+
+// GetMetricOptionForStatement returns the metric option for statement of given operation.
+func (m *localMetricManager) GetMetricOptionForStatement(db DB, operation string) gmetric.Option {
+	return gmetric.Option{
+		Attributes: gmetric.Attributes{
+			gmetric.NewAttribute(metricAttrKeyDbType, db.GetConfig().Type),
+			gmetric.NewAttribute(metricAttrKeyDbGroup, db.GetGroup()),
+			gmetric.NewAttribute(metricAttrKeyDbOperation, operation),
+		},
+	}
+}
+
+// IncStatementRetry increases the retry counter for statement of given operation.
+func (m *localMetricManager) IncStatementRetry(ctx context.Context, db DB, operation string) {
+	if !gmetric.IsEnabled() {
+		return
+	}
+	m.DbClientStatementRetryTotal.Inc(ctx, m.GetMetricOptionForStatement(db, operation))
+}
+
+// GetMetricOptionForCursor returns the metric option for cursor of given type.
+func (m *localMetricManager) GetMetricOptionForCursor(db DB, cursorType CursorType) gmetric.Option {
+	return gmetric.Option{
+		Attributes: gmetric.Attributes{
+			gmetric.NewAttribute(metricAttrKeyDbType, db.GetConfig().Type),
+			gmetric.NewAttribute(metricAttrKeyDbGroup, db.GetGroup()),
+			gmetric.NewAttribute(metricAttrKeyCursorType, string(cursorType)),
+		},
+	}
+}
+
+// IncOpenCursor increases the open cursor counter for cursor of given type.
+func (m *localMetricManager) IncOpenCursor(ctx context.Context, db DB, cursorType CursorType) {
+	if !gmetric.IsEnabled() {
+		return
+	}
+	m.DbClientCursorOpen.Inc(ctx, m.GetMetricOptionForCursor(db, cursorType))
+}
+
+// DecOpenCursor decreases the open cursor counter for cursor of given type.
+func (m *localMetricManager) DecOpenCursor(ctx context.Context, db DB, cursorType CursorType) {
+	if !gmetric.IsEnabled() {
+		return
+	}
+	m.DbClientCursorOpen.Dec(ctx, m.GetMetricOptionForCursor(db, cursorType))
+}
+
+// IncLeakedCursor increases the leak counter for cursor of given type.
+func (m *localMetricManager) IncLeakedCursor(ctx context.Context, db DB, cursorType CursorType) {
+	if !gmetric.IsEnabled() {
+		return
+	}
+	m.DbClientCursorLeakTotal.Inc(ctx, m.GetMetricOptionForCursor(db, cursorType))
+}
@@ -0,0 +1,201 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/gogf/gf/v2/os/gctx"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+// RedactionRule is the rule redacting the sql arguments before the sql is written to logs,
+// traces and error messages, so that sensitive values like passwords and tokens are not exposed.
+type RedactionRule struct {
+	// Columns specifies the case-insensitive column name patterns of which the arguments are redacted,
+	// which support wildcard "*", eg: "password", "*token*".
+	// The column of an argument is recognized from the "column=?" like condition or the
+	// column list of the "INSERT INTO" statement.
+	Columns []string
+
+	// ArgIndexes specifies the indexes of the arguments to be redacted, starting from 0.
+	ArgIndexes []int
+
+	// Replacement is the replacement of the redacted arguments, it is "******" in default.
+	Replacement string
+}
+
+const (
+	ctxKeyForRedactionRules gctx.StrKey = `CtxKeyForRedactionRules`
+	defaultRedactionMask                = "******"
+)
+
+var (
+	// redactionPlaceholderRegex matches the placeholders of the sql, which is the same as FormatSqlWithArgs.
+	redactionPlaceholderRegex = regexp.MustCompile(`\?|:v\d+|\$\d+|@p\d+`)
+
+	// redactionColumnRegex matches the column name before the placeholder, eg: `password`=, "token" LIKE.
+	redactionColumnRegex = regexp.MustCompile(
+		`(?i)([\w]+)[\x60"\]]?\s*(?:=|<>|!=|>=|<=|>|<|\s(?:NOT\s+)?(?:LIKE|IN)\s*\(?)\s*$`,
+	)
+
+	// redactionListRegex matches the list separator before the placeholder, eg: IN(?, ?).
+	redactionListRegex = regexp.MustCompile(`(?:\?|:v\d+|\$\d+|@p\d+)\s*,\s*$`)
+
+	// redactionInsertRegex matches the column list of "INSERT INTO" statement.
+	redactionInsertRegex = regexp.MustCompile(`(?is)^\s*(?:INSERT|REPLACE)\b.*?\bINTO\s+[^(]+\(([^)]*)\)\s*VALUES`)
+)
+
+// WithRedaction returns a new context containing the redaction rules, which are applied along
+// with the rules of the configuration for the statements committed with the context.
+func WithRedaction(ctx context.Context, rules ...RedactionRule) context.Context {
+	if len(rules) == 0 {
+		return ctx
+	}
+	if existing, ok := ctx.Value(ctxKeyForRedactionRules).([]RedactionRule); ok {
+		rules = append(append([]RedactionRule{}, existing...), rules...)
+	}
+	return context.WithValue(ctx, ctxKeyForRedactionRules, rules)
+}
+
+// formatSqlForLogging formats the sql with arguments for logging, tracing and error messages,
+// in which the arguments are redacted by the rules of the configuration and context.
+func (c *Core) formatSqlForLogging(ctx context.Context, sql string, args []any) string {
+	var rules []RedactionRule
+	if config := c.db.GetConfig(); config != nil && config.LogRedactColumns != "" {
+		rules = append(rules, RedactionRule{
+			Columns: gstr.SplitAndTrim(config.LogRedactColumns, ","),
+		})
+	}
+	if ctx != nil {
+		if ctxRules, ok := ctx.Value(ctxKeyForRedactionRules).([]RedactionRule); ok {
+			rules = append(rules, ctxRules...)
+		}
+	}
+	if len(rules) > 0 && len(args) > 0 {
+		args = redactSqlArgs(sql, args, rules)
+	}
+	return FormatSqlWithArgs(sql, args)
+}
+
+// redactSqlArgs returns the arguments of which the values matching `rules` are replaced.
+// It returns `args` itself if there's nothing redacted.
+func redactSqlArgs(sql string, args []any, rules []RedactionRule) []any {
+	var (
+		newArgs       []any
+		prevColumn    string
+		locations     = redactionPlaceholderRegex.FindAllStringIndex(sql, -1)
+		insertColumns = getInsertPlaceholderColumns(sql)
+	)
+	for i, location := range locations {
+		if i >= len(args) {
+			break
+		}
+		column, ok := insertColumns[location[0]]
+		if !ok {
+			prefix := sql[:location[0]]
+			if match := redactionColumnRegex.FindStringSubmatch(prefix); len(match) > 1 {
+				column = match[1]
+			} else if redactionListRegex.MatchString(prefix) {
+				column = prevColumn
+			}
+		}
+		prevColumn = column
+		if replacement, redacted := matchRedactionRules(rules, i, column); redacted {
+			if newArgs == nil {
+				newArgs = make([]any, len(args))
+				copy(newArgs, args)
+			}
+			newArgs[i] = replacement
+		}
+	}
+	if newArgs == nil {
+		return args
+	}
+	return newArgs
+}
+
+// getInsertPlaceholderColumns returns the mapping from the placeholder position to the column name
+// for the VALUES part of "INSERT INTO" statement, eg: INSERT INTO `user`(`id`,`password`) VALUES(?,?),(?,?).
+func getInsertPlaceholderColumns(sql string) map[int]string {
+	match := redactionInsertRegex.FindStringSubmatchIndex(sql)
+	if len(match) < 4 {
+		return nil
+	}
+	var (
+		valuesSql    = sql[match[1]:]
+		columns      = strings.Split(sql[match[2]:match[3]], ",")
+		placeholders = make(map[int]struct{})
+		positions    = make(map[int]string)
+		depth        = 0 // Depth of the brackets, which is 1 in the value tuple.
+		index        = 0 // Column index of the value in the tuple.
+	)
+	for i, column := range columns {
+		columns[i] = strings.Trim(strings.TrimSpace(column), "`\"[]")
+	}
+	for _, location := range redactionPlaceholderRegex.FindAllStringIndex(valuesSql, -1) {
+		placeholders[location[0]] = struct{}{}
+	}
+	for i := 0; i < len(valuesSql); i++ {
+		if _, ok := placeholders[i]; ok {
+			if depth == 1 && index < len(columns) {
+				positions[match[1]+i] = columns[index]
+			}
+			continue
+		}
+		switch valuesSql[i] {
+		case '(':
+			depth++
+			if depth == 1 {
+				index = 0
+			}
+		case ')':
+			depth--
+		case ',':
+			if depth == 1 {
+				index++
+			}
+		}
+	}
+	return positions
+}
+
+// matchRedactionRules checks whether the argument of `index` and `column` matches any of `rules`,
+// and returns the replacement.
+func matchRedactionRules(rules []RedactionRule, index int, column string) (replacement string, redacted bool) {
+	column = strings.ToLower(column)
+	for _, rule := range rules {
+		if rule.matches(index, column) {
+			if rule.Replacement != "" {
+				return rule.Replacement, true
+			}
+			return defaultRedactionMask, true
+		}
+	}
+	return "", false
+}
+
+// matches checks whether the argument of `index` and lowercase `column` matches the rule.
+func (r RedactionRule) matches(index int, column string) bool {
+	for _, argIndex := range r.ArgIndexes {
+		if argIndex == index {
+			return true
+		}
+	}
+	if column == "" {
+		return false
+	}
+	for _, pattern := range r.Columns {
+		if ok, _ := path.Match(strings.ToLower(pattern), column); ok {
+			return true
+		}
+	}
+	return false
+}
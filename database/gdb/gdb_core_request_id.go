@@ -0,0 +1,33 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/os/gctx"
+)
+
+// appendRequestIdComment appends the request id of the context to `sql` as a comment like
+// "/* request_id=xxx */", so that the slow query logs and process lists of the database can be
+// correlated with the request. It returns `sql` unchanged if there's no request id in the context,
+// or the request id contains characters other than letters, digits and "-", "_", ".", ":".
+func appendRequestIdComment(ctx context.Context, sql string) string {
+	requestId := gctx.RequestId(ctx)
+	if requestId == "" || sql == "" {
+		return sql
+	}
+	for i := 0; i < len(requestId); i++ {
+		c := requestId[i]
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == ':' {
+			continue
+		}
+		return sql
+	}
+	return sql + " /* request_id=" + requestId + " */"
+}
@@ -0,0 +1,197 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"path"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+// SanitizeFunc is the function sanitizing the column value before it is written to database.
+type SanitizeFunc func(value any) any
+
+const (
+	SanitizerTrim  = "trim"  // Removes the leading and trailing white spaces of string.
+	SanitizerLower = "lower" // Converts string to lower case, eg: for email.
+	SanitizerUpper = "upper" // Converts string to upper case, eg: for country code.
+	SanitizerNFC   = "nfc"   // Normalizes string to unicode normalization form C.
+	SanitizerNFKC  = "nfkc"  // Normalizes string to unicode normalization form KC, eg: full-width to half-width.
+)
+
+// SanitizeRule is the rule sanitizing the column values of the data of insert/update/save operations,
+// so that the data hygiene like trimming and lowering is not duplicated in every handler.
+type SanitizeRule struct {
+	// Tables specifies the case-insensitive table name patterns the rule applies to,
+	// which support wildcard "*". The rule applies to all tables if it is empty.
+	Tables []string
+
+	// Columns specifies the case-insensitive column name patterns of which the values are sanitized,
+	// which support wildcard "*", eg: "email", "*_name".
+	Columns []string
+
+	// Sanitizers specifies the names of the sanitizers applied in sequence,
+	// which are the built-in ones like SanitizerTrim or the ones registered by RegisterSanitizer.
+	Sanitizers []string
+
+	// Func is the custom sanitizing function, which is applied after Sanitizers if it is not nil.
+	Func SanitizeFunc
+}
+
+var (
+	sanitizerMu  sync.RWMutex
+	sanitizerMap = map[string]SanitizeFunc{
+		SanitizerTrim:  sanitizeString(strings.TrimSpace),
+		SanitizerLower: sanitizeString(strings.ToLower),
+		SanitizerUpper: sanitizeString(strings.ToUpper),
+		SanitizerNFC:   sanitizeString(norm.NFC.String),
+		SanitizerNFKC:  sanitizeString(norm.NFKC.String),
+	}
+)
+
+// RegisterSanitizer registers custom sanitizer with `name`, which can be used in SanitizeRule.Sanitizers
+// and the configuration. It overwrites the existing sanitizer with the same name.
+func RegisterSanitizer(name string, fn SanitizeFunc) {
+	sanitizerMu.Lock()
+	defer sanitizerMu.Unlock()
+	sanitizerMap[name] = fn
+}
+
+// getSanitizer returns the sanitizer of `name`.
+func getSanitizer(name string) SanitizeFunc {
+	sanitizerMu.RLock()
+	defer sanitizerMu.RUnlock()
+	return sanitizerMap[name]
+}
+
+// sanitizeString converts string function `fn` to SanitizeFunc, which ignores the non-string values.
+func sanitizeString(fn func(string) string) SanitizeFunc {
+	return func(value any) any {
+		switch v := value.(type) {
+		case string:
+			return fn(v)
+		case *string:
+			if v != nil {
+				return fn(*v)
+			}
+		}
+		return value
+	}
+}
+
+// SetSanitizeRules sets the sanitizing rules of the database, which sanitize the data of
+// insert/update/save operations of Model along with the rules of configuration.
+func (c *Core) SetSanitizeRules(rules ...SanitizeRule) {
+	c.sanitizeRules.Set(rules)
+}
+
+// GetSanitizeRules returns the sanitizing rules of the database set by SetSanitizeRules.
+func (c *Core) GetSanitizeRules() []SanitizeRule {
+	if rules, ok := c.sanitizeRules.Val().([]SanitizeRule); ok {
+		return rules
+	}
+	return nil
+}
+
+// Sanitize sets the sanitizing rules for the model, which sanitize the data of its
+// insert/update/save operations after the rules of the configuration and database.
+// Note that only the data of type map/struct and their slices are sanitized.
+//
+// Example:
+//
+//	db.Model("user").Sanitize(gdb.SanitizeRule{
+//		Columns:    []string{"email"},
+//		Sanitizers: []string{gdb.SanitizerTrim, gdb.SanitizerLower},
+//	}).Data(user).Insert()
+func (m *Model) Sanitize(rules ...SanitizeRule) *Model {
+	model := m.getModel()
+	model.sanitizeRules = append(append([]SanitizeRule{}, m.sanitizeRules...), rules...)
+	return model
+}
+
+// getSanitizeRules returns the sanitizing rules of the configuration, database and the model in sequence.
+func (m *Model) getSanitizeRules() []SanitizeRule {
+	var (
+		core  = m.db.GetCore()
+		rules []SanitizeRule
+	)
+	if config := core.GetConfig(); config != nil && config.Sanitizers != "" {
+		for _, item := range gstr.SplitAndTrim(config.Sanitizers, ";") {
+			column, names, _ := strings.Cut(item, ":")
+			rules = append(rules, SanitizeRule{
+				Columns:    []string{strings.TrimSpace(column)},
+				Sanitizers: gstr.SplitAndTrim(names, ","),
+			})
+		}
+	}
+	rules = append(rules, core.GetSanitizeRules()...)
+	return append(rules, m.sanitizeRules...)
+}
+
+// sanitizeData sanitizes the values of `data` of `table` in place with the rules of the model.
+func (m *Model) sanitizeData(table string, data Map) error {
+	rules := m.getSanitizeRules()
+	if len(rules) == 0 || len(data) == 0 {
+		return nil
+	}
+	lowerTable := strings.ToLower(m.db.GetCore().guessPrimaryTableName(table))
+	for column, value := range data {
+		if value == nil {
+			continue
+		}
+		lowerColumn := strings.ToLower(column)
+		for _, rule := range rules {
+			if !rule.matches(lowerTable, lowerColumn) {
+				continue
+			}
+			for _, name := range rule.Sanitizers {
+				fn := getSanitizer(name)
+				if fn == nil {
+					return gerror.NewCodef(
+						gcode.CodeInvalidConfiguration,
+						`sanitizer "%s" for column "%s" is not registered`,
+						name, column,
+					)
+				}
+				value = fn(value)
+			}
+			if rule.Func != nil {
+				value = rule.Func(value)
+			}
+		}
+		data[column] = value
+	}
+	return nil
+}
+
+// matches checks whether the lowercase `table` and `column` match the rule.
+func (r *SanitizeRule) matches(table, column string) bool {
+	if len(r.Tables) > 0 {
+		var tableMatched bool
+		for _, pattern := range r.Tables {
+			if ok, _ := path.Match(strings.ToLower(pattern), table); ok {
+				tableMatched = true
+				break
+			}
+		}
+		if !tableMatched {
+			return false
+		}
+	}
+	for _, pattern := range r.Columns {
+		if ok, _ := path.Match(strings.ToLower(pattern), column); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -93,6 +93,13 @@ func (c *Core) ConvertDataForRecord(ctx context.Context, value any, table string
 // The parameter `fieldType` is the target record field.
 // The parameter `fieldValue` is the value that to be committed to record field.
 func (c *Core) ConvertValueForField(ctx context.Context, fieldType string, fieldValue any) (any, error) {
+	// The time value is converted to UTC if TimeStoreUTC is enabled,
+	// except for the year, date and time types which have no zone information.
+	switch fieldType {
+	case fieldTypeYear, fieldTypeDate, fieldTypeTime:
+	default:
+		fieldValue = c.convertTimeForStorage(fieldValue)
+	}
 	var (
 		err            error
 		convertedValue = fieldValue
@@ -443,6 +450,8 @@ func (c *Core) ConvertValueForLocal(
 		}
 		return gconv.Bool(fieldValue), nil
 
+	// The date and time types have no zone information, which are not converted by TimeStoreUTC
+	// and TimeScanLocation.
 	case LocalTypeDate:
 		if t, ok := fieldValue.(time.Time); ok {
 			return gtime.NewFromTime(t).Format("Y-m-d"), nil
@@ -459,9 +468,9 @@ func (c *Core) ConvertValueForLocal(
 
 	case LocalTypeDatetime:
 		if t, ok := fieldValue.(time.Time); ok {
-			return gtime.NewFromTime(t), nil
+			return gtime.NewFromTime(c.convertTimeForLocal(ctx, t)), nil
 		}
-		t, _ := gtime.StrToTime(gconv.String(fieldValue))
+		t, _ := c.parseTimeForLocal(ctx, gconv.String(fieldValue))
 		return t, nil
 
 	default:
@@ -510,3 +519,18 @@ func (c *Core) mappingAndFilterData(ctx context.Context, schema, table string, d
 	}
 	return data, nil
 }
+
+// removeGeneratedFieldsFromData removes the generated fields from `data` for inserting or updating,
+// as the values of generated columns are computed by the database and cannot be written.
+func (c *Core) removeGeneratedFieldsFromData(ctx context.Context, schema, table string, data map[string]any) (map[string]any, error) {
+	fieldsMap, err := c.db.TableFields(ctx, c.guessPrimaryTableName(table), schema)
+	if err != nil {
+		return nil, err
+	}
+	for dataKey := range data {
+		if field, ok := fieldsMap[dataKey]; ok && field.Generated {
+			delete(data, dataKey)
+		}
+	}
+	return data, nil
+}
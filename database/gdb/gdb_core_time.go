@@ -0,0 +1,135 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogf/gf/v2/internal/intlog"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+var (
+	// timeZoneSuffixRegex matches the zone information at the end of time string,
+	// eg: "Z", "+08:00", "-0700", "UTC", "+0800 CST".
+	timeZoneSuffixRegex = regexp.MustCompile(`(?i)(Z|[+-]\d{2}:?\d{2}(\s+[A-Z]{2,5})?|\s(UTC|GMT))$`)
+
+	// timeLocationCache caches the loaded locations by their names.
+	timeLocationCache sync.Map
+)
+
+// loadTimeLocation loads and returns the location of given `name`, which is cached for later usage.
+func loadTimeLocation(name string) (*time.Location, error) {
+	if v, ok := timeLocationCache.Load(name); ok {
+		return v.(*time.Location), nil
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	timeLocationCache.Store(name, location)
+	return location, nil
+}
+
+// isTimeStoreUTC checks whether the time values are stored as UTC in database.
+func (c *Core) isTimeStoreUTC() bool {
+	config := c.db.GetConfig()
+	return config != nil && config.TimeStoreUTC
+}
+
+// getTimeScanLocation returns the location configured by TimeScanLocation,
+// which is nil if it is not configured or invalid.
+func (c *Core) getTimeScanLocation(ctx context.Context) *time.Location {
+	config := c.db.GetConfig()
+	if config == nil || config.TimeScanLocation == "" {
+		return nil
+	}
+	location, err := loadTimeLocation(config.TimeScanLocation)
+	if err != nil {
+		intlog.Errorf(ctx, `invalid TimeScanLocation "%s": %+v`, config.TimeScanLocation, err)
+		return nil
+	}
+	return location
+}
+
+// convertTimeForStorage converts the time value to UTC if TimeStoreUTC is enabled,
+// or else it returns `value` directly.
+func (c *Core) convertTimeForStorage(value any) any {
+	if !c.isTimeStoreUTC() {
+		return value
+	}
+	switch v := value.(type) {
+	case time.Time:
+		return v.UTC()
+	case *time.Time:
+		if v != nil {
+			t := v.UTC()
+			return &t
+		}
+	case gtime.Time:
+		return *gtime.NewFromTime(v.Time.UTC())
+	case *gtime.Time:
+		if v != nil {
+			return gtime.NewFromTime(v.Time.UTC())
+		}
+	}
+	return value
+}
+
+// convertTimeArgsForStorage converts the time values of `args` for storage,
+// which should only be the data arguments of writes but not the arguments of conditions.
+// It returns `args` itself if there's nothing converted.
+func (c *Core) convertTimeArgsForStorage(args []any) []any {
+	if !c.isTimeStoreUTC() {
+		return args
+	}
+	var newArgs []any
+	for i, arg := range args {
+		switch arg.(type) {
+		case time.Time, *time.Time, gtime.Time, *gtime.Time:
+		default:
+			continue
+		}
+		if newArgs == nil {
+			newArgs = make([]any, len(args))
+			copy(newArgs, args)
+		}
+		newArgs[i] = c.convertTimeForStorage(arg)
+	}
+	if newArgs == nil {
+		return args
+	}
+	return newArgs
+}
+
+// convertTimeForLocal converts the time value read from database according to the
+// configuration TimeStoreUTC and TimeScanLocation.
+func (c *Core) convertTimeForLocal(ctx context.Context, t time.Time) time.Time {
+	if location := c.getTimeScanLocation(ctx); location != nil {
+		return t.In(location)
+	}
+	return t
+}
+
+// parseTimeForLocal parses the time string read from database, in which the time string
+// without zone information is considered UTC if TimeStoreUTC is enabled.
+func (c *Core) parseTimeForLocal(ctx context.Context, s string) (*gtime.Time, error) {
+	t, err := gtime.StrToTime(s)
+	if err != nil || t == nil {
+		return t, err
+	}
+	if c.isTimeStoreUTC() && !timeZoneSuffixRegex.MatchString(strings.TrimSpace(s)) {
+		t = gtime.NewFromTime(time.Date(
+			t.Year(), t.Time.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC,
+		))
+	}
+	return gtime.NewFromTime(c.convertTimeForLocal(ctx, t.Time)), nil
+}
@@ -196,6 +196,35 @@ func (c *Core) TransactionWithOptions(
 	}
 }
 
+// WithTx executes function `f` within the transaction of `ctx` if it has one of current database group,
+// or else it starts a new transaction, which is committed if `f` returns nil or rolled back otherwise.
+// The context passed to `f` carries the transaction, so that the models created with it like
+// `db.Model(table).Ctx(ctx)` and the nested WithTx calls join the transaction automatically.
+//
+// It makes the service methods compose transactions without passing the transaction object, eg:
+//
+//	func (s *sOrder) Create(ctx context.Context, in CreateInput) error {
+//		return g.DB().WithTx(ctx, func(ctx context.Context) error {
+//			if err := service.Stock().Deduct(ctx, in.Items); err != nil {
+//				return err
+//			}
+//			_, err := dao.Order.Ctx(ctx).Data(in).Insert()
+//			return err
+//		})
+//	}
+//
+// The optional parameter `propagation` is PropagationRequired in default. It can be PropagationRequiresNew
+// which always starts a new transaction suspending the one of `ctx`, or any other propagation behavior.
+func (c *Core) WithTx(ctx context.Context, f func(ctx context.Context) error, propagation ...Propagation) error {
+	opts := TxOptions{Propagation: PropagationRequired}
+	if len(propagation) > 0 && propagation[0] != "" {
+		opts.Propagation = propagation[0]
+	}
+	return c.db.TransactionWithOptions(ctx, opts, func(ctx context.Context, tx TX) error {
+		return f(ctx)
+	})
+}
+
 // createNewTransaction handles creating and managing a new transaction
 func (c *Core) createNewTransaction(
 	ctx context.Context, opts TxOptions, f func(ctx context.Context, tx TX) error,
@@ -289,6 +318,26 @@ func TXFromCtx(ctx context.Context, group string) TX {
 	return nil
 }
 
+// MustTXFromCtx retrieves and returns transaction object of database `group` from context.
+// The optional parameter `group` is the default group of configuration if it is not given.
+// It panics if there's no transaction in the context, which is used by the service methods
+// that must be called within a transaction, like those called by WithTx.
+func MustTXFromCtx(ctx context.Context, group ...string) TX {
+	groupName := GetDefaultGroup()
+	if len(group) > 0 && group[0] != "" {
+		groupName = group[0]
+	}
+	tx := TXFromCtx(ctx, groupName)
+	if tx == nil {
+		panic(gerror.NewCodef(
+			gcode.CodeInvalidOperation,
+			`no transaction of database group "%s" found in context`,
+			groupName,
+		))
+	}
+	return tx
+}
+
 // transactionKeyForContext forms and returns a key for storing transaction object of certain database group into context.
 func transactionKeyForContext(group string) transactionCtxKey {
 	return transactionCtxKey(contextTransactionKeyPrefix + group)
@@ -10,8 +10,12 @@ package gdb
 import (
 	"context"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
@@ -20,6 +24,7 @@ import (
 	"github.com/gogf/gf/v2/container/gvar"
 	"github.com/gogf/gf/v2/errors/gcode"
 	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/empty"
 	"github.com/gogf/gf/v2/internal/intlog"
 	"github.com/gogf/gf/v2/os/gtime"
 	"github.com/gogf/gf/v2/util/gconv"
@@ -35,20 +40,8 @@ func (c *Core) Query(ctx context.Context, sql string, args ...any) (result Resul
 // DoQuery commits the sql string and its arguments to underlying driver
 // through given link object and returns the execution result.
 func (c *Core) DoQuery(ctx context.Context, link Link, sql string, args ...any) (result Result, err error) {
-	// Transaction checks.
-	if link == nil {
-		if tx := TXFromCtx(ctx, c.db.GetGroup()); tx != nil {
-			// Firstly, check and retrieve transaction link from context.
-			link = &txLink{tx.GetSqlTX()}
-		} else if link, err = c.SlaveLink(); err != nil {
-			// Or else it creates one from master node.
-			return nil, err
-		}
-	} else if !link.IsTransaction() {
-		// If current link is not transaction link, it checks and retrieves transaction from context.
-		if tx := TXFromCtx(ctx, c.db.GetGroup()); tx != nil {
-			link = &txLink{tx.GetSqlTX()}
-		}
+	if link, err = c.getQueryLink(ctx, link); err != nil {
+		return nil, err
 	}
 
 	// Sql filtering.
@@ -84,6 +77,33 @@ func (c *Core) DoQuery(ctx context.Context, link Link, sql string, args ...any)
 	return out.Records, err
 }
 
+// getQueryLink returns the link for query statement, which is the transaction link if any in context,
+// or else the given `link`, or else the slave link.
+func (c *Core) getQueryLink(ctx context.Context, link Link) (Link, error) {
+	var err error
+	// Transaction checks.
+	if link == nil {
+		if tx := TXFromCtx(ctx, c.db.GetGroup()); tx != nil {
+			// Firstly, check and retrieve transaction link from context.
+			link = &txLink{tx.GetSqlTX()}
+		} else if IsMasterRead(ctx) {
+			// Read-your-writes: it reads from master node.
+			if link, err = c.MasterLink(); err != nil {
+				return nil, err
+			}
+		} else if link, err = c.SlaveLink(); err != nil {
+			// Or else it creates one from slave node.
+			return nil, err
+		}
+	} else if !link.IsTransaction() {
+		// If current link is not transaction link, it checks and retrieves transaction from context.
+		if tx := TXFromCtx(ctx, c.db.GetGroup()); tx != nil {
+			link = &txLink{tx.GetSqlTX()}
+		}
+	}
+	return link, nil
+}
+
 // Exec commits one query SQL to underlying driver and returns the execution result.
 // It is most commonly used for data inserting and updating.
 func (c *Core) Exec(ctx context.Context, sql string, args ...any) (result sql.Result, err error) {
@@ -139,6 +159,7 @@ func (c *Core) DoExec(ctx context.Context, link Link, sql string, args ...any) (
 	if err != nil {
 		return nil, err
 	}
+	markWriteInCtx(ctx)
 	return out.Result, err
 }
 
@@ -153,16 +174,33 @@ func (c *Core) DoFilter(
 
 // DoCommit commits current sql and arguments to underlying sql driver.
 func (c *Core) DoCommit(ctx context.Context, in DoCommitInput) (out DoCommitOutput, err error) {
+	// Request id comment, which correlates the SQL statement with the request in database logs.
+	// The prepared statement is left as it is, so that it can be reused by different requests.
+	if c.db.GetConfig().RequestIdComment {
+		switch in.Type {
+		case SqlTypeExecContext, SqlTypeQueryContext, SqlTypeQueryRowsContext:
+			in.Sql = appendRequestIdComment(ctx, in.Sql)
+		}
+	}
+	// The committing and rolling back are always done, or else the transaction is left open.
+	switch in.Type {
+	case SqlTypeTXCommit, SqlTypeTXRollback:
+	default:
+		if err = c.checkCtxBudget(ctx); err != nil {
+			return out, err
+		}
+	}
 	var (
 		sqlTx                *sql.Tx
 		sqlStmt              *sql.Stmt
 		sqlRows              *sql.Rows
 		sqlResult            sql.Result
 		stmtSqlRows          *sql.Rows
+		streamSqlRows        *sql.Rows
 		stmtSqlRow           *sql.Row
 		rowsAffected         int64
 		cancelFuncForTimeout context.CancelFunc
-		formattedSql         = FormatSqlWithArgs(in.Sql, in.Args)
+		formattedSql         = c.formatSqlForLogging(ctx, in.Sql, in.Args)
 		timestampMilli1      = gtime.TimestampMilli()
 	)
 
@@ -173,7 +211,7 @@ func (c *Core) DoCommit(ctx context.Context, in DoCommitInput) (out DoCommitOutp
 				if v, ok := exception.(error); ok && gerror.HasStack(v) {
 					err = v
 				} else {
-					err = gerror.WrapCodef(gcode.CodeDbOperationError, gerror.NewCodef(gcode.CodeInternalPanic, "%+v", exception), FormatSqlWithArgs(in.Sql, in.Args))
+					err = gerror.WrapCodef(gcode.CodeDbOperationError, gerror.NewCodef(gcode.CodeInternalPanic, "%+v", exception), c.formatSqlForLogging(ctx, in.Sql, in.Args))
 				}
 			}
 		}
@@ -234,12 +272,21 @@ func (c *Core) DoCommit(ctx context.Context, in DoCommitInput) (out DoCommitOutp
 		ctx, cancelFuncForTimeout = c.GetCtxTimeout(ctx, ctxTimeoutTypeQuery)
 		defer cancelFuncForTimeout()
 		sqlRows, err = in.Link.QueryContext(ctx, in.Sql, in.Args...)
+		c.trackRows(ctx, sqlRows, in.Sql)
 		out.RawResult = sqlRows
 
+	case SqlTypeQueryRowsContext:
+		// The rows are returned to the caller for streaming for arbitrary duration, so the query timeout
+		// is not applied, and the caller is responsible for canceling `ctx` after the rows are closed.
+		streamSqlRows, err = in.Link.QueryContext(ctx, in.Sql, in.Args...)
+		c.trackRows(ctx, streamSqlRows, in.Sql)
+		out.RawResult = streamSqlRows
+
 	case SqlTypePrepareContext:
 		ctx, cancelFuncForTimeout = c.GetCtxTimeout(ctx, ctxTimeoutTypePrepare)
 		defer cancelFuncForTimeout()
 		sqlStmt, err = in.Link.PrepareContext(ctx, in.Sql)
+		c.trackStmt(ctx, sqlStmt, in.Sql)
 		out.RawResult = sqlStmt
 
 	case SqlTypeStmtExecContext:
@@ -253,9 +300,15 @@ func (c *Core) DoCommit(ctx context.Context, in DoCommitInput) (out DoCommitOutp
 		out.RawResult = sqlResult
 
 	case SqlTypeStmtQueryContext:
+		// The rows are returned to the caller, so the timeout context is not canceled on return,
+		// or else the rows are closed by database/sql before the caller reads them.
+		// The timeout context is released when the timeout reaches.
 		ctx, cancelFuncForTimeout = c.GetCtxTimeout(ctx, ctxTimeoutTypeQuery)
-		defer cancelFuncForTimeout()
 		stmtSqlRows, err = in.Stmt.QueryContext(ctx, in.Args...)
+		if err != nil {
+			cancelFuncForTimeout()
+		}
+		c.trackRows(ctx, stmtSqlRows, in.Sql)
 		out.RawResult = stmtSqlRows
 
 	case SqlTypeStmtQueryRowContext:
@@ -312,8 +365,8 @@ func (c *Core) DoCommit(ctx context.Context, in DoCommitInput) (out DoCommitOutp
 	if err != nil && err != sql.ErrNoRows {
 		err = gerror.WrapCode(
 			gcode.CodeDbOperationError,
-			err,
-			FormatSqlWithArgs(in.Sql, in.Args),
+			c.classifyError(err),
+			c.formatSqlForLogging(ctx, in.Sql, in.Args),
 		)
 	}
 	return out, err
@@ -457,9 +510,23 @@ func (c *Core) RowsToResult(ctx context.Context, rows *sql.Rows) (Result, error)
 		if err := rows.Close(); err != nil {
 			intlog.Errorf(ctx, `%+v`, err)
 		}
+		c.untrackRows(ctx, rows)
 	}()
+	// The column types for empty result are retrieved before iterating,
+	// as the rows are closed automatically if there's no row.
+	var emptyColumnTypes []*sql.ColumnType
+	if isEmptyResultColumnTypesRequired(ctx) {
+		var err error
+		if emptyColumnTypes, err = rows.ColumnTypes(); err != nil {
+			return nil, err
+		}
+	}
 	if !rows.Next() {
-		return nil, nil
+		// The error of the rows is checked as the iteration also ends if the context is canceled.
+		if err := rows.Err(); err != nil || emptyColumnTypes == nil {
+			return nil, err
+		}
+		return Result{}.withColumnTypes(ctx, newColumnTypes(emptyColumnTypes)), nil
 	}
 	// Column names and types.
 	columnTypes, err := rows.ColumnTypes()
@@ -473,9 +540,10 @@ func (c *Core) RowsToResult(ctx context.Context, rows *sql.Rows) (Result, error)
 		}
 	}
 	var (
-		values   = make([]any, len(columnTypes))
-		result   = make(Result, 0)
-		scanArgs = make([]any, len(values))
+		values       = make([]any, len(columnTypes))
+		result       = make(Result, 0)
+		scanArgs     = make([]any, len(values))
+		limitChecker = newResultLimitChecker(ctx)
 	)
 	for i := range values {
 		scanArgs[i] = &values[i]
@@ -484,6 +552,16 @@ func (c *Core) RowsToResult(ctx context.Context, rows *sql.Rows) (Result, error)
 		if err = rows.Scan(scanArgs...); err != nil {
 			return result, err
 		}
+		if limitChecker != nil {
+			var appendable bool
+			if appendable, err = limitChecker.Add(values); err != nil {
+				return nil, err
+			}
+			if !appendable {
+				limitChecker.Truncated(ctx, c, len(result))
+				break
+			}
+		}
 		record := Record{}
 		for i, value := range values {
 			if value == nil {
@@ -503,10 +581,14 @@ func (c *Core) RowsToResult(ctx context.Context, rows *sql.Rows) (Result, error)
 		}
 		result = append(result, record)
 		if !rows.Next() {
+			if err = rows.Err(); err != nil {
+				return nil, err
+			}
 			break
 		}
 	}
-	return result, nil
+	// The column types are attached in the spare capacity of the result, see Result.ColumnTypes.
+	return append(result, newColumnTypesRecord(newColumnTypes(columnTypes)))[:len(result)], nil
 }
 
 // OrderRandomFunction returns the SQL function for random ordering.
@@ -514,6 +596,78 @@ func (c *Core) OrderRandomFunction() string {
 	return "RAND()"
 }
 
+// FormatStatementTimeout returns the select statement `sql` as it is, as there's no standard statement hint
+// for the server-side execution timeout. The statement is still canceled by the context deadline of `timeout`,
+// and the databases supporting such hint should override this function, like the MAX_EXECUTION_TIME of MySQL.
+func (c *Core) FormatStatementTimeout(sql string, timeout time.Duration) string {
+	return sql
+}
+
+// FormatExists returns the statement selecting whether the select statement `sql` returns any row,
+// which uses EXISTS as a value that is supported by most databases.
+func (c *Core) FormatExists(sql string) string {
+	return fmt.Sprintf(`SELECT EXISTS(%s)`, sql)
+}
+
+// FormatLiteral returns `value` as the SQL literal in standard SQL, in which the single quotes of string
+// are doubled and the binary is in hexadecimal like X'01ab'.
+// The databases of different literal syntax should override this function.
+func (c *Core) FormatLiteral(value any) string {
+	if v, ok := value.(Value); ok {
+		value = v.Val()
+	}
+	if empty.IsNil(value) {
+		return "NULL"
+	}
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return gconv.String(v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case []byte:
+		return "X'" + hex.EncodeToString(v) + "'"
+	case time.Time:
+		return quoteLiteralString(v.Format(literalTimeLayout))
+	case *time.Time:
+		return quoteLiteralString(v.Format(literalTimeLayout))
+	case *gtime.Time:
+		return quoteLiteralString(v.Time.Format(literalTimeLayout))
+	default:
+		return quoteLiteralString(gconv.String(v))
+	}
+}
+
+// FormatForeignKeyChecks returns empty string, as there's no standard statement for the foreign key checks.
+func (c *Core) FormatForeignKeyChecks(enabled bool) string {
+	return ""
+}
+
+// quoteLiteralString quotes the string as SQL string literal, in which single quote is doubled.
+func quoteLiteralString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// DateBucketFunction returns the SQL expression truncating the quoted `column` to the start of the date `bucket`.
+func (c *Core) DateBucketFunction(column string, bucket DateBucket) string {
+	switch bucket {
+	case BucketHour:
+		return fmt.Sprintf(`DATE_FORMAT(%s,'%%Y-%%m-%%d %%H:00:00')`, column)
+	case BucketMonth:
+		return fmt.Sprintf(`DATE_FORMAT(%s,'%%Y-%%m-01')`, column)
+	case BucketYear:
+		return fmt.Sprintf(`DATE_FORMAT(%s,'%%Y-01-01')`, column)
+	default:
+		return fmt.Sprintf(`DATE_FORMAT(%s,'%%Y-%%m-%%d')`, column)
+	}
+}
+
 func (c *Core) columnValueToLocalValue(ctx context.Context, value any, columnType *sql.ColumnType) (any, error) {
 	var scanType = columnType.ScanType()
 	if scanType != nil {
@@ -10,7 +10,6 @@ package gdb
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/gogf/gf/v2/errors/gcode"
 	"github.com/gogf/gf/v2/errors/gerror"
@@ -31,7 +30,7 @@ func (c *Core) GetLink(ctx context.Context, master bool, schema string) (Link, e
 	if tx != nil {
 		return &txLink{tx.GetSqlTX()}, nil
 	}
-	if master {
+	if master || IsMasterRead(ctx) {
 		link, err := c.db.GetCore().MasterLink(schema)
 		if err != nil {
 			return nil, err
@@ -254,20 +253,13 @@ func (c *Core) guessPrimaryTableName(tableStr string) string {
 }
 
 // GetPrimaryKeys retrieves and returns the primary key field names of the specified table.
-// This method extracts primary key information from TableFields.
+// This method extracts primary key information from TableFields, and the keys of composite
+// primary key are ordered by their field index.
 // The parameter `schema` is optional, if not specified it uses the default schema.
 func (c *Core) GetPrimaryKeys(ctx context.Context, table string, schema ...string) ([]string, error) {
 	tableFields, err := c.db.TableFields(ctx, table, schema...)
 	if err != nil {
 		return nil, err
 	}
-
-	var primaryKeys []string
-	for _, field := range tableFields {
-		if strings.EqualFold(field.Key, "pri") {
-			primaryKeys = append(primaryKeys, field.Name)
-		}
-	}
-
-	return primaryKeys, nil
+	return getKeyFieldNames(tableFields, "pri"), nil
 }
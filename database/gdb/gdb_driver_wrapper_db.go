@@ -36,6 +36,15 @@ func (d *DriverWrapperDB) Open(node *ConfigNode) (db *sql.DB, err error) {
 	return d.DB.Open(node)
 }
 
+// GetChars returns the security char for current database.
+// It returns empty chars if the quoting is disabled by configuration, see ConfigNode.QuoteDisabled.
+func (d *DriverWrapperDB) GetChars() (charLeft string, charRight string) {
+	if config := d.GetConfig(); config != nil && config.QuoteDisabled {
+		return "", ""
+	}
+	return d.DB.GetChars()
+}
+
 // Tables retrieves and returns the tables of current schema.
 // It's mainly used in cli tool chain for automatically generating the models.
 func (d *DriverWrapperDB) Tables(ctx context.Context, schema ...string) (tables []string, err error) {
@@ -0,0 +1,798 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// DumpFormat is the content format of Dump and Load.
+type DumpFormat string
+
+const (
+	// DumpFormatSQL dumps each table as INSERT statements in standard SQL.
+	DumpFormatSQL DumpFormat = "sql"
+
+	// DumpFormatCSV dumps each table as a CSV section, which starts with a marker
+	// record ["#table", name] and a header record of the column names.
+	DumpFormatCSV DumpFormat = "csv"
+)
+
+// DumpOption is the option for Dump.
+type DumpOption struct {
+	// Format is the content format, which is DumpFormatSQL in default.
+	Format DumpFormat
+
+	// BatchSize is the count of records read in one query, and also the count of records in
+	// one INSERT statement for DumpFormatSQL. It is 1000 in default.
+	BatchSize int
+
+	// NullString is the string representing NULL value for DumpFormatCSV, which is `\N` in default.
+	NullString string
+
+	// MaskRules are the masking rules applied to the dumped records, so that production snapshots
+	// can be shared safely. Note that the masking rules of the database are not applied in Dump.
+	MaskRules []MaskRule
+}
+
+// LoadOption is the option for Load.
+type LoadOption struct {
+	// Format is the content format, which is DumpFormatSQL in default.
+	Format DumpFormat
+
+	// BatchSize is the count of records inserted in one statement, which is 1000 in default.
+	BatchSize int
+
+	// NullString is the string representing NULL value for DumpFormatCSV, which is `\N` in default.
+	NullString string
+
+	// Clean specifies deleting all existing records of the tables before loading their records.
+	Clean bool
+}
+
+const (
+	defaultDumpBatchSize   = 1000
+	defaultDumpNullString  = `\N`
+	dumpCsvTableMarker     = "#table"
+	dumpSqlStatementPrefix = "INSERT INTO "
+	literalTimeLayout      = "2006-01-02 15:04:05.999999999"
+
+	// dumpSqlBackslashEscapes is the leading comment of the SQL dump of the databases escaping
+	// backslashes in string literals, like MySQL.
+	dumpSqlBackslashEscapes = "-- Backslash escapes: on"
+)
+
+// Dump dumps the records of `tables` of `db` to `writer` in portable format, which can be loaded
+// into the database of the same schema by Load, even of different database type.
+// It dumps all tables of the database if `tables` is empty.
+//
+// The identifiers and values of DumpFormatSQL are quoted in the dialect of `db`, so that the
+// statements can also be executed directly by the client of the database.
+//
+// The tables are read in one read-only transaction of repeatable read isolation level for a
+// consistent snapshot, and it falls back to the default transaction if the database does not
+// support the isolation level. The records are read in batches ordered by primary keys, so that
+// large tables are never loaded into memory at once.
+//
+// Example:
+//
+//	err := gdb.Dump(ctx, g.DB(), []string{"user", "user_detail"}, file)
+func Dump(ctx context.Context, db DB, tables []string, writer io.Writer, option ...DumpOption) (err error) {
+	var opt DumpOption
+	if len(option) > 0 {
+		opt = option[0]
+	}
+	if opt.Format == "" {
+		opt.Format = DumpFormatSQL
+	}
+	if opt.BatchSize <= 0 {
+		opt.BatchSize = defaultDumpBatchSize
+	}
+	if opt.NullString == "" {
+		opt.NullString = defaultDumpNullString
+	}
+	if opt.Format != DumpFormatSQL && opt.Format != DumpFormatCSV {
+		return gerror.NewCodef(gcode.CodeInvalidParameter, `unsupported dump format "%s"`, opt.Format)
+	}
+	if len(tables) == 0 {
+		if tables, err = db.Tables(ctx); err != nil {
+			return err
+		}
+	}
+	if opt.Format == DumpFormatSQL && db.FormatLiteral(`\`) != `'\'` {
+		if _, err = io.WriteString(writer, dumpSqlBackslashEscapes+"\n"); err != nil {
+			return gerror.Wrap(err, `write dump failed`)
+		}
+	}
+	var (
+		started = false
+		dumpFn  = func(ctx context.Context, tx TX) error {
+			started = true
+			for _, table := range tables {
+				if err := dumpTable(ctx, tx, table, writer, opt); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	)
+	err = db.TransactionWithOptions(ctx, TxOptions{
+		Propagation: PropagationRequired,
+		Isolation:   sql.LevelRepeatableRead,
+		ReadOnly:    true,
+	}, dumpFn)
+	if err != nil && !started {
+		err = db.TransactionWithOptions(ctx, TxOptions{Propagation: PropagationRequired}, dumpFn)
+	}
+	return err
+}
+
+// Load loads the records dumped by Dump from `reader` into `db`, which is done in one transaction.
+//
+// The foreign key checks are disabled or deferred during loading for the databases supporting it,
+// like MySQL, SQLite and PostgreSQL (deferrable constraints only), see DB.FormatForeignKeyChecks,
+// so that the tables can be loaded and cleaned in any order.
+//
+// Note that the records are inserted by ORM with parameters instead of executing the statements
+// directly for DumpFormatSQL, so only INSERT statements of the format generated by Dump are supported.
+func Load(ctx context.Context, db DB, reader io.Reader, option ...LoadOption) error {
+	var opt LoadOption
+	if len(option) > 0 {
+		opt = option[0]
+	}
+	if opt.Format == "" {
+		opt.Format = DumpFormatSQL
+	}
+	if opt.BatchSize <= 0 {
+		opt.BatchSize = defaultDumpBatchSize
+	}
+	if opt.NullString == "" {
+		opt.NullString = defaultDumpNullString
+	}
+	return db.Transaction(ctx, func(ctx context.Context, tx TX) (err error) {
+		if disableSql := db.FormatForeignKeyChecks(false); disableSql != "" {
+			if _, err = tx.Exec(disableSql); err != nil {
+				return err
+			}
+			// The checks are restored even if loading fails, as they might be of the session.
+			if enableSql := db.FormatForeignKeyChecks(true); enableSql != "" {
+				defer func() {
+					if _, enableErr := tx.Exec(enableSql); err == nil {
+						err = enableErr
+					}
+				}()
+			}
+		}
+		var (
+			cleaned  = make(map[string]struct{})
+			insertFn = func(table string, list List) error {
+				if _, ok := cleaned[table]; opt.Clean && !ok {
+					cleaned[table] = struct{}{}
+					if _, err := tx.Model(table).Where("1=1").Delete(); err != nil {
+						return err
+					}
+				}
+				if len(list) == 0 {
+					return nil
+				}
+				_, err := tx.Model(table).Data(list).Batch(opt.BatchSize).Insert()
+				return err
+			}
+		)
+		switch opt.Format {
+		case DumpFormatSQL:
+			return loadSql(reader, insertFn)
+		case DumpFormatCSV:
+			return loadCsv(reader, opt, insertFn)
+		default:
+			return gerror.NewCodef(gcode.CodeInvalidParameter, `unsupported dump format "%s"`, opt.Format)
+		}
+	})
+}
+
+// dumpTable dumps the records of one table.
+func dumpTable(ctx context.Context, tx TX, table string, writer io.Writer, opt DumpOption) (err error) {
+	fieldsMap, err := tx.GetDB().TableFields(ctx, table)
+	if err != nil {
+		return err
+	}
+	var (
+		fields  = make([]*TableField, 0, len(fieldsMap))
+		columns = make([]string, 0, len(fieldsMap))
+		model   = tx.Model(table)
+	)
+	for _, field := range fieldsMap {
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Index < fields[j].Index
+	})
+	for _, field := range fields {
+		columns = append(columns, field.Name)
+		if strings.EqualFold(field.Key, "pri") {
+			model = model.OrderAsc(field.Name)
+		}
+	}
+	if len(columns) == 0 {
+		return gerror.NewCodef(gcode.CodeInvalidParameter, `no fields found for table "%s"`, table)
+	}
+	var csvWriter *csv.Writer
+	switch opt.Format {
+	case DumpFormatCSV:
+		csvWriter = csv.NewWriter(writer)
+		if err = csvWriter.Write([]string{dumpCsvTableMarker, table}); err == nil {
+			err = csvWriter.Write(columns)
+		}
+	default:
+		_, err = fmt.Fprintf(writer, "-- Dump of table %s\n", table)
+	}
+	if err != nil {
+		return gerror.Wrapf(err, `write dump of table "%s" failed`, table)
+	}
+	model.Fields(columns).Chunk(opt.BatchSize, func(result Result, chunkErr error) bool {
+		if err = chunkErr; err != nil {
+			return false
+		}
+		if len(opt.MaskRules) > 0 {
+			result = maskResult(table, result, opt.MaskRules)
+		}
+		if csvWriter != nil {
+			err = dumpCsvRecords(csvWriter, columns, result, opt)
+		} else {
+			err = dumpSqlRecords(writer, tx.GetDB(), table, columns, result)
+		}
+		return err == nil
+	})
+	if err == nil && csvWriter != nil {
+		csvWriter.Flush()
+		err = csvWriter.Error()
+	}
+	if err != nil {
+		return gerror.Wrapf(err, `dump table "%s" failed`, table)
+	}
+	return nil
+}
+
+// dumpSqlRecords writes the records as one INSERT statement in the dialect of `db`.
+func dumpSqlRecords(writer io.Writer, db DB, table string, columns []string, result Result) error {
+	var builder strings.Builder
+	builder.WriteString(dumpSqlStatementPrefix + quoteDumpIdentifier(db, table) + "(")
+	for i, column := range columns {
+		if i > 0 {
+			builder.WriteString(",")
+		}
+		builder.WriteString(quoteDumpIdentifier(db, column))
+	}
+	builder.WriteString(") VALUES\n")
+	for i, record := range result {
+		if i > 0 {
+			builder.WriteString(",\n")
+		}
+		builder.WriteString("(")
+		for j, column := range columns {
+			if j > 0 {
+				builder.WriteString(",")
+			}
+			builder.WriteString(db.FormatLiteral(record[column]))
+		}
+		builder.WriteString(")")
+	}
+	builder.WriteString(";\n")
+	_, err := io.WriteString(writer, builder.String())
+	return err
+}
+
+// quoteDumpIdentifier quotes each part of the identifier `name` like "schema.table" with the quote chars
+// of `db`, in which the closing quote char is doubled.
+func quoteDumpIdentifier(db DB, name string) string {
+	charLeft, charRight := db.GetChars()
+	if charLeft == "" || charRight == "" {
+		return name
+	}
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = charLeft + strings.ReplaceAll(part, charRight, charRight+charRight) + charRight
+	}
+	return strings.Join(parts, ".")
+}
+
+// dumpCsvRecords writes the records as CSV records.
+func dumpCsvRecords(writer *csv.Writer, columns []string, result Result, opt DumpOption) error {
+	line := make([]string, len(columns))
+	for _, record := range result {
+		for i, column := range columns {
+			value := record[column]
+			if value == nil || value.IsNil() {
+				line[i] = opt.NullString
+				continue
+			}
+			switch v := value.Val().(type) {
+			case time.Time:
+				line[i] = v.Format(literalTimeLayout)
+			case *time.Time:
+				line[i] = v.Format(literalTimeLayout)
+			case *gtime.Time:
+				line[i] = v.Time.Format(literalTimeLayout)
+			default:
+				line[i] = value.String()
+			}
+		}
+		if err := writer.Write(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadCsv loads the CSV content dumped by Dump.
+func loadCsv(reader io.Reader, opt LoadOption, insertFn func(table string, list List) error) error {
+	var (
+		csvReader = csv.NewReader(reader)
+		table     string
+		columns   []string
+		list      List
+	)
+	csvReader.FieldsPerRecord = -1
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return gerror.Wrap(err, `read csv dump failed`)
+		}
+		if len(record) == 2 && record[0] == dumpCsvTableMarker {
+			if table != "" {
+				if err = insertFn(table, list); err != nil {
+					return err
+				}
+			}
+			table, columns, list = record[1], nil, nil
+			continue
+		}
+		if table == "" {
+			return gerror.NewCode(gcode.CodeInvalidParameter, `invalid csv dump: table marker record not found`)
+		}
+		if columns == nil {
+			columns = record
+			continue
+		}
+		if len(record) != len(columns) {
+			return gerror.NewCodef(
+				gcode.CodeInvalidParameter,
+				`invalid csv dump: record of table "%s" has %d fields, but %d expected`,
+				table, len(record), len(columns),
+			)
+		}
+		item := make(Map, len(columns))
+		for i, column := range columns {
+			if record[i] == opt.NullString {
+				item[column] = nil
+			} else {
+				item[column] = record[i]
+			}
+		}
+		list = append(list, item)
+		if len(list) >= opt.BatchSize {
+			if err = insertFn(table, list); err != nil {
+				return err
+			}
+			list = nil
+		}
+	}
+	if table != "" {
+		return insertFn(table, list)
+	}
+	return nil
+}
+
+// loadSql loads the INSERT statements dumped by Dump.
+func loadSql(reader io.Reader, insertFn func(table string, list List) error) error {
+	parser := &dumpSqlParser{reader: bufio.NewReader(reader)}
+	for {
+		table, list, err := parser.nextStatement()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = insertFn(table, list); err != nil {
+			return err
+		}
+	}
+}
+
+// dumpSqlParser parses the INSERT statements dumped in the dialects of the databases like:
+// INSERT INTO `table`(`column1`,`column2`) VALUES(1,'a'),(2,NULL);
+type dumpSqlParser struct {
+	reader           *bufio.Reader
+	backslashEscapes bool // Backslashes are escaped in string literals, see dumpSqlBackslashEscapes.
+}
+
+// nextStatement parses and returns the table name and records of the next INSERT statement.
+// It returns io.EOF if there's no more statement.
+func (p *dumpSqlParser) nextStatement() (table string, list List, err error) {
+	if err = p.skipSpaces(); err != nil {
+		return
+	}
+	for _, keyword := range []string{"INSERT", "INTO"} {
+		var word string
+		if word, err = p.readWord(); err != nil {
+			return "", nil, p.unexpected(err)
+		}
+		if !strings.EqualFold(word, keyword) {
+			return "", nil, gerror.NewCodef(gcode.CodeInvalidParameter, `unsupported dump statement "%s"`, word)
+		}
+	}
+	if table, err = p.readIdentifier(); err != nil {
+		return
+	}
+	if err = p.expect('('); err != nil {
+		return
+	}
+	var columns []string
+	for {
+		var column string
+		if column, err = p.readIdentifier(); err != nil {
+			return
+		}
+		columns = append(columns, column)
+		var c byte
+		if c, err = p.next(); err != nil {
+			return
+		}
+		if c == ')' {
+			break
+		}
+		if c != ',' {
+			return "", nil, p.unexpectedChar(c)
+		}
+	}
+	var word string
+	if word, err = p.readWord(); err != nil {
+		return "", nil, p.unexpected(err)
+	}
+	if !strings.EqualFold(word, "VALUES") {
+		return "", nil, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid dump statement: unexpected "%s"`, word)
+	}
+	for {
+		if err = p.expect('('); err != nil {
+			return
+		}
+		item := make(Map, len(columns))
+		for i, column := range columns {
+			if item[column], err = p.readValue(); err != nil {
+				return
+			}
+			var c byte
+			if c, err = p.next(); err != nil {
+				return
+			}
+			if (i < len(columns)-1 && c != ',') || (i == len(columns)-1 && c != ')') {
+				return "", nil, p.unexpectedChar(c)
+			}
+		}
+		list = append(list, item)
+		var c byte
+		if c, err = p.next(); err != nil {
+			return
+		}
+		if c == ';' {
+			return table, list, nil
+		}
+		if c != ',' {
+			return "", nil, p.unexpectedChar(c)
+		}
+	}
+}
+
+// skipSpaces skips the white spaces and "--" comments.
+func (p *dumpSqlParser) skipSpaces() error {
+	for {
+		peeked, err := p.reader.Peek(1)
+		if err != nil {
+			return err
+		}
+		switch peeked[0] {
+		case ' ', '\t', '\r', '\n':
+			_, _ = p.reader.ReadByte()
+		case '-':
+			if peeked, _ = p.reader.Peek(2); len(peeked) < 2 || peeked[1] != '-' {
+				return nil
+			}
+			line, err := p.reader.ReadString('\n')
+			if strings.TrimSpace(line) == dumpSqlBackslashEscapes {
+				p.backslashEscapes = true
+			}
+			if err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+// next returns the next char that is not white space.
+func (p *dumpSqlParser) next() (byte, error) {
+	if err := p.skipSpaces(); err != nil {
+		return 0, p.unexpected(err)
+	}
+	return p.reader.ReadByte()
+}
+
+func (p *dumpSqlParser) expect(expected byte) error {
+	c, err := p.next()
+	if err != nil {
+		return err
+	}
+	if c != expected {
+		return p.unexpectedChar(c)
+	}
+	return nil
+}
+
+// readWord reads the word of letters, digits, underscores, dots, signs, which are keywords or numbers.
+func (p *dumpSqlParser) readWord() (string, error) {
+	if err := p.skipSpaces(); err != nil {
+		return "", err
+	}
+	var builder strings.Builder
+	for {
+		c, err := p.reader.ReadByte()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+			c == '_' || c == '.' || c == '-' || c == '+') {
+			if err = p.reader.UnreadByte(); err != nil {
+				return "", err
+			}
+			break
+		}
+		builder.WriteByte(c)
+	}
+	if builder.Len() == 0 {
+		return "", io.ErrUnexpectedEOF
+	}
+	return builder.String(), nil
+}
+
+// readIdentifier reads the identifier like "schema.table", of which each part can be quoted by "`", `"` or "[]".
+func (p *dumpSqlParser) readIdentifier() (string, error) {
+	identifier, err := p.readIdentifierPart()
+	if err != nil {
+		return "", err
+	}
+	for {
+		if peeked, _ := p.reader.Peek(1); len(peeked) == 0 || peeked[0] != '.' {
+			return identifier, nil
+		}
+		_, _ = p.reader.ReadByte()
+		part, err := p.readIdentifierPart()
+		if err != nil {
+			return "", err
+		}
+		identifier += "." + part
+	}
+}
+
+// readIdentifierPart reads one part of the identifier.
+func (p *dumpSqlParser) readIdentifierPart() (string, error) {
+	c, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	var closing byte
+	switch c {
+	case '`', '"':
+		closing = c
+	case '[':
+		closing = ']'
+	default:
+		if err = p.reader.UnreadByte(); err != nil {
+			return "", err
+		}
+		word, err := p.readIdentifierWord()
+		if err != nil {
+			return "", p.unexpected(err)
+		}
+		return word, nil
+	}
+	return p.readQuoted(closing, false)
+}
+
+// readIdentifierWord reads the unquoted identifier, which ends before the dot separating its parts.
+func (p *dumpSqlParser) readIdentifierWord() (string, error) {
+	var builder strings.Builder
+	for {
+		c, err := p.reader.ReadByte()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '$') {
+			if err = p.reader.UnreadByte(); err != nil {
+				return "", err
+			}
+			break
+		}
+		builder.WriteByte(c)
+	}
+	if builder.Len() == 0 {
+		return "", io.ErrUnexpectedEOF
+	}
+	return builder.String(), nil
+}
+
+// readString reads the content of string literal, whose leading single quote is already read.
+func (p *dumpSqlParser) readString() (string, error) {
+	return p.readQuoted('\'', p.backslashEscapes)
+}
+
+// readQuoted reads the content until `closing` char, in which doubled `closing` char is escaped.
+// The char after backslash is also escaped if `backslashEscapes` is true, like "\\" and "\'".
+func (p *dumpSqlParser) readQuoted(closing byte, backslashEscapes bool) (string, error) {
+	var builder strings.Builder
+	for {
+		c, err := p.reader.ReadByte()
+		if err != nil {
+			return "", p.unexpected(err)
+		}
+		if c == '\\' && backslashEscapes {
+			if c, err = p.reader.ReadByte(); err != nil {
+				return "", p.unexpected(err)
+			}
+			builder.WriteByte(c)
+			continue
+		}
+		if c == closing {
+			if next, _ := p.reader.Peek(1); len(next) == 1 && next[0] == closing {
+				_, _ = p.reader.ReadByte()
+			} else {
+				return builder.String(), nil
+			}
+		}
+		builder.WriteByte(c)
+	}
+}
+
+// readValue reads the literal value, which is string, hex binary, number, boolean or NULL,
+// in the syntax of the dialects generated by DB.FormatLiteral.
+func (p *dumpSqlParser) readValue() (any, error) {
+	c, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if c == '\'' {
+		return p.readStringValue()
+	}
+	if err = p.reader.UnreadByte(); err != nil {
+		return nil, err
+	}
+	word, err := p.readWord()
+	if err != nil {
+		return nil, p.unexpected(err)
+	}
+	switch strings.ToUpper(word) {
+	case "NULL":
+		return nil, nil
+	case "TRUE":
+		return true, nil
+	case "FALSE":
+		return false, nil
+	case "X":
+		// Binary in standard SQL, like X'01ab'.
+		if err = p.expect('\''); err != nil {
+			return nil, err
+		}
+		s, err := p.readString()
+		if err != nil {
+			return nil, err
+		}
+		return decodeDumpHex(s)
+	case "HEXTORAW":
+		// Binary of Oracle, like HEXTORAW('01ab').
+		if err = p.expect('('); err != nil {
+			return nil, err
+		}
+		if err = p.expect('\''); err != nil {
+			return nil, err
+		}
+		s, err := p.readString()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect(')'); err != nil {
+			return nil, err
+		}
+		return decodeDumpHex(s)
+	case "N", "TIMESTAMP":
+		// Unicode string of SQL Server like N'abc', or timestamp like TIMESTAMP '2006-01-02 15:04:05'.
+		if err = p.expect('\''); err != nil {
+			return nil, err
+		}
+		return p.readStringValue()
+	}
+	// Binary of SQL Server, like 0x01ab.
+	if len(word) > 2 && (word[:2] == "0x" || word[:2] == "0X") {
+		return decodeDumpHex(word[2:])
+	}
+	if i, err := strconv.ParseInt(word, 10, 64); err == nil {
+		return i, nil
+	}
+	if u, err := strconv.ParseUint(word, 10, 64); err == nil {
+		return u, nil
+	}
+	// It keeps the decimal value as string in case of precision loss.
+	if _, ok := new(big.Float).SetString(word); ok {
+		return word, nil
+	}
+	return nil, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid dump statement: unexpected "%s"`, word)
+}
+
+// readStringValue reads the string literal, whose leading single quote is already read, along with
+// its optional type cast of PostgreSQL like '\x01ab'::bytea.
+func (p *dumpSqlParser) readStringValue() (any, error) {
+	s, err := p.readString()
+	if err != nil {
+		return nil, err
+	}
+	if peeked, _ := p.reader.Peek(2); len(peeked) < 2 || peeked[0] != ':' || peeked[1] != ':' {
+		return s, nil
+	}
+	_, _ = p.reader.Discard(2)
+	typeName, err := p.readWord()
+	if err != nil {
+		return nil, p.unexpected(err)
+	}
+	if strings.EqualFold(typeName, "bytea") && strings.HasPrefix(s, `\x`) {
+		return decodeDumpHex(s[2:])
+	}
+	return s, nil
+}
+
+// decodeDumpHex decodes the hexadecimal content of binary literal.
+func decodeDumpHex(s string) ([]byte, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, gerror.WrapCodef(gcode.CodeInvalidParameter, err, `invalid dump binary value "%s"`, s)
+	}
+	return b, nil
+}
+
+func (p *dumpSqlParser) unexpected(err error) error {
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return gerror.WrapCode(gcode.CodeInvalidParameter, err, `invalid dump statement`)
+}
+
+func (p *dumpSqlParser) unexpectedChar(c byte) error {
+	return gerror.NewCodef(gcode.CodeInvalidParameter, `invalid dump statement: unexpected char "%c"`, c)
+}
@@ -66,6 +66,8 @@ const (
 	OrmTagForWithOrder    = "order"
 	OrmTagForWithUnscoped = "unscoped"
 	OrmTagForDo           = "do"
+	OrmTagForLink         = "link" // Relation fields for ScanList binding, eg: link:uid=Uid.
+	OrmTagForLinkFrom     = "from" // Relation attribute name for ScanList binding, eg: from:User.
 )
 
 var (
@@ -429,7 +431,19 @@ type formatWhereHolderInput struct {
 	OmitNil   bool
 	OmitEmpty bool
 	Schema    string
-	Table     string // Table is used for fields mapping and filtering internally.
+	Table     string   // Table is used for fields mapping and filtering internally.
+	Aliases   []string // Aliases are the select field aliases that are not prefixed, which is used by HAVING.
+}
+
+// getKeyPrefix returns the prefix for condition key `key`, which is empty if `key` is one of the Aliases.
+func (in formatWhereHolderInput) getKeyPrefix(key string) string {
+	if in.Prefix == "" || len(in.Aliases) == 0 {
+		return in.Prefix
+	}
+	if column, _ := gregex.MatchString(`^\s*([\w\-]+)`, key); len(column) > 1 && gstr.InArray(in.Aliases, column[1]) {
+		return ""
+	}
+	return in.Prefix
 }
 
 func isKeyValueCanBeOmitEmpty(omitEmpty bool, whereType string, key, value any) bool {
@@ -481,7 +495,7 @@ func formatWhereHolder(ctx context.Context, db DB, in formatWhereHolderInput) (n
 				Args:   newArgs,
 				Key:    key,
 				Value:  value,
-				Prefix: in.Prefix,
+				Prefix: in.getKeyPrefix(key),
 				Type:   in.Type,
 			})
 		}
@@ -512,7 +526,7 @@ func formatWhereHolder(ctx context.Context, db DB, in formatWhereHolderInput) (n
 					Key:       ketStr,
 					Value:     value,
 					OmitEmpty: in.OmitEmpty,
-					Prefix:    in.Prefix,
+					Prefix:    in.getKeyPrefix(ketStr),
 					Type:      in.Type,
 				})
 				return true
@@ -597,7 +611,7 @@ func formatWhereHolder(ctx context.Context, db DB, in formatWhereHolderInput) (n
 				Key:       whereStr,
 				Value:     in.Args[0],
 				OmitEmpty: in.OmitEmpty,
-				Prefix:    in.Prefix,
+				Prefix:    in.getKeyPrefix(whereStr),
 				Type:      in.Type,
 			})
 			in.Args = in.Args[:0]
@@ -9,6 +9,7 @@ package gdb
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gogf/gf/v2/text/gregex"
 	"github.com/gogf/gf/v2/text/gstr"
@@ -32,7 +33,9 @@ type Model struct {
 	whereBuilder    *WhereBuilder     // Condition builder for where operation.
 	groupBy         string            // Used for "group by" statement.
 	orderBy         string            // Used for "order by" statement.
+	orderArgs       []any             // Arguments of the "order by" statement.
 	having          []any             // Used for "having..." statement.
+	fieldAliases    []string          // Aliases of the aggregate fields, which can be referenced by HAVING.
 	start           int               // Used for "select ... start, limit ..." statement.
 	limit           int               // Used for "select ... start, limit ..." statement.
 	option          int               // Option for extra operation features.
@@ -43,19 +46,37 @@ type Model struct {
 	filter          bool              // Filter data and where key-value pairs according to the fields of the table.
 	distinct        string            // Force the query to only return distinct results.
 	lockInfo        string            // Lock for update or in shared lock.
+	lockErr         error             // Error of the lock options, which is returned by select statements.
+	fieldsErr       error             // Error of the select fields, which is returned by select statements.
+	stmtTimeout     time.Duration     // Server-side execution timeout of select statements.
 	cacheEnabled    bool              // Enable sql result cache feature, which is mainly for indicating cache duration(especially 0) usage.
 	cacheOption     CacheOption       // Cache option for query statement.
 	pageCacheOption []CacheOption     // Cache option for paging query statement.
 	hookHandler     HookHandler       // Hook functions for model hook feature.
 	unscoped        bool              // Disables soft deleting features when select/delete operations.
+	trashed         trashedScope      // Scope of the soft deleted records of the primary table for select operations.
 	safe            bool              // If true, it clones and returns a new model object whenever operation done; or else it changes the attribute of current model.
 	onDuplicate     any               // onDuplicate is used for on Upsert clause.
 	onDuplicateEx   any               // onDuplicateEx is used for excluding some columns on Upsert clause.
 	onConflict      any               // onConflict is used for conflict keys on Upsert clause.
+	conflictAction  conflictAction    // conflictAction is the action of Insert operation when conflict occurs.
 	tableAliasMap   map[string]string // Table alias to true table name, usually used in join statements.
 	softTimeOption  SoftTimeOption    // SoftTimeOption is the option to customize soft time feature for Model.
+	softDeleteField string            // Soft deleting field of the primary table, see SoftDeleteField.
+	softDeleteType  SoftDeleteType    // Value semantics of the soft deleting field.
 	shardingConfig  ShardingConfig    // ShardingConfig for database/table sharding feature.
 	shardingValue   any               // Sharding value for sharding feature.
+	retryOption     RetryOption       // Retry option for idempotent statements.
+	mappingOption   []MappingOption   // Mapping option for converting records to structs.
+	inSplitSize     int               // Maximum count of values of the IN condition in one select statement.
+	replaceMode     ReplaceMode       // Mode of Replace operation.
+	resultLimit     ResultLimit       // Guardrail limiting the size of the result set of select statements.
+	readOnly        *bool             // Read-only guard rejecting writes, it is automatically detected for views if nil.
+	failoverOption  FailoverOption    // Failover option for reading from the fallback database when the primary is down.
+	maskRules       []MaskRule        // Masking rules for the results of select statements.
+	ctes            []modelCTE        // Common table expressions for the WITH clause of select statements.
+	fieldMask       []string          // Field mask limiting the written columns of the data, see FieldMask.
+	sanitizeRules   []SanitizeRule    // Sanitizing rules for the data of insert/update/save operations.
 }
 
 // ModelHandler is a function that handles given Model and returns a new Model that is custom modified.
@@ -145,6 +166,15 @@ func (c *Core) Model(tableNameQueryOrStruct ...any) *Model {
 	if defaultModelSafe {
 		m.safe = true
 	}
+	// Group level default options.
+	if config := c.db.GetConfig(); config != nil {
+		if config.ModelSafe {
+			m.safe = true
+		}
+		if config.ModelOmitEmptyWhere {
+			m.option = m.option | optionOmitEmptyWhere
+		}
+	}
 	return m
 }
 
@@ -295,6 +325,10 @@ func (m *Model) Clone() *Model {
 		newModel.extraArgs = make([]any, n)
 		copy(newModel.extraArgs, m.extraArgs)
 	}
+	if n := len(m.orderArgs); n > 0 {
+		newModel.orderArgs = make([]any, n)
+		copy(newModel.orderArgs, m.orderArgs)
+	}
 	if n := len(m.withArray); n > 0 {
 		newModel.withArray = make([]any, n)
 		copy(newModel.withArray, m.withArray)
@@ -303,6 +337,10 @@ func (m *Model) Clone() *Model {
 		newModel.having = make([]any, n)
 		copy(newModel.having, m.having)
 	}
+	if n := len(m.fieldAliases); n > 0 {
+		newModel.fieldAliases = make([]string, n)
+		copy(newModel.fieldAliases, m.fieldAliases)
+	}
 	return newModel
 }
 
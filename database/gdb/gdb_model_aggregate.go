@@ -0,0 +1,103 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+// AggregateFunction is the SQL aggregate function for Aggregate.
+type AggregateFunction string
+
+const (
+	AggregateSum AggregateFunction = "SUM"
+	AggregateAvg AggregateFunction = "AVG"
+	AggregateMin AggregateFunction = "MIN"
+	AggregateMax AggregateFunction = "MAX"
+)
+
+// Aggregate does "SELECT FUNCTION(x) FROM ..." statement for the model, and converts the value to type `T`
+// without round-tripping through float64 like Sum/Avg/Min/Max, which keeps the precision of big integers
+// and DECIMAL columns, and supports time columns. It returns the zero value of `T` if there's no record.
+//
+// The type `T` can be any type supported by gconv.Scan, and time.Time/*gtime.Time for time columns, eg:
+//
+//	total, err := gdb.Aggregate[int64](db.Model("order"), gdb.AggregateSum, "quantity")
+//	last, err := gdb.Aggregate[time.Time](db.Model("order"), gdb.AggregateMax, "created_at")
+func Aggregate[T any](m *Model, function AggregateFunction, column string) (result T, err error) {
+	if len(column) == 0 {
+		return
+	}
+	value, err := m.doAggregate(function, column)
+	if err != nil || value.IsNil() {
+		return
+	}
+	switch v := any(&result).(type) {
+	case *time.Time:
+		*v = value.Time()
+	case **gtime.Time:
+		*v = value.GTime()
+	case *gtime.Time:
+		if t := value.GTime(); t != nil {
+			*v = *t
+		}
+	default:
+		err = gconv.Scan(value.Val(), &result)
+	}
+	return
+}
+
+// SumInt64 does "SELECT SUM(x) FROM ..." statement for the model, and returns the sum as int64,
+// which keeps the precision of big integers.
+func (m *Model) SumInt64(column string) (int64, error) {
+	if len(column) == 0 {
+		return 0, nil
+	}
+	value, err := m.doAggregate(AggregateSum, column)
+	if err != nil {
+		return 0, err
+	}
+	return value.Int64(), nil
+}
+
+// SumDecimal does "SELECT SUM(x) FROM ..." statement for the model, and returns the sum of DECIMAL column
+// as the exact decimal string like "1024.50" returned by database, which can be parsed by decimal libraries.
+// It returns "0" if there's no record.
+func (m *Model) SumDecimal(column string) (string, error) {
+	if len(column) == 0 {
+		return "0", nil
+	}
+	value, err := m.doAggregate(AggregateSum, column)
+	if err != nil {
+		return "", err
+	}
+	if value.IsNil() {
+		return "0", nil
+	}
+	return value.String(), nil
+}
+
+// MinTime does "SELECT MIN(x) FROM ..." statement for the model, and returns the minimum time of time
+// column, which is nil if there's no record.
+func (m *Model) MinTime(column string) (*gtime.Time, error) {
+	return Aggregate[*gtime.Time](m, AggregateMin, column)
+}
+
+// MaxTime does "SELECT MAX(x) FROM ..." statement for the model, and returns the maximum time of time
+// column, which is nil if there's no record.
+func (m *Model) MaxTime(column string) (*gtime.Time, error) {
+	return Aggregate[*gtime.Time](m, AggregateMax, column)
+}
+
+// doAggregate does "SELECT FUNCTION(x) FROM ..." statement for the model and returns the value.
+func (m *Model) doAggregate(function AggregateFunction, column string) (Value, error) {
+	return m.Fields(fmt.Sprintf(`%s(%s)`, function, m.QuoteWord(column))).Value()
+}
@@ -14,6 +14,7 @@ import (
 type WhereBuilder struct {
 	model       *Model        // A WhereBuilder should be bound to certain Model.
 	whereHolder []WhereHolder // Condition strings for where operation.
+	err         error         // Error of the where conditions, which is returned by the statements of the Model.
 }
 
 // WhereHolder is the holder for where condition preparing.
@@ -34,6 +35,16 @@ func (m *Model) Builder() *WhereBuilder {
 	return b
 }
 
+// groupWhere returns the model of which the existing where conditions are grouped in parentheses,
+// so that the conditions appended later are not mixed up with the OR conditions by precedence.
+func (m *Model) groupWhere() *Model {
+	model := m.getModel()
+	if len(model.whereBuilder.whereHolder) > 1 {
+		model.whereBuilder = model.Builder().Where(model.whereBuilder)
+	}
+	return model
+}
+
 // getBuilder creates and returns a cloned WhereBuilder of current WhereBuilder
 func (b *WhereBuilder) getBuilder() *WhereBuilder {
 	return b.Clone()
@@ -43,6 +54,7 @@ func (b *WhereBuilder) getBuilder() *WhereBuilder {
 func (b *WhereBuilder) Clone() *WhereBuilder {
 	newBuilder := b.model.Builder()
 	newBuilder.whereHolder = make([]WhereHolder, len(b.whereHolder))
+	newBuilder.err = b.err
 	copy(newBuilder.whereHolder, b.whereHolder)
 	return newBuilder
 }
@@ -67,6 +79,7 @@ func (b *WhereBuilder) Build() (conditionWhere string, conditionArgs []any) {
 					OmitEmpty:   b.model.option&optionOmitEmptyWhere > 0,
 					Schema:      b.model.schema,
 					Table:       tableForMappingAndFiltering,
+					Aliases:     b.model.fieldAliases,
 				})
 				if len(newWhere) > 0 {
 					if len(conditionWhere) == 0 {
@@ -86,6 +99,7 @@ func (b *WhereBuilder) Build() (conditionWhere string, conditionArgs []any) {
 					OmitEmpty:   b.model.option&optionOmitEmptyWhere > 0,
 					Schema:      b.model.schema,
 					Table:       tableForMappingAndFiltering,
+					Aliases:     b.model.fieldAliases,
 				})
 				if len(newWhere) > 0 {
 					if len(conditionWhere) == 0 {
@@ -103,8 +117,9 @@ func (b *WhereBuilder) Build() (conditionWhere string, conditionArgs []any) {
 	return
 }
 
-// convertWhereBuilder converts parameter `where` to condition string and parameters if `where` is also a WhereBuilder.
-func (b *WhereBuilder) convertWhereBuilder(where any, args []any) (newWhere any, newArgs []any) {
+// convertWhereBuilder converts parameter `where` to condition string and parameters if `where` is also a WhereBuilder
+// or a FuncExpr. It also returns the error of the conditions if `where` is a WhereBuilder.
+func (b *WhereBuilder) convertWhereBuilder(where any, args []any) (newWhere any, newArgs []any, err error) {
 	var builder *WhereBuilder
 	switch v := where.(type) {
 	case WhereBuilder:
@@ -112,13 +127,27 @@ func (b *WhereBuilder) convertWhereBuilder(where any, args []any) (newWhere any,
 
 	case *WhereBuilder:
 		builder = v
+
+	case FuncExpr, *FuncExpr:
+		newWhere, newArgs = convertFuncExprWhere(b.model.db, where, args)
+		return newWhere, newArgs, nil
 	}
 	if builder != nil {
 		conditionWhere, conditionArgs := builder.Build()
 		if conditionWhere != "" && (len(b.whereHolder) == 0 || len(builder.whereHolder) > 1) {
 			conditionWhere = "(" + conditionWhere + ")"
 		}
-		return conditionWhere, conditionArgs
+		return conditionWhere, conditionArgs, builder.err
+	}
+	return where, args, nil
+}
+
+// getBuilderWithErr creates and returns a cloned WhereBuilder of current WhereBuilder,
+// which keeps the first error of the where conditions.
+func (b *WhereBuilder) getBuilderWithErr(err error) *WhereBuilder {
+	builder := b.getBuilder()
+	if builder.err == nil {
+		builder.err = err
 	}
-	return where, args
+	return builder
 }
@@ -8,17 +8,25 @@ package gdb
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
+	"time"
 
+	"github.com/gogf/gf/v2/container/gmap"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
 	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gconv"
 )
 
 // doWhereType sets the condition statement for the model. The parameter `where` can be type of
 // string/map/gmap/slice/struct/*struct, etc. Note that, if it's called more than one times,
 // multiple conditions will be joined into where statement using "AND".
 func (b *WhereBuilder) doWhereType(whereType string, where any, args ...any) *WhereBuilder {
-	where, args = b.convertWhereBuilder(where, args)
+	where, args, err := b.convertWhereBuilder(where, args)
 
-	builder := b.getBuilder()
+	builder := b.getBuilderWithErr(err)
 	if builder.whereHolder == nil {
 		builder.whereHolder = make([]WhereHolder, 0)
 	}
@@ -42,6 +50,7 @@ func (b *WhereBuilder) doWhereType(whereType string, where any, args ...any) *Wh
 // Note that if the number of `args` is more than the placeholder in `format`,
 // the extra `args` will be used as the where condition arguments of the Model.
 func (b *WhereBuilder) doWherefType(t string, format string, args ...any) *WhereBuilder {
+	format = formatSubQueryInHolder(t, format, args)
 	var (
 		placeHolderCount = gstr.Count(format, "?")
 		conditionStr     = fmt.Sprintf(format, args[:len(args)-placeHolderCount]...)
@@ -49,6 +58,18 @@ func (b *WhereBuilder) doWherefType(t string, format string, args ...any) *Where
 	return b.doWhereType(t, conditionStr, args[len(args)-placeHolderCount:]...)
 }
 
+// formatSubQueryInHolder removes the brackets of the "IN (?)" holder if its value is a sub query Model,
+// as the brackets are automatically added for sub query.
+func formatSubQueryInHolder(t string, format string, args []any) string {
+	if t != whereHolderTypeIn || len(args) == 0 {
+		return format
+	}
+	if _, ok := args[len(args)-1].(*Model); ok {
+		return gstr.Replace(format, "(?)", "?")
+	}
+	return format
+}
+
 // Where sets the condition statement for the builder. The parameter `where` can be type of
 // string/map/gmap/slice/struct/*struct, etc. Note that, if it's called more than one times,
 // multiple conditions will be joined into where statement using "AND".
@@ -79,14 +100,101 @@ func (b *WhereBuilder) Wheref(format string, args ...any) *WhereBuilder {
 // key value. That is, if primary key is "id" and given `where` parameter as "123", the
 // WherePri function treats the condition as "id=123", but Model.Where treats the condition
 // as string "123".
+//
+// For the table of composite primary key, the parameter `where` can also be:
+// a slice of the key values each in order of the primary key fields, eg: g.Slice{g.Slice{1, "a"}, g.Slice{2, "b"}};
+// a slice of map/struct of the key values, eg: g.Slice{g.Map{"uid": 1, "type": "a"}};
+// a map/struct of the key values, eg: g.Map{"uid": 1, "type": "a"}.
+// The other condition, including the flat slice like g.Slice{1, 2}, is treated as the value(s)
+// of the first primary key field.
 func (b *WhereBuilder) WherePri(where any, args ...any) *WhereBuilder {
 	if len(args) > 0 {
 		return b.Where(where, args...)
 	}
+	if primaryKeys := b.model.getPrimaryKeys(); len(primaryKeys) > 1 {
+		if builder := b.getCompositePrimaryKeyCondition(primaryKeys, where); builder != nil {
+			return b.Where(builder)
+		}
+	}
 	newWhere := GetPrimaryKeyCondition(b.model.getPrimaryKey(), where)
 	return b.Where(newWhere[0], newWhere[1:]...)
 }
 
+// getCompositePrimaryKeyCondition creates and returns the condition builder for the composite
+// primary key values `where`. It returns nil if `where` is not the composite key values.
+func (b *WhereBuilder) getCompositePrimaryKeyCondition(primaryKeys []string, where any) *WhereBuilder {
+	rv := reflect.ValueOf(where)
+	for rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+	default:
+		return nil
+	}
+	if rv.Len() == 0 {
+		return nil
+	}
+	if _, ok := where.([]byte); ok {
+		return nil
+	}
+	if !isCompositeKeyValues(rv.Index(0)) {
+		// It is the values of the first primary key field like g.Slice{1, 2}.
+		return nil
+	}
+	builder := b.model.Builder()
+	for i := 0; i < rv.Len(); i++ {
+		item := rv.Index(i)
+		for item.Kind() == reflect.Interface || item.Kind() == reflect.Pointer {
+			item = item.Elem()
+		}
+		switch item.Kind() {
+		case reflect.Slice, reflect.Array:
+			if item.Len() != len(primaryKeys) {
+				// The key values do not match the primary key fields, which matches nothing.
+				builder = builder.WhereOr("0=1")
+				continue
+			}
+			builder = builder.WhereOr(b.model.Builder().Where(b.getCompositePrimaryKeyMap(primaryKeys, item)))
+		default:
+			builder = builder.WhereOr(b.model.Builder().Where(item.Interface()))
+		}
+	}
+	return builder
+}
+
+// getCompositePrimaryKeyMap returns the ordered map of the primary keys and their values from slice `rv`.
+func (b *WhereBuilder) getCompositePrimaryKeyMap(primaryKeys []string, rv reflect.Value) *gmap.ListMap {
+	keyMap := gmap.NewListMap()
+	for i, key := range primaryKeys {
+		keyMap.Set(key, rv.Index(i).Interface())
+	}
+	return keyMap
+}
+
+// isCompositeKeyValues checks whether `rv` is the key values of a composite primary key,
+// which is type of slice/array/map/struct.
+func isCompositeKeyValues(rv reflect.Value) bool {
+	for rv.Kind() == reflect.Interface || rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		return rv.Type().Elem().Kind() != reflect.Uint8
+	case reflect.Map:
+		return true
+	case reflect.Struct:
+		// The time values are single key values.
+		switch rv.Interface().(type) {
+		case time.Time, gtime.Time:
+			return false
+		}
+		return true
+	default:
+		return false
+	}
+}
+
 // WhereLT builds `column < value` statement.
 func (b *WhereBuilder) WhereLT(column string, value any) *WhereBuilder {
 	return b.Wheref(`%s < ?`, b.model.QuoteWord(column), value)
@@ -118,6 +226,7 @@ func (b *WhereBuilder) WhereLike(column string, like string) *WhereBuilder {
 }
 
 // WhereIn builds `column IN (in)` statement.
+// The parameter `in` can also be a sub query Model, eg: WhereIn("id", db.Model("order").Fields("user_id")).
 func (b *WhereBuilder) WhereIn(column string, in any) *WhereBuilder {
 	return b.doWherefType(whereHolderTypeIn, `%s IN (?)`, b.model.QuoteWord(column), in)
 }
@@ -161,11 +270,95 @@ func (b *WhereBuilder) WhereNotNull(columns ...string) *WhereBuilder {
 }
 
 // WhereExists builds `EXISTS (subQuery)` statement.
-func (b *WhereBuilder) WhereExists(subQuery *Model) *WhereBuilder {
-	return b.Wheref(`EXISTS (?)`, subQuery)
+// The `subQuery` can reference the columns of the outer model, which makes a correlated sub query.
+//
+// The optional `correlations` correlate the sub query with the outer model automatically, each of which
+// is like "sub_column=outer_column", or "column" for the same column name of both. The columns are
+// qualified with the declared aliases of the tables, or the table names if no alias, eg:
+//
+//	db.Model("user u").WhereExists(db.Model("order o").Where("o.status", 1), "uid=id")
+//	// SELECT * FROM `user` u WHERE EXISTS (SELECT * FROM `order` o WHERE (o.status=1) AND (`o`.`uid`=`u`.`id`))
+func (b *WhereBuilder) WhereExists(subQuery *Model, correlations ...string) *WhereBuilder {
+	// The brackets are automatically added for sub query.
+	return b.Wheref(`EXISTS ?`, b.correlateSubQuery(subQuery, correlations))
 }
 
 // WhereNotExists builds `NOT EXISTS (subQuery)` statement.
-func (b *WhereBuilder) WhereNotExists(subQuery *Model) *WhereBuilder {
-	return b.Wheref(`NOT EXISTS (?)`, subQuery)
+// See WhereExists for the optional `correlations`.
+func (b *WhereBuilder) WhereNotExists(subQuery *Model, correlations ...string) *WhereBuilder {
+	return b.Wheref(`NOT EXISTS ?`, b.correlateSubQuery(subQuery, correlations))
+}
+
+// correlateSubQuery returns a copy of `subQuery` with the conditions correlating it with the model of
+// the builder by `correlations`, or `subQuery` itself if there's no correlation.
+func (b *WhereBuilder) correlateSubQuery(subQuery *Model, correlations []string) *Model {
+	if len(correlations) == 0 {
+		return subQuery
+	}
+	var (
+		model       = subQuery.Clone()
+		subPrefix   = subQuery.getTableAliasOrName()
+		outerPrefix = b.model.getTableAliasOrName()
+	)
+	for _, correlation := range correlations {
+		subColumn, outerColumn := correlation, correlation
+		if pos := strings.Index(correlation, "="); pos != -1 {
+			subColumn = strings.TrimSpace(correlation[:pos])
+			outerColumn = strings.TrimSpace(correlation[pos+1:])
+		}
+		model = model.Wheref(
+			`%s.%s=%s.%s`,
+			subPrefix, model.QuoteWord(subColumn), outerPrefix, b.model.QuoteWord(outerColumn),
+		)
+	}
+	return model
+}
+
+// WhereLastDays builds `column >= ?` statement, in which the parameter is the time `days` days
+// before now. It is usually used for the relative time predicates of dashboards.
+func (b *WhereBuilder) WhereLastDays(column string, days int) *WhereBuilder {
+	return b.Wheref(`%s >= ?`, b.model.QuoteWord(column), time.Now().AddDate(0, 0, -days))
+}
+
+// WhereDateBetween builds `column >= start AND column < end` statement, in which the start is the
+// beginning of date `startDate` and the end is the beginning of the next day of date `endDate`,
+// so that both the dates are included whatever the time part of the column values is.
+// The parameters `startDate` and `endDate` can be type of time.Time/*gtime.Time or date string
+// like "2024-01-01". If any of them cannot be parsed as date, the error is returned by the statements.
+func (b *WhereBuilder) WhereDateBetween(column string, startDate, endDate any) *WhereBuilder {
+	start, err := parseWhereDate(startDate)
+	if err != nil {
+		return b.getBuilderWithErr(err)
+	}
+	end, err := parseWhereDate(endDate)
+	if err != nil {
+		return b.getBuilderWithErr(err)
+	}
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	end = time.Date(end.Year(), end.Month(), end.Day()+1, 0, 0, 0, 0, end.Location())
+	return b.Wheref(`%s >= ? AND %s < ?`, b.model.QuoteWord(column), b.model.QuoteWord(column), start, end)
+}
+
+// parseWhereDate parses and returns the date `value` strictly, which can be type of time.Time/*gtime.Time
+// or date string. It returns error if `value` is empty or cannot be parsed.
+func parseWhereDate(value any) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case *time.Time:
+		if v != nil {
+			return *v, nil
+		}
+	case gtime.Time:
+		return v.Time, nil
+	case *gtime.Time:
+		if v != nil {
+			return v.Time, nil
+		}
+	default:
+		if t, err := gtime.StrToTime(gconv.String(value)); err == nil && t != nil && !t.IsZero() {
+			return t.Time, nil
+		}
+	}
+	return time.Time{}, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid date value "%v"`, value)
 }
@@ -11,9 +11,9 @@ package gdb
 // WherePrefix("order", "status", "paid")                        => WHERE `order`.`status`='paid'
 // WherePrefix("order", struct{Status:"paid", "channel":"bank"}) => WHERE `order`.`status`='paid' AND `order`.`channel`='bank'
 func (b *WhereBuilder) WherePrefix(prefix string, where any, args ...any) *WhereBuilder {
-	where, args = b.convertWhereBuilder(where, args)
+	where, args, err := b.convertWhereBuilder(where, args)
 
-	builder := b.getBuilder()
+	builder := b.getBuilderWithErr(err)
 	if builder.whereHolder == nil {
 		builder.whereHolder = make([]WhereHolder, 0)
 	}
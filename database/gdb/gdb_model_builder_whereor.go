@@ -14,9 +14,9 @@ import (
 
 // WhereOr adds "OR" condition to the where statement.
 func (b *WhereBuilder) doWhereOrType(t string, where any, args ...any) *WhereBuilder {
-	where, args = b.convertWhereBuilder(where, args)
+	where, args, err := b.convertWhereBuilder(where, args)
 
-	builder := b.getBuilder()
+	builder := b.getBuilderWithErr(err)
 	if builder.whereHolder == nil {
 		builder.whereHolder = make([]WhereHolder, 0)
 	}
@@ -31,6 +31,7 @@ func (b *WhereBuilder) doWhereOrType(t string, where any, args ...any) *WhereBui
 
 // WhereOrf builds `OR` condition string using fmt.Sprintf and arguments.
 func (b *WhereBuilder) doWhereOrfType(t string, format string, args ...any) *WhereBuilder {
+	format = formatSubQueryInHolder(t, format, args)
 	var (
 		placeHolderCount = gstr.Count(format, "?")
 		conditionStr     = fmt.Sprintf(format, args[:len(args)-placeHolderCount]...)
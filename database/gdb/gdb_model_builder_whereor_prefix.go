@@ -11,9 +11,9 @@ package gdb
 // WhereOrPrefix("order", "status", "paid")                        => WHERE xxx OR (`order`.`status`='paid')
 // WhereOrPrefix("order", struct{Status:"paid", "channel":"bank"}) => WHERE xxx OR (`order`.`status`='paid' AND `order`.`channel`='bank')
 func (b *WhereBuilder) WhereOrPrefix(prefix string, where any, args ...any) *WhereBuilder {
-	where, args = b.convertWhereBuilder(where, args)
+	where, args, err := b.convertWhereBuilder(where, args)
 
-	builder := b.getBuilder()
+	builder := b.getBuilderWithErr(err)
 	builder.whereHolder = append(builder.whereHolder, WhereHolder{
 		Type:     whereHolderTypeDefault,
 		Operator: whereHolderOperatorOr,
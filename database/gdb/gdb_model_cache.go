@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/gogf/gf/v2/internal/intlog"
+	"github.com/gogf/gf/v2/os/gcache"
 )
 
 // CacheOption is options for model cache control in query.
@@ -29,12 +30,25 @@ type CacheOption struct {
 	// Force caches the query result whatever the result is nil or not.
 	// It is used to avoid Cache Penetration.
 	Force bool
+
+	// Jitter is the ratio in range (0, 1] of Duration that is randomly reduced from Duration,
+	// which avoids the synchronized expirations of the caches set at the same time.
+	// eg: 0.1 makes the cache expire in [0.9*Duration, Duration].
+	Jitter float64
+
+	// EarlyRefresh is the eagerness of refreshing the cache probabilistically before it expires,
+	// which is usually 1, and greater value refreshes earlier. It is disabled if it is 0.
+	// It avoids the concurrent queries on the database when the cache expires, as only few of
+	// the queries refresh the cache early, see gcache.ShouldRefreshEarly.
+	EarlyRefresh float64
 }
 
 // selectCacheItem is the cache item for SELECT statement result.
 type selectCacheItem struct {
-	Result            Result // Sql result of SELECT statement.
-	FirstResultColumn string // The first column name of result, for Value/Count functions.
+	Result            Result        // Sql result of SELECT statement.
+	ColumnTypes       []ColumnType  // Column types of the result, see Result.ColumnTypes.
+	FirstResultColumn string        // The first column name of result, for Value/Count functions.
+	Cost              time.Duration // Duration of the SELECT statement, for early refresh feature.
 }
 
 // Cache sets the cache feature for the model. It caches the result of the sql, which means
@@ -45,7 +59,7 @@ type selectCacheItem struct {
 // on a transaction.
 func (m *Model) Cache(option CacheOption) *Model {
 	model := m.getModel()
-	model.cacheOption = option
+	model.cacheOption = m.withDefaultCacheDuration(option)
 	model.cacheEnabled = true
 	return model
 }
@@ -57,11 +71,25 @@ func (m *Model) Cache(option CacheOption) *Model {
 // on a transaction.
 func (m *Model) PageCache(countOption CacheOption, dataOption CacheOption) *Model {
 	model := m.getModel()
-	model.pageCacheOption = []CacheOption{countOption, dataOption}
+	model.pageCacheOption = []CacheOption{
+		m.withDefaultCacheDuration(countOption),
+		m.withDefaultCacheDuration(dataOption),
+	}
 	model.cacheEnabled = true
 	return model
 }
 
+// withDefaultCacheDuration applies the group level default cache duration to `option`
+// if its Duration is 0.
+func (m *Model) withDefaultCacheDuration(option CacheOption) CacheOption {
+	if option.Duration == 0 {
+		if config := m.db.GetConfig(); config != nil && config.ModelCacheDuration > 0 {
+			option.Duration = config.ModelCacheDuration
+		}
+	}
+	return option
+}
+
 // checkAndRemoveSelectCache checks and removes the cache in insert/update/delete statement if
 // cache feature is enabled.
 func (m *Model) checkAndRemoveSelectCache(ctx context.Context) {
@@ -93,16 +121,42 @@ func (m *Model) getSelectResultFromCache(ctx context.Context, sql string, args .
 		}
 	}()
 	if v, _ := cacheObj.Get(ctx, cacheKey); !v.IsNil() {
-		if err = v.Scan(&cacheItem); err != nil {
+		if codec := core.getCachePayloadCodec(); codec != nil {
+			// The payload failing decoding is treated as cache missing.
+			if cacheItem, err = codec.decode(v.Bytes()); err != nil {
+				intlog.Errorf(ctx, `%+v`, err)
+				return nil, nil
+			}
+		} else if err = v.Scan(&cacheItem); err != nil {
 			return nil, err
 		}
-		return cacheItem.Result, nil
+		if cacheItem == nil || m.shouldRefreshCacheEarly(ctx, cacheKey, cacheItem) {
+			cacheItem = nil
+			return nil, nil
+		}
+		if cacheItem.Result == nil {
+			return nil, nil
+		}
+		return cacheItem.Result.withColumnTypes(ctx, cacheItem.ColumnTypes), nil
 	}
 	return
 }
 
+// shouldRefreshCacheEarly checks whether the cache item should be refreshed before it expires.
+func (m *Model) shouldRefreshCacheEarly(ctx context.Context, cacheKey string, cacheItem *selectCacheItem) bool {
+	if m.cacheOption.EarlyRefresh <= 0 || cacheItem.Cost <= 0 {
+		return false
+	}
+	remaining, err := m.db.GetCache().GetExpire(ctx, cacheKey)
+	if err != nil {
+		intlog.Errorf(ctx, `%+v`, err)
+		return false
+	}
+	return gcache.ShouldRefreshEarly(remaining, cacheItem.Cost, m.cacheOption.EarlyRefresh)
+}
+
 func (m *Model) saveSelectResultToCache(
-	ctx context.Context, selectType SelectType, result Result, sql string, args ...any,
+	ctx context.Context, selectType SelectType, result Result, cost time.Duration, sql string, args ...any,
 ) (err error) {
 	if !m.cacheEnabled || m.tx != nil {
 		return
@@ -117,6 +171,8 @@ func (m *Model) saveSelectResultToCache(
 		}
 		return
 	}
+	// The column types are kept before the result is changed for caching.
+	columnTypes := result.ColumnTypes()
 	// Special handler for Value/Count operations result.
 	if len(result) > 0 {
 		var core = m.db.GetCore()
@@ -142,13 +198,25 @@ func (m *Model) saveSelectResultToCache(
 	var (
 		core      = m.db.GetCore()
 		cacheItem = &selectCacheItem{
-			Result: result,
+			Result:      result,
+			ColumnTypes: columnTypes,
+			Cost:        cost,
 		}
 	)
 	if internalData := core.getInternalColumnFromCtx(ctx); internalData != nil {
 		cacheItem.FirstResultColumn = internalData.FirstResultColumn
 	}
-	if errCache := cacheObj.Set(ctx, cacheKey, cacheItem, m.cacheOption.Duration); errCache != nil {
+	var cacheValue any = cacheItem
+	if codec := core.getCachePayloadCodec(); codec != nil {
+		payload, errCache := codec.encode(cacheItem)
+		if errCache != nil {
+			intlog.Errorf(ctx, `%+v`, errCache)
+			return
+		}
+		cacheValue = payload
+	}
+	duration := gcache.JitterDuration(m.cacheOption.Duration, m.cacheOption.Jitter)
+	if errCache := cacheObj.Set(ctx, cacheKey, cacheValue, duration); errCache != nil {
 		intlog.Errorf(ctx, `%+v`, errCache)
 	}
 	return
@@ -0,0 +1,124 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// modelCTE is the common table expression of the model.
+type modelCTE struct {
+	Name      string // Name of the CTE, which can be with column names like: tree(id, parent_id).
+	Query     *Model // Sub query of the CTE, or the anchor member of the recursive CTE.
+	Recursive *Model // Recursive member of the recursive CTE, which is nil for non-recursive CTE.
+}
+
+// WithCTE adds common table expression "WITH name AS (subQuery)" to the select statement of the model,
+// so that the `name` can be used as table in the model like: Model, Join and Where sub-queries.
+// It can be called multiple times for multiple CTEs.
+//
+// The parameter `name` can be with column names like: "tree(id, parent_id)", and the table prefix
+// of the configuration is also added to the name like the table names of the model.
+//
+// Example:
+//
+//	db.Model("active_user").
+//		WithCTE("active_user", db.Model("user").Where("status", 1)).
+//		Where("age>?", 18).
+//		All()
+//	// WITH `active_user` AS (SELECT * FROM `user` WHERE `status`=1)
+//	// SELECT * FROM `active_user` WHERE age>18
+func (m *Model) WithCTE(name string, subQuery *Model) *Model {
+	model := m.getModel()
+	model.ctes = append(append([]modelCTE{}, m.ctes...), modelCTE{
+		Name:  name,
+		Query: subQuery,
+	})
+	return model
+}
+
+// WithRecursive adds recursive common table expression
+// "WITH RECURSIVE name AS (anchor UNION ALL recursive)" to the select statement of the model,
+// which is usually used for querying hierarchical data like trees.
+// The `recursive` member references the `name` as table.
+//
+// Note that some databases like SQLServer and Oracle do not support the RECURSIVE keyword,
+// in which case WithCTE with a Raw sub query should be used.
+//
+// Example:
+//
+//	db.Model("tree").WithRecursive(
+//		"tree",
+//		db.Model("category").Where("id", 1),
+//		db.Model("category c").InnerJoin("tree t", "c.parent_id=t.id").Fields("c.*"),
+//	).All()
+//	// WITH RECURSIVE `tree` AS (
+//	//   SELECT * FROM `category` WHERE `id`=1
+//	//   UNION ALL
+//	//   SELECT c.* FROM `category` AS c INNER JOIN `tree` AS t ON (c.parent_id=t.id)
+//	// ) SELECT * FROM `tree`
+func (m *Model) WithRecursive(name string, anchor, recursive *Model) *Model {
+	model := m.getModel()
+	model.ctes = append(append([]modelCTE{}, m.ctes...), modelCTE{
+		Name:      name,
+		Query:     anchor,
+		Recursive: recursive,
+	})
+	return model
+}
+
+// getCTEClauseAndArgs returns the "WITH ..." clause and its arguments of the model,
+// which is prepended to the select statement.
+func (m *Model) getCTEClauseAndArgs(ctx context.Context) (clause string, args []any) {
+	if len(m.ctes) == 0 {
+		return "", nil
+	}
+	var (
+		recursive bool
+		items     = make([]string, 0, len(m.ctes))
+	)
+	for _, cte := range m.ctes {
+		querySql, queryArgs := cte.Query.getHolderAndArgsAsSubModel(ctx)
+		args = append(args, queryArgs...)
+		if cte.Recursive != nil {
+			recursive = true
+			recursiveSql, recursiveArgs := cte.Recursive.getHolderAndArgsAsSubModel(ctx)
+			querySql = fmt.Sprintf(`%s UNION ALL %s`, querySql, recursiveSql)
+			args = append(args, recursiveArgs...)
+		}
+		items = append(items, fmt.Sprintf(`%s AS (%s)`, m.quoteCTEName(cte.Name), querySql))
+	}
+	clause = "WITH "
+	if recursive {
+		clause = "WITH RECURSIVE "
+	}
+	return clause + strings.Join(items, ", ") + " ", args
+}
+
+// quoteCTEName quotes the CTE name with table prefix, and keeps its column names if any.
+func (m *Model) quoteCTEName(name string) string {
+	name = strings.TrimSpace(name)
+	if pos := strings.Index(name, "("); pos > 0 {
+		return m.db.GetCore().QuotePrefixTableName(strings.TrimSpace(name[:pos])) + name[pos:]
+	}
+	return m.db.GetCore().QuotePrefixTableName(name)
+}
+
+// mergeSelectArguments creates and returns new arguments for select statement,
+// which merges the arguments of CTEs, sub query fields, `m.extraArgs` and given `args` in sequence.
+func (m *Model) mergeSelectArguments(ctx context.Context, args []any) []any {
+	var (
+		_, cteArgs = m.getCTEClauseAndArgs(ctx)
+		fieldsArgs = m.getFieldsArgs(ctx)
+	)
+	if len(cteArgs) == 0 && len(fieldsArgs) == 0 {
+		return m.mergeArguments(args)
+	}
+	return append(append(cteArgs, fieldsArgs...), m.mergeArguments(args)...)
+}
@@ -0,0 +1,205 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/json"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+// Cursor is the opaque cursor of keyset pagination, which encodes the sort values of the last record
+// of the page. The empty Cursor is for the first page.
+type Cursor string
+
+// cursorColumn is a sort column of keyset pagination.
+type cursorColumn struct {
+	Column string // Column is the quoted column in ORDER BY clause, like "`u`.`id`".
+	Key    string // Key is the column name in the result record, like "id".
+	Desc   bool   // Desc marks the column is sorted in descending order.
+}
+
+// CursorPaginate queries and returns a page of `size` records after `cursor` using keyset pagination,
+// along with the cursor of the next page, which is empty if there's no more records.
+//
+// Unlike Page with OFFSET, it seeks the page by the sort values of the last record of the previous page
+// like "WHERE (`score` < ?) OR (`score` = ? AND `id` > ?)", which is as fast for deep pages as the first
+// page if there's index on the sort columns.
+//
+// The sort columns are from Order of the model, which should be plain columns without expressions, and
+// the primary key is appended automatically as the tie-breaker if it is not in the sort columns.
+// The sort columns should not be NULL. Example:
+//
+//	result, next, err := db.Model("article").Where("status", 1).OrderDesc("score").CursorPaginate(cursor, 20)
+func (m *Model) CursorPaginate(cursor Cursor, size int) (result Result, next Cursor, err error) {
+	if size <= 0 {
+		return nil, "", gerror.NewCodef(gcode.CodeInvalidParameter, `invalid page size %d for cursor pagination`, size)
+	}
+	columns, err := m.getCursorColumns()
+	if err != nil {
+		return nil, "", err
+	}
+	model := m.Clone()
+	if primaryKey := m.getPrimaryKey(); primaryKey != "" {
+		var found bool
+		for _, column := range columns {
+			if strings.EqualFold(column.Key, primaryKey) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			column := cursorColumn{Column: m.QuoteWord(primaryKey), Key: primaryKey}
+			if len(columns) > 0 {
+				column.Desc = columns[len(columns)-1].Desc
+			}
+			columns = append(columns, column)
+			if column.Desc {
+				model = model.OrderDesc(primaryKey)
+			} else {
+				model = model.OrderAsc(primaryKey)
+			}
+		}
+	}
+	if len(columns) == 0 {
+		return nil, "", gerror.NewCode(
+			gcode.CodeMissingParameter,
+			`sort columns or primary key are required for cursor pagination`,
+		)
+	}
+	if cursor != "" {
+		values, err := cursor.decode(len(columns))
+		if err != nil {
+			return nil, "", err
+		}
+		condition, args := buildCursorCondition(columns, values)
+		model = model.groupWhere().Where(condition, args...)
+	}
+	if result, err = model.Limit(size + 1).All(); err != nil {
+		return nil, "", err
+	}
+	if len(result) <= size {
+		return result, "", nil
+	}
+	result = result[:size]
+	values := make([]any, len(columns))
+	for i, column := range columns {
+		value, ok := result[size-1][column.Key]
+		if !ok {
+			return nil, "", gerror.NewCodef(
+				gcode.CodeInvalidParameter,
+				`sort column "%s" is not selected for cursor pagination`,
+				column.Key,
+			)
+		}
+		values[i] = value.Val()
+	}
+	next, err = encodeCursor(values)
+	return result, next, err
+}
+
+// getCursorColumns parses and returns the sort columns from the ORDER BY clause of the model.
+func (m *Model) getCursorColumns() ([]cursorColumn, error) {
+	if m.orderBy == "" {
+		return nil, nil
+	}
+	var (
+		columns      []cursorColumn
+		charL, charR = m.db.GetChars()
+	)
+	for _, item := range strings.Split(m.orderBy, ",") {
+		var (
+			fields = strings.Fields(item)
+			column cursorColumn
+		)
+		if len(fields) == 0 || len(fields) > 2 || strings.ContainsAny(fields[0], "()") {
+			return nil, gerror.NewCodef(
+				gcode.CodeNotSupported,
+				`order "%s" is not supported by cursor pagination, which should be plain column`,
+				strings.TrimSpace(item),
+			)
+		}
+		column.Column = fields[0]
+		if len(fields) == 2 {
+			switch strings.ToUpper(fields[1]) {
+			case "ASC":
+			case "DESC":
+				column.Desc = true
+			default:
+				return nil, gerror.NewCodef(
+					gcode.CodeNotSupported,
+					`order "%s" is not supported by cursor pagination`,
+					strings.TrimSpace(item),
+				)
+			}
+		}
+		column.Key = column.Column
+		if pos := strings.LastIndex(column.Key, "."); pos != -1 {
+			column.Key = column.Key[pos+1:]
+		}
+		column.Key = gstr.Trim(column.Key, charL+charR)
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+// buildCursorCondition builds the seek condition after the sort `values` of `columns`,
+// like "(`a` > ?) OR (`a` = ? AND `b` < ?)", which supports the columns of mixed directions.
+func buildCursorCondition(columns []cursorColumn, values []any) (condition string, args []any) {
+	var items = make([]string, 0, len(columns))
+	for i, column := range columns {
+		var parts = make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			parts = append(parts, columns[j].Column+" = ?")
+			args = append(args, values[j])
+		}
+		operator := ">"
+		if column.Desc {
+			operator = "<"
+		}
+		parts = append(parts, column.Column+" "+operator+" ?")
+		args = append(args, values[i])
+		items = append(items, "("+strings.Join(parts, " AND ")+")")
+	}
+	return strings.Join(items, " OR "), args
+}
+
+// encodeCursor encodes the sort `values` into Cursor.
+func encodeCursor(values []any) (Cursor, error) {
+	b, err := json.Marshal(values)
+	if err != nil {
+		return "", gerror.WrapCode(gcode.CodeInternalError, err, `encoding cursor failed`)
+	}
+	return Cursor(base64.RawURLEncoding.EncodeToString(b)), nil
+}
+
+// decode decodes and returns `count` sort values of the cursor.
+func (c Cursor) decode(count int) ([]any, error) {
+	var values []any
+	b, err := base64.RawURLEncoding.DecodeString(string(c))
+	if err == nil {
+		err = json.UnmarshalUseNumber(b, &values)
+	}
+	if err != nil || len(values) != count {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid cursor "%s"`, c)
+	}
+	// The numbers are decoded as integers if possible, which keeps the precision of big integers.
+	for i, value := range values {
+		if number, ok := value.(json.Number); ok {
+			if v, err := number.Int64(); err == nil {
+				values[i] = v
+			} else if v, err := number.Float64(); err == nil {
+				values[i] = v
+			}
+		}
+	}
+	return values, nil
+}
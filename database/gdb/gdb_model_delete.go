@@ -28,6 +28,12 @@ func (m *Model) Delete(where ...any) (result sql.Result, err error) {
 			m.checkAndRemoveSelectCache(ctx)
 		}
 	}()
+	if err = m.checkWritable(ctx, "Delete"); err != nil {
+		return nil, err
+	}
+	if m.whereBuilder.err != nil {
+		return nil, m.whereBuilder.err
+	}
 	var (
 		conditionWhere, conditionExtra, conditionArgs = m.formatCondition(ctx, false, false)
 		conditionStr                                  = conditionWhere + conditionExtra
@@ -57,6 +63,7 @@ func (m *Model) Delete(where ...any) (result sql.Result, err error) {
 			internalParamHookUpdate: internalParamHookUpdate{
 				internalParamHook: internalParamHook{
 					link: m.getLink(true),
+					mode: m.hookHandler.Mode,
 				},
 				handler: m.hookHandler.Update,
 			},
@@ -65,7 +72,7 @@ func (m *Model) Delete(where ...any) (result sql.Result, err error) {
 			Schema:    m.schema,
 			Data:      dataHolder,
 			Condition: conditionStr,
-			Args:      append([]any{dataValue}, conditionArgs...),
+			Args:      append([]any{m.db.GetCore().convertTimeForStorage(dataValue)}, conditionArgs...),
 		}
 		return in.Next(ctx)
 	}
@@ -74,6 +81,7 @@ func (m *Model) Delete(where ...any) (result sql.Result, err error) {
 		internalParamHookDelete: internalParamHookDelete{
 			internalParamHook: internalParamHook{
 				link: m.getLink(true),
+				mode: m.hookHandler.Mode,
 			},
 			handler: m.hookHandler.Delete,
 		},
@@ -0,0 +1,236 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gogf/gf/v2/container/gmap"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/intlog"
+)
+
+// FailoverOption is the option for read failover feature of Model.
+// Note that, the failover feature only takes effect for SELECT statements, and never in transaction.
+type FailoverOption struct {
+	// Group is the configuration group name of the fallback database, like a local read replica
+	// or a SQLite cache. Note that the fallback database should accept the SQL of the primary one.
+	Group string
+
+	// DB is the fallback database, which takes priority over Group if it is not nil.
+	DB DB
+
+	// Threshold is the count of consecutive failures of the primary database to mark it down,
+	// which is 1 in default. The reads go to the fallback database directly while the primary is down.
+	Threshold int
+
+	// ProbeInterval is the interval of half-open probing after the primary database is marked down,
+	// which is 5 seconds in default. A probing read goes to the primary database once in the interval,
+	// and the primary is marked up again if the probing succeeds.
+	ProbeInterval time.Duration
+
+	// Checker checks whether given error means the primary database is down.
+	// It uses IsRetryableError in default if it is nil.
+	Checker func(err error) bool
+
+	// OnEvent is the callback when the state of the primary database changes.
+	OnEvent func(ctx context.Context, event FailoverEvent)
+}
+
+// FailoverState is the health state of the primary database in failover feature.
+type FailoverState string
+
+const (
+	FailoverStateClosed   FailoverState = "closed"    // The primary database is up, reads go to the primary.
+	FailoverStateOpen     FailoverState = "open"      // The primary database is down, reads go to the fallback.
+	FailoverStateHalfOpen FailoverState = "half-open" // The primary database is being probed.
+)
+
+// FailoverEvent is the event when the state of the primary database changes.
+type FailoverEvent struct {
+	Primary  string        // Group name of the primary database.
+	Fallback string        // Group name of the fallback database.
+	State    FailoverState // New state of the primary database.
+	Error    error         // Error of the primary database that causes the state change, which is nil when closed.
+}
+
+const (
+	defaultFailoverThreshold     = 1
+	defaultFailoverProbeInterval = 5 * time.Second
+)
+
+// failoverBreakers caches the breakers by the group names of the primary and fallback databases,
+// so that the health state is shared among models.
+var failoverBreakers = gmap.NewKVMap[string, *failoverBreaker](true)
+
+// failoverBreaker is the circuit breaker tracking the health state of the primary database.
+type failoverBreaker struct {
+	mu         sync.Mutex
+	state      FailoverState
+	failures   int       // Count of consecutive failures.
+	openedTime time.Time // Time the primary database is marked down or last probed.
+	probing    bool      // Whether a probing read is in progress.
+}
+
+// Failover sets the read failover option for the model, which reads from the fallback database
+// automatically when the primary database is down, and marks the primary up again with half-open
+// probing after it recovers. The health state is shared among models with the same primary and
+// fallback databases.
+//
+// Example:
+//
+//	db.Model("user").Failover(gdb.FailoverOption{Group: "replica"}).All()
+func (m *Model) Failover(option FailoverOption) *Model {
+	model := m.getModel()
+	model.failoverOption = option
+	return model
+}
+
+// GetFailoverState returns the health state of the primary database `primary` of failover feature
+// with fallback database `fallback`, which is FailoverStateClosed if it is never marked down.
+func GetFailoverState(primary, fallback DB) FailoverState {
+	breaker := failoverBreakers.Get(failoverBreakerKey(primary.GetGroup(), fallback.GetGroup()))
+	if breaker == nil {
+		return FailoverStateClosed
+	}
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+	return breaker.state
+}
+
+// doWithFailover calls `f` with the model itself, or with the model on the fallback database
+// according to the failover option and the health state of the primary database.
+func (m *Model) doWithFailover(ctx context.Context, f func(model *Model) error) (err error) {
+	var option = m.failoverOption
+	if (option.Group == "" && option.DB == nil) || m.tx != nil || TXFromCtx(ctx, m.db.GetGroup()) != nil {
+		return f(m)
+	}
+	fallbackDB := option.DB
+	if fallbackDB == nil {
+		if fallbackDB, err = Instance(option.Group); err != nil {
+			return gerror.WrapCodef(
+				gcode.CodeMissingConfiguration, err, `get fallback database of group "%s" failed`, option.Group,
+			)
+		}
+	}
+	if option.Threshold <= 0 {
+		option.Threshold = defaultFailoverThreshold
+	}
+	if option.ProbeInterval <= 0 {
+		option.ProbeInterval = defaultFailoverProbeInterval
+	}
+	if option.Checker == nil {
+		option.Checker = IsRetryableError
+	}
+	var (
+		primaryGroup  = m.db.GetGroup()
+		fallbackGroup = fallbackDB.GetGroup()
+		breaker       = failoverBreakers.GetOrSetFuncLock(
+			failoverBreakerKey(primaryGroup, fallbackGroup),
+			func() *failoverBreaker {
+				return &failoverBreaker{state: FailoverStateClosed}
+			},
+		)
+		event = FailoverEvent{
+			Primary:  primaryGroup,
+			Fallback: fallbackGroup,
+		}
+	)
+	allowed, changed := breaker.allow(option)
+	if changed {
+		event.State = FailoverStateHalfOpen
+		option.fireEvent(ctx, event)
+	}
+	if allowed {
+		err = f(m)
+		isDown := err != nil && option.Checker(err)
+		if event.State, changed = breaker.report(option, isDown); changed {
+			if isDown {
+				event.Error = err
+			}
+			option.fireEvent(ctx, event)
+		}
+		if !isDown {
+			return err
+		}
+	}
+	fallbackModel := m.Clone()
+	fallbackModel.db = fallbackDB
+	fallbackModel.failoverOption = FailoverOption{}
+	return f(fallbackModel)
+}
+
+// fireEvent calls the event callback if it is set.
+func (o FailoverOption) fireEvent(ctx context.Context, event FailoverEvent) {
+	intlog.Printf(
+		ctx, `failover state of primary group "%s" with fallback group "%s" changes to "%s": %v`,
+		event.Primary, event.Fallback, event.State, event.Error,
+	)
+	if o.OnEvent != nil {
+		o.OnEvent(ctx, event)
+	}
+}
+
+// allow checks whether the read can go to the primary database.
+// The returned `changed` is true if the breaker turns half-open for probing.
+func (b *failoverBreaker) allow(option FailoverOption) (allowed, changed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case FailoverStateOpen:
+		if time.Since(b.openedTime) < option.ProbeInterval {
+			return false, false
+		}
+		b.state = FailoverStateHalfOpen
+		b.probing = true
+		return true, true
+
+	case FailoverStateHalfOpen:
+		// Only one probing read is allowed at the same time.
+		if b.probing {
+			return false, false
+		}
+		b.probing = true
+		return true, false
+
+	default:
+		return true, false
+	}
+}
+
+// report reports the result of the read on the primary database.
+// It returns the new state and whether the state of the breaker changes.
+func (b *failoverBreaker) report(option FailoverOption, isDown bool) (state FailoverState, changed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if !isDown {
+		b.failures = 0
+		if b.state == FailoverStateClosed {
+			return b.state, false
+		}
+		b.state = FailoverStateClosed
+		return b.state, true
+	}
+	b.failures++
+	if b.state == FailoverStateClosed && b.failures < option.Threshold {
+		return b.state, false
+	}
+	b.openedTime = time.Now()
+	if b.state == FailoverStateOpen {
+		return b.state, false
+	}
+	b.state = FailoverStateOpen
+	return b.state, true
+}
+
+func failoverBreakerKey(primaryGroup, fallbackGroup string) string {
+	return primaryGroup + "->" + fallbackGroup
+}
@@ -0,0 +1,90 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gconv"
+	"github.com/gogf/gf/v2/util/gutil"
+)
+
+// iFieldMaskPaths is the interface for field mask that has paths,
+// like the FieldMask of protobuf: google.golang.org/protobuf/types/known/fieldmaskpb.
+type iFieldMaskPaths interface {
+	GetPaths() []string
+}
+
+// FieldMask sets the field mask for the data of insert/update/save operations, so that only
+// the masked columns of the data are written. Different from Fields, the masked columns are
+// always written even if their values are nil or empty, that is the OmitNilData/OmitEmptyData
+// options do not apply to them, which makes the zero values of struct updating explicit.
+//
+// The parameter `fields` can be type of string, which can be multiple fields joined using
+// char ',', []string, or the FieldMask of protobuf which implements method "GetPaths() []string".
+// The field names are mapped to table columns like the data keys, and it returns error
+// in writing if any field cannot be mapped, as the mask is usually from client requests.
+//
+// Example:
+// Data(user).FieldMask("nickname", "status").WherePri(1).Update()
+// Data(user).FieldMask("nickname,status").WherePri(1).Update()
+// Data(user).FieldMask(req.UpdateMask).WherePri(1).Update()
+func (m *Model) FieldMask(fields ...any) *Model {
+	var maskFields []string
+	for _, field := range fields {
+		switch v := field.(type) {
+		case iFieldMaskPaths:
+			maskFields = append(maskFields, v.GetPaths()...)
+		case string:
+			maskFields = append(maskFields, gstr.SplitAndTrim(v, ",")...)
+		default:
+			maskFields = append(maskFields, gconv.Strings(v)...)
+		}
+	}
+	model := m.getModel()
+	model.fieldMask = append(append([]string{}, m.fieldMask...), maskFields...)
+	return model
+}
+
+// getFieldMaskColumns maps the fields of the field mask to table columns,
+// it returns nil if no field mask is set.
+func (m *Model) getFieldMaskColumns(ctx context.Context, schema, table string) (map[string]struct{}, error) {
+	if len(m.fieldMask) == 0 {
+		return nil, nil
+	}
+	table = m.db.GetCore().guessPrimaryTableName(table)
+	fieldsMap, err := m.db.TableFields(ctx, table, schema)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		fieldsKeyMap = make(map[string]any, len(fieldsMap))
+		columns      = make(map[string]struct{}, len(m.fieldMask))
+	)
+	for k := range fieldsMap {
+		fieldsKeyMap[k] = nil
+	}
+	for _, field := range m.fieldMask {
+		if _, ok := fieldsKeyMap[field]; ok {
+			columns[field] = struct{}{}
+			continue
+		}
+		foundKey, _ := gutil.MapPossibleItemByKey(fieldsKeyMap, field)
+		if foundKey == "" {
+			return nil, gerror.NewCodef(
+				gcode.CodeInvalidParameter,
+				`field "%s" of field mask does not exist in table "%s"`,
+				field, table,
+			)
+		}
+		columns[foundKey] = struct{}{}
+	}
+	return columns, nil
+}
@@ -8,8 +8,11 @@ package gdb
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/gogf/gf/v2/container/gset"
+	"github.com/gogf/gf/v2/errors/gcode"
 	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/text/gstr"
 	"github.com/gogf/gf/v2/util/gconv"
@@ -102,61 +105,87 @@ func (m *Model) FieldsExPrefix(prefixOrAlias string, fieldNamesOrMapStruct ...an
 
 // FieldCount formats and appends commonly used field `COUNT(column)` to the select fields of model.
 func (m *Model) FieldCount(column string, as ...string) *Model {
-	asStr := ""
-	if len(as) > 0 && as[0] != "" {
-		asStr = fmt.Sprintf(` AS %s`, m.QuoteWord(as[0]))
-	}
-	model := m.getModel()
-	return model.appendToFields(
-		fmt.Sprintf(`COUNT(%s)%s`, m.QuoteWord(column), asStr),
-	)
+	return m.doFieldAggregate(`COUNT`, column, as...)
 }
 
 // FieldSum formats and appends commonly used field `SUM(column)` to the select fields of model.
 func (m *Model) FieldSum(column string, as ...string) *Model {
-	asStr := ""
-	if len(as) > 0 && as[0] != "" {
-		asStr = fmt.Sprintf(` AS %s`, m.QuoteWord(as[0]))
-	}
-	model := m.getModel()
-	return model.appendToFields(
-		fmt.Sprintf(`SUM(%s)%s`, m.QuoteWord(column), asStr),
-	)
+	return m.doFieldAggregate(`SUM`, column, as...)
 }
 
 // FieldMin formats and appends commonly used field `MIN(column)` to the select fields of model.
 func (m *Model) FieldMin(column string, as ...string) *Model {
-	asStr := ""
-	if len(as) > 0 && as[0] != "" {
-		asStr = fmt.Sprintf(` AS %s`, m.QuoteWord(as[0]))
-	}
-	model := m.getModel()
-	return model.appendToFields(
-		fmt.Sprintf(`MIN(%s)%s`, m.QuoteWord(column), asStr),
-	)
+	return m.doFieldAggregate(`MIN`, column, as...)
 }
 
 // FieldMax formats and appends commonly used field `MAX(column)` to the select fields of model.
 func (m *Model) FieldMax(column string, as ...string) *Model {
-	asStr := ""
-	if len(as) > 0 && as[0] != "" {
-		asStr = fmt.Sprintf(` AS %s`, m.QuoteWord(as[0]))
-	}
-	model := m.getModel()
-	return model.appendToFields(
-		fmt.Sprintf(`MAX(%s)%s`, m.QuoteWord(column), asStr),
-	)
+	return m.doFieldAggregate(`MAX`, column, as...)
 }
 
 // FieldAvg formats and appends commonly used field `AVG(column)` to the select fields of model.
 func (m *Model) FieldAvg(column string, as ...string) *Model {
-	asStr := ""
+	return m.doFieldAggregate(`AVG`, column, as...)
+}
+
+// FieldPercentile formats and appends the continuous percentile field of `column` to the select fields of model,
+// in which `percentile` is between 0 and 1, like 0.95 for the 95th percentile.
+// It uses "PERCENTILE_CONT(p) WITHIN GROUP (ORDER BY column)" for pgsql, gaussdb, oracle and dm, and
+// "quantile(p)(column)" for clickhouse. The select statement returns error for other databases, eg:
+//
+//	db.Model("request_log").Fields("api").FieldPercentile("latency", 0.95, "p95").Group("api").All()
+func (m *Model) FieldPercentile(column string, percentile float64, as ...string) *Model {
+	model := m.getModel()
+	field, err := formatPercentileField(m.db.GetConfig().Type, m.QuoteWord(column), percentile)
+	if err != nil {
+		model.fieldsErr = err
+		return model
+	}
+	if len(as) > 0 && as[0] != "" {
+		field += fmt.Sprintf(` AS %s`, m.QuoteWord(as[0]))
+		model.fieldAliases = append(model.fieldAliases[:len(model.fieldAliases):len(model.fieldAliases)], as[0])
+	}
+	return model.appendToFields(field)
+}
+
+// formatPercentileField formats and returns the continuous percentile field of quoted `column`
+// for database type `dbType`.
+func formatPercentileField(dbType, column string, percentile float64) (string, error) {
+	if percentile < 0 || percentile > 1 {
+		return "", gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`invalid percentile "%v", which should be between 0 and 1`,
+			percentile,
+		)
+	}
+	p := strconv.FormatFloat(percentile, 'f', -1, 64)
+	switch strings.ToLower(dbType) {
+	case "pgsql", "gaussdb", "oracle", "dm":
+		return fmt.Sprintf(`PERCENTILE_CONT(%s) WITHIN GROUP (ORDER BY %s)`, p, column), nil
+	case "clickhouse":
+		return fmt.Sprintf(`quantile(%s)(%s)`, p, column), nil
+	default:
+		return "", gerror.NewCodef(
+			gcode.CodeNotSupported,
+			`percentile field is not supported by database type "%s"`,
+			dbType,
+		)
+	}
+}
+
+// doFieldAggregate formats and appends the aggregate field `function(column)` to the select fields of model.
+// The optional alias `as` is recorded, so that it is not prefixed with table in HAVING conditions.
+func (m *Model) doFieldAggregate(function, column string, as ...string) *Model {
+	var (
+		asStr = ""
+		model = m.getModel()
+	)
 	if len(as) > 0 && as[0] != "" {
 		asStr = fmt.Sprintf(` AS %s`, m.QuoteWord(as[0]))
+		model.fieldAliases = append(model.fieldAliases[:len(model.fieldAliases):len(model.fieldAliases)], as[0])
 	}
-	model := m.getModel()
 	return model.appendToFields(
-		fmt.Sprintf(`AVG(%s)%s`, m.QuoteWord(column), asStr),
+		fmt.Sprintf(`%s(%s)%s`, function, m.QuoteWord(column), asStr),
 	)
 }
 
@@ -236,6 +265,18 @@ func (m *Model) filterFieldsFrom(table string, fieldNamesOrMapStruct ...any) []a
 		return nil
 	}
 	switch {
+	// Sub query fields along with other fields, which are kept in sequence.
+	case length >= 2 && isSubQueryField(fieldNamesOrMapStruct):
+		var fields = make([]any, 0, length)
+		for _, v := range fieldNamesOrMapStruct {
+			if _, ok := v.(*SubQueryField); ok {
+				fields = append(fields, v)
+				continue
+			}
+			fields = append(fields, m.mappingAndFilterToTableFields(table, []any{v}, true)...)
+		}
+		return fields
+
 	// String slice.
 	case length >= 2:
 		return m.mappingAndFilterToTableFields(
@@ -252,9 +293,15 @@ func (m *Model) filterFieldsFrom(table string, fieldNamesOrMapStruct ...any) []a
 		case []string:
 			return m.mappingAndFilterToTableFields(table, gconv.Interfaces(r), true)
 
-		case Raw, *Raw:
+		case Raw, *Raw, *SubQueryField:
 			return []any{structOrMap}
 
+		case FuncExpr:
+			return []any{newFuncExprField(m.db, r)}
+
+		case *FuncExpr:
+			return []any{newFuncExprField(m.db, *r)}
+
 		default:
 			return m.mappingAndFilterToTableFields(table, getFieldsFromStructOrMap(structOrMap), true)
 		}
@@ -0,0 +1,67 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/reflection"
+	"github.com/gogf/gf/v2/text/gregex"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+// checkHaving checks the keys of the map HAVING condition for aggregate queries, which must reference
+// the aggregate aliases, the grouped or selected columns, or be expressions like "SUM(score) >".
+// It does nothing for string and WhereBuilder conditions, or if the model is not an aggregate query.
+func (m *Model) checkHaving() error {
+	if len(m.having) == 0 || (m.groupBy == "" && len(m.fieldAliases) == 0) {
+		return nil
+	}
+	var keys []string
+	if iterator, ok := m.having[0].(iIterator); ok {
+		iterator.Iterator(func(key, value any) bool {
+			keys = append(keys, gconv.String(key))
+			return true
+		})
+	} else if reflection.OriginValueAndKind(m.having[0]).OriginKind == reflect.Map {
+		for key := range gconv.Map(m.having[0]) {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	// The grouped and selected columns, which may be referenced by HAVING in most databases.
+	columns := fmt.Sprintf(`%s,%s`, m.groupBy, gstr.JoinAny(m.fields, ","))
+	for _, key := range keys {
+		if gstr.Contains(key, "(") {
+			continue
+		}
+		match, _ := gregex.MatchString(`^\s*([\w\.\x60"\[\]]+)`, key)
+		if len(match) < 2 {
+			continue
+		}
+		column := strings.Trim(match[1][strings.LastIndex(match[1], ".")+1:], "`\"[]")
+		if gstr.InArray(m.fieldAliases, column) {
+			continue
+		}
+		if gregex.IsMatchString(fmt.Sprintf(`(^|[^\w])%s([^\w]|$)`, gregex.Quote(column)), columns) {
+			continue
+		}
+		return gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`HAVING condition "%s" references "%s", which is neither an aggregate alias nor a grouped or selected column`,
+			key, column,
+		)
+	}
+	return nil
+}
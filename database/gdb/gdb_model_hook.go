@@ -12,6 +12,8 @@ import (
 	"fmt"
 
 	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/text/gregex"
 	"github.com/gogf/gf/v2/text/gstr"
 )
@@ -23,19 +25,38 @@ type (
 	HookFuncDelete func(ctx context.Context, in *HookDeleteInput) (result sql.Result, err error)
 )
 
+// HookMode specifies how the errors and panics of hook functions are handled.
+type HookMode int
+
+const (
+	// HookModeEnforcing is the default hook mode, in which the errors of hook functions abort the operation.
+	// The panics of hook functions are not recovered, which propagate to the caller like other panics.
+	HookModeEnforcing HookMode = iota
+
+	// HookModeObservational is the hook mode for best-effort hooks like cache warming or auditing,
+	// in which the errors and panics of hook functions are logged and the operation continues.
+	// Note that the errors of the operation itself are still returned.
+	HookModeObservational
+)
+
 // HookHandler manages all supported hook functions for Model.
 type HookHandler struct {
 	Select HookFuncSelect
 	Insert HookFuncInsert
 	Update HookFuncUpdate
 	Delete HookFuncDelete
+	Mode   HookMode // Mode specifies how the errors and panics of hook functions are handled.
 }
 
 // internalParamHook manages all internal parameters for hook operations.
 // The `internal` obviously means you cannot access these parameters outside this package.
 type internalParamHook struct {
 	link               Link      // Connection object from third party sql driver.
+	mode               HookMode  // Mode for handling the errors and panics of custom handler.
 	handlerCalled      bool      // Simple mark for custom handler called, in case of recursive calling.
+	nextCalled         bool      // Simple mark for the operation executed after custom handler called.
+	nextResult         any       // Result of the operation, which is used in observational mode.
+	nextErr            error     // Error of the operation, which is used in observational mode.
 	removedWhere       bool      // Removed mark for condition string that was removed `WHERE` prefix.
 	originalTableName  *gvar.Var // The original table name.
 	originalSchemaName *gvar.Var // The original schema name.
@@ -136,8 +157,16 @@ func (h *HookSelectInput) Next(ctx context.Context) (result Result, err error) {
 	// Custom hook handler call.
 	if h.handler != nil && !h.handlerCalled {
 		h.handlerCalled = true
-		return h.handler(ctx, h)
+		return callHookHandler(ctx, &h.internalParamHook, h.Model, func() (Result, error) {
+			return h.handler(ctx, h)
+		}, func() (Result, error) {
+			return h.Next(ctx)
+		})
 	}
+	h.nextCalled = true
+	defer func() {
+		h.nextResult, h.nextErr = result, err
+	}()
 	var toBeCommittedSql = h.Sql
 	// Table change.
 	if h.Table != h.originalTableName.String() {
@@ -188,8 +217,16 @@ func (h *HookInsertInput) Next(ctx context.Context) (result sql.Result, err erro
 
 	if h.handler != nil && !h.handlerCalled {
 		h.handlerCalled = true
-		return h.handler(ctx, h)
+		return callHookHandler(ctx, &h.internalParamHook, h.Model, func() (sql.Result, error) {
+			return h.handler(ctx, h)
+		}, func() (sql.Result, error) {
+			return h.Next(ctx)
+		})
 	}
+	h.nextCalled = true
+	defer func() {
+		h.nextResult, h.nextErr = result, err
+	}()
 
 	// No need to handle table change.
 
@@ -232,11 +269,20 @@ func (h *HookUpdateInput) Next(ctx context.Context) (result sql.Result, err erro
 			h.removedWhere = true
 			h.Condition = gstr.TrimLeftStr(h.Condition, whereKeyInCondition)
 		}
-		return h.handler(ctx, h)
+		return callHookHandler(ctx, &h.internalParamHook, h.Model, func() (sql.Result, error) {
+			return h.handler(ctx, h)
+		}, func() (sql.Result, error) {
+			return h.Next(ctx)
+		})
 	}
 	if h.removedWhere {
 		h.Condition = whereKeyInCondition + h.Condition
+		h.removedWhere = false
 	}
+	h.nextCalled = true
+	defer func() {
+		h.nextResult, h.nextErr = result, err
+	}()
 
 	// No need to handle table change.
 
@@ -279,11 +325,20 @@ func (h *HookDeleteInput) Next(ctx context.Context) (result sql.Result, err erro
 			h.removedWhere = true
 			h.Condition = gstr.TrimLeftStr(h.Condition, whereKeyInCondition)
 		}
-		return h.handler(ctx, h)
+		return callHookHandler(ctx, &h.internalParamHook, h.Model, func() (sql.Result, error) {
+			return h.handler(ctx, h)
+		}, func() (sql.Result, error) {
+			return h.Next(ctx)
+		})
 	}
 	if h.removedWhere {
 		h.Condition = whereKeyInCondition + h.Condition
+		h.removedWhere = false
 	}
+	h.nextCalled = true
+	defer func() {
+		h.nextResult, h.nextErr = result, err
+	}()
 
 	// No need to handle table change.
 
@@ -307,3 +362,45 @@ func (m *Model) Hook(hook HookHandler) *Model {
 	model.hookHandler = hook
 	return model
 }
+
+// callHookHandler calls the custom hook handler `handler`, and handles its error according to the hook mode.
+// In observational mode, the panic of the handler is recovered as error, and if the handler fails before
+// the operation executed, the error is logged and the operation continues by calling `next`.
+func callHookHandler[R any](
+	ctx context.Context, h *internalParamHook, model *Model, handler, next func() (R, error),
+) (result R, err error) {
+	if h.mode != HookModeObservational {
+		return handler()
+	}
+	func() {
+		defer func() {
+			if exception := recover(); exception != nil {
+				if v, ok := exception.(error); ok && gerror.HasStack(v) {
+					err = v
+				} else {
+					err = gerror.NewCodef(gcode.CodeInternalPanic, "exception recovered in hook: %+v", exception)
+				}
+			}
+		}()
+		result, err = handler()
+	}()
+	if err == nil {
+		return
+	}
+	if h.nextCalled {
+		if h.nextErr != nil {
+			// It returns the error of the operation itself.
+			if v, ok := h.nextResult.(R); ok {
+				return v, h.nextErr
+			}
+			return result, h.nextErr
+		}
+		model.db.GetLogger().Errorf(ctx, `observational hook failed after operation done: %+v`, err)
+		if v, ok := h.nextResult.(R); ok {
+			return v, nil
+		}
+		return result, nil
+	}
+	model.db.GetLogger().Errorf(ctx, `observational hook failed, operation continues: %+v`, err)
+	return next()
+}
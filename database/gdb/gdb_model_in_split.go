@@ -0,0 +1,136 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+// InSplit sets the maximum count of values of the IN condition in one select statement.
+// If the values of an IN condition exceed the size, the select statement is split into
+// multiple statements each with a chunk of the values, and the results are merged, as
+// the parameter count limits of Oracle/MSSQL and packet size of MySQL break huge value lists.
+//
+// The splitting only takes effect for All/Array/Scan operations with AND-joined IN condition,
+// and it returns error if the model has Order/Group/Having/Limit/Offset statements that cannot
+// be merged across the split statements.
+// It uses the ModelInSplitSize of the configuration node in default, and a negative `size`
+// disables the splitting.
+//
+// Example:
+//
+//	db.Model("user").InSplit(1000).WhereIn("id", ids).All()
+func (m *Model) InSplit(size int) *Model {
+	model := m.getModel()
+	model.inSplitSize = size
+	return model
+}
+
+// getInSplitSize returns the IN split size of the model, which uses the configuration
+// of the group in default.
+func (m *Model) getInSplitSize() int {
+	if m.inSplitSize != 0 {
+		return m.inSplitSize
+	}
+	if config := m.db.GetConfig(); config != nil {
+		return config.ModelInSplitSize
+	}
+	return 0
+}
+
+// getInSplitHolderIndex returns the index of the where holder whose IN values exceed the
+// split size, which is -1 if there's no such holder.
+func (m *Model) getInSplitHolderIndex(size int) int {
+	var index = -1
+	for i, holder := range m.whereBuilder.whereHolder {
+		if holder.Type != whereHolderTypeIn || holder.Operator == whereHolderOperatorOr || len(holder.Args) == 0 {
+			continue
+		}
+		// NOT IN condition cannot be split, as its results are the intersection of all the chunks.
+		if whereStr, ok := holder.Where.(string); !ok || gstr.ContainsI(whereStr, "NOT IN") {
+			continue
+		}
+		reflectValue := reflect.ValueOf(holder.Args[0])
+		if reflectValue.Kind() != reflect.Slice && reflectValue.Kind() != reflect.Array {
+			continue
+		}
+		// It splits the holder having the most values.
+		if reflectValue.Len() > size &&
+			(index == -1 || reflectValue.Len() > reflect.ValueOf(m.whereBuilder.whereHolder[index].Args[0]).Len()) {
+			index = i
+		}
+	}
+	return index
+}
+
+// doGetAllWithInSplit splits the select statement by the values of the IN condition that
+// exceeds the split size, and merges the results.
+// The returned `split` is false if there's no need to split.
+func (m *Model) doGetAllWithInSplit(
+	ctx context.Context, selectType SelectType, limit1 bool,
+) (result Result, split bool, err error) {
+	var size = m.getInSplitSize()
+	if size <= 0 || limit1 || (selectType != SelectTypeDefault && selectType != SelectTypeArray) {
+		return nil, false, nil
+	}
+	index := m.getInSplitHolderIndex(size)
+	if index == -1 {
+		return nil, false, nil
+	}
+	if m.orderBy != "" || m.groupBy != "" || len(m.having) > 0 || m.limit > 0 || m.start > 0 || m.offset > 0 {
+		return nil, true, gerror.NewCodef(
+			gcode.CodeNotSupported,
+			`the IN condition values exceed the split size %d, which cannot be split with Order/Group/Having/Limit/Offset statements`,
+			size,
+		)
+	}
+	var (
+		holder = m.whereBuilder.whereHolder[index]
+		values = uniqueInSplitValues(gconv.Interfaces(holder.Args[0]))
+	)
+	for i := 0; i < len(values); i += size {
+		var (
+			end        = min(i+size, len(values))
+			chunkModel = m.Clone()
+			chunkArgs  = make([]any, len(holder.Args))
+		)
+		copy(chunkArgs, holder.Args)
+		chunkArgs[0] = values[i:end]
+		chunkModel.whereBuilder.whereHolder[index].Args = chunkArgs
+		sqlWithHolder, holderArgs := chunkModel.getFormattedSqlAndArgs(ctx, selectType, false)
+		chunkResult, err := chunkModel.doGetAllBySql(ctx, selectType, sqlWithHolder, holderArgs...)
+		if err != nil {
+			return nil, true, err
+		}
+		result = append(result, chunkResult...)
+	}
+	return result, true, nil
+}
+
+// uniqueInSplitValues removes the duplicated values, so that no record is selected
+// more than once by different chunks.
+func uniqueInSplitValues(values []any) []any {
+	var (
+		uniqueValues = make([]any, 0, len(values))
+		existence    = make(map[string]struct{}, len(values))
+	)
+	for _, value := range values {
+		key := gconv.String(value)
+		if _, ok := existence[key]; ok {
+			continue
+		}
+		existence[key] = struct{}{}
+		uniqueValues = append(uniqueValues, value)
+	}
+	return uniqueValues
+}
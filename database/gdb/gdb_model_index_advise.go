@@ -0,0 +1,118 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/debug/gdebug"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/text/gregex"
+)
+
+// QueryPlanIssueType is the type of the issue found in the query plan.
+type QueryPlanIssueType string
+
+const (
+	QueryPlanIssueFullScan QueryPlanIssueType = "full-scan" // Full table scan without using index.
+	QueryPlanIssueFileSort QueryPlanIssueType = "filesort"  // Sorting or grouping in temporary space without using index.
+)
+
+const (
+	defaultIndexAdviseThreshold = 200 * time.Millisecond
+	indexAdviseInterval         = time.Minute // The same statement is analyzed only once in the interval.
+	indexAdviseCallerFilterKey  = "/database/gdb/"
+)
+
+// QueryPlanIssue is the issue found in the query plan of a select statement, see DB.AnalyzeQueryPlan.
+type QueryPlanIssue struct {
+	Type   QueryPlanIssueType // Type of the issue.
+	Table  string             // Table of the issue, which might be empty if it is unknown.
+	Detail string             // Detail of the query plan step.
+}
+
+// Suggestion returns the index suggestion for the issue.
+func (i QueryPlanIssue) Suggestion() string {
+	var table = "the table"
+	if i.Table != "" {
+		table = fmt.Sprintf(`table "%s"`, i.Table)
+	}
+	switch i.Type {
+	case QueryPlanIssueFullScan:
+		return fmt.Sprintf(
+			`full scan on %s, consider adding an index on the columns of its WHERE or JOIN conditions (%s)`,
+			table, i.Detail,
+		)
+	case QueryPlanIssueFileSort:
+		return fmt.Sprintf(
+			`filesort on %s, consider adding an index matching its ORDER BY or GROUP BY columns (%s)`,
+			table, i.Detail,
+		)
+	default:
+		return fmt.Sprintf(`%s on %s (%s)`, i.Type, table, i.Detail)
+	}
+}
+
+// AnalyzeQueryPlan explains the select statement `sql` and returns the issues found in its query plan.
+// The EXPLAIN output is database-specific, so the default implementation returns an error of code
+// gcode.CodeNotSupported, and the drivers supporting it should override it.
+func (c *Core) AnalyzeQueryPlan(ctx context.Context, link Link, sql string, args ...any) ([]QueryPlanIssue, error) {
+	return nil, gerror.NewCodef(
+		gcode.CodeNotSupported,
+		`analyzing query plan is not supported by database type "%s"`,
+		c.db.GetConfig().Type,
+	)
+}
+
+// adviseIndex analyzes the query plan of the slow select statement in IndexAdvise mode,
+// and logs the index suggestions along with the caller of the statement.
+func (m *Model) adviseIndex(ctx context.Context, sql string, args []any, cost time.Duration) {
+	var (
+		core   = m.db.GetCore()
+		config = m.db.GetConfig()
+	)
+	if config == nil || !config.IndexAdvise || !m.db.GetDebug() {
+		return
+	}
+	threshold := config.IndexAdviseThreshold
+	if threshold <= 0 {
+		threshold = defaultIndexAdviseThreshold
+	}
+	if cost < threshold || !gregex.IsMatchString(`(?i)^\s*(SELECT|WITH)\s`, sql) {
+		return
+	}
+	cacheKey := fmt.Sprintf(`%s%s@%s`, cachePrefixIndexAdvise, m.db.GetGroup(), sql)
+	if ok, _ := core.GetInnerMemCache().SetIfNotExist(ctx, cacheKey, true, indexAdviseInterval); !ok {
+		return
+	}
+	issues, err := m.db.AnalyzeQueryPlan(ctx, m.getLink(false), sql, args...)
+	if err != nil {
+		if gerror.Code(err) != gcode.CodeNotSupported {
+			core.logger.Warningf(ctx, `[gdb] analyze query plan failed: %+v`, err)
+		}
+		return
+	}
+	if len(issues) == 0 {
+		return
+	}
+	var (
+		function, path, line = gdebug.CallerWithFilter([]string{indexAdviseCallerFilterKey})
+		builder              strings.Builder
+	)
+	builder.WriteString(fmt.Sprintf(
+		"[gdb] index advice for slow query [%d ms]: %s\nCalled at: %s:%d %s",
+		cost.Milliseconds(), core.formatSqlForLogging(ctx, sql, args), path, line, function,
+	))
+	for _, issue := range issues {
+		builder.WriteString("\n  - " + issue.Suggestion())
+	}
+	core.logger.Warning(ctx, builder.String())
+}
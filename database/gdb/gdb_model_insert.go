@@ -21,6 +21,11 @@ import (
 	"github.com/gogf/gf/v2/util/gutil"
 )
 
+const (
+	// onConflictKeyForOtherDrivers is the key of conflict keys map for the drivers not specified in the map.
+	onConflictKeyForOtherDrivers = "*"
+)
+
 // Batch sets the batch operation number for the model.
 func (m *Model) Batch(batch int) *Model {
 	model := m.getModel()
@@ -43,7 +48,7 @@ func (m *Model) Data(data ...any) *Model {
 	if len(data) > 1 {
 		if s := gconv.String(data[0]); gstr.Contains(s, "?") {
 			model.data = s
-			model.extraArgs = data[1:]
+			model.extraArgs = m.db.GetCore().convertTimeArgsForStorage(data[1:])
 		} else {
 			newData := make(map[string]any)
 			for i := 0; i < len(data); i += 2 {
@@ -121,6 +126,19 @@ func (m *Model) Data(data ...any) *Model {
 
 // OnConflict sets the primary key or index when columns conflicts occurs.
 // It's not necessary for MySQL driver.
+//
+// The parameter `onConflict` can be type of string/slice, or map of which the keys are the driver
+// types and the values are the conflict keys of the driver, for models of different drivers
+// using the same code. The key "*" of the map specifies the conflict keys of other drivers.
+// Example:
+//
+// OnConflict("id")
+// OnConflict("tenant_id", "id")
+//
+//	OnConflict(g.Map{
+//		  "pgsql": "tenant_id,id",
+//		  "*":     "id",
+//	}).
 func (m *Model) OnConflict(onConflict ...any) *Model {
 	if len(onConflict) == 0 {
 		return m
@@ -227,11 +245,16 @@ func (m *Model) InsertIgnore(data ...any) (result sql.Result, err error) {
 // Replace does "REPLACE INTO ..." statement for the model.
 // The optional parameter `data` is the same as the parameter of Model.Data function,
 // see Model.Data.
+//
+// For databases not supporting "REPLACE INTO" statement, see Model.ReplaceMode.
 func (m *Model) Replace(data ...any) (result sql.Result, err error) {
 	var ctx = m.GetCtx()
 	if len(data) > 0 {
 		return m.Data(data...).Replace()
 	}
+	if m.replaceMode == ReplaceModeDeleteInsert {
+		return m.doReplaceByDeleteInsert(ctx)
+	}
 	return m.doInsertWithOption(ctx, InsertOptionReplace)
 }
 
@@ -256,6 +279,12 @@ func (m *Model) doInsertWithOption(ctx context.Context, insertOption InsertOptio
 			m.checkAndRemoveSelectCache(ctx)
 		}
 	}()
+	if insertOption == InsertOptionDefault {
+		insertOption = m.conflictAction.insertOption()
+	}
+	if err = m.checkWritable(ctx, getInsertOperationName(insertOption)); err != nil {
+		return nil, err
+	}
 	if m.data == nil {
 		return nil, gerror.NewCode(gcode.CodeMissingParameter, "inserting into table with empty data")
 	}
@@ -325,21 +354,35 @@ func (m *Model) doInsertWithOption(ctx context.Context, insertOption InsertOptio
 	if err != nil {
 		return result, err
 	}
+	if insertOption == InsertOptionSave && m.onConflict == nil {
+		doInsertOption.OnConflict = m.inferOnConflictKeys(list[0])
+	}
 
-	in := &HookInsertInput{
-		internalParamHookInsert: internalParamHookInsert{
-			internalParamHook: internalParamHook{
-				link: m.getLink(true),
+	var doInsert = func() (err error) {
+		in := &HookInsertInput{
+			internalParamHookInsert: internalParamHookInsert{
+				internalParamHook: internalParamHook{
+					link: m.getLink(true),
+					mode: m.hookHandler.Mode,
+				},
+				handler: m.hookHandler.Insert,
 			},
-			handler: m.hookHandler.Insert,
-		},
-		Model:  m,
-		Table:  m.tables,
-		Schema: m.schema,
-		Data:   list,
-		Option: doInsertOption,
-	}
-	return in.Next(ctx)
+			Model:  m,
+			Table:  m.tables,
+			Schema: m.schema,
+			Data:   list,
+			Option: doInsertOption,
+		}
+		result, err = in.Next(ctx)
+		return
+	}
+	// Only the keyed upsert statement is idempotent for retrying.
+	if insertOption == InsertOptionSave {
+		err = m.doWithRetry(ctx, retryOperationSave, doInsert)
+	} else {
+		err = doInsert()
+	}
+	return
 }
 
 func (m *Model) formatDoInsertOption(insertOption InsertOption, columnNames []string) (option DoInsertOption, err error) {
@@ -347,7 +390,7 @@ func (m *Model) formatDoInsertOption(insertOption InsertOption, columnNames []st
 		InsertOption: insertOption,
 		BatchCount:   m.getBatch(),
 	}
-	if insertOption != InsertOptionSave {
+	if insertOption != InsertOptionSave && insertOption != InsertOptionIgnore {
 		return
 	}
 
@@ -356,6 +399,10 @@ func (m *Model) formatDoInsertOption(insertOption InsertOption, columnNames []st
 		return option, err
 	}
 	option.OnConflict = onConflictKeys
+	// The conflict keys are used by the drivers implementing InsertIgnore with MERGE statement.
+	if insertOption == InsertOptionIgnore {
+		return
+	}
 
 	onDuplicateExKeys, err := m.formatOnDuplicateExKeys(m.onDuplicateEx)
 	if err != nil {
@@ -455,6 +502,20 @@ func (m *Model) formatOnConflictKeys(onConflict any) ([]string, error) {
 	case reflect.Slice, reflect.Array:
 		return gconv.Strings(onConflict), nil
 
+	case reflect.Map:
+		// Conflict keys specified by driver types.
+		var (
+			onConflictMap = gconv.Map(onConflict)
+			value, ok     = onConflictMap[m.db.GetConfig().Type]
+		)
+		if !ok {
+			value = onConflictMap[onConflictKeyForOtherDrivers]
+		}
+		if value == nil {
+			return nil, nil
+		}
+		return m.formatOnConflictKeys(value)
+
 	default:
 		return nil, gerror.NewCodef(
 			gcode.CodeInvalidParameter,
@@ -464,6 +525,34 @@ func (m *Model) formatOnConflictKeys(onConflict any) ([]string, error) {
 	}
 }
 
+// inferOnConflictKeys infers the conflict keys of the Save operation from the table metadata
+// if OnConflict is not called, which are the primary keys (composite primary keys included)
+// if all of them are given in `data`, or else a unique key given in `data`.
+// It returns nil if no key can be inferred, in which case the driver decides the conflict keys.
+func (m *Model) inferOnConflictKeys(data Map) []string {
+	tableFields, err := m.TableFields(m.getPrimaryTableName())
+	if err != nil || len(tableFields) == 0 {
+		return nil
+	}
+	var hasAllKeys = func(keys []string) bool {
+		for _, key := range keys {
+			if foundKey, _ := gutil.MapPossibleItemByKey(data, key); foundKey == "" {
+				return false
+			}
+		}
+		return len(keys) > 0
+	}
+	if primaryKeys := getKeyFieldNames(tableFields, "pri"); hasAllKeys(primaryKeys) {
+		return primaryKeys
+	}
+	for _, uniqueKey := range getKeyFieldNames(tableFields, "uni") {
+		if hasAllKeys([]string{uniqueKey}) {
+			return []string{uniqueKey}
+		}
+	}
+	return nil
+}
+
 func (m *Model) getBatch() int {
 	return m.batch
 }
@@ -0,0 +1,202 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/internal/intlog"
+)
+
+// Iterator streams the query result rows one by one from the underlying driver,
+// which keeps only the current row in memory. It is used for retrieving large result sets
+// that cannot be loaded into memory as Result.
+//
+// The Iterator must be closed after use, or else the underlying connection is not released,
+// but it is closed automatically when all rows are iterated.
+//
+// Example:
+//
+//	it, err := db.Model("user").Iterator()
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		var user *User
+//		if err = it.Scan(&user); err != nil {
+//			return err
+//		}
+//	}
+//	return it.Err()
+type Iterator struct {
+	ctx         context.Context
+	cancel      context.CancelFunc // cancel releases the query context, which is called by Close.
+	model       *Model
+	rows        *sql.Rows
+	columnTypes []*sql.ColumnType
+	values      []any
+	scanArgs    []any
+	record      Record
+	err         error
+	closed      bool
+}
+
+// Iterator does "SELECT FROM ..." statement for the model and returns an Iterator streaming
+// the records, instead of retrieving all records into memory like All.
+//
+// Note that the select hooks and cache feature of the model are not applied to the Iterator,
+// as they work on the whole Result.
+//
+// The optional parameter `where` is the same as the parameter of Model.Where function,
+// see Model.Where.
+func (m *Model) Iterator(where ...any) (*Iterator, error) {
+	if len(where) > 0 {
+		return m.Where(where[0], where[1:]...).Iterator()
+	}
+	if err := m.checkSelect(); err != nil {
+		return nil, err
+	}
+	var (
+		ctx, cancel               = context.WithCancel(m.GetCtx())
+		sqlWithHolder, holderArgs = m.getFormattedSqlAndArgs(ctx, SelectTypeDefault, false)
+	)
+	rows, err := m.db.GetCore().doQueryRows(ctx, m.getLink(false), sqlWithHolder, m.mergeSelectArguments(ctx, holderArgs)...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &Iterator{
+		ctx:    ctx,
+		cancel: cancel,
+		model:  m,
+		rows:   rows,
+	}, nil
+}
+
+// doQueryRows commits the query statement to underlying driver through given link object,
+// and returns the unread rows, which should be closed by the caller.
+// It returns nil rows if the statement is not committed for sql catching.
+func (c *Core) doQueryRows(ctx context.Context, link Link, sqlStr string, args ...any) (rows *sql.Rows, err error) {
+	if link, err = c.getQueryLink(ctx, link); err != nil {
+		return nil, err
+	}
+	// Sql filtering.
+	sqlStr, args = c.FormatSqlBeforeExecuting(sqlStr, args)
+	sqlStr, args, err = c.db.DoFilter(ctx, link, sqlStr, args)
+	if err != nil {
+		return nil, err
+	}
+	// SQL format and retrieve.
+	if v := ctx.Value(ctxKeyCatchSQL); v != nil {
+		var manager = v.(*CatchSQLManager)
+		manager.SQLArray.Append(FormatSqlWithArgs(sqlStr, args))
+		if !manager.DoCommit && ctx.Value(ctxKeyInternalProducedSQL) == nil {
+			return nil, nil
+		}
+	}
+	out, err := c.db.DoCommit(ctx, DoCommitInput{
+		Link:          link,
+		Sql:           sqlStr,
+		Args:          args,
+		Type:          SqlTypeQueryRowsContext,
+		IsTransaction: link.IsTransaction(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	rows, _ = out.RawResult.(*sql.Rows)
+	return rows, nil
+}
+
+// Next prepares the next record for reading with Record or Scan.
+// It returns false if there's no more record or any error occurs, and the Iterator is closed
+// automatically in this case. Err should be checked to distinguish the two cases.
+func (it *Iterator) Next() bool {
+	if it.closed || it.rows == nil {
+		return false
+	}
+	if !it.rows.Next() {
+		// The error of the rows is checked as the iteration also ends if the context is canceled.
+		it.err = it.rows.Err()
+		_ = it.Close()
+		return false
+	}
+	if it.columnTypes == nil {
+		if it.columnTypes, it.err = it.rows.ColumnTypes(); it.err != nil {
+			_ = it.Close()
+			return false
+		}
+		it.values = make([]any, len(it.columnTypes))
+		it.scanArgs = make([]any, len(it.columnTypes))
+		for i := range it.values {
+			it.scanArgs[i] = &it.values[i]
+		}
+	}
+	if it.err = it.rows.Scan(it.scanArgs...); it.err != nil {
+		_ = it.Close()
+		return false
+	}
+	var (
+		core   = it.model.db.GetCore()
+		record = make(Record, len(it.columnTypes))
+	)
+	for i, value := range it.values {
+		if value == nil {
+			record[it.columnTypes[i].Name()] = nil
+			continue
+		}
+		var convertedValue any
+		if convertedValue, it.err = core.columnValueToLocalValue(it.ctx, value, it.columnTypes[i]); it.err != nil {
+			_ = it.Close()
+			return false
+		}
+		record[it.columnTypes[i].Name()] = gvar.New(convertedValue)
+	}
+	if masked := it.model.maskResult(Result{record}); len(masked) > 0 {
+		record = masked[0]
+	}
+	it.record = record
+	return true
+}
+
+// Record returns the current record prepared by Next.
+func (it *Iterator) Record() Record {
+	return it.record
+}
+
+// Scan converts the current record prepared by Next to `pointer`,
+// which should be type of *struct/**struct, see Record.Struct.
+func (it *Iterator) Scan(pointer any) error {
+	return it.record.Struct(pointer)
+}
+
+// Err returns the error that occurred during the iteration, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close closes the Iterator and releases the underlying connection.
+// It is safe to call Close multiple times.
+func (it *Iterator) Close() error {
+	if it.cancel != nil {
+		defer it.cancel()
+	}
+	if it.closed || it.rows == nil {
+		it.closed = true
+		return nil
+	}
+	it.closed = true
+	err := it.rows.Close()
+	if err != nil {
+		intlog.Errorf(it.ctx, `%+v`, err)
+	}
+	it.model.db.GetCore().untrackRows(it.ctx, it.rows)
+	return err
+}
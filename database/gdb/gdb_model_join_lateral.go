@@ -0,0 +1,73 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"fmt"
+)
+
+// LeftJoinLateral does "LEFT JOIN LATERAL (subQuery) alias ON ..." statement on the model,
+// in which the sub query can reference the columns of the preceding tables. It is usually used for
+// top-N-per-group queries. The optional parameter `on` is the join condition, which is "TRUE" in default.
+// The arguments of the sub query are merged in sequence.
+//
+// Note that it is supported by pgsql, gaussdb and mysql 8.0.14+. Use CrossApply/OuterApply for mssql. Eg:
+//
+//	db.Model("user u").Fields("u.id, o.amount").LeftJoinLateral(
+//		db.Model("order o").Where("o.uid=u.id").OrderDesc("o.amount").Limit(3), "o",
+//	).All()
+//	// SELECT u.id,o.amount FROM `user` u LEFT JOIN LATERAL (SELECT * FROM `order` o WHERE o.uid=u.id
+//	// ORDER BY `o`.`amount` DESC LIMIT 3) `o` ON (TRUE)
+func (m *Model) LeftJoinLateral(subQuery *Model, alias string, on ...string) *Model {
+	condition := "TRUE"
+	if len(on) > 0 && on[0] != "" {
+		condition = on[0]
+	}
+	return m.doJoinSubQuery(fmt.Sprintf(`%s JOIN LATERAL`, joinOperatorLeft), subQuery, alias, condition)
+}
+
+// InnerJoinLateral does "INNER JOIN LATERAL (subQuery) alias ON ..." statement on the model.
+// See LeftJoinLateral.
+func (m *Model) InnerJoinLateral(subQuery *Model, alias string, on ...string) *Model {
+	condition := "TRUE"
+	if len(on) > 0 && on[0] != "" {
+		condition = on[0]
+	}
+	return m.doJoinSubQuery(fmt.Sprintf(`%s JOIN LATERAL`, joinOperatorInner), subQuery, alias, condition)
+}
+
+// CrossApply does "CROSS APPLY (subQuery) alias" statement on the model, which works like
+// InnerJoinLateral for mssql and oracle 12c+. The arguments of the sub query are merged in sequence.
+//
+//	db.Model("user u").CrossApply(db.Model("order o").Where("o.uid=u.id").OrderDesc("o.amount").Limit(3), "o").All()
+func (m *Model) CrossApply(subQuery *Model, alias string) *Model {
+	return m.doJoinSubQuery("CROSS APPLY", subQuery, alias, "")
+}
+
+// OuterApply does "OUTER APPLY (subQuery) alias" statement on the model, which works like
+// LeftJoinLateral for mssql and oracle 12c+. The arguments of the sub query are merged in sequence.
+func (m *Model) OuterApply(subQuery *Model, alias string) *Model {
+	return m.doJoinSubQuery("OUTER APPLY", subQuery, alias, "")
+}
+
+// doJoinSubQuery joins the sub query Model `subQuery` with `alias` using `clause`, and the join condition
+// `on` if given. The arguments of the sub query are appended to the extra arguments of the model, as the
+// joined tables are always in front of the conditions.
+//
+// Note that the alias is not prefixed with "AS", which is not accepted for table alias by oracle and dm.
+func (m *Model) doJoinSubQuery(clause string, subQuery *Model, alias, on string) *Model {
+	var (
+		model        = m.getModel()
+		holder, args = subQuery.getHolderAndArgsAsSubModel(m.GetCtx())
+	)
+	model.tables += fmt.Sprintf(` %s (%s) %s`, clause, holder, m.db.GetCore().QuoteWord(alias))
+	if on != "" {
+		model.tables += fmt.Sprintf(` ON (%s)`, on)
+	}
+	model.extraArgs = append(model.extraArgs[:len(model.extraArgs):len(model.extraArgs)], args...)
+	return model
+}
@@ -6,6 +6,13 @@
 
 package gdb
 
+import (
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
 // Lock clause constants for different databases.
 // These constants provide type-safe and IDE-friendly access to various lock syntaxes.
 const (
@@ -42,6 +49,20 @@ const (
 	LockWithUpdLockHoldLock = "WITH (UPDLOCK, HOLDLOCK)"
 )
 
+// LockOption is the option of LockUpdate and LockShared.
+type LockOption struct {
+	// SkipLocked skips the rows locked by other transactions instead of waiting for them,
+	// which is usually used for job queues. It cannot be used along with NoWait.
+	SkipLocked bool
+
+	// NoWait returns error immediately instead of waiting if any row is locked by other transactions.
+	NoWait bool
+
+	// Of specifies the tables of which the rows are locked in join queries, like "FOR UPDATE OF user".
+	// Note that Oracle and Dameng use columns instead of tables, and MariaDB does not support it.
+	Of []string
+}
+
 // Lock sets a custom lock clause for the current operation.
 // This is a generic method that allows you to specify any lock syntax supported by your database.
 // You can use predefined constants or custom strings.
@@ -98,15 +119,20 @@ const (
 func (m *Model) Lock(lockClause string) *Model {
 	model := m.getModel()
 	model.lockInfo = lockClause
+	model.lockErr = nil
 	return model
 }
 
 // LockUpdate sets the lock for update for current operation.
 // This is equivalent to Lock("FOR UPDATE").
-func (m *Model) LockUpdate() *Model {
-	model := m.getModel()
-	model.lockInfo = LockForUpdate
-	return model
+//
+// The optional parameter `option` specifies the SKIP LOCKED, NOWAIT and OF clauses, which are supported
+// by mysql 8.0+, mariadb 10.6+, pgsql, gaussdb, oracle and dm. The select statement returns error
+// for other databases if any of them is specified, eg:
+//
+//	db.Model("job").Where("status", 0).LockUpdate(gdb.LockOption{SkipLocked: true}).Limit(10).All()
+func (m *Model) LockUpdate(option ...LockOption) *Model {
+	return m.doLock(LockForUpdate, option...)
 }
 
 // LockUpdateSkipLocked sets the lock for update with skip locked behavior for current operation.
@@ -116,14 +142,80 @@ func (m *Model) LockUpdate() *Model {
 func (m *Model) LockUpdateSkipLocked() *Model {
 	model := m.getModel()
 	model.lockInfo = LockForUpdateSkipLocked
+	model.lockErr = nil
 	return model
 }
 
 // LockShared sets the lock in share mode for current operation.
 // This is equivalent to Lock("LOCK IN SHARE MODE") for MySQL or Lock("FOR SHARE") for PostgreSQL.
 // Note: For maximum compatibility, this uses MySQL's legacy syntax.
-func (m *Model) LockShared() *Model {
+//
+// The optional parameter `option` specifies the SKIP LOCKED, NOWAIT and OF clauses like LockUpdate,
+// in which case it uses "FOR SHARE" syntax except mariadb. Note that oracle and dm have no shared row lock.
+func (m *Model) LockShared(option ...LockOption) *Model {
+	return m.doLock(LockInShareMode, option...)
+}
+
+// doLock sets the lock clause of `lock` with the `option` for current operation.
+func (m *Model) doLock(lock string, option ...LockOption) *Model {
 	model := m.getModel()
-	model.lockInfo = LockInShareMode
+	model.lockInfo = lock
+	model.lockErr = nil
+	if len(option) > 0 {
+		model.lockInfo, model.lockErr = formatLockClause(m.db.GetConfig().Type, lock, option[0], m.QuoteWord)
+	}
 	return model
 }
+
+// formatLockClause formats and returns the lock clause of `lock` with `option` for database type `dbType`.
+// It returns error if any option is not supported by the database.
+func formatLockClause(dbType string, lock string, option LockOption, quote func(string) string) (string, error) {
+	if !option.SkipLocked && !option.NoWait && len(option.Of) == 0 {
+		return lock, nil
+	}
+	if option.SkipLocked && option.NoWait {
+		return "", gerror.NewCode(
+			gcode.CodeInvalidParameter,
+			`lock options SkipLocked and NoWait cannot be used together`,
+		)
+	}
+	dbType = strings.ToLower(dbType)
+	unsupported := func(feature string) error {
+		return gerror.NewCodef(
+			gcode.CodeNotSupported,
+			`lock option %s is not supported by database type "%s"`,
+			feature, dbType,
+		)
+	}
+	switch dbType {
+	case "mysql", "mariadb", "pgsql", "gaussdb", "oracle", "dm":
+	default:
+		return "", unsupported("SKIP LOCKED/NOWAIT/OF")
+	}
+	if lock == LockInShareMode {
+		switch dbType {
+		case "oracle", "dm":
+			return "", unsupported("of shared lock")
+		case "mariadb":
+		default:
+			lock = LockForShare
+		}
+	}
+	if len(option.Of) > 0 {
+		if dbType == "mariadb" {
+			return "", unsupported("OF")
+		}
+		of := make([]string, len(option.Of))
+		for i, v := range option.Of {
+			of[i] = quote(v)
+		}
+		lock += " OF " + strings.Join(of, ",")
+	}
+	switch {
+	case option.SkipLocked:
+		lock += " SKIP LOCKED"
+	case option.NoWait:
+		lock += " NOWAIT"
+	}
+	return lock, nil
+}
@@ -0,0 +1,19 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+// Mapping sets the mapping option for converting records to structs in Scan/ScanList functions,
+// which supports custom tag priority, column name normalization and strict mode, see MappingOption.
+//
+// Example:
+//
+//	err := db.Model("user").Fields("id,name,extra").Mapping(gdb.MappingOption{Strict: true}).Scan(&users)
+func (m *Model) Mapping(option MappingOption) *Model {
+	model := m.getModel()
+	model.mappingOption = []MappingOption{option}
+	return model
+}
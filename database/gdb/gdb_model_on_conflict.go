@@ -0,0 +1,68 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+// conflictAction is the action of Insert operation when the primary or unique key conflicts.
+type conflictAction int
+
+const (
+	conflictActionNone      conflictAction = iota // Returns the duplicate key error.
+	conflictActionDoNothing                       // Ignores the conflicting records.
+	conflictActionDoUpdate                        // Updates the conflicting records.
+)
+
+// insertOption returns the insert option of the action for Insert operation.
+func (a conflictAction) insertOption() InsertOption {
+	switch a {
+	case conflictActionDoNothing:
+		return InsertOptionIgnore
+	case conflictActionDoUpdate:
+		return InsertOptionSave
+	default:
+		return InsertOptionDefault
+	}
+}
+
+// OnConflictDoNothing makes the following Insert/InsertAndGetId operations ignore the records
+// conflicting on primary or unique keys, without driver-specific code.
+//
+// It compiles to "ON CONFLICT DO NOTHING" for pgsql, "INSERT OR IGNORE" for sqlite, "INSERT IGNORE"
+// for mysql/mariadb, and MERGE statement for mssql/oracle/dm, which is the same as InsertIgnore.
+// The conflict keys can be specified by OnConflict, which are the primary keys in default for MERGE statement.
+// Example:
+//
+//	db.Model("user").Data(data).OnConflict("passport").OnConflictDoNothing().Insert()
+func (m *Model) OnConflictDoNothing() *Model {
+	model := m.getModel()
+	model.conflictAction = conflictActionDoNothing
+	return model
+}
+
+// OnConflictDoUpdate makes the following Insert/InsertAndGetId operations update the records
+// conflicting on primary or unique keys, without driver-specific code.
+//
+// It compiles to "ON CONFLICT (keys) DO UPDATE" for pgsql/sqlite, "ON DUPLICATE KEY UPDATE" for
+// mysql/mariadb, and MERGE statement for mssql/oracle/dm, which is the same as Save.
+// The optional parameter `update` specifies the updating columns like OnDuplicate, which are all the
+// inserting columns in default. The conflict keys can be specified by OnConflict, or else they are
+// inferred from the primary or unique keys in the data.
+// Example:
+//
+//	db.Model("user").Data(data).OnConflict("passport").OnConflictDoUpdate("nickname").Insert()
+//	db.Model("user").Data(data).OnConflictDoUpdate(g.Map{
+//		"login_count": &gdb.Counter{Field: "login_count", Value: 1},
+//	}).Insert()
+func (m *Model) OnConflictDoUpdate(update ...any) *Model {
+	model := m.getModel()
+	model.conflictAction = conflictActionDoUpdate
+	if len(update) > 1 {
+		model.onDuplicate = update
+	} else if len(update) == 1 {
+		model.onDuplicate = update[0]
+	}
+	return model
+}
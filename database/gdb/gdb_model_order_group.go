@@ -7,6 +7,7 @@
 package gdb
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/gogf/gf/v2/text/gstr"
@@ -21,7 +22,8 @@ import (
 // Order("id desc,name asc")
 // Order("id desc", "name asc")
 // Order("id desc").Order("name asc")
-// Order(gdb.Raw("field(id, 3,1,2)")).
+// Order(gdb.Raw("field(id, 3,1,2)"))
+// Order(gdb.Func("LENGTH", "name"), "DESC").
 func (m *Model) Order(orderBy ...any) *Model {
 	if len(orderBy) == 0 {
 		return m
@@ -34,9 +36,17 @@ func (m *Model) Order(orderBy ...any) *Model {
 		if model.orderBy != "" {
 			model.orderBy += ","
 		}
-		switch v.(type) {
+		switch r := v.(type) {
 		case Raw, *Raw:
 			model.orderBy += gconv.String(v)
+		case FuncExpr:
+			orderBySql, orderByArgs := r.Build(m.db)
+			model.orderBy += orderBySql
+			model.orderArgs = append(model.orderArgs[:len(model.orderArgs):len(model.orderArgs)], orderByArgs...)
+		case *FuncExpr:
+			orderBySql, orderByArgs := r.Build(m.db)
+			model.orderBy += orderBySql
+			model.orderArgs = append(model.orderArgs[:len(model.orderArgs):len(model.orderArgs)], orderByArgs...)
 		default:
 			orderByStr := gconv.String(v)
 			if gstr.Contains(orderByStr, " ") {
@@ -74,6 +84,7 @@ func (m *Model) OrderDesc(column string) *Model {
 func (m *Model) OrderRandom() *Model {
 	model := m.getModel()
 	model.orderBy = m.db.OrderRandomFunction()
+	model.orderArgs = nil
 	return model
 }
 
@@ -93,3 +104,28 @@ func (m *Model) Group(groupBy ...string) *Model {
 	model.groupBy += core.QuoteString(strings.Join(groupBy, ","))
 	return model
 }
+
+// GroupByDate truncates the date/time `column` to the start of the date `bucket` using the
+// dialect-specific function of the database, and groups by the truncated value.
+// The truncated value is also appended to the fields of the model as `alias`, which is
+// the column name if `alias` is empty.
+//
+// Example:
+//
+//	db.Model("order").Fields("COUNT(1) total").GroupByDate("created_at", gdb.BucketDay, "day").All()
+//	-> SELECT COUNT(1) total,DATE_FORMAT(`created_at`,'%Y-%m-%d') AS `day` FROM `order` GROUP BY DATE_FORMAT(`created_at`,'%Y-%m-%d')
+func (m *Model) GroupByDate(column string, bucket DateBucket, alias string) *Model {
+	if alias == "" {
+		alias = column
+	}
+	var (
+		core  = m.db.GetCore()
+		model = m.getModel()
+		expr  = m.db.DateBucketFunction(core.QuoteWord(column), bucket)
+	)
+	if model.groupBy != "" {
+		model.groupBy += ","
+	}
+	model.groupBy += expr
+	return model.appendToFields(Raw(fmt.Sprintf(`%s AS %s`, expr, core.QuoteWord(alias))))
+}
@@ -0,0 +1,49 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+// PageResult is the pagination metadata returned by Model.Paginate.
+type PageResult struct {
+	Total int `json:"total"` // Total count of the records matching the conditions.
+	Pages int `json:"pages"` // Count of the pages of Size.
+	Page  int `json:"page"`  // Current page number, which starts from 1.
+	Size  int `json:"size"`  // Count of the records of each page.
+}
+
+// HasNext checks and returns whether there's a page after current page.
+func (r *PageResult) HasNext() bool {
+	return r.Page < r.Pages
+}
+
+// Paginate queries the records of page `page` of `size` records into `pointer`, and returns the
+// pagination metadata along with them. It runs the count statement and the page statement sharing the
+// same conditions and joins like ScanAndCount, and the page statement is not executed if there's no record.
+//
+// The parameter `page` starts from 1, and `pointer` can be type of *[]struct/*[]*struct/*Result, etc.
+// Example:
+//
+//	var users []*User
+//	pageResult, err := db.Model("user").Where("status", 1).OrderDesc("id").Paginate(2, 20, &users)
+func (m *Model) Paginate(page, size int, pointer any) (pageResult *PageResult, err error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size < 0 {
+		size = 0
+	}
+	pageResult = &PageResult{
+		Page: page,
+		Size: size,
+	}
+	if err = m.Page(page, size).ScanAndCount(pointer, &pageResult.Total, false); err != nil {
+		return nil, err
+	}
+	if size > 0 {
+		pageResult.Pages = (pageResult.Total + size - 1) / size
+	}
+	return pageResult, nil
+}
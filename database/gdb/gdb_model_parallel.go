@@ -0,0 +1,92 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+const (
+	// defaultParallelLimit is the default maximum count of queries running concurrently in Parallel.
+	defaultParallelLimit = 8
+)
+
+// Parallel runs the select queries of `models` concurrently with the context `ctx`, and returns
+// their results positionally, that is, the result of models[i] is results[i].
+// It is usually used for scatter-gather queries like dashboard endpoints.
+//
+// At most 8 queries run concurrently, use ParallelWithLimit for other concurrency limit.
+// Once any query fails, the remaining queries are canceled and the error of the failed query
+// is returned.
+//
+// Example:
+//
+//	results, err := gdb.Parallel(ctx,
+//		db.Model("user").Where("status", 1),
+//		db.Model("order").WhereGTE("created_at", today),
+//	)
+func Parallel(ctx context.Context, models ...*Model) ([]Result, error) {
+	return ParallelWithLimit(ctx, defaultParallelLimit, models...)
+}
+
+// ParallelWithLimit does the same as Parallel, but it runs at most `limit` queries concurrently.
+// It runs all queries concurrently if `limit` <= 0.
+func ParallelWithLimit(ctx context.Context, limit int, models ...*Model) ([]Result, error) {
+	if len(models) == 0 {
+		return nil, nil
+	}
+	if limit <= 0 || limit > len(models) {
+		limit = len(models)
+	}
+	var (
+		wg                sync.WaitGroup
+		errOnce           sync.Once
+		firstErr          error
+		results           = make([]Result, len(models))
+		indexChan         = make(chan int)
+		cancelCtx, cancel = context.WithCancel(ctx)
+	)
+	defer cancel()
+	for i := 0; i < limit; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexChan {
+				result, err := models[index].Ctx(cancelCtx).All()
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = gerror.Wrapf(err, `parallel query at index %d failed`, index)
+						cancel()
+					})
+					continue
+				}
+				results[index] = result
+			}
+		}()
+	}
+	for i := range models {
+		select {
+		case indexChan <- i:
+		case <-cancelCtx.Done():
+		}
+		if cancelCtx.Err() != nil {
+			break
+		}
+	}
+	close(indexChan)
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
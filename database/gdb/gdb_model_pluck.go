@@ -0,0 +1,83 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+// Pluck queries and returns the values of `column` as slice from database.
+// Different from Array, it replaces the fields of the model with `column`.
+//
+// The optional parameter `where` is the same as the parameter of Model.Where function,
+// see Model.Where. Example:
+//
+//	names, err := db.Model("user").Where("status", 1).Order("id").Pluck("name")
+func (m *Model) Pluck(column string, where ...any) ([]Value, error) {
+	if len(where) > 0 {
+		return m.Where(where[0], where[1:]...).Pluck(column)
+	}
+	return m.getPluckModel().Fields(column).Array()
+}
+
+// PluckKeyValue queries and returns the values of `valueColumn` as map from database, whose keys are the
+// values of `keyColumn` in string. The latter one overwrites the former one if there are duplicated keys.
+// Different from Result.MapKeyValue, it selects only the two columns from database.
+//
+// The optional parameter `where` is the same as the parameter of Model.Where function,
+// see Model.Where. Example:
+//
+//	names, err := db.Model("user").WhereIn("id", ids).PluckKeyValue("id", "name")
+func (m *Model) PluckKeyValue(keyColumn, valueColumn string, where ...any) (map[string]Value, error) {
+	if len(where) > 0 {
+		return m.Where(where[0], where[1:]...).PluckKeyValue(keyColumn, valueColumn)
+	}
+	model := m.getPluckModel()
+	if keyColumn == valueColumn {
+		model = model.Fields(keyColumn)
+	} else {
+		model = model.Fields(keyColumn, valueColumn)
+	}
+	result, err := model.All()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		keyName   = m.getPluckResultName(keyColumn)
+		valueName = m.getPluckResultName(valueColumn)
+		data      = make(map[string]Value, len(result))
+	)
+	for _, record := range result {
+		if key, ok := record[keyName]; ok {
+			data[key.String()] = record[valueName]
+		}
+	}
+	return data, nil
+}
+
+// getPluckModel returns a cloned model of which the fields are cleared for Pluck operations.
+func (m *Model) getPluckModel() *Model {
+	model := m.Clone()
+	model.fields = nil
+	model.fieldsEx = nil
+	model.fieldAliases = nil
+	return model
+}
+
+// getPluckResultName returns the name of `column` in the result records, which removes the table prefix,
+// the quote chars, and uses the alias if any, eg: "u.id" for "id", "u.id AS uid" for "uid".
+func (m *Model) getPluckResultName(column string) string {
+	column = gstr.Trim(column)
+	if array := gstr.SplitAndTrim(column, " "); len(array) > 1 {
+		column = array[len(array)-1]
+	}
+	if pos := gstr.PosR(column, "."); pos != -1 {
+		column = column[pos+1:]
+	}
+	charL, charR := m.db.GetChars()
+	return gstr.Trim(column, charL+charR)
+}
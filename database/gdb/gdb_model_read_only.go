@@ -0,0 +1,124 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/intlog"
+	"github.com/gogf/gf/v2/os/gcache"
+	"github.com/gogf/gf/v2/util/gutil"
+)
+
+// ReadOnly marks the model read-only, which rejects the Insert/Replace/Save/Update/Delete
+// operations with error before the statements are built and committed.
+// The model of a database view is read-only automatically, which can be disabled by
+// ReadOnly(false) for the updatable views.
+//
+// Example:
+//
+//	db.Model("report_daily_view").ReadOnly().Data(g.Map{"total": 1}).Insert() // error
+func (m *Model) ReadOnly(readOnly ...bool) *Model {
+	model := m.getModel()
+	enabled := true
+	if len(readOnly) > 0 {
+		enabled = readOnly[0]
+	}
+	model.readOnly = &enabled
+	return model
+}
+
+// IsReadOnly checks and returns whether the model is read-only, either marked by ReadOnly
+// or automatically for the database view.
+func (m *Model) IsReadOnly() bool {
+	if m.readOnly != nil {
+		return *m.readOnly
+	}
+	return m.isViewTable(m.GetCtx())
+}
+
+// checkWritable checks whether the model allows the write `operation`, and returns error if it is read-only.
+func (m *Model) checkWritable(ctx context.Context, operation string) error {
+	if m.readOnly != nil {
+		if !*m.readOnly {
+			return nil
+		}
+		return gerror.NewCodef(
+			gcode.CodeInvalidOperation,
+			`%s operation is not allowed on read-only model of table "%s"`,
+			operation, m.tablesInit,
+		)
+	}
+	if m.isViewTable(ctx) {
+		return gerror.NewCodef(
+			gcode.CodeInvalidOperation,
+			`%s operation is not allowed on read-only model of table "%s", which is a database view`,
+			operation, m.tablesInit,
+		)
+	}
+	return nil
+}
+
+// getInsertOperationName returns the operation name of the insert option for error messages.
+func getInsertOperationName(insertOption InsertOption) string {
+	switch insertOption {
+	case InsertOptionReplace:
+		return "Replace"
+	case InsertOptionSave:
+		return "Save"
+	case InsertOptionIgnore:
+		return "InsertIgnore"
+	default:
+		return "Insert"
+	}
+}
+
+// isViewTable checks and returns whether the primary table of the model is a database view.
+func (m *Model) isViewTable(ctx context.Context) bool {
+	table := m.db.GetCore().guessPrimaryTableName(m.tablesInit)
+	if table == "" {
+		return false
+	}
+	isView, err := m.db.GetCore().isViewWithCache(ctx, table, m.schema)
+	if err != nil {
+		intlog.Errorf(ctx, `check view of table "%s" failed: %+v`, table, err)
+		return false
+	}
+	return isView
+}
+
+// IsView checks and returns whether the specified table is a database view.
+// It returns false in default, which should be implemented by the drivers.
+func (c *Core) IsView(ctx context.Context, table string, schema ...string) (bool, error) {
+	return false, nil
+}
+
+// isViewWithCache checks whether the table is a database view with cache, as the views are
+// rarely changed in runtime.
+func (c *Core) isViewWithCache(ctx context.Context, table string, schema string) (bool, error) {
+	var (
+		usedSchema = gutil.GetOrDefaultStr(c.db.GetSchema(), schema)
+		cacheKey   = fmt.Sprintf(`%s%s@%s#%s`, cachePrefixIsView, c.db.GetGroup(), usedSchema, table)
+	)
+	result, err := c.GetInnerMemCache().GetOrSetFuncLock(
+		ctx, cacheKey,
+		func(ctx context.Context) (any, error) {
+			var schemas []string
+			if schema != "" {
+				schemas = []string{schema}
+			}
+			return c.db.IsView(ctx, table, schemas...)
+		}, gcache.DurationNoExpire,
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.Bool(), nil
+}
@@ -0,0 +1,126 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// ReplaceMode is the mode of Model.Replace operation.
+type ReplaceMode int
+
+const (
+	// ReplaceModeDefault uses the "REPLACE INTO" statement of the database, or the upsert
+	// statement of the given columns like Save for the databases not supporting it, eg: PostgreSQL.
+	ReplaceModeDefault ReplaceMode = iota
+
+	// ReplaceModeDeleteInsert emulates the "REPLACE INTO" statement by deleting the rows
+	// conflicting with the data and then inserting the data in a transaction, which is portable
+	// for all databases and keeps the semantics of MySQL that the columns not given in the data
+	// are reset to their default values.
+	ReplaceModeDeleteInsert
+)
+
+// ReplaceMode sets the mode of Replace operation for the model, which is an explicit opt-in
+// for the code ported from MySQL keeping working on databases not supporting "REPLACE INTO".
+// The conflicting rows are detected by the OnConflict keys of the model, or the primary keys
+// of the table if the OnConflict keys are not specified.
+//
+// Example:
+//
+//	db.Model("user").ReplaceMode(gdb.ReplaceModeDeleteInsert).Data(g.Map{"id": 1, "name": "john"}).Replace()
+func (m *Model) ReplaceMode(mode ReplaceMode) *Model {
+	model := m.getModel()
+	model.replaceMode = mode
+	return model
+}
+
+// doReplaceByDeleteInsert emulates the Replace operation by deleting the conflicting rows and
+// inserting the data in a transaction.
+func (m *Model) doReplaceByDeleteInsert(ctx context.Context) (result sql.Result, err error) {
+	if err = m.checkWritable(ctx, "Replace"); err != nil {
+		return nil, err
+	}
+	if m.data == nil {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "replacing into table with empty data")
+	}
+	newData, err := m.filterDataForInsertOrUpdate(m.data)
+	if err != nil {
+		return nil, err
+	}
+	var list List
+	switch value := newData.(type) {
+	case List:
+		list = value
+	case Map:
+		list = List{value}
+	default:
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`unsupported data type "%T" for replacing in mode ReplaceModeDeleteInsert`,
+			newData,
+		)
+	}
+	conflictKeys, err := m.formatOnConflictKeys(m.onConflict)
+	if err != nil {
+		return nil, err
+	}
+	if len(conflictKeys) == 0 {
+		var schema []string
+		if m.schema != "" {
+			schema = []string{m.schema}
+		}
+		if conflictKeys, err = m.db.GetCore().GetPrimaryKeys(ctx, m.tablesInit, schema...); err != nil {
+			return nil, err
+		}
+	}
+	if len(conflictKeys) == 0 {
+		return nil, gerror.NewCodef(
+			gcode.CodeMissingParameter,
+			`replacing requires conflict keys: either specify OnConflict() columns or ensure table "%s" has a primary key`,
+			m.tablesInit,
+		)
+	}
+	if m.tx != nil {
+		ctx = WithTX(ctx, m.tx)
+	}
+	err = m.db.Transaction(ctx, func(ctx context.Context, tx TX) error {
+		deleteModel := tx.Model(m.tablesInit).Ctx(ctx).Unscoped()
+		if m.schema != "" {
+			deleteModel = deleteModel.Schema(m.schema)
+		}
+		deleteWhere := deleteModel.Builder()
+		for _, item := range list {
+			itemWhere := deleteModel.Builder()
+			for _, key := range conflictKeys {
+				value, ok := item[key]
+				if !ok {
+					return gerror.NewCodef(
+						gcode.CodeMissingParameter,
+						`conflict key "%s" not found in replacing data`,
+						key,
+					)
+				}
+				itemWhere = itemWhere.Where(key, value)
+			}
+			deleteWhere = deleteWhere.WhereOr(itemWhere)
+		}
+		if _, err = deleteModel.Where(deleteWhere).Delete(); err != nil {
+			return err
+		}
+		insertModel := m.Clone()
+		insertModel.tx = tx
+		insertModel.data = list
+		result, err = insertModel.doInsertWithOption(ctx, InsertOptionDefault)
+		return err
+	})
+	return
+}
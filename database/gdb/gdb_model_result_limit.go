@@ -0,0 +1,161 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gctx"
+)
+
+// ResultLimit is the guardrail limiting the size of the result set of select statements,
+// which protects services from accidental unbounded SELECTs.
+type ResultLimit struct {
+	// MaxRows is the maximum count of rows of the result, it is not limited if it is 0.
+	MaxRows int
+
+	// MaxBytes is the maximum estimated bytes of the values of the result, it is not limited if it is 0.
+	MaxBytes int64
+
+	// Truncate specifies truncating the result to the limit instead of returning error
+	// if the result set exceeds the limit. The truncation is logged as warning.
+	Truncate bool
+
+	// OnTruncate is called with the count of rows of the truncated result if the result is
+	// truncated in Truncate mode, so that the caller can tell the result is incomplete.
+	OnTruncate func(ctx context.Context, rows int)
+}
+
+const (
+	ctxKeyForResultLimit gctx.StrKey = `CtxKeyForResultLimit`
+
+	// resultLimitValueSize is the estimated size of the values that are not string or bytes.
+	resultLimitValueSize = 8
+)
+
+// MaxRows sets the maximum count of rows of the result set for the select statements of the model.
+// The select statement fails if its result set exceeds `n` rows, or the result is truncated to `n` rows
+// if `truncate` is given true.
+// It uses the MaxResultRows of the configuration node in default.
+//
+// Example:
+//
+//	db.Model("user").MaxRows(10000).All()
+func (m *Model) MaxRows(n int, truncate ...bool) *Model {
+	model := m.getModel()
+	model.resultLimit.MaxRows = n
+	if len(truncate) > 0 {
+		model.resultLimit.Truncate = truncate[0]
+	}
+	return model
+}
+
+// ResultLimit sets the guardrail of the result set size for the select statements of the model,
+// which limits both the rows count and estimated bytes of the result set.
+func (m *Model) ResultLimit(limit ResultLimit) *Model {
+	model := m.getModel()
+	model.resultLimit = limit
+	return model
+}
+
+// getResultLimit returns the result limit of the model, which uses the configuration
+// of the group in default.
+func (m *Model) getResultLimit() ResultLimit {
+	var limit = m.resultLimit
+	if config := m.db.GetConfig(); config != nil {
+		if limit.MaxRows == 0 && limit.MaxBytes == 0 {
+			limit.Truncate = config.MaxResultTruncate
+		}
+		if limit.MaxRows == 0 {
+			limit.MaxRows = config.MaxResultRows
+		}
+		if limit.MaxBytes == 0 {
+			limit.MaxBytes = config.MaxResultBytes
+		}
+	}
+	return limit
+}
+
+// injectResultLimit injects the result limit of the model into the context for RowsToResult.
+// It also overwrites the limit of outer statement in the context, eg: the statements of With feature.
+func (m *Model) injectResultLimit(ctx context.Context) context.Context {
+	limit := m.getResultLimit()
+	if limit.MaxRows > 0 || limit.MaxBytes > 0 || ctx.Value(ctxKeyForResultLimit) != nil {
+		ctx = context.WithValue(ctx, ctxKeyForResultLimit, limit)
+	}
+	return ctx
+}
+
+// resultLimitChecker checks the size of the result set in RowsToResult.
+type resultLimitChecker struct {
+	limit    ResultLimit
+	rows     int
+	bytes    int64
+	exceeded string // The exceeded limit, "rows" or "bytes", it is empty if the result is in limit.
+}
+
+// newResultLimitChecker creates and returns a checker from the context,
+// which is nil if there's no limit.
+func newResultLimitChecker(ctx context.Context) *resultLimitChecker {
+	if ctx == nil {
+		return nil
+	}
+	limit, ok := ctx.Value(ctxKeyForResultLimit).(ResultLimit)
+	if !ok || (limit.MaxRows <= 0 && limit.MaxBytes <= 0) {
+		return nil
+	}
+	return &resultLimitChecker{limit: limit}
+}
+
+// Add adds the record values to the checker before appending the record to the result.
+// It returns false if the record should not be appended as the result set reaches the limit
+// in truncating mode, or error if the result set exceeds the limit.
+func (c *resultLimitChecker) Add(values []any) (bool, error) {
+	c.rows++
+	for _, value := range values {
+		switch v := value.(type) {
+		case nil:
+		case []byte:
+			c.bytes += int64(len(v))
+		case string:
+			c.bytes += int64(len(v))
+		default:
+			c.bytes += resultLimitValueSize
+		}
+	}
+	switch {
+	case c.limit.MaxRows > 0 && c.rows > c.limit.MaxRows:
+		c.exceeded = "rows"
+	case c.limit.MaxBytes > 0 && c.bytes > c.limit.MaxBytes:
+		c.exceeded = "bytes"
+	default:
+		return true, nil
+	}
+	if c.limit.Truncate {
+		return false, nil
+	}
+	return false, gerror.NewCodef(
+		gcode.CodeInvalidOperation,
+		`result set exceeds the limit of max %s, MaxRows: %d, MaxBytes: %d`,
+		c.exceeded, c.limit.MaxRows, c.limit.MaxBytes,
+	)
+}
+
+// Truncated logs the truncation of the result set as warning, and calls the OnTruncate
+// callback of the limit with the count of rows of the truncated result.
+func (c *resultLimitChecker) Truncated(ctx context.Context, core *Core, rows int) {
+	core.logger.Warningf(
+		ctx,
+		`[gdb] result set is truncated to %d rows by the limit of max %s, MaxRows: %d, MaxBytes: %d`,
+		rows, c.exceeded, c.limit.MaxRows, c.limit.MaxBytes,
+	)
+	if c.limit.OnTruncate != nil {
+		c.limit.OnTruncate(ctx, rows)
+	}
+}
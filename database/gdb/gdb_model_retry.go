@@ -0,0 +1,101 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogf/gf/v2/os/gctx"
+	"github.com/gogf/gf/v2/util/grand"
+)
+
+// RetryOption is the option for statement retry feature of Model.
+// Note that, the retry feature only takes effect for idempotent operations,
+// which are SELECT statements and keyed upsert(Save) statements, and never in transaction.
+type RetryOption struct {
+	// Count is the maximum retry count, the retry feature is disabled if it is 0.
+	Count int
+
+	// Interval is the base backoff interval between retries, which doubles after each retry.
+	Interval time.Duration
+
+	// MaxInterval is the maximum backoff interval between retries. It is not limited if it is 0.
+	MaxInterval time.Duration
+
+	// Checker checks whether given error can be retried.
+	// It uses IsRetryableError in default if it is nil.
+	Checker func(err error) bool
+}
+
+const (
+	retryOperationSelect = "select"
+	retryOperationSave   = "save"
+)
+
+// Retry sets the retry option for idempotent statements of the model,
+// which retries the statement with jittered backoff on transient errors like lost connection
+// or deadlock, instead of surfacing every blip to callers.
+//
+// Example:
+//
+//	db.Model("user").Retry(gdb.RetryOption{Count: 3, Interval: 50 * time.Millisecond}).All()
+func (m *Model) Retry(option RetryOption) *Model {
+	model := m.getModel()
+	model.retryOption = option
+	return model
+}
+
+// doWithRetry calls `f` and retries it according to the retry option of the model.
+func (m *Model) doWithRetry(ctx context.Context, operation string, f func() error) (err error) {
+	var option = m.retryOption
+	if option.Count <= 0 || m.tx != nil || TXFromCtx(ctx, m.db.GetGroup()) != nil {
+		return f()
+	}
+	checker := option.Checker
+	if checker == nil {
+		checker = IsRetryableError
+	}
+	for i := 0; ; i++ {
+		if err = f(); err == nil || i >= option.Count || !checker(err) {
+			return
+		}
+		backoff := option.getBackoff(i)
+		// It stops retrying if the budget of the call chain cannot afford another attempt.
+		if budget := gctx.GetBudget(ctx); budget != nil &&
+			!budget.Allows(backoff+m.db.GetConfig().BudgetMinTimeout) {
+			return
+		}
+		metricManager.IncStatementRetry(ctx, m.db, operation)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// getBackoff returns the jittered backoff interval for the retry of given index.
+// The returned interval is in range [interval/2, interval), in which interval = Interval * 2^index.
+func (o RetryOption) getBackoff(index int) time.Duration {
+	if o.Interval <= 0 {
+		return 0
+	}
+	interval := o.Interval
+	for i := 0; i < index; i++ {
+		interval *= 2
+		if o.MaxInterval > 0 && interval >= o.MaxInterval {
+			interval = o.MaxInterval
+			break
+		}
+	}
+	half := interval / 2
+	if half <= 0 {
+		return interval
+	}
+	return half + time.Duration(grand.Intn(int(half)))
+}
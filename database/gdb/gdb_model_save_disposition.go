@@ -0,0 +1,99 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// SaveDisposition is the disposition of a row in the Save operation.
+type SaveDisposition int
+
+const (
+	SaveDispositionUnknown   SaveDisposition = iota // The driver cannot tell whether the row is inserted or updated.
+	SaveDispositionInserted                         // The row is inserted.
+	SaveDispositionUpdated                          // The existing row is updated.
+	SaveDispositionUnchanged                        // The existing row is not changed as all the values are the same.
+)
+
+// SaveDispositionResult is the interface that the sql.Result of drivers can implement,
+// which returns the disposition of the saved row, eg: by "RETURNING (xmax = 0)" of PostgreSQL.
+type SaveDispositionResult interface {
+	SaveDisposition() SaveDisposition
+}
+
+// SaveWithDisposition does the Save operation like Model.Save, and returns the disposition
+// of each saved row in sequence of the data, which tells whether the row is inserted or updated.
+// It is usually used for idempotent sync jobs.
+//
+// Note that the rows are saved one by one for retrieving the dispositions, and the disposition
+// is SaveDispositionUnknown if the driver does not support it.
+//
+// Example:
+//
+//	dispositions, err := db.Model("user").Data(list).OnConflict("id").SaveWithDisposition()
+func (m *Model) SaveWithDisposition(data ...any) (dispositions []SaveDisposition, err error) {
+	if len(data) > 0 {
+		return m.Data(data...).SaveWithDisposition()
+	}
+	if m.data == nil {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "saving into table with empty data")
+	}
+	var (
+		ctx  = context.WithValue(m.GetCtx(), ctxKeyForSaveDisposition, true)
+		rows []any
+	)
+	switch value := m.data.(type) {
+	case List:
+		for _, item := range value {
+			rows = append(rows, item)
+		}
+	default:
+		rows = []any{value}
+	}
+	dispositions = make([]SaveDisposition, 0, len(rows))
+	for _, row := range rows {
+		var (
+			result sql.Result
+			model  = m.Clone()
+		)
+		model.data = row
+		if result, err = model.doInsertWithOption(ctx, InsertOptionSave); err != nil {
+			return dispositions, err
+		}
+		dispositions = append(dispositions, m.db.GetSaveDisposition(result))
+	}
+	return dispositions, nil
+}
+
+// IsSaveDispositionRequired checks and returns whether the disposition of the Save statement
+// is required in the context, which is used by drivers to retrieve the disposition in
+// database-specific way.
+func IsSaveDispositionRequired(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	required, _ := ctx.Value(ctxKeyForSaveDisposition).(bool)
+	return required
+}
+
+// GetSaveDisposition returns the disposition of the saved row from the result of a single-row
+// Save statement. It returns the disposition if the result implements SaveDispositionResult,
+// or else SaveDispositionUnknown.
+func (c *Core) GetSaveDisposition(result sql.Result) SaveDisposition {
+	if r, ok := result.(*SqlResult); ok {
+		result = r.Result
+	}
+	if r, ok := result.(SaveDispositionResult); ok {
+		return r.SaveDisposition()
+	}
+	return SaveDispositionUnknown
+}
@@ -10,6 +10,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/gogf/gf/v2/container/gset"
 	"github.com/gogf/gf/v2/encoding/gjson"
@@ -115,6 +117,76 @@ func (m *Model) Chunk(size int, handler ChunkHandler) {
 	}
 }
 
+// ChunkByPk iterates the query result in batches of `size` ordered by the primary key, and calls `handler`
+// for each batch until there's no more records or `handler` returns error, which is returned.
+//
+// Different from Chunk, it seeks each batch with the last primary key of the previous batch instead of
+// OFFSET, which keeps steady performance for large tables. The order of the model is replaced by the
+// primary key, and the primary key should be selected if Fields is used. Composite primary key is not
+// supported. Example:
+//
+//	err := db.Model("user").Where("status", 1).ChunkByPk(1000, func(result gdb.Result) error {
+//		return process(result)
+//	})
+func (m *Model) ChunkByPk(size int, handler func(result Result) error) error {
+	if size <= 0 {
+		return gerror.NewCodef(gcode.CodeInvalidParameter, `invalid chunk size "%d"`, size)
+	}
+	primaryKeys := m.getPrimaryKeys()
+	if len(primaryKeys) == 0 {
+		return gerror.NewCodef(
+			gcode.CodeInvalidOperation,
+			`primary key not found for table "%s"`,
+			m.tablesInit,
+		)
+	}
+	if len(primaryKeys) > 1 {
+		return gerror.NewCodef(
+			gcode.CodeNotSupported,
+			`composite primary key "%s" of table "%s" is not supported by ChunkByPk`,
+			strings.Join(primaryKeys, ","), m.tablesInit,
+		)
+	}
+	var (
+		primaryKey = primaryKeys[0]
+		column     = m.QuoteWord(primaryKey)
+		lastValue  any
+	)
+	if autoPrefix := m.getAutoPrefix(); autoPrefix != "" {
+		column = autoPrefix + "." + column
+	}
+	for {
+		model := m.Clone()
+		model.orderBy = column
+		model.orderArgs = nil
+		if lastValue != nil {
+			model = model.groupWhere().Wheref(`%s>?`, column, lastValue)
+		}
+		result, err := model.Limit(0, size).All()
+		if err != nil {
+			return err
+		}
+		if len(result) == 0 {
+			return nil
+		}
+		value, ok := result[len(result)-1][primaryKey]
+		if !ok || value.IsNil() {
+			return gerror.NewCodef(
+				gcode.CodeInvalidParameter,
+				`primary key "%s" is not selected in the chunk result`,
+				primaryKey,
+			)
+		}
+		if err = handler(result); err != nil {
+			return err
+		}
+		if len(result) < size {
+			return nil
+		}
+		lastValue = value.Val()
+	}
+}
+
 // One retrieves one record from table and returns the result as map type.
 // It returns nil if there's no record retrieved with the given conditions from table.
 //
@@ -221,7 +293,7 @@ func (m *Model) doStruct(pointer any, where ...any) error {
 	if err != nil {
 		return err
 	}
-	if err = one.Struct(pointer); err != nil {
+	if err = one.Struct(pointer, model.mappingOption...); err != nil {
 		return err
 	}
 	return model.doWithScanStruct(pointer)
@@ -265,7 +337,7 @@ func (m *Model) doStructs(pointer any, where ...any) error {
 	if err != nil {
 		return err
 	}
-	if err = all.Structs(pointer); err != nil {
+	if err = all.Structs(pointer, model.mappingOption...); err != nil {
 		return err
 	}
 	return model.doWithScanStructs(pointer)
@@ -419,6 +491,47 @@ func (m *Model) ScanList(structSlicePointer any, bindToAttrName string, relation
 		BindToAttrName:     bindToAttrName,
 		RelationAttrName:   relationAttrName,
 		RelationFields:     relationFields,
+		MappingOption:      m.mappingOption,
+	})
+}
+
+// ScanListAuto converts `r` to struct slice which contains other complex struct attributes,
+// of which the relation binding is declared by the "orm" tag of the bound attribute.
+//
+// The optional parameter `bindToAttrName` specifies the attribute that the result is bound to.
+// Note that if it is not given, all fields of the table are selected and the bound attribute
+// is inferred from the fields of the result.
+//
+// See Result.ScanListAuto.
+func (m *Model) ScanListAuto(structSlicePointer any, bindToAttrName ...string) (err error) {
+	if len(bindToAttrName) > 0 && bindToAttrName[0] != "" {
+		binding, err := getScanListBinding(structSlicePointer, nil, bindToAttrName[0])
+		if err != nil {
+			return err
+		}
+		return m.ScanList(structSlicePointer, binding.BindToAttrName, binding.RelationArgs()...)
+	}
+	result, err := m.All()
+	if err != nil || result.IsEmpty() {
+		return err
+	}
+	binding, err := getScanListBinding(structSlicePointer, result)
+	if err != nil {
+		return err
+	}
+	out, err := checkGetSliceElementInfoForScanList(structSlicePointer, binding.BindToAttrName)
+	if err != nil {
+		return err
+	}
+	return doScanList(doScanListInput{
+		Model:              m,
+		Result:             result,
+		StructSlicePointer: structSlicePointer,
+		StructSliceValue:   out.SliceReflectValue,
+		BindToAttrName:     binding.BindToAttrName,
+		RelationAttrName:   binding.RelationAttrName,
+		RelationFields:     binding.RelationFields,
+		MappingOption:      m.mappingOption,
 	})
 }
 
@@ -559,6 +672,43 @@ func (m *Model) Exist(where ...any) (bool, error) {
 	return false, nil
 }
 
+// Exists does "SELECT EXISTS(SELECT 1 FROM ...)" statement for the model, which checks the
+// existence in database without counting or transferring records. It replaces the fields of the
+// model with "1" unless the model has GROUP BY or HAVING statement, which might reference the fields
+// and their aliases. The statement is rendered by the driver, see DB.FormatExists.
+//
+// The optional parameter `where` is the same as the parameter of Model.Where function,
+// see Model.Where.
+func (m *Model) Exists(where ...any) (bool, error) {
+	if len(where) > 0 {
+		return m.Where(where[0], where[1:]...).Exists()
+	}
+	var (
+		ctx   = m.GetCtx()
+		model = m.Clone()
+	)
+	if model.groupBy == "" && len(model.having) == 0 {
+		model.fields = []any{Raw("1")}
+		model.fieldsEx = nil
+		model.fieldAliases = nil
+	}
+	if model.limit <= 0 {
+		model.orderBy = ""
+		model.orderArgs = nil
+	}
+	sqlWithHolder, holderArgs := model.getFormattedSqlAndArgs(ctx, SelectTypeValue, false)
+	all, err := model.doGetAllBySql(ctx, SelectTypeValue, m.db.FormatExists(sqlWithHolder), holderArgs...)
+	if err != nil {
+		return false, err
+	}
+	if len(all) > 0 {
+		for _, v := range all[0] {
+			return v.Bool(), nil
+		}
+	}
+	return false, nil
+}
+
 // CountColumn does "SELECT COUNT(x) FROM ..." statement for the model.
 func (m *Model) CountColumn(column string) (int, error) {
 	if len(column) == 0 {
@@ -572,7 +722,7 @@ func (m *Model) Min(column string) (float64, error) {
 	if len(column) == 0 {
 		return 0, nil
 	}
-	value, err := m.Fields(fmt.Sprintf(`MIN(%s)`, m.QuoteWord(column))).Value()
+	value, err := m.doAggregate(AggregateMin, column)
 	if err != nil {
 		return 0, err
 	}
@@ -584,7 +734,7 @@ func (m *Model) Max(column string) (float64, error) {
 	if len(column) == 0 {
 		return 0, nil
 	}
-	value, err := m.Fields(fmt.Sprintf(`MAX(%s)`, m.QuoteWord(column))).Value()
+	value, err := m.doAggregate(AggregateMax, column)
 	if err != nil {
 		return 0, err
 	}
@@ -596,7 +746,7 @@ func (m *Model) Avg(column string) (float64, error) {
 	if len(column) == 0 {
 		return 0, nil
 	}
-	value, err := m.Fields(fmt.Sprintf(`AVG(%s)`, m.QuoteWord(column))).Value()
+	value, err := m.doAggregate(AggregateAvg, column)
 	if err != nil {
 		return 0, err
 	}
@@ -608,7 +758,7 @@ func (m *Model) Sum(column string) (float64, error) {
 	if len(column) == 0 {
 		return 0, nil
 	}
-	value, err := m.Fields(fmt.Sprintf(`SUM(%s)`, m.QuoteWord(column))).Value()
+	value, err := m.doAggregate(AggregateSum, column)
 	if err != nil {
 		return 0, err
 	}
@@ -625,6 +775,17 @@ func (m *Model) UnionAll(unions ...*Model) *Model {
 	return m.db.UnionAll(unions...)
 }
 
+// Intersect does "(SELECT xxx FROM xxx) INTERSECT (SELECT xxx FROM xxx) ..." statement for the model.
+func (m *Model) Intersect(intersects ...*Model) *Model {
+	return m.db.Intersect(intersects...)
+}
+
+// Except does "(SELECT xxx FROM xxx) EXCEPT (SELECT xxx FROM xxx) ..." statement for the model.
+// Note that the rows of the first model are returned, which are not in the results of the other models.
+func (m *Model) Except(excepts ...*Model) *Model {
+	return m.db.Except(excepts...)
+}
+
 // Limit sets the "LIMIT" statement for the model.
 // The parameter `limit` can be either one or two number, if passed two number is passed,
 // it then sets "LIMIT limit[0],limit[1]" statement for the model, or else it sets "LIMIT limit[0]"
@@ -690,6 +851,16 @@ func (m *Model) Page(page, limit int) *Model {
 // Having sets the having statement for the model.
 // The parameters of this function usage are as the same as function Where.
 // See Where.
+//
+// The parameter `having` can also be a WhereBuilder for complex conditions, and the keys of map condition
+// can reference the aliases of FieldCount/FieldSum/FieldMin/FieldMax/FieldAvg, which are not prefixed with
+// table. For aggregate queries, the select statement returns error if any key of the map condition is not
+// an aggregate alias, a grouped or selected column, or an expression, eg:
+//
+//	db.Model("user").Fields("city").FieldSum("score", "total").Group("city").Having(g.Map{"total >": 100}).All()
+//	db.Model("user").Fields("city").FieldCount("id", "cnt").Group("city").Having(
+//		db.Model("user").Builder().Where("cnt > ?", 1).WhereOr("SUM(score) > ?", 100),
+//	).All()
 func (m *Model) Having(having any, args ...any) *Model {
 	model := m.getModel()
 	model.having = []any{
@@ -709,42 +880,105 @@ func (m *Model) doGetAll(ctx context.Context, selectType SelectType, limit1 bool
 	if len(where) > 0 {
 		return m.Where(where[0], where[1:]...).All()
 	}
+	if result, split, err := m.doGetAllWithInSplit(ctx, selectType, limit1); split {
+		return result, err
+	}
 	sqlWithHolder, holderArgs := m.getFormattedSqlAndArgs(ctx, selectType, limit1)
 	return m.doGetAllBySql(ctx, selectType, sqlWithHolder, holderArgs...)
 }
 
+// checkSelect checks and returns the error of the model options for select statements.
+func (m *Model) checkSelect() error {
+	if m.lockErr != nil {
+		return m.lockErr
+	}
+	if m.fieldsErr != nil {
+		return m.fieldsErr
+	}
+	if m.whereBuilder.err != nil {
+		return m.whereBuilder.err
+	}
+	return m.checkHaving()
+}
+
 // doGetAllBySql does the select statement on the database.
 func (m *Model) doGetAllBySql(
 	ctx context.Context, selectType SelectType, sql string, args ...any,
 ) (result Result, err error) {
-	if result, err = m.getSelectResultFromCache(ctx, sql, args...); err != nil || result != nil {
+	defer func() {
+		if err == nil {
+			result = m.maskResult(result)
+		}
+	}()
+	if err = m.checkSelect(); err != nil {
+		return nil, err
+	}
+	// The cache key is built from the fully merged arguments, as the arguments of CTEs,
+	// sub query fields and derived tables affect the result as well.
+	mergedArgs := m.mergeSelectArguments(ctx, args)
+	if result, err = m.getSelectResultFromCache(ctx, sql, mergedArgs...); err != nil || result != nil {
 		return
 	}
 
-	in := &HookSelectInput{
-		internalParamHookSelect: internalParamHookSelect{
-			internalParamHook: internalParamHook{
-				link: m.getLink(false),
-			},
-			handler: m.hookHandler.Select,
-		},
-		Model:      m,
-		Table:      m.tables,
-		Schema:     m.schema,
-		Sql:        sql,
-		Args:       m.mergeArguments(args),
-		SelectType: selectType,
-	}
-	if result, err = in.Next(ctx); err != nil {
+	ctx = m.injectResultLimit(ctx)
+	// The statement timeout applies to the query only, not to the caching and analyzing after it.
+	var (
+		queryCtx = ctx
+		querySql = sql
+	)
+	if m.stmtTimeout > 0 {
+		// The server-side timeout is also limited by the remaining budget of the call chain.
+		stmtTimeout := budgetStatementTimeout(ctx, m.stmtTimeout)
+		var cancel context.CancelFunc
+		queryCtx, cancel = context.WithTimeout(ctx, stmtTimeout)
+		defer cancel()
+		querySql = m.db.FormatStatementTimeout(sql, stmtTimeout)
+	}
+	startTime := time.Now()
+	err = m.doWithFailover(queryCtx, func(model *Model) error {
+		return model.doWithRetry(queryCtx, retryOperationSelect, func() (err error) {
+			in := &HookSelectInput{
+				internalParamHookSelect: internalParamHookSelect{
+					internalParamHook: internalParamHook{
+						link: model.getLink(false),
+						mode: model.hookHandler.Mode,
+					},
+					handler: model.hookHandler.Select,
+				},
+				Model:      model,
+				Table:      model.tables,
+				Schema:     model.schema,
+				Sql:        querySql,
+				Args:       model.mergeSelectArguments(ctx, args),
+				SelectType: selectType,
+			}
+			result, err = in.Next(queryCtx)
+			return
+		})
+	})
+	if err != nil {
 		return
 	}
+	m.adviseIndex(ctx, sql, mergedArgs, time.Since(startTime))
 
-	err = m.saveSelectResultToCache(ctx, selectType, result, sql, args...)
+	err = m.saveSelectResultToCache(ctx, selectType, result, time.Since(startTime), sql, mergedArgs...)
 	return
 }
 
 func (m *Model) getFormattedSqlAndArgs(
 	ctx context.Context, selectType SelectType, limit1 bool,
+) (sqlWithHolder string, holderArgs []any) {
+	sqlWithHolder, holderArgs = m.doGetFormattedSqlAndArgs(ctx, selectType, limit1)
+	// The arguments of CTEs are merged in mergeSelectArguments,
+	// as they should be in front of the extra arguments.
+	if cteClause, _ := m.getCTEClauseAndArgs(ctx); cteClause != "" {
+		sqlWithHolder = cteClause + sqlWithHolder
+	}
+	return
+}
+
+func (m *Model) doGetFormattedSqlAndArgs(
+	ctx context.Context, selectType SelectType, limit1 bool,
 ) (sqlWithHolder string, holderArgs []any) {
 	switch selectType {
 	case SelectTypeCount:
@@ -795,7 +1029,7 @@ func (m *Model) getHolderAndArgsAsSubModel(ctx context.Context) (holder string,
 	holder, args = m.getFormattedSqlAndArgs(
 		ctx, SelectTypeDefault, false,
 	)
-	args = m.mergeArguments(args)
+	args = m.mergeSelectArguments(ctx, args)
 	return
 }
 
@@ -814,6 +1048,14 @@ func (m *Model) getFieldsAsStr() string {
 		fieldsStr string
 	)
 	for _, v := range m.fields {
+		if subQueryField, ok := v.(*SubQueryField); ok {
+			field, _ := subQueryField.format(m.GetCtx())
+			if fieldsStr != "" {
+				fieldsStr += ","
+			}
+			fieldsStr += field
+			continue
+		}
 		field := gconv.String(v)
 		switch {
 		case gstr.ContainsAny(field, "()"):
@@ -922,12 +1164,19 @@ func (m *Model) formatCondition(
 			Args:   gconv.Interfaces(m.having[1]),
 			Prefix: autoPrefix,
 		}
+		switch v := havingHolder.Where.(type) {
+		case WhereBuilder:
+			havingHolder.Where, havingHolder.Args = v.Build()
+		case *WhereBuilder:
+			havingHolder.Where, havingHolder.Args = v.Build()
+		}
 		havingStr, havingArgs := formatWhereHolder(ctx, m.db, formatWhereHolderInput{
 			WhereHolder: havingHolder,
 			OmitNil:     m.option&optionOmitNilWhere > 0,
 			OmitEmpty:   m.option&optionOmitEmptyWhere > 0,
 			Schema:      m.schema,
 			Table:       m.tables,
+			Aliases:     m.fieldAliases,
 		})
 		if len(havingStr) > 0 {
 			conditionExtra += " HAVING " + havingStr
@@ -938,6 +1187,7 @@ func (m *Model) formatCondition(
 	if !isCountStatement { // The count statement of sqlserver cannot contain the order by statement
 		if m.orderBy != "" {
 			conditionExtra += " ORDER BY " + m.orderBy
+			conditionArgs = append(conditionArgs, m.orderArgs...)
 		}
 	}
 	// LIMIT.
@@ -43,6 +43,19 @@ type softTimeMaintainer struct {
 	*Model
 }
 
+// SoftDeleteType defines the value semantics of the soft deleting field, see Model.SoftDeleteField.
+type SoftDeleteType int
+
+const (
+	// SoftDeleteTime marks the records deleted with the deleting time, which is NULL or 0 if not deleted.
+	// The value type follows SoftTimeOption, which is auto-detected by the field type in default.
+	SoftDeleteTime SoftDeleteType = iota
+
+	// SoftDeleteFlag marks the records deleted with flag 1 of integer field, which is 0 if not deleted.
+	// For boolean field, it marks the records deleted with true, which is false or NULL if not deleted.
+	SoftDeleteFlag
+)
+
 // SoftTimeFieldType represents different soft time field purposes.
 type SoftTimeFieldType int
 
@@ -88,6 +101,25 @@ func (m *Model) SoftTime(option SoftTimeOption) *Model {
 	return model
 }
 
+// SoftDeleteField sets the soft deleting field of the primary table of the model, instead of the DeletedAt
+// of configuration or the default "deleted_at". It is usually used for the legacy tables flagging the deleted
+// records like "is_deleted", which works with Delete/Unscoped like the default soft deleting feature.
+//
+// The optional parameter `deleteType` specifies the value semantics of the field, which is SoftDeleteTime
+// in default. Note that it enables soft deleting even if TimeMaintainDisabled is configured. Example:
+//
+//	db.Model("user").SoftDeleteField("is_deleted", gdb.SoftDeleteFlag).Where("id", 1).Delete()
+//	// UPDATE `user` SET `is_deleted`=1 WHERE (`id`=1) AND `is_deleted`=0
+func (m *Model) SoftDeleteField(field string, deleteType ...SoftDeleteType) *Model {
+	model := m.getModel()
+	model.softDeleteField = field
+	model.softDeleteType = SoftDeleteTime
+	if len(deleteType) > 0 {
+		model.softDeleteType = deleteType[0]
+	}
+	return model
+}
+
 // Unscoped disables the soft time feature for insert, update and delete operations.
 func (m *Model) Unscoped() *Model {
 	model := m.getModel()
@@ -106,6 +138,13 @@ func (m *Model) softTimeMaintainer() iSoftTimeMaintainer {
 func (m *softTimeMaintainer) GetFieldInfo(
 	ctx context.Context, schema, table string, fieldPurpose SoftTimeFieldType,
 ) (fieldName string, localType LocalType) {
+	// The soft deleting field of the primary table specified by the model.
+	if fieldPurpose == SoftTimeFieldDelete && m.softDeleteField != "" && m.isPrimaryTable(table) {
+		if table == "" {
+			table = m.tablesInit
+		}
+		return m.getSoftFieldNameAndType(ctx, schema, table, []string{m.softDeleteField})
+	}
 	// Check if feature is disabled
 	if m.db.GetConfig().TimeMaintainDisabled {
 		return "", LocalTypeUndefined
@@ -143,6 +182,24 @@ func (m *softTimeMaintainer) GetFieldInfo(
 	return m.getSoftFieldNameAndType(ctx, schema, tableName, defaultFields)
 }
 
+// isPrimaryTable checks and returns whether `table` is the primary table of the model.
+func (m *softTimeMaintainer) isPrimaryTable(table string) bool {
+	if table == "" || table == m.tablesInit {
+		return true
+	}
+	var (
+		core         = m.db.GetCore()
+		charL, charR = m.db.GetChars()
+	)
+	return gstr.Trim(table, charL+charR) == core.guessPrimaryTableName(m.tablesInit)
+}
+
+// isSoftDeleteFlag checks and returns whether the soft deleting field `fieldName` is of SoftDeleteFlag.
+func (m *softTimeMaintainer) isSoftDeleteFlag(fieldName string) bool {
+	return m.softDeleteType == SoftDeleteFlag && m.softDeleteField != "" &&
+		strings.EqualFold(utils.RemoveSymbols(fieldName), utils.RemoveSymbols(m.softDeleteField))
+}
+
 // getSoftFieldNameAndType retrieves and returns the field name of the table for possible key.
 func (m *softTimeMaintainer) getSoftFieldNameAndType(
 	ctx context.Context, schema, table string, candidateFields []string,
@@ -211,17 +268,17 @@ func (m *softTimeMaintainer) GetDeleteCondition(ctx context.Context) string {
 	if gstr.Contains(m.tables, " JOIN ") {
 		// Base table.
 		tableMatch, _ := gregex.MatchString(`(.+?) [A-Z]+ JOIN`, m.tables)
-		conditionArray.Append(m.getConditionOfTableStringForSoftDeleting(ctx, tableMatch[1]))
+		conditionArray.Append(m.getConditionOfTableStringForSoftDeleting(ctx, tableMatch[1], true))
 		// Multiple joined tables, exclude the sub query sql which contains char '(' and ')'.
 		tableMatches, _ := gregex.MatchAllString(`JOIN ([^()]+?) ON`, m.tables)
 		for _, match := range tableMatches {
-			conditionArray.Append(m.getConditionOfTableStringForSoftDeleting(ctx, match[1]))
+			conditionArray.Append(m.getConditionOfTableStringForSoftDeleting(ctx, match[1], false))
 		}
 	}
 	if conditionArray.Len() == 0 && gstr.Contains(m.tables, ",") {
 		// Multiple base tables.
-		for _, s := range gstr.SplitAndTrim(m.tables, ",") {
-			conditionArray.Append(m.getConditionOfTableStringForSoftDeleting(ctx, s))
+		for i, s := range gstr.SplitAndTrim(m.tables, ",") {
+			conditionArray.Append(m.getConditionOfTableStringForSoftDeleting(ctx, s, i == 0))
 		}
 	}
 	conditionArray.FilterEmpty()
@@ -231,7 +288,7 @@ func (m *softTimeMaintainer) GetDeleteCondition(ctx context.Context) string {
 	// Only one table.
 	fieldName, fieldType := m.GetFieldInfo(ctx, "", m.tablesInit, SoftTimeFieldDelete)
 	if fieldName != "" {
-		return m.buildDeleteCondition(ctx, "", fieldName, fieldType)
+		return m.buildTrashedCondition(ctx, true, "", fieldName, fieldType)
 	}
 	return ""
 }
@@ -242,7 +299,11 @@ func (m *softTimeMaintainer) GetDeleteCondition(ctx context.Context) string {
 // - `test`.`demo` b
 // - `demo`
 // - demo
-func (m *softTimeMaintainer) getConditionOfTableStringForSoftDeleting(ctx context.Context, s string) string {
+//
+// The parameter `isPrimary` specifies whether `s` is the primary table of the model.
+func (m *softTimeMaintainer) getConditionOfTableStringForSoftDeleting(
+	ctx context.Context, s string, isPrimary bool,
+) string {
 	var (
 		table  string
 		schema string
@@ -259,13 +320,29 @@ func (m *softTimeMaintainer) getConditionOfTableStringForSoftDeleting(ctx contex
 	if fieldName == "" {
 		return ""
 	}
+	var prefix = table
 	if len(array1) >= 3 {
-		return m.buildDeleteCondition(ctx, array1[2], fieldName, fieldType)
+		prefix = array1[2]
+	} else if len(array1) >= 2 {
+		prefix = array1[1]
 	}
-	if len(array1) >= 2 {
-		return m.buildDeleteCondition(ctx, array1[1], fieldName, fieldType)
+	return m.buildTrashedCondition(ctx, isPrimary, prefix, fieldName, fieldType)
+}
+
+// buildTrashedCondition builds the soft deleting condition with the trashed scope of the model,
+// which only applies to the primary table.
+func (m *softTimeMaintainer) buildTrashedCondition(
+	ctx context.Context, isPrimary bool, prefix, fieldName string, fieldType LocalType,
+) string {
+	if m.trashed == trashedScopeExcluded || !isPrimary {
+		return m.buildDeleteCondition(ctx, prefix, fieldName, fieldType)
 	}
-	return m.buildDeleteCondition(ctx, table, fieldName, fieldType)
+	if m.trashed == trashedScopeOnly {
+		if condition := m.buildDeleteCondition(ctx, prefix, fieldName, fieldType); condition != "" {
+			return fmt.Sprintf(`NOT(%s)`, condition)
+		}
+	}
+	return ""
 }
 
 // GetDeleteData returns UPDATE statement data for soft delete.
@@ -280,7 +357,16 @@ func (m *softTimeMaintainer) GetDeleteData(
 	}
 
 	holder = fmt.Sprintf(`%s=?`, quotedName)
-	value = m.GetFieldValue(ctx, fieldType, false)
+	if m.isSoftDeleteFlag(fieldName) {
+		// Boolean field does not accept integer in some databases, like pgsql.
+		if fieldType == LocalTypeBool {
+			value = true
+		} else {
+			value = 1
+		}
+	} else {
+		value = m.GetFieldValue(ctx, fieldType, false)
+	}
 	return
 }
 
@@ -294,6 +380,14 @@ func (m *softTimeMaintainer) buildDeleteCondition(
 	if prefix != "" {
 		quotedName = fmt.Sprintf(`%s.%s`, core.QuoteWord(prefix), quotedName)
 	}
+	if m.isSoftDeleteFlag(fieldName) {
+		// The NULL boolean flag is also treated as not deleted. The string literal '0' is used as it is accepted
+		// by the boolean type of pgsql and the bit or integer types of mssql, oracle and dm, unlike IS NOT TRUE.
+		if fieldType == LocalTypeBool {
+			return fmt.Sprintf(`(%s='0' OR %s IS NULL)`, quotedName, quotedName)
+		}
+		return fmt.Sprintf(`%s=0`, quotedName)
+	}
 	switch m.softTimeOption.SoftTimeType {
 	case SoftTimeTypeAuto:
 		switch fieldType {
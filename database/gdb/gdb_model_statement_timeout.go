@@ -0,0 +1,26 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"time"
+)
+
+// StatementTimeout sets the execution timeout of the select statements of the model, so that the
+// runaway queries are killed server-side instead of being abandoned client-side only.
+//
+// It injects the optimizer hint MAX_EXECUTION_TIME for MySQL, and the statement is canceled with
+// the context deadline for other databases, like the cancel request of pgsql.
+// The timeout of all statements of the session can be configured by ConfigNode.StatementTimeout.
+// Example:
+//
+//	db.Model("order").StatementTimeout(3 * time.Second).Where("status", 1).All()
+func (m *Model) StatementTimeout(timeout time.Duration) *Model {
+	model := m.getModel()
+	model.stmtTimeout = timeout
+	return model
+}
@@ -0,0 +1,96 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubQueryField is the sub query Model used as a select field, which is created by SubQuery.
+type SubQueryField struct {
+	Model *Model // Model is the sub query.
+	Alias string // Alias is the optional alias of the field.
+}
+
+// SubQuery creates and returns a select field of the sub query `model` with optional `alias`,
+// whose arguments are merged in front of the arguments of the table and conditions, eg:
+//
+//	db.Model("user u").Fields("u.id", gdb.SubQuery(db.Model("order o").Fields("COUNT(1)").Where("o.uid=u.id"), "orders"))
+//	// SELECT u.id,(SELECT COUNT(1) FROM `order` o WHERE o.uid=u.id) AS `orders` FROM `user` u
+func SubQuery(model *Model, alias ...string) *SubQueryField {
+	field := &SubQueryField{
+		Model: model,
+	}
+	if len(alias) > 0 {
+		field.Alias = alias[0]
+	}
+	return field
+}
+
+// From sets the table of the model to `tableOrSubQuery` with optional `alias`, in which `tableOrSubQuery`
+// can be a table name or a sub query Model as derived table. The arguments of the sub query are merged in
+// front of the arguments of the conditions. Note that it replaces the tables and joins of the model,
+// so it should be called before any joins, eg:
+//
+//	db.Model().From(db.Model("order").Fields("uid, SUM(amount) total").Group("uid"), "t").Where("t.total>?", 100).All()
+//	// SELECT * FROM (SELECT uid, SUM(amount) total FROM `order` GROUP BY uid) AS `t` WHERE t.total>100
+func (m *Model) From(tableOrSubQuery any, alias ...string) *Model {
+	var from *Model
+	if subModel, ok := tableOrSubQuery.(*Model); ok {
+		holder := "?"
+		if len(alias) > 0 && alias[0] != "" {
+			holder = fmt.Sprintf(`? AS %s`, m.QuoteWord(alias[0]))
+		}
+		from = m.db.Model(holder, subModel)
+	} else {
+		tables := []any{tableOrSubQuery}
+		if len(alias) > 0 && alias[0] != "" {
+			tables = append(tables, alias[0])
+		}
+		from = m.db.Model(tables...)
+	}
+	model := m.getModel()
+	model.tablesInit = from.tablesInit
+	model.tables = from.tables
+	model.extraArgs = from.extraArgs
+	return model
+}
+
+// format formats and returns the select field string of the sub query and its arguments.
+func (f *SubQueryField) format(ctx context.Context) (field string, args []any) {
+	holder, args := f.Model.getHolderAndArgsAsSubModel(ctx)
+	field = "(" + holder + ")"
+	if f.Alias != "" {
+		field += " AS " + f.Model.QuoteWord(f.Alias)
+	}
+	return field, args
+}
+
+// getFieldsArgs returns the arguments of the sub query fields and function expression fields of the model.
+func (m *Model) getFieldsArgs(ctx context.Context) (args []any) {
+	for _, v := range m.fields {
+		switch field := v.(type) {
+		case *SubQueryField:
+			_, fieldArgs := field.format(ctx)
+			args = append(args, fieldArgs...)
+		case funcExprField:
+			args = append(args, field.Args...)
+		}
+	}
+	return
+}
+
+// isSubQueryField checks and returns whether any of `fields` is a sub query field.
+func isSubQueryField(fields []any) bool {
+	for _, v := range fields {
+		if _, ok := v.(*SubQueryField); ok {
+			return true
+		}
+	}
+	return false
+}
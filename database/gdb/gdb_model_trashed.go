@@ -0,0 +1,73 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"database/sql"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// trashedScope is the scope of the soft deleted records of the primary table for select operations.
+type trashedScope int
+
+const (
+	trashedScopeExcluded trashedScope = iota // Excludes the soft deleted records, which is the default.
+	trashedScopeWith                         // Includes the soft deleted records.
+	trashedScopeOnly                         // Only the soft deleted records.
+)
+
+// WithTrashed includes the soft deleted records of the primary table for select operations.
+//
+// Different from Unscoped, it does not disable the soft time feature for insert, update and delete
+// operations, and the soft deleting conditions of the joined tables are still applied.
+func (m *Model) WithTrashed() *Model {
+	model := m.getModel()
+	model.trashed = trashedScopeWith
+	return model
+}
+
+// OnlyTrashed retrieves only the soft deleted records of the primary table for select operations.
+// The soft deleting conditions of the joined tables are still applied.
+func (m *Model) OnlyTrashed() *Model {
+	model := m.getModel()
+	model.trashed = trashedScopeOnly
+	return model
+}
+
+// Restore restores the soft deleted records of the primary table, which resets the soft deleting field
+// to NULL or 0 and updates the updating time field if any.
+// It returns error if the table has no soft deleting field.
+//
+// The optional parameter `where` is the same as the parameter of Model.Where function,
+// see Model.Where. Example:
+//
+//	db.Model("user").Restore("id", 1)
+//	// UPDATE `user` SET `deleted_at`=NULL,`updated_at`=... WHERE (`id`=1) AND NOT(`deleted_at` IS NULL)
+func (m *Model) Restore(where ...any) (result sql.Result, err error) {
+	if len(where) > 0 {
+		return m.Where(where[0], where[1:]...).Restore()
+	}
+	var (
+		ctx = m.GetCtx()
+		stm = &softTimeMaintainer{m}
+	)
+	fieldName, fieldType := stm.GetFieldInfo(ctx, "", m.tablesInit, SoftTimeFieldDelete)
+	if fieldName == "" {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidOperation,
+			`no soft deleting field found for table "%s"`,
+			m.tablesInit,
+		)
+	}
+	var value any = 0
+	if !stm.isSoftDeleteFlag(fieldName) {
+		value = stm.GetFieldValue(ctx, fieldType, true)
+	}
+	return m.OnlyTrashed().Data(Map{fieldName: value}).Update()
+}
@@ -41,6 +41,12 @@ func (m *Model) Update(dataAndWhere ...any) (result sql.Result, err error) {
 			m.checkAndRemoveSelectCache(ctx)
 		}
 	}()
+	if err = m.checkWritable(ctx, "Update"); err != nil {
+		return nil, err
+	}
+	if m.whereBuilder.err != nil {
+		return nil, m.whereBuilder.err
+	}
 	if m.data == nil {
 		return nil, gerror.NewCode(gcode.CodeMissingParameter, "updating table with empty data")
 	}
@@ -77,7 +83,7 @@ func (m *Model) Update(dataAndWhere ...any) (result sql.Result, err error) {
 		if fieldNameUpdate != "" && !gstr.Contains(updateStr, fieldNameUpdate) {
 			dataValue := stm.GetFieldValue(ctx, fieldTypeUpdate, false)
 			updateStr += fmt.Sprintf(`,%s=?`, fieldNameUpdate)
-			conditionArgs = append([]any{dataValue}, conditionArgs...)
+			conditionArgs = append([]any{m.db.GetCore().convertTimeForStorage(dataValue)}, conditionArgs...)
 		}
 		newData = updateStr
 	}
@@ -98,6 +104,7 @@ func (m *Model) Update(dataAndWhere ...any) (result sql.Result, err error) {
 		internalParamHookUpdate: internalParamHookUpdate{
 			internalParamHook: internalParamHook{
 				link: m.getLink(true),
+				mode: m.hookHandler.Mode,
 			},
 			handler: m.hookHandler.Update,
 		},
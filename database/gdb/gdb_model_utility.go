@@ -7,6 +7,7 @@
 package gdb
 
 import (
+	"sort"
 	"time"
 
 	"github.com/gogf/gf/v2/container/gset"
@@ -190,11 +191,25 @@ func (m *Model) doMappingAndFilterForInsertOrUpdateDataMap(data Map, allowOmitEm
 	if err != nil {
 		return nil, err
 	}
+	// Remove the generated columns, which cause errors if they are written.
+	data, err = core.removeGeneratedFieldsFromData(ctx, schema, table, data)
+	if err != nil {
+		return nil, err
+	}
+	// Sanitize the values before the "omit" options, as they might be empty after sanitizing.
+	if err = m.sanitizeData(table, data); err != nil {
+		return nil, err
+	}
+	// The masked columns are always written, which are not filtered by "omit" options.
+	maskColumns, err := m.getFieldMaskColumns(ctx, schema, table)
+	if err != nil {
+		return nil, err
+	}
 	// Remove key-value pairs of which the value is nil.
 	if allowOmitEmpty && m.option&optionOmitNilData > 0 {
 		tempMap := make(Map, len(data))
 		for k, v := range data {
-			if empty.IsNil(v) {
+			if _, ok := maskColumns[k]; !ok && empty.IsNil(v) {
 				continue
 			}
 			tempMap[k] = v
@@ -206,6 +221,10 @@ func (m *Model) doMappingAndFilterForInsertOrUpdateDataMap(data Map, allowOmitEm
 	if allowOmitEmpty && m.option&optionOmitEmptyData > 0 {
 		tempMap := make(Map, len(data))
 		for k, v := range data {
+			if _, ok := maskColumns[k]; ok {
+				tempMap[k] = v
+				continue
+			}
 			if empty.IsEmpty(v) {
 				continue
 			}
@@ -233,6 +252,14 @@ func (m *Model) doMappingAndFilterForInsertOrUpdateDataMap(data Map, allowOmitEm
 		data = tempMap
 	}
 
+	if maskColumns != nil {
+		// Keep masked fields.
+		for k := range data {
+			if _, ok := maskColumns[k]; !ok {
+				delete(data, k)
+			}
+		}
+	}
 	if len(m.fields) > 0 {
 		// Keep specified fields.
 		var (
@@ -268,7 +295,7 @@ func (m *Model) getLink(master bool) Link {
 	}
 	linkType := m.linkType
 	if linkType == 0 {
-		if master {
+		if master || IsMasterRead(m.GetCtx()) {
 			linkType = linkTypeMaster
 		} else {
 			linkType = linkTypeSlave
@@ -295,17 +322,58 @@ func (m *Model) getLink(master bool) Link {
 // It parses m.tables to retrieve the primary table name, supporting m.tables like:
 // "user", "user u", "user as u, user_detail as ud".
 func (m *Model) getPrimaryKey() string {
-	table := gstr.SplitAndTrim(m.tablesInit, " ")[0]
-	tableFields, err := m.TableFields(table)
+	if primaryKeys := m.getPrimaryKeys(); len(primaryKeys) > 0 {
+		return primaryKeys[0]
+	}
+	return ""
+}
+
+// getPrimaryKeys retrieves and returns the primary key names of the model table,
+// which are ordered by their field index for composite primary key.
+func (m *Model) getPrimaryKeys() []string {
+	tableFields, err := m.TableFields(m.getPrimaryTableName())
 	if err != nil {
+		return nil
+	}
+	return getKeyFieldNames(tableFields, "pri")
+}
+
+// getTableAliasOrName returns the quoted alias of the primary table of the model, or the quoted
+// table name with prefix if it has no alias, which is used for qualifying its columns.
+func (m *Model) getTableAliasOrName() string {
+	tables := gstr.SplitAndTrim(m.tablesInit, ",")
+	if len(tables) == 0 {
 		return ""
 	}
-	for name, field := range tableFields {
-		if gstr.ContainsI(field.Key, "pri") {
-			return name
+	array := gstr.SplitAndTrim(tables[0], " ")
+	if len(array) >= 2 {
+		return m.QuoteWord(array[len(array)-1])
+	}
+	return m.db.GetCore().QuotePrefixTableName(array[0])
+}
+
+// getPrimaryTableName returns the primary table name of the model without alias.
+func (m *Model) getPrimaryTableName() string {
+	return gstr.SplitAndTrim(m.tablesInit, " ")[0]
+}
+
+// getKeyFieldNames returns the names of fields of which the key information contains `key`
+// case-insensitively, which are ordered by their field index.
+func getKeyFieldNames(tableFields map[string]*TableField, key string) []string {
+	fields := make([]*TableField, 0)
+	for _, field := range tableFields {
+		if gstr.ContainsI(field.Key, key) {
+			fields = append(fields, field)
 		}
 	}
-	return ""
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Index < fields[j].Index
+	})
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, field.Name)
+	}
+	return names
 }
 
 // mergeArguments creates and returns new arguments by merging `m.extraArgs` and given `args`.
@@ -119,11 +119,25 @@ func (m *Model) WhereNotNull(columns ...string) *Model {
 }
 
 // WhereExists builds `EXISTS (subQuery)` statement.
-func (m *Model) WhereExists(subQuery *Model) *Model {
-	return m.callWhereBuilder(m.whereBuilder.WhereExists(subQuery))
+// See WhereBuilder.WhereExists.
+func (m *Model) WhereExists(subQuery *Model, correlations ...string) *Model {
+	return m.callWhereBuilder(m.whereBuilder.WhereExists(subQuery, correlations...))
 }
 
 // WhereNotExists builds `NOT EXISTS (subQuery)` statement.
-func (m *Model) WhereNotExists(subQuery *Model) *Model {
-	return m.callWhereBuilder(m.whereBuilder.WhereNotExists(subQuery))
+// See WhereBuilder.WhereNotExists.
+func (m *Model) WhereNotExists(subQuery *Model, correlations ...string) *Model {
+	return m.callWhereBuilder(m.whereBuilder.WhereNotExists(subQuery, correlations...))
+}
+
+// WhereLastDays builds `column >= ?` statement for the time `days` days before now.
+// See WhereBuilder.WhereLastDays.
+func (m *Model) WhereLastDays(column string, days int) *Model {
+	return m.callWhereBuilder(m.whereBuilder.WhereLastDays(column, days))
+}
+
+// WhereDateBetween builds `column >= start AND column < end` statement for dates including both ends.
+// See WhereBuilder.WhereDateBetween.
+func (m *Model) WhereDateBetween(column string, startDate, endDate any) *Model {
+	return m.callWhereBuilder(m.whereBuilder.WhereDateBetween(column, startDate, endDate))
 }
@@ -321,11 +321,21 @@ type parseWithTagInFieldStructOutput struct {
 }
 
 func (m *Model) parseWithTagInFieldStruct(field gstructs.Field) (output parseWithTagInFieldStructOutput) {
+	data := parseOrmTagToMap(field.Tag(OrmTagForStruct))
+	output.With = data[OrmTagForWith]
+	output.Where = data[OrmTagForWithWhere]
+	output.Order = data[OrmTagForWithOrder]
+	output.Unscoped = data[OrmTagForWithUnscoped]
+	return
+}
+
+// parseOrmTagToMap parses the orm tag of struct attribute like "with:uid=id, where:status=1"
+// to map of which key is the tag key and value is the tag value.
+func parseOrmTagToMap(ormTag string) map[string]string {
 	var (
-		ormTag = field.Tag(OrmTagForStruct)
-		data   = make(map[string]string)
-		array  []string
-		key    string
+		data  = make(map[string]string)
+		array []string
+		key   string
 	)
 	for _, v := range gstr.SplitAndTrim(ormTag, ",") {
 		array = gstr.Split(v, ":")
@@ -341,9 +351,5 @@ func (m *Model) parseWithTagInFieldStruct(field gstructs.Field) (output parseWit
 			}
 		}
 	}
-	output.With = data[OrmTagForWith]
-	output.Where = data[OrmTagForWithWhere]
-	output.Order = data[OrmTagForWithOrder]
-	output.Unscoped = data[OrmTagForWithUnscoped]
-	return
+	return data
 }
@@ -0,0 +1,207 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/json"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+const (
+	// DefaultOutboxTable is the default table of the outbox messages, see TX.Outbox.
+	DefaultOutboxTable = "gf_outbox"
+
+	defaultOutboxRelayBatchSize = 100
+	defaultOutboxRelayInterval  = time.Second
+)
+
+// OutboxMessage is the message in the outbox table, which should be created before use, eg for MySQL:
+//
+//	CREATE TABLE `gf_outbox` (
+//	    `id`         bigint       NOT NULL AUTO_INCREMENT,
+//	    `topic`      varchar(255) NOT NULL,
+//	    `payload`    longtext     NOT NULL,
+//	    `created_at` datetime(3)  NOT NULL,
+//	    PRIMARY KEY (`id`)
+//	);
+type OutboxMessage struct {
+	Id        int64       `orm:"id"         json:"id"`        // Id is the auto-increment id, which can be used by consumers for deduplication.
+	Topic     string      `orm:"topic"      json:"topic"`     // Topic of the message queue.
+	Payload   string      `orm:"payload"    json:"payload"`   // Payload of the message, which is JSON encoded if it's not string or []byte.
+	CreatedAt *gtime.Time `orm:"created_at" json:"createdAt"` // CreatedAt is the time the message is written.
+}
+
+// OutboxPublisher publishes the outbox messages to the message queue.
+type OutboxPublisher interface {
+	// Publish publishes the message, which is deleted from the outbox table only if it returns nil.
+	Publish(ctx context.Context, message *OutboxMessage) error
+}
+
+// OutboxPublisherFunc is the function implementing OutboxPublisher.
+type OutboxPublisherFunc func(ctx context.Context, message *OutboxMessage) error
+
+// Publish implements OutboxPublisher.
+func (f OutboxPublisherFunc) Publish(ctx context.Context, message *OutboxMessage) error {
+	return f(ctx, message)
+}
+
+// OutboxRelayOption is the option for OutboxRelay.
+type OutboxRelayOption struct {
+	// Table is the outbox table, which is the OutboxTable of configuration or DefaultOutboxTable in default.
+	Table string
+
+	// BatchSize is the maximum count of messages relayed in one round, which is 100 in default.
+	BatchSize int
+
+	// Interval is the interval between rounds when there's no more message, which is 1 second in default.
+	Interval time.Duration
+}
+
+// OutboxRelay is the worker relaying the messages of the outbox table to the message queue.
+//
+// The messages are published in order of their ids and deleted after they are published in the same
+// transaction. The delivery is at-least-once: a message may be published again if the transaction fails
+// after publishing, so the consumers should be idempotent using the message id for deduplication.
+// Multiple relays of the same table are safe, which skip the messages locked by each other if the database
+// supports "FOR UPDATE SKIP LOCKED", or else the messages are relayed one batch after another.
+type OutboxRelay struct {
+	db        DB
+	publisher OutboxPublisher
+	table     string
+	batchSize int
+	interval  time.Duration
+}
+
+// Outbox writes a message of `topic` with `payload` to the outbox table in the transaction, so that the
+// message is committed or rolled back along with the business data, and published later by OutboxRelay.
+// The outbox table is the OutboxTable of configuration or DefaultOutboxTable in default.
+//
+// The parameter `payload` is saved as it is if it's string or []byte, or else it's JSON encoded.
+func (tx *TXCore) Outbox(ctx context.Context, topic string, payload any) error {
+	if topic == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, `topic of outbox message cannot be empty`)
+	}
+	var content string
+	switch v := payload.(type) {
+	case string:
+		content = v
+	case []byte:
+		content = string(v)
+	default:
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return gerror.WrapCode(gcode.CodeInvalidParameter, err, `encoding outbox payload failed`)
+		}
+		content = string(b)
+	}
+	_, err := tx.Model(getOutboxTable(tx.db)).Ctx(ctx).Data(Map{
+		"topic":      topic,
+		"payload":    content,
+		"created_at": gtime.Now(),
+	}).Insert()
+	return err
+}
+
+// NewOutboxRelay creates and returns an OutboxRelay publishing the messages of `db` with `publisher`.
+func NewOutboxRelay(db DB, publisher OutboxPublisher, option ...OutboxRelayOption) *OutboxRelay {
+	relay := &OutboxRelay{
+		db:        db,
+		publisher: publisher,
+		table:     getOutboxTable(db),
+		batchSize: defaultOutboxRelayBatchSize,
+		interval:  defaultOutboxRelayInterval,
+	}
+	if len(option) > 0 {
+		if option[0].Table != "" {
+			relay.table = option[0].Table
+		}
+		if option[0].BatchSize > 0 {
+			relay.batchSize = option[0].BatchSize
+		}
+		if option[0].Interval > 0 {
+			relay.interval = option[0].Interval
+		}
+	}
+	return relay
+}
+
+// Run relays the messages continually until `ctx` is done, which is usually called in a goroutine.
+// The errors are logged, and the failed messages are retried in the next round.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	for {
+		count, err := r.RelayOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.db.GetLogger().Errorf(ctx, `relay outbox messages of table "%s" failed: %+v`, r.table, err)
+		}
+		// Continues immediately if the batch is full, as there may be more messages.
+		if err == nil && count >= r.batchSize {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.interval):
+		}
+	}
+}
+
+// RelayOnce publishes one batch of messages in order and deletes the published ones, and returns the
+// count of the published messages. It stops at the first message failed publishing to keep the order,
+// and returns the error.
+func (r *OutboxRelay) RelayOnce(ctx context.Context) (count int, err error) {
+	var publishErr error
+	err = r.db.Transaction(ctx, func(ctx context.Context, tx TX) error {
+		var (
+			messages []*OutboxMessage
+			model    = tx.Model(r.table).Ctx(ctx).OrderAsc("id").Limit(r.batchSize)
+		)
+		if _, lockErr := formatLockClause(
+			r.db.GetConfig().Type, LockForUpdate, LockOption{SkipLocked: true}, r.db.GetCore().QuoteWord,
+		); lockErr == nil {
+			model = model.LockUpdate(LockOption{SkipLocked: true})
+		}
+		if err := model.Scan(&messages); err != nil {
+			return err
+		}
+		publishedIds := make([]int64, 0, len(messages))
+		for _, message := range messages {
+			if publishErr = r.publisher.Publish(ctx, message); publishErr != nil {
+				publishErr = gerror.Wrapf(publishErr, `publish outbox message %d failed`, message.Id)
+				break
+			}
+			publishedIds = append(publishedIds, message.Id)
+		}
+		if len(publishedIds) > 0 {
+			if _, err := tx.Model(r.table).Ctx(ctx).WhereIn("id", publishedIds).Delete(); err != nil {
+				return err
+			}
+		}
+		count = len(publishedIds)
+		// The published messages are committed as deleted even if the later one fails.
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, publishErr
+}
+
+// getOutboxTable returns the outbox table of `db`.
+func getOutboxTable(db DB) string {
+	if table := db.GetConfig().OutboxTable; table != "" {
+		return table
+	}
+	return DefaultOutboxTable
+}
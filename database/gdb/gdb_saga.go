@@ -0,0 +1,308 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+// SagaStep is a step of Saga, which writes to one database in a local transaction.
+type SagaStep struct {
+	// Name is the name of the step, which is used in errors.
+	Name string
+
+	// DB is the database of the step, which can be of different groups from other steps.
+	DB DB
+
+	// Action does the writes of the step in the transaction `tx` of DB. The context `ctx` carries
+	// the transaction, so that the models created with it join the transaction automatically.
+	Action func(ctx context.Context, tx TX) error
+
+	// Compensate undoes the writes of the committed Action when any later step fails.
+	// It is optional for the steps having nothing to undo, like the last step.
+	Compensate func(ctx context.Context) error
+}
+
+// Saga coordinates the writes across multiple databases, each step of which is committed in its own
+// local transaction, and the committed steps are compensated in reverse order if any step fails.
+//
+// It provides eventual consistency only: the committed writes of earlier steps are visible before the
+// compensation, and the compensation should be idempotent as it may be retried by the caller.
+//
+// The steps can also be committed atomically by RunXA if all their databases support XA transactions.
+//
+// Note that it does not cover the durable saga log: the state of the steps is kept in memory, so the
+// compensations are lost if the process crashes during the run. The steps needing crash recovery
+// should write the messages to the outbox table with TX.Outbox in their actions, which are relayed
+// by OutboxRelay to drive the compensations or the following steps asynchronously.
+type Saga struct {
+	steps []SagaStep
+}
+
+// XAAction is the action of the XA transaction branch, which is performed by DB.DoXA.
+type XAAction string
+
+const (
+	XAActionStart            XAAction = "START"             // Starts the branch in the transaction.
+	XAActionPrepare          XAAction = "PREPARE"           // Ends and prepares the branch in the transaction.
+	XAActionCommit           XAAction = "COMMIT"            // Commits the prepared branch.
+	XAActionRollback         XAAction = "ROLLBACK"          // Rolls back the branch that is not prepared.
+	XAActionRollbackPrepared XAAction = "ROLLBACK PREPARED" // Rolls back the prepared branch.
+)
+
+// sagaXABranch is the XA transaction branch of a step in Saga.RunXA.
+type sagaXABranch struct {
+	step     SagaStep
+	tx       TX
+	xid      string
+	prepared bool
+}
+
+// NewSaga creates and returns a Saga with given steps.
+func NewSaga(steps ...SagaStep) *Saga {
+	return &Saga{
+		steps: append([]SagaStep{}, steps...),
+	}
+}
+
+// Step appends a step to the saga and returns the saga itself for chaining.
+func (s *Saga) Step(step SagaStep) *Saga {
+	s.steps = append(s.steps, step)
+	return s
+}
+
+// Run executes the steps in order, each of which is committed in a new transaction of its database,
+// even if there's already a transaction in the context `ctx`. If any step fails, it compensates the
+// committed steps in reverse order and returns the error of the failed step, which also contains the
+// errors of the failed compensations if any.
+func (s *Saga) Run(ctx context.Context) error {
+	if err := s.checkSteps(); err != nil {
+		return err
+	}
+	var txOptions = TxOptions{
+		Propagation: PropagationRequiresNew,
+	}
+	for i, step := range s.steps {
+		err := step.DB.TransactionWithOptions(ctx, txOptions, func(ctx context.Context, tx TX) error {
+			return step.Action(ctx, tx)
+		})
+		if err != nil {
+			return s.compensate(ctx, i, err)
+		}
+	}
+	return nil
+}
+
+// RunTwoPhase executes the actions of all steps in the transactions that are opened at the same time,
+// and commits the transactions only if all actions succeed, or else rolls back all of them.
+// So that there's nothing to compensate if any action fails, which is the common case.
+//
+// The transactions are committed in order, which cannot be atomic without XA. If any commit fails,
+// it rolls back the uncommitted transactions and compensates the committed steps in reverse order.
+// Note that it holds the locks of all databases until the commits, which should be short.
+func (s *Saga) RunTwoPhase(ctx context.Context) (err error) {
+	if err = s.checkSteps(); err != nil {
+		return err
+	}
+	var (
+		txs       = make([]TX, 0, len(s.steps))
+		committed = 0
+	)
+	defer func() {
+		for _, tx := range txs[committed:] {
+			_ = tx.Rollback()
+		}
+	}()
+	for _, step := range s.steps {
+		tx, err := step.DB.Begin(ctx)
+		if err != nil {
+			return s.wrapStepError(step, err)
+		}
+		txs = append(txs, tx)
+		if err = s.callAction(WithTX(ctx, tx), tx, step); err != nil {
+			return s.wrapStepError(step, err)
+		}
+	}
+	for i, tx := range txs {
+		if err = tx.Commit(); err != nil {
+			// The transaction is closed by the failed commit.
+			committed = i + 1
+			return s.compensate(ctx, i, err)
+		}
+		committed = i + 1
+	}
+	return nil
+}
+
+// RunXA executes the actions of all steps in the XA transaction branches of their databases, which are
+// committed atomically by two-phase commit: the branches are prepared after all actions succeed, and
+// committed after all of them are prepared. If any action or preparing fails, all branches are rolled
+// back, so that there's nothing to compensate and the compensations of the steps are never called.
+//
+// It returns an error of code gcode.CodeNotSupported if any database does not support XA transactions,
+// which are supported by MySQL and PostgreSQL(with max_prepared_transactions > 0).
+//
+// Note that the branches prepared but not committed are kept in the databases if the process crashes
+// or the connection is lost in the commit phase, as there's no durable coordinator log. They should be
+// resolved manually, which can be listed by "XA RECOVER" of MySQL or "pg_prepared_xacts" of PostgreSQL
+// with the global transaction id contained in the returned error.
+func (s *Saga) RunXA(ctx context.Context) (err error) {
+	if err = s.checkSteps(); err != nil {
+		return err
+	}
+	var (
+		gtrid    = guid.S()
+		branches = make([]*sagaXABranch, 0, len(s.steps))
+		// The transactions are not bound with the cancellation of `ctx`, as database/sql rolls back the
+		// transaction directly when the context is done, which cannot end the XA transaction branch.
+		txCtx = context.WithoutCancel(ctx)
+	)
+	defer func() {
+		if err != nil {
+			err = s.rollbackXA(txCtx, gtrid, branches, err)
+		}
+	}()
+	for i, step := range s.steps {
+		branch := &sagaXABranch{
+			step: step,
+			xid:  fmt.Sprintf(`%s-%d`, gtrid, i),
+		}
+		if branch.tx, err = step.DB.Begin(txCtx); err != nil {
+			return s.wrapStepError(step, err)
+		}
+		branches = append(branches, branch)
+		if err = step.DB.DoXA(txCtx, branch.tx, XAActionStart, branch.xid); err != nil {
+			return s.wrapStepError(step, err)
+		}
+		if err = s.callAction(WithTX(ctx, branch.tx), branch.tx, step); err != nil {
+			return s.wrapStepError(step, err)
+		}
+	}
+	for _, branch := range branches {
+		if err = branch.step.DB.DoXA(txCtx, branch.tx, XAActionPrepare, branch.xid); err != nil {
+			return s.wrapStepError(branch.step, err)
+		}
+		branch.prepared = true
+	}
+	// All branches are prepared, which should be committed even if some of them fail.
+	var commitErrors []string
+	for _, branch := range branches {
+		if e := branch.step.DB.DoXA(txCtx, branch.tx, XAActionCommit, branch.xid); e != nil {
+			commitErrors = append(commitErrors, fmt.Sprintf(`step "%s": %s`, branch.step.Name, e.Error()))
+		}
+		// It releases the connection of the transaction, of which the branch is already ended.
+		_ = branch.tx.Rollback()
+	}
+	if len(commitErrors) > 0 {
+		return gerror.NewCodef(
+			gcode.CodeOperationFailed,
+			`saga XA commit failed for global transaction "%s": %s`,
+			gtrid, strings.Join(commitErrors, "; "),
+		)
+	}
+	return nil
+}
+
+// rollbackXA rolls back all the XA transaction `branches` of global transaction `gtrid` for the error `err`,
+// and returns the error containing the errors of the failed rollbacks of the prepared branches if any.
+func (s *Saga) rollbackXA(ctx context.Context, gtrid string, branches []*sagaXABranch, err error) error {
+	var rollbackErrors []string
+	for i := len(branches) - 1; i >= 0; i-- {
+		branch := branches[i]
+		if branch.prepared {
+			if e := branch.step.DB.DoXA(ctx, branch.tx, XAActionRollbackPrepared, branch.xid); e != nil {
+				rollbackErrors = append(rollbackErrors, fmt.Sprintf(`step "%s": %s`, branch.step.Name, e.Error()))
+			}
+		} else {
+			// The error is ignored, as the branch might be not started.
+			_ = branch.step.DB.DoXA(ctx, branch.tx, XAActionRollback, branch.xid)
+		}
+		_ = branch.tx.Rollback()
+	}
+	if len(rollbackErrors) > 0 {
+		return gerror.WrapCodef(
+			gcode.CodeOperationFailed, err,
+			`saga XA rollback failed for global transaction "%s": %s`,
+			gtrid, strings.Join(rollbackErrors, "; "),
+		)
+	}
+	return err
+}
+
+// DoXA performs the `action` of the XA transaction branch `xid` in the transaction `tx`.
+// The XA statements are database-specific, so the default implementation returns an error of code
+// gcode.CodeNotSupported, and the drivers supporting it should override it.
+func (c *Core) DoXA(ctx context.Context, tx TX, action XAAction, xid string) error {
+	return gerror.NewCodef(
+		gcode.CodeNotSupported,
+		`XA transaction is not supported by database type "%s"`,
+		c.db.GetConfig().Type,
+	)
+}
+
+// callAction calls the action of `step`, which returns the panic of the action as error.
+func (s *Saga) callAction(ctx context.Context, tx TX, step SagaStep) (err error) {
+	defer func() {
+		if exception := recover(); exception != nil {
+			if v, ok := exception.(error); ok && gerror.HasStack(v) {
+				err = v
+			} else {
+				err = gerror.NewCodef(gcode.CodeInternalPanic, "%+v", exception)
+			}
+		}
+	}()
+	return step.Action(ctx, tx)
+}
+
+// compensate compensates the steps before index `failed` in reverse order for the error `err`
+// of the failed step.
+func (s *Saga) compensate(ctx context.Context, failed int, err error) error {
+	var compensationErrors []string
+	for i := failed - 1; i >= 0; i-- {
+		step := s.steps[i]
+		if step.Compensate == nil {
+			continue
+		}
+		if e := step.Compensate(ctx); e != nil {
+			compensationErrors = append(compensationErrors, fmt.Sprintf(`step "%s": %s`, step.Name, e.Error()))
+		}
+	}
+	err = s.wrapStepError(s.steps[failed], err)
+	if len(compensationErrors) > 0 {
+		return gerror.WrapCodef(
+			gcode.CodeOperationFailed, err,
+			`saga compensation failed: %s`,
+			strings.Join(compensationErrors, "; "),
+		)
+	}
+	return err
+}
+
+// wrapStepError wraps the error `err` of `step` with the step name.
+func (s *Saga) wrapStepError(step SagaStep, err error) error {
+	return gerror.WrapCodef(gerror.Code(err), err, `saga step "%s" failed`, step.Name)
+}
+
+// checkSteps checks the steps of the saga before running.
+func (s *Saga) checkSteps() error {
+	for i, step := range s.steps {
+		if step.DB == nil || step.Action == nil {
+			return gerror.NewCodef(
+				gcode.CodeMissingParameter,
+				`DB and Action are required for saga step "%s" at index %d`,
+				step.Name, i,
+			)
+		}
+	}
+	return nil
+}
@@ -114,5 +114,6 @@ func (s *Stmt) QueryRow(args ...any) *sql.Row {
 
 // Close closes the statement.
 func (s *Stmt) Close() error {
+	s.core.untrackStmt(s.core.db.GetCtx(), s.Stmt)
 	return s.Stmt.Close()
 }
@@ -0,0 +1,163 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/gogf/gf/v2/internal/empty"
+)
+
+// FuncExpr is the SQL function expression on columns, like LOWER(`email`) or LENGTH(`name`),
+// of which the column names are quoted with the quote chars of the database dialect when
+// the statement is built. It can be used as the condition of Where/WhereOr, the column of
+// Order and the field of Fields, avoiding raw strings for common function-wrapped predicates.
+//
+// The arguments of the function are treated as:
+// 1. string: column name, which is quoted, eg: "name" -> `name`, "u.name" -> `u`.`name`;
+// 2. Raw: raw sql part which is written as it is;
+// 3. FuncExpr: nested function expression;
+// 4. nil: NULL;
+// 5. others: value which is passed as bound argument of placeholder '?'.
+type FuncExpr struct {
+	Name string // Function name, eg: LOWER.
+	Args []any  // Function arguments.
+}
+
+// Func creates and returns a function expression of function `name` with arguments `args`.
+//
+// Example:
+//
+//	Order(gdb.Func("LENGTH", "name"))               -> ORDER BY LENGTH(`name`)
+//	Where(gdb.Func("SUBSTR", "name", 1, 4), "john") -> WHERE SUBSTR(`name`,1,4)='john'
+func Func(name string, args ...any) FuncExpr {
+	return FuncExpr{
+		Name: name,
+		Args: args,
+	}
+}
+
+// Lower creates and returns the expression of LOWER function on `column`.
+//
+// Example:
+//
+//	Where(gdb.Lower("email"), "john@goframe.org") -> WHERE LOWER(`email`)='john@goframe.org'
+func Lower(column string) FuncExpr {
+	return Func("LOWER", column)
+}
+
+// Upper creates and returns the expression of UPPER function on `column`.
+func Upper(column string) FuncExpr {
+	return Func("UPPER", column)
+}
+
+// Length creates and returns the expression of LENGTH function on `column`.
+func Length(column string) FuncExpr {
+	return Func("LENGTH", column)
+}
+
+// Build builds and returns the sql of the function expression and its bound arguments,
+// in which the column names are quoted using the quote chars of `db`.
+func (e FuncExpr) Build(db DB) (sql string, args []any) {
+	var buffer = bytes.NewBuffer(nil)
+	buffer.WriteString(e.Name)
+	buffer.WriteByte('(')
+	for i, arg := range e.Args {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+		argSql, argArgs := formatFuncExprArg(db, arg)
+		buffer.WriteString(argSql)
+		args = append(args, argArgs...)
+	}
+	buffer.WriteByte(')')
+	return buffer.String(), args
+}
+
+// formatFuncExprArg formats and returns the sql of the function argument and its bound arguments.
+// Values are never written into the sql but passed as arguments of placeholder '?'.
+func formatFuncExprArg(db DB, arg any) (sql string, args []any) {
+	switch v := arg.(type) {
+	case string:
+		return db.GetCore().QuoteWord(v), nil
+	case Raw:
+		return string(v), nil
+	case *Raw:
+		return string(*v), nil
+	case FuncExpr:
+		return v.Build(db)
+	case *FuncExpr:
+		return v.Build(db)
+	case nil:
+		return "NULL", nil
+	default:
+		return "?", []any{arg}
+	}
+}
+
+// funcExprField is the select field of a function expression with bound arguments,
+// which is formatted as its sql using String and whose arguments are merged in front of
+// the arguments of the table and conditions.
+type funcExprField struct {
+	Sql  string
+	Args []any
+}
+
+// String implements the iString interface, which returns the sql of the field.
+func (f funcExprField) String() string {
+	return f.Sql
+}
+
+// newFuncExprField creates and returns the select field of function expression `expr`.
+func newFuncExprField(db DB, expr FuncExpr) any {
+	sql, args := expr.Build(db)
+	if len(args) == 0 {
+		return Raw(sql)
+	}
+	return funcExprField{Sql: sql, Args: args}
+}
+
+// convertFuncExprWhere converts the function expression condition `where` to condition string
+// and parameters, which compares the expression with the only parameter.
+func convertFuncExprWhere(db DB, where any, args []any) (newWhere any, newArgs []any) {
+	var expr FuncExpr
+	switch v := where.(type) {
+	case FuncExpr:
+		expr = v
+	case *FuncExpr:
+		expr = *v
+	default:
+		return where, args
+	}
+	whereStr, exprArgs := expr.Build(db)
+	if len(args) != 1 {
+		return whereStr, append(exprArgs, args...)
+	}
+	switch {
+	case args[0] == nil || empty.IsNil(args[0]):
+		return whereStr + " IS NULL", exprArgs
+	case isFuncExprArgArray(args[0]):
+		return whereStr + " IN (?)", append(exprArgs, args...)
+	default:
+		return whereStr + "=?", append(exprArgs, args...)
+	}
+}
+
+// isFuncExprArgArray checks whether the condition parameter is slice or array,
+// excluding []byte and array types implementing String method.
+func isFuncExprArgArray(arg any) bool {
+	if _, ok := arg.([]byte); ok {
+		return false
+	}
+	kind := reflect.ValueOf(arg).Kind()
+	if _, ok := arg.(iString); ok && kind == reflect.Array {
+		// Eg: uuid.UUID.
+		return false
+	}
+	return kind == reflect.Slice || kind == reflect.Array
+}
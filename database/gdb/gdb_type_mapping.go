@@ -0,0 +1,201 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/utils"
+	"github.com/gogf/gf/v2/os/gstructs"
+	"github.com/gogf/gf/v2/util/gconv"
+	"github.com/gogf/gf/v2/util/gtag"
+)
+
+// MappingOption is the option for mapping records to structs,
+// which is used by Record.Struct, Result.Structs, Result.ScanList and Model.Mapping.
+type MappingOption struct {
+	// PriorityTags specifies the custom struct tags for mapping column names to attributes,
+	// which have higher priority than the default "orm" tag, eg: []string{"db"}.
+	PriorityTags []string
+
+	// KeyNormalizer normalizes the column names of records before mapping,
+	// eg: stripping the table prefix of column alias like "u_name" to "name".
+	KeyNormalizer func(key string) string
+
+	// Strict specifies returning error if any column of the records cannot be mapped
+	// to the struct attributes, so that schema drift is detected instead of silently
+	// dropping the columns.
+	Strict bool
+}
+
+// mappingStructInfo is the mapping information of certain struct type.
+type mappingStructInfo struct {
+	structType        reflect.Type
+	paramKeyToAttrMap map[string]string   // Custom tag value to attribute name mapping.
+	tagKeys           map[string]struct{} // Tag values of all attributes.
+	nameKeys          map[string]struct{} // Lowercase attribute names without symbols.
+}
+
+// doStruct converts `record` to struct `pointer` with the mapping option.
+func (o MappingOption) doStruct(record Record, pointer any) error {
+	info, err := o.getStructInfo(pointer)
+	if err != nil {
+		return err
+	}
+	record = o.normalizeRecord(record)
+	if err = o.checkStrict(record, info); err != nil {
+		return err
+	}
+	return converter.Struct(record, pointer, o.getStructOption(info))
+}
+
+// doStructs converts `result` to struct slice `pointer` with the mapping option.
+func (o MappingOption) doStructs(result Result, pointer any) error {
+	info, err := o.getStructInfo(pointer)
+	if err != nil {
+		return err
+	}
+	var normalized = result
+	if o.KeyNormalizer != nil {
+		normalized = make(Result, len(result))
+		for i, record := range result {
+			normalized[i] = o.normalizeRecord(record)
+		}
+	}
+	// All records of a result share the same columns.
+	if len(normalized) > 0 {
+		if err = o.checkStrict(normalized[0], info); err != nil {
+			return err
+		}
+	}
+	return converter.Structs(normalized, pointer, gconv.StructsOption{
+		SliceOption:  gconv.SliceOption{ContinueOnError: true},
+		StructOption: o.getStructOption(info),
+	})
+}
+
+func (o MappingOption) getStructOption(info *mappingStructInfo) gconv.StructOption {
+	return gconv.StructOption{
+		ParamKeyToAttrMap: info.paramKeyToAttrMap,
+		PriorityTag:       OrmTagForStruct,
+		ContinueOnError:   true,
+	}
+}
+
+// normalizeRecord returns a new record of which keys are normalized by KeyNormalizer.
+func (o MappingOption) normalizeRecord(record Record) Record {
+	if o.KeyNormalizer == nil {
+		return record
+	}
+	normalized := make(Record, len(record))
+	for k, v := range record {
+		normalized[o.KeyNormalizer(k)] = v
+	}
+	return normalized
+}
+
+// checkStrict checks whether all columns of `record` can be mapped to struct attributes in strict mode.
+func (o MappingOption) checkStrict(record Record, info *mappingStructInfo) error {
+	if !o.Strict {
+		return nil
+	}
+	var unmappedKeys = make([]string, 0)
+	for key := range record {
+		if _, ok := info.tagKeys[key]; ok {
+			continue
+		}
+		if _, ok := info.nameKeys[strings.ToLower(utils.RemoveSymbols(key))]; ok {
+			continue
+		}
+		unmappedKeys = append(unmappedKeys, key)
+	}
+	if len(unmappedKeys) == 0 {
+		return nil
+	}
+	sort.Strings(unmappedKeys)
+	return gerror.NewCodef(
+		gcode.CodeInvalidParameter,
+		`columns "%s" cannot be mapped to any attribute of struct "%s" in strict mode`,
+		strings.Join(unmappedKeys, ","), info.structType.String(),
+	)
+}
+
+// getStructInfo retrieves the mapping information of the struct type of `pointer`,
+// which can be type of *struct/**struct/*[]struct/*[]*struct or their reflect.Value.
+func (o MappingOption) getStructInfo(pointer any) (*mappingStructInfo, error) {
+	var structType reflect.Type
+	if v, ok := pointer.(reflect.Value); ok {
+		structType = v.Type()
+	} else {
+		structType = reflect.TypeOf(pointer)
+	}
+	for structType != nil && (structType.Kind() == reflect.Pointer ||
+		structType.Kind() == reflect.Slice || structType.Kind() == reflect.Array) {
+		structType = structType.Elem()
+	}
+	if structType == nil || structType.Kind() != reflect.Struct {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`invalid pointer type "%T" for mapping, which should be type of struct pointer or struct slice pointer`,
+			pointer,
+		)
+	}
+	var (
+		info = &mappingStructInfo{
+			structType: structType,
+			tagKeys:    make(map[string]struct{}),
+			nameKeys:   make(map[string]struct{}),
+		}
+		structPointer = reflect.New(structType).Interface()
+	)
+	if len(o.PriorityTags) > 0 {
+		tagFields, err := gstructs.TagFields(structPointer, o.PriorityTags)
+		if err != nil {
+			return nil, err
+		}
+		info.paramKeyToAttrMap = make(map[string]string, len(tagFields))
+		for _, field := range tagFields {
+			info.paramKeyToAttrMap[getMappingTagName(field.TagValue)] = field.Name()
+		}
+	}
+	if !o.Strict {
+		return info, nil
+	}
+	var priorityTags = append(append([]string{}, o.PriorityTags...), OrmTagForStruct)
+	tagFields, err := gstructs.TagFields(structPointer, append(priorityTags, gtag.StructTagPriority...))
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range tagFields {
+		info.tagKeys[getMappingTagName(field.TagValue)] = struct{}{}
+	}
+	fields, err := gstructs.Fields(gstructs.FieldsInput{
+		Pointer:         structPointer,
+		RecursiveOption: gstructs.RecursiveOptionEmbedded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range fields {
+		if field.IsExported() {
+			info.nameKeys[strings.ToLower(utils.RemoveSymbols(field.Name()))] = struct{}{}
+		}
+	}
+	return info, nil
+}
+
+// getMappingTagName returns the name part of tag value, eg: "name" of "name,omitempty".
+func getMappingTagName(tagValue string) string {
+	if i := strings.Index(tagValue, ","); i >= 0 {
+		return strings.TrimSpace(tagValue[:i])
+	}
+	return tagValue
+}
@@ -44,8 +44,10 @@ func (r Record) GMap() *gmap.StrAnyMap {
 // Struct converts `r` to a struct.
 // Note that the parameter `pointer` should be type of *struct/**struct.
 //
+// The optional parameter `option` specifies the mapping option, see MappingOption.
+//
 // Note that it returns sql.ErrNoRows if `r` is empty.
-func (r Record) Struct(pointer any) error {
+func (r Record) Struct(pointer any, option ...MappingOption) error {
 	// If the record is empty, it returns error.
 	if r.IsEmpty() {
 		if !empty.IsNil(pointer, true) {
@@ -53,6 +55,9 @@ func (r Record) Struct(pointer any) error {
 		}
 		return nil
 	}
+	if len(option) > 0 {
+		return option[0].doStruct(r, pointer)
+	}
 	return converter.Struct(r, pointer, gconv.StructOption{
 		PriorityTag:     OrmTagForStruct,
 		ContinueOnError: true,
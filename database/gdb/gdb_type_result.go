@@ -192,7 +192,9 @@ func (r Result) RecordKeyUint(key string) map[uint]Record {
 
 // Structs converts `r` to struct slice.
 // Note that the parameter `pointer` should be type of *[]struct/*[]*struct.
-func (r Result) Structs(pointer any) (err error) {
+//
+// The optional parameter `option` specifies the mapping option, see MappingOption.
+func (r Result) Structs(pointer any, option ...MappingOption) (err error) {
 	// If the result is empty and the target pointer is not empty, it returns error.
 	if r.IsEmpty() {
 		if !empty.IsEmpty(pointer, true) {
@@ -200,6 +202,9 @@ func (r Result) Structs(pointer any) (err error) {
 		}
 		return nil
 	}
+	if len(option) > 0 {
+		return option[0].doStructs(r, pointer)
+	}
 	var (
 		sliceOption  = gconv.SliceOption{ContinueOnError: true}
 		structOption = gconv.StructOption{
@@ -0,0 +1,180 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"reflect"
+
+	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/os/gctx"
+)
+
+// ColumnType is the column type metadata of query result,
+// which is captured from the sql.ColumnType of underlying driver.
+type ColumnType struct {
+	Name        string       // Column name or alias of the result.
+	Type        string       // Database type name of the column, eg: VARCHAR, DECIMAL, BIGINT.
+	Nullable    bool         // Whether the column may be null, it is false if the driver does not support it.
+	HasNullable bool         // Whether the driver supports the Nullable property.
+	Length      int64        // Length of variable length column types, eg: text and binary types.
+	HasLength   bool         // Whether the column type has the Length property.
+	Precision   int64        // Precision of decimal types.
+	Scale       int64        // Scale of decimal types.
+	HasDecimal  bool         // Whether the column type has the Precision and Scale properties.
+	ScanType    reflect.Type `json:"-"` // Go type suitable for scanning of the column, which is not kept in serialized cache.
+}
+
+// ResultWithColumns is the query result along with the column type metadata of the query,
+// which is returned by Model.AllWithColumns and Core.GetAllWithColumns.
+type ResultWithColumns struct {
+	Result
+	// Columns is the column type metadata in order of the query columns, which is also available
+	// for empty result.
+	Columns []ColumnType
+}
+
+const (
+	// columnTypesField is the field name of the hidden record carrying the column types of the result,
+	// which is stored in the spare capacity right after the last record of the result, so that it
+	// affects neither the length nor the iteration and serialization of the result.
+	columnTypesField = "\x00ColumnTypes"
+
+	// ctxKeyForEmptyResultColumnTypes marks that the empty result of the query should carry the
+	// column types, which is an empty but non-nil result instead of nil.
+	ctxKeyForEmptyResultColumnTypes gctx.StrKey = `CtxKeyForEmptyResultColumnTypes`
+)
+
+// ColumnTypes returns the column type metadata of the result in order of the query columns,
+// which can be used by generic tooling like exporters or admin grids formatting the values
+// without querying the table schema again. The column types are attached to the result when it is
+// retrieved from the database or the cache.
+//
+// Note that it returns nil if the result is empty, or its end is changed from the one returned by the
+// query, like being truncated or appended. Use Model.AllWithColumns for the column types of empty result.
+func (r Result) ColumnTypes() []ColumnType {
+	if len(r) == cap(r) {
+		return nil
+	}
+	if v, ok := r[:len(r)+1][len(r)][columnTypesField]; ok {
+		columnTypes, _ := v.Val().([]ColumnType)
+		return columnTypes
+	}
+	return nil
+}
+
+// withColumnTypes returns a copy of the result with `columnTypes` attached, see Result.ColumnTypes.
+// The empty result carries the column types only if it's required by the context.
+func (r Result) withColumnTypes(ctx context.Context, columnTypes []ColumnType) Result {
+	if len(columnTypes) == 0 || (len(r) == 0 && !isEmptyResultColumnTypesRequired(ctx)) {
+		return r
+	}
+	result := make(Result, len(r), len(r)+1)
+	copy(result, r)
+	return append(result, newColumnTypesRecord(columnTypes))[:len(r)]
+}
+
+// newColumnTypesRecord creates and returns the hidden record carrying `columnTypes`.
+func newColumnTypesRecord(columnTypes []ColumnType) Record {
+	return Record{columnTypesField: gvar.New(columnTypes)}
+}
+
+// isEmptyResultColumnTypesRequired checks whether the empty result should carry the column types.
+func isEmptyResultColumnTypesRequired(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	required, _ := ctx.Value(ctxKeyForEmptyResultColumnTypes).(bool)
+	return required
+}
+
+// AllWithColumns does "SELECT FROM ..." statement for the model like All, and returns the result
+// along with its column type metadata, which is also available for empty result.
+func (m *Model) AllWithColumns(where ...any) (*ResultWithColumns, error) {
+	ctx := context.WithValue(m.GetCtx(), ctxKeyForEmptyResultColumnTypes, true)
+	all, err := m.Ctx(ctx).All(where...)
+	if err != nil {
+		return nil, err
+	}
+	return &ResultWithColumns{
+		Result:  all,
+		Columns: all.ColumnTypes(),
+	}, nil
+}
+
+// GetAllWithColumns queries and returns data records from database like GetAll, along with the
+// column type metadata of the query.
+func (c *Core) GetAllWithColumns(ctx context.Context, sql string, args ...any) (*ResultWithColumns, error) {
+	if ctx == nil {
+		ctx = c.db.GetCtx()
+	}
+	all, err := c.db.GetAll(context.WithValue(ctx, ctxKeyForEmptyResultColumnTypes, true), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	return &ResultWithColumns{
+		Result:  all,
+		Columns: all.ColumnTypes(),
+	}, nil
+}
+
+// ExportColumns returns the default exported columns of the result in order of the query columns.
+// It uses Result.ExportColumns if the column types are not available.
+func (r *ResultWithColumns) ExportColumns() []ExportColumn {
+	if len(r.Columns) == 0 {
+		return r.Result.ExportColumns()
+	}
+	var columns = make([]ExportColumn, 0, len(r.Columns))
+	for _, columnType := range r.Columns {
+		columns = append(columns, ExportColumn{Field: columnType.Name})
+	}
+	return columns
+}
+
+// ToCSV writes the result as CSV content to `writer` like Result.ToCSV,
+// the columns are in order of the query columns if no columns are given by the option.
+func (r *ResultWithColumns) ToCSV(writer io.Writer, option ...CSVOption) error {
+	var usedOption CSVOption
+	if len(option) > 0 {
+		usedOption = option[0]
+	}
+	if len(usedOption.Columns) == 0 {
+		usedOption.Columns = r.ExportColumns()
+	}
+	return r.Result.ToCSV(writer, usedOption)
+}
+
+// newColumnTypes converts the column types of underlying driver.
+func newColumnTypes(columnTypes []*sql.ColumnType) []ColumnType {
+	types := make([]ColumnType, len(columnTypes))
+	for i, columnType := range columnTypes {
+		types[i] = newColumnType(columnType)
+	}
+	return types
+}
+
+func newColumnType(columnType *sql.ColumnType) ColumnType {
+	var (
+		nullable, hasNullable        = columnType.Nullable()
+		length, hasLength            = columnType.Length()
+		precision, scale, hasDecimal = columnType.DecimalSize()
+	)
+	return ColumnType{
+		Name:        columnType.Name(),
+		Type:        columnType.DatabaseTypeName(),
+		Nullable:    nullable,
+		HasNullable: hasNullable,
+		Length:      length,
+		HasLength:   hasLength,
+		Precision:   precision,
+		Scale:       scale,
+		HasDecimal:  hasDecimal,
+		ScanType:    columnType.ScanType(),
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/json"
+)
+
+// ExportColumn is the column mapping for exporting Result to file formats like CSV.
+type ExportColumn struct {
+	Field  string                   // Field name of the record.
+	Title  string                   // Title of the column in header, it uses Field if it is empty.
+	Format func(value Value) string // Custom formatter for the value, it uses Value.String if it is nil.
+}
+
+// CSVOption is the option for Result.ToCSV.
+type CSVOption struct {
+	// Columns specifies the exported columns and their order.
+	// It uses Result.ExportColumns if it is empty.
+	Columns []ExportColumn
+
+	// Comma is the field delimiter, it is ',' in default.
+	Comma rune
+
+	// UseCRLF specifies using "\r\n" as the line terminator.
+	UseCRLF bool
+
+	// NoHeader specifies not writing the header line, which is usually used for writing
+	// the chunks after the first one in streaming exporting.
+	NoHeader bool
+}
+
+// ExportColumns returns the default exported columns of the result.
+// The columns are in order of the query columns if the column types of the result are available,
+// or else in alphabetical order of the field names of the first record, see Result.ColumnTypes.
+func (r Result) ExportColumns() []ExportColumn {
+	if len(r) == 0 {
+		return nil
+	}
+	var columns = make([]ExportColumn, 0, len(r[0]))
+	if columnTypes := r.ColumnTypes(); len(columnTypes) > 0 {
+		for _, columnType := range columnTypes {
+			columns = append(columns, ExportColumn{Field: columnType.Name})
+		}
+		return columns
+	}
+	var fields = make([]string, 0, len(r[0]))
+	for field := range r[0] {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		columns = append(columns, ExportColumn{Field: field})
+	}
+	return columns
+}
+
+// ToCSV writes the result as CSV content to `writer`.
+//
+// It can be used with Model.Chunk for streaming exporting of large tables, in which only
+// the first chunk writes the header:
+//
+//	var isFirst = true
+//	db.Model("user").OrderAsc("id").Chunk(1000, func(result gdb.Result, err error) bool {
+//		if err == nil {
+//			err = result.ToCSV(w, gdb.CSVOption{Columns: columns, NoHeader: !isFirst})
+//		}
+//		isFirst = false
+//		return err == nil
+//	})
+func (r Result) ToCSV(writer io.Writer, option ...CSVOption) error {
+	var usedOption CSVOption
+	if len(option) > 0 {
+		usedOption = option[0]
+	}
+	columns := usedOption.Columns
+	if len(columns) == 0 {
+		columns = r.ExportColumns()
+	}
+	csvWriter := csv.NewWriter(writer)
+	if usedOption.Comma != 0 {
+		csvWriter.Comma = usedOption.Comma
+	}
+	csvWriter.UseCRLF = usedOption.UseCRLF
+	line := make([]string, len(columns))
+	if !usedOption.NoHeader && len(columns) > 0 {
+		for i, column := range columns {
+			line[i] = column.GetTitle()
+		}
+		if err := csvWriter.Write(line); err != nil {
+			return gerror.Wrap(err, `write csv header failed`)
+		}
+	}
+	for _, record := range r {
+		for i, column := range columns {
+			line[i] = column.FormatValue(record[column.Field])
+		}
+		if err := csvWriter.Write(line); err != nil {
+			return gerror.Wrap(err, `write csv line failed`)
+		}
+	}
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return gerror.Wrap(err, `flush csv content failed`)
+	}
+	return nil
+}
+
+// ToNDJSON writes the result as newline delimited JSON content to `writer`,
+// each record a JSON object in one line.
+//
+// The optional parameter `columns` specifies the exported columns, the keys of the JSON objects
+// are the titles of the columns and values are formatted if the column formatter is given.
+// It writes all fields of records with their original values if `columns` is not given.
+func (r Result) ToNDJSON(writer io.Writer, columns ...ExportColumn) error {
+	encoder := json.NewEncoder(writer)
+	for _, record := range r {
+		var item Map
+		if len(columns) > 0 {
+			item = make(Map, len(columns))
+			for _, column := range columns {
+				value := record[column.Field]
+				if column.Format != nil {
+					item[column.GetTitle()] = column.Format(value)
+				} else if value != nil {
+					item[column.GetTitle()] = value.Val()
+				} else {
+					item[column.GetTitle()] = nil
+				}
+			}
+		} else {
+			item = record.Map()
+		}
+		if err := encoder.Encode(item); err != nil {
+			return gerror.Wrap(err, `write ndjson line failed`)
+		}
+	}
+	return nil
+}
+
+// GetTitle returns the title of the column in header.
+func (c ExportColumn) GetTitle() string {
+	if c.Title != "" {
+		return c.Title
+	}
+	return c.Field
+}
+
+// FormatValue formats and returns the string value of the column.
+func (c ExportColumn) FormatValue(value Value) string {
+	if c.Format != nil {
+		return c.Format(value)
+	}
+	if value == nil {
+		return ""
+	}
+	return value.String()
+}
@@ -92,6 +92,23 @@ import (
 //
 // See the example or unit testing cases for clear understanding for this function.
 func (r Result) ScanList(structSlicePointer any, bindToAttrName string, relationAttrNameAndFields ...string) (err error) {
+	return r.doScanList(nil, structSlicePointer, bindToAttrName, relationAttrNameAndFields...)
+}
+
+// ScanListWithOption is the same as function ScanList, but converts the records to structs
+// with given mapping option, see MappingOption.
+func (r Result) ScanListWithOption(
+	option MappingOption, structSlicePointer any, bindToAttrName string, relationAttrNameAndFields ...string,
+) (err error) {
+	return r.doScanList(
+		[]MappingOption{option}, structSlicePointer, bindToAttrName, relationAttrNameAndFields...,
+	)
+}
+
+func (r Result) doScanList(
+	mappingOption []MappingOption,
+	structSlicePointer any, bindToAttrName string, relationAttrNameAndFields ...string,
+) (err error) {
 	out, err := checkGetSliceElementInfoForScanList(structSlicePointer, bindToAttrName)
 	if err != nil {
 		return err
@@ -116,6 +133,7 @@ func (r Result) ScanList(structSlicePointer any, bindToAttrName string, relation
 		BindToAttrName:     bindToAttrName,
 		RelationAttrName:   relationAttrName,
 		RelationFields:     relationFields,
+		MappingOption:      mappingOption,
 	})
 }
 
@@ -199,6 +217,7 @@ type doScanListInput struct {
 	BindToAttrName     string
 	RelationAttrName   string
 	RelationFields     string
+	MappingOption      []MappingOption
 }
 
 // doScanList converts `result` to struct slice which contains other complex struct attributes recursively.
@@ -389,7 +408,7 @@ func doScanList(in doScanListInput) (err error) {
 					for _, v := range relationDataMap[gconv.String(relationFromAttrField.Interface())].Slice() {
 						results = append(results, v.(Record))
 					}
-					if err = results.Structs(bindToAttrValue.Addr()); err != nil {
+					if err = results.Structs(bindToAttrValue.Addr(), in.MappingOption...); err != nil {
 						return err
 					}
 					// Recursively Scan.
@@ -426,11 +445,11 @@ func doScanList(in doScanListInput) (err error) {
 						continue
 					}
 					if v.IsSlice() {
-						if err = v.Slice()[0].(Record).Struct(element); err != nil {
+						if err = v.Slice()[0].(Record).Struct(element, in.MappingOption...); err != nil {
 							return err
 						}
 					} else {
-						if err = v.Val().(Record).Struct(element); err != nil {
+						if err = v.Val().(Record).Struct(element, in.MappingOption...); err != nil {
 							return err
 						}
 					}
@@ -448,7 +467,7 @@ func doScanList(in doScanListInput) (err error) {
 					// There's no relational data.
 					continue
 				}
-				if err = v.Struct(element); err != nil {
+				if err = v.Struct(element, in.MappingOption...); err != nil {
 					return err
 				}
 			}
@@ -470,11 +489,11 @@ func doScanList(in doScanListInput) (err error) {
 						continue
 					}
 					if relationDataItem.IsSlice() {
-						if err = relationDataItem.Slice()[0].(Record).Struct(bindToAttrValue); err != nil {
+						if err = relationDataItem.Slice()[0].(Record).Struct(bindToAttrValue, in.MappingOption...); err != nil {
 							return err
 						}
 					} else {
-						if err = relationDataItem.Val().(Record).Struct(bindToAttrValue); err != nil {
+						if err = relationDataItem.Val().(Record).Struct(bindToAttrValue, in.MappingOption...); err != nil {
 							return err
 						}
 					}
@@ -492,7 +511,7 @@ func doScanList(in doScanListInput) (err error) {
 					// There's no relational data.
 					continue
 				}
-				if err = relationDataItem.Struct(bindToAttrValue); err != nil {
+				if err = relationDataItem.Struct(bindToAttrValue, in.MappingOption...); err != nil {
 					return err
 				}
 			}
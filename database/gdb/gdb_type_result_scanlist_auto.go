@@ -0,0 +1,190 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/utils"
+	"github.com/gogf/gf/v2/os/gstructs"
+)
+
+// scanListBinding is the ScanList binding declared by the orm tag of the bound attribute.
+type scanListBinding struct {
+	BindToAttrName   string // Attribute name that the result is bound to, eg: UserDetail.
+	RelationAttrName string // Attribute name of the relation, eg: User.
+	RelationFields   string // Relation fields, eg: uid=Uid.
+}
+
+// ScanListAuto converts `r` to struct slice which contains other complex struct attributes,
+// like ScanList, but the relation binding is declared by the "orm" tag of the bound attribute
+// instead of the positional string arguments.
+//
+// The tag "link" specifies the relation fields in format "ResultFieldName=RelationAttrName", and
+// the optional tag "from" specifies the relation attribute of the slice element, which is the
+// slice element itself if it is not given. For example:
+//
+//	type Entity struct {
+//		User       *EntityUser
+//		UserDetail *EntityUserDetail   `orm:"from:User, link:uid=Uid"`
+//		UserScores []*EntityUserScores `orm:"from:User, link:uid=Uid"`
+//	}
+//
+//	var users []*Entity
+//	ScanListAuto(&users, "User")
+//	ScanListAuto(&users, "UserDetail")
+//	ScanListAuto(&users, "UserScores")
+//
+// The optional parameter `bindToAttrName` specifies the attribute that the result is bound to.
+// If it is not given, the attribute is inferred as the only one of which the struct type
+// contains all the fields of the result, for example:
+//
+//	ScanListAuto(&users)
+//
+// It returns error if the attribute cannot be inferred or the inferring is ambiguous.
+func (r Result) ScanListAuto(structSlicePointer any, bindToAttrName ...string) (err error) {
+	if r.IsEmpty() {
+		return nil
+	}
+	binding, err := getScanListBinding(structSlicePointer, r, bindToAttrName...)
+	if err != nil {
+		return err
+	}
+	return r.ScanList(structSlicePointer, binding.BindToAttrName, binding.RelationArgs()...)
+}
+
+// RelationArgs returns the relation arguments for ScanList function.
+func (b scanListBinding) RelationArgs() []string {
+	switch {
+	case b.RelationFields == "":
+		return nil
+	case b.RelationAttrName == "":
+		return []string{b.RelationFields}
+	default:
+		return []string{b.RelationAttrName, b.RelationFields}
+	}
+}
+
+// getScanListBinding retrieves the ScanList binding from the orm tag of the bound attribute.
+// The bound attribute is inferred from the fields of `result` if `bindToAttrName` is not given.
+func getScanListBinding(structSlicePointer any, result Result, bindToAttrName ...string) (*scanListBinding, error) {
+	var elemType = reflect.TypeOf(structSlicePointer)
+	for elemType != nil && (elemType.Kind() == reflect.Pointer ||
+		elemType.Kind() == reflect.Slice || elemType.Kind() == reflect.Array) {
+		elemType = elemType.Elem()
+	}
+	if elemType == nil || elemType.Kind() != reflect.Struct {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			"structSlicePointer should be type of *[]struct/*[]*struct, but got: %T",
+			structSlicePointer,
+		)
+	}
+	var (
+		ok          bool
+		structField reflect.StructField
+	)
+	if len(bindToAttrName) > 0 && bindToAttrName[0] != "" {
+		if structField, ok = elemType.FieldByName(bindToAttrName[0]); !ok {
+			return nil, gerror.NewCodef(
+				gcode.CodeInvalidParameter,
+				`field "%s" not found in element of "%T"`,
+				bindToAttrName[0], structSlicePointer,
+			)
+		}
+	} else {
+		var err error
+		if structField, err = inferScanListBindToField(elemType, result); err != nil {
+			return nil, err
+		}
+	}
+	tagMap := parseOrmTagToMap(structField.Tag.Get(OrmTagForStruct))
+	return &scanListBinding{
+		BindToAttrName:   structField.Name,
+		RelationAttrName: tagMap[OrmTagForLinkFrom],
+		RelationFields:   tagMap[OrmTagForLink],
+	}, nil
+}
+
+// inferScanListBindToField infers the attribute of `elemType` that `result` should be bound to,
+// which is the only one of which the struct type contains all the fields of the result.
+func inferScanListBindToField(elemType reflect.Type, result Result) (reflect.StructField, error) {
+	var (
+		resultFields = make([]string, 0, len(result[0]))
+		matched      = make([]reflect.StructField, 0)
+	)
+	for field := range result[0] {
+		resultFields = append(resultFields, field)
+	}
+	for i := 0; i < elemType.NumField(); i++ {
+		structField := elemType.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+		attrType := structField.Type
+		for attrType.Kind() == reflect.Pointer || attrType.Kind() == reflect.Slice || attrType.Kind() == reflect.Array {
+			attrType = attrType.Elem()
+		}
+		if attrType.Kind() != reflect.Struct {
+			continue
+		}
+		if isStructContainsFields(attrType, resultFields) {
+			matched = append(matched, structField)
+		}
+	}
+	switch len(matched) {
+	case 1:
+		return matched[0], nil
+	case 0:
+		return reflect.StructField{}, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`cannot infer the bound attribute of "%s" for result fields "%s"`,
+			elemType.String(), strings.Join(resultFields, ","),
+		)
+	default:
+		matchedNames := make([]string, len(matched))
+		for i, field := range matched {
+			matchedNames[i] = field.Name
+		}
+		return reflect.StructField{}, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`ambiguous bound attributes "%s" of "%s" for result fields "%s", please specify the attribute name`,
+			strings.Join(matchedNames, ","), elemType.String(), strings.Join(resultFields, ","),
+		)
+	}
+}
+
+// isStructContainsFields checks whether all `fields` can be mapped to the attributes of `structType`.
+func isStructContainsFields(structType reflect.Type, fields []string) bool {
+	structFields, err := gstructs.Fields(gstructs.FieldsInput{
+		Pointer:         reflect.New(structType).Interface(),
+		RecursiveOption: gstructs.RecursiveOptionEmbedded,
+	})
+	if err != nil || len(structFields) == 0 {
+		return false
+	}
+	for _, field := range fields {
+		var found bool
+		for _, structField := range structFields {
+			if !structField.IsExported() {
+				continue
+			}
+			if utils.EqualFoldWithoutChars(field, structField.Name()) ||
+				field == getMappingTagName(structField.Tag(OrmTagForStruct)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
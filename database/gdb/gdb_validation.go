@@ -0,0 +1,184 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gogf/gf/v2/container/gmap"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gctx"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gutil"
+	"github.com/gogf/gf/v2/util/gvalid"
+)
+
+const (
+	// ValidationRuleExists is the validation rule checking the value exists in the table column,
+	// which is usually used for the foreign key values.
+	//
+	// Format: exists:table[,column]
+	// The column is the validating field name if it is not given.
+	ValidationRuleExists = "exists"
+
+	// ValidationRuleUnique is the validation rule checking the value does not exist in the table column.
+	//
+	// Format: unique:table[,column[,ignore[,key]]]
+	// The column is the validating field name if it is not given.
+	// The optional `ignore` is the field name of the validating data, of which the value is ignored in
+	// checking, which is usually the primary key value of the updating record. Nothing is ignored if
+	// the validating data has no such field. It is the ignored value itself if it is quoted with
+	// single quotes, eg: unique:user,passport,'1'.
+	// The optional `key` is the column of the ignored value, which is the primary key in default.
+	ValidationRuleUnique = "unique"
+)
+
+const (
+	ctxKeyForValidationCache gctx.StrKey = `CtxKeyForValidationCache`
+)
+
+// RegisterValidationRules registers the database validation rules ValidationRuleExists and
+// ValidationRuleUnique to package gvalid, which should be called before validating, usually at boot.
+//
+// The error messages of the database validation rules can be configured by i18n keys like other rules,
+// which are "gf.gvalid.rule.exists" and "gf.gvalid.rule.unique".
+func RegisterValidationRules() {
+	gvalid.RegisterRuleByMap(map[string]gvalid.RuleFunc{
+		ValidationRuleExists: validationRuleExists,
+		ValidationRuleUnique: validationRuleUnique,
+	})
+}
+
+// WithValidationCache returns a new context caching the query results of the database validation rules
+// like ValidationRuleExists and ValidationRuleUnique, so that the same value is queried only once
+// in the context. It is usually created once per request in middleware.
+//
+// The database validation rules use the DB from the context by WithDB, or else the default DB of Instance.
+func WithValidationCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxKeyForValidationCache, gmap.NewStrAnyMap(true))
+}
+
+// validationRuleExists implements the ValidationRuleExists.
+func validationRuleExists(ctx context.Context, in gvalid.RuleFuncInput) error {
+	if in.Value.IsEmpty() {
+		return nil
+	}
+	params := getValidationRuleParams(in)
+	if len(params) == 0 {
+		return newValidationRuleError(in)
+	}
+	exist, err := doValidationQuery(ctx, in, params, nil, "")
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return gerror.NewCode(gcode.CodeValidationFailed, in.Message)
+	}
+	return nil
+}
+
+// validationRuleUnique implements the ValidationRuleUnique.
+func validationRuleUnique(ctx context.Context, in gvalid.RuleFuncInput) error {
+	if in.Value.IsEmpty() {
+		return nil
+	}
+	params := getValidationRuleParams(in)
+	if len(params) == 0 {
+		return newValidationRuleError(in)
+	}
+	var (
+		ignoreValue any
+		ignoreKey   string
+	)
+	if len(params) > 2 && params[2] != "" {
+		if length := len(params[2]); length > 1 && params[2][0] == '\'' && params[2][length-1] == '\'' {
+			ignoreValue = params[2][1 : length-1]
+		} else if dataMap := in.Data.Map(); len(dataMap) > 0 {
+			if foundKey, foundValue := gutil.MapPossibleItemByKey(dataMap, params[2]); foundKey != "" {
+				ignoreValue = foundValue
+			}
+		}
+		if len(params) > 3 {
+			ignoreKey = params[3]
+		}
+	}
+	exist, err := doValidationQuery(ctx, in, params, ignoreValue, ignoreKey)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return gerror.NewCode(gcode.CodeValidationFailed, in.Message)
+	}
+	return nil
+}
+
+// doValidationQuery checks whether the validating value exists in the table column of `params`,
+// in which the record of `ignoreValue` is ignored if it is not empty.
+func doValidationQuery(
+	ctx context.Context, in gvalid.RuleFuncInput, params []string, ignoreValue any, ignoreKey string,
+) (exist bool, err error) {
+	var (
+		table  = params[0]
+		column = in.Field
+	)
+	if len(params) > 1 && params[1] != "" {
+		column = params[1]
+	}
+	var (
+		cache    *gmap.StrAnyMap
+		cacheKey = fmt.Sprintf(`%s:%s:%v:%s:%v`, table, column, in.Value.Val(), ignoreKey, ignoreValue)
+	)
+	if v, ok := ctx.Value(ctxKeyForValidationCache).(*gmap.StrAnyMap); ok {
+		cache = v
+		if v := cache.Get(cacheKey); v != nil {
+			return v.(bool), nil
+		}
+	}
+	db := DBFromCtx(ctx)
+	if db == nil {
+		if db, err = Instance(); err != nil {
+			return false, err
+		}
+	}
+	model := db.Model(table).Ctx(ctx).Where(column, in.Value.Val())
+	if ignoreValue != nil && gstr.Trim(fmt.Sprint(ignoreValue)) != "" {
+		if ignoreKey == "" {
+			if ignoreKey = model.getPrimaryKey(); ignoreKey == "" {
+				return false, gerror.NewCodef(
+					gcode.CodeInvalidParameter,
+					`no primary key found in table "%s" for ignoring value of rule "%s"`,
+					table, in.Rule,
+				)
+			}
+		}
+		model = model.WhereNot(ignoreKey, ignoreValue)
+	}
+	if exist, err = model.Exist(); err != nil {
+		return false, err
+	}
+	if cache != nil {
+		cache.Set(cacheKey, exist)
+	}
+	return exist, nil
+}
+
+// getValidationRuleParams returns the parameters after the rule name, eg: "unique:user,passport".
+func getValidationRuleParams(in gvalid.RuleFuncInput) []string {
+	_, pattern, _ := strings.Cut(in.Rule, ":")
+	if pattern == "" {
+		return nil
+	}
+	return gstr.SplitAndTrim(pattern, ",")
+}
+
+// newValidationRuleError returns the error for invalid rule pattern.
+func newValidationRuleError(in gvalid.RuleFuncInput) error {
+	return gerror.NewCodef(gcode.CodeInvalidParameter, `invalid pattern of validation rule "%s"`, in.Rule)
+}
@@ -0,0 +1,88 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogf/gf/v2/container/gtype"
+	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func newTestCachePayloadCodec(t *gtest.T, option CachePayloadOption) *cachePayloadCodec {
+	core := &Core{cachePayloadCodec: gtype.NewInterface()}
+	t.AssertNil(core.SetCachePayloadOption(option))
+	return core.getCachePayloadCodec()
+}
+
+func Test_cachePayloadCodec(t *testing.T) {
+	var (
+		oldKey = CacheKey{Id: "old", Key: bytes.Repeat([]byte{1}, 16)}
+		newKey = CacheKey{Id: "new", Key: bytes.Repeat([]byte{2}, 32)}
+		item   = &selectCacheItem{
+			Result: Result{
+				{"id": gvar.New(1), "email": gvar.New("john@example.com")},
+			},
+			FirstResultColumn: "id",
+		}
+	)
+	gtest.C(t, func(t *gtest.T) {
+		core := &Core{cachePayloadCodec: gtype.NewInterface()}
+		t.AssertNil(core.SetCachePayloadOption(CachePayloadOption{}))
+		t.Assert(core.getCachePayloadCodec() == nil, true)
+		t.AssertNE(core.SetCachePayloadOption(CachePayloadOption{Keys: []CacheKey{{Id: "a", Key: []byte("short")}}}), nil)
+		t.AssertNE(core.SetCachePayloadOption(CachePayloadOption{Keys: []CacheKey{oldKey, oldKey}}), nil)
+	})
+	// Compression only.
+	gtest.C(t, func(t *gtest.T) {
+		codec := newTestCachePayloadCodec(t, CachePayloadOption{Compress: true})
+		payload, err := codec.encode(item)
+		t.AssertNil(err)
+		decoded, err := codec.decode(payload)
+		t.AssertNil(err)
+		t.Assert(decoded.Result, item.Result)
+		t.Assert(decoded.FirstResultColumn, "id")
+
+		// Plain payload is cache missing.
+		decoded, err = codec.decode([]byte(`{"Result":[]}`))
+		t.AssertNil(err)
+		t.Assert(decoded == nil, true)
+	})
+	// Encryption with key rotation.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			oldCodec     = newTestCachePayloadCodec(t, CachePayloadOption{Keys: []CacheKey{oldKey}})
+			rotatedCodec = newTestCachePayloadCodec(t, CachePayloadOption{Compress: true, Keys: []CacheKey{newKey, oldKey}})
+			newCodec     = newTestCachePayloadCodec(t, CachePayloadOption{Keys: []CacheKey{newKey}})
+		)
+		oldPayload, err := oldCodec.encode(item)
+		t.AssertNil(err)
+		t.Assert(bytes.Contains(oldPayload, []byte("john@example.com")), false)
+
+		decoded, err := rotatedCodec.decode(oldPayload)
+		t.AssertNil(err)
+		t.Assert(decoded.Result, item.Result)
+
+		newPayload, err := rotatedCodec.encode(item)
+		t.AssertNil(err)
+		decoded, err = newCodec.decode(newPayload)
+		t.AssertNil(err)
+		t.Assert(decoded.Result, item.Result)
+
+		// Payload of removed key is cache missing.
+		decoded, err = newCodec.decode(oldPayload)
+		t.AssertNil(err)
+		t.Assert(decoded == nil, true)
+
+		// Tampered payload fails decrypting.
+		newPayload[len(newPayload)-1] ^= 0xff
+		_, err = newCodec.decode(newPayload)
+		t.AssertNE(err, nil)
+	})
+}
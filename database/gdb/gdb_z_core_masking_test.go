@@ -0,0 +1,66 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_MaskRule_mask(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		rule := &MaskRule{Replacement: "x"}
+		t.Assert(rule.mask(gvar.New("secret")), "x")
+		t.Assert(rule.mask(gvar.New(nil)).IsNil(), true)
+
+		rule = &MaskRule{Type: MaskTypeHash, Salt: "salt"}
+		t.Assert(rule.mask(gvar.New("a")), rule.mask(gvar.New("a")))
+		t.AssertNE(rule.mask(gvar.New("a")), (&MaskRule{Type: MaskTypeHash}).mask(gvar.New("a")))
+
+		rule = &MaskRule{Func: func(value Value) any {
+			return strings.ToUpper(value.String())
+		}}
+		t.Assert(rule.mask(gvar.New("a")), "A")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		rule := &MaskRule{Type: MaskTypeFaker}
+		text := rule.fake("Ab-12 中" + strings.Repeat("z", 40))
+		t.Assert(len([]rune(text)), 47)
+		t.Assert(text[2:3], "-")
+		t.Assert(text[5:6], " ")
+		t.Assert(text[0] >= 'A' && text[0] <= 'Z', true)
+		t.Assert(text[3] >= '0' && text[3] <= '9', true)
+		t.Assert(text[6:7], "x")
+
+		rule.Faker = MaskFakerEmail
+		t.Assert(strings.HasSuffix(rule.fake("john@gmail.com"), "@example.com"), true)
+		rule.Faker = MaskFakerPhone
+		t.Assert(len(rule.fake("13800138000")), 13)
+	})
+}
+
+func Test_maskResult(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		result := Result{
+			{"id": gvar.New(1), "Email": gvar.New("a@b.com")},
+		}
+		masked := maskResult("user", result, []MaskRule{
+			{Tables: []string{"USER"}, Columns: []string{"email"}},
+		})
+		t.Assert(masked[0]["id"], 1)
+		t.Assert(masked[0]["Email"], defaultRedactionMask)
+		t.Assert(result[0]["Email"], "a@b.com")
+
+		masked = maskResult("order", result, []MaskRule{
+			{Tables: []string{"user*"}, Columns: []string{"email"}},
+		})
+		t.Assert(masked[0]["Email"], "a@b.com")
+	})
+}
@@ -0,0 +1,35 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_WithMasterRead(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(IsMasterRead(context.Background()), false)
+		t.Assert(IsMasterRead(WithMasterRead(context.Background())), true)
+	})
+}
+
+func Test_WithReadYourWrites(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		ctx := WithReadYourWrites(context.Background(), 100*time.Millisecond)
+		t.Assert(IsMasterRead(ctx), false)
+
+		markWriteInCtx(ctx)
+		t.Assert(IsMasterRead(ctx), true)
+
+		time.Sleep(150 * time.Millisecond)
+		t.Assert(IsMasterRead(ctx), false)
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_redactSqlArgs(t *testing.T) {
+	rules := []RedactionRule{{Columns: []string{"password", "*token*"}}}
+	// Conditions.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			sql  = "SELECT * FROM `user` WHERE `name`=? AND `password`=? AND access_token IN(?,?) AND id>?"
+			args = []any{"john", "123456", "t1", "t2", 1}
+		)
+		t.Assert(
+			FormatSqlWithArgs(sql, redactSqlArgs(sql, args, rules)),
+			"SELECT * FROM `user` WHERE `name`='john' AND `password`='******' AND access_token IN('******','******') AND id>1",
+		)
+		// Original arguments are not changed.
+		t.Assert(args[1], "123456")
+	})
+	// Insert statement with multiple rows.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			sql  = `INSERT INTO "user"("id","name","password") VALUES($1,$2,$3),($4,LOWER($5),$6)`
+			args = []any{1, "john", "p1", 2, "smith", "p2"}
+		)
+		t.Assert(
+			FormatSqlWithArgs(sql, redactSqlArgs(sql, args, rules)),
+			`INSERT INTO "user"("id","name","password") VALUES(1,'john','******'),(2,LOWER('smith'),'******')`,
+		)
+	})
+	// Argument indexes and replacement.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			sql  = "UPDATE `user` SET `name`=?,`secret`=? WHERE `id`=?"
+			args = []any{"john", "s", 1}
+		)
+		t.Assert(
+			FormatSqlWithArgs(sql, redactSqlArgs(sql, args, []RedactionRule{{ArgIndexes: []int{1}, Replacement: "<hidden>"}})),
+			"UPDATE `user` SET `name`='john',`secret`='<hidden>' WHERE `id`=1",
+		)
+		t.Assert(redactSqlArgs(sql, args, rules), args)
+	})
+}
+
+func Test_WithRedaction(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		ctx := context.Background()
+		t.Assert(WithRedaction(ctx), ctx)
+
+		ctx = WithRedaction(ctx, RedactionRule{Columns: []string{"password"}})
+		ctx = WithRedaction(ctx, RedactionRule{ArgIndexes: []int{0}})
+		rules, ok := ctx.Value(ctxKeyForRedactionRules).([]RedactionRule)
+		t.Assert(ok, true)
+		t.Assert(len(rules), 2)
+	})
+}
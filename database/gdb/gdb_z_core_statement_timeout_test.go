@@ -0,0 +1,22 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Core_FormatStatementTimeout(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		core := &Core{}
+		t.Assert(core.FormatStatementTimeout("SELECT * FROM `user`", 2*time.Second), "SELECT * FROM `user`")
+		t.Assert(core.FormatStatementTimeout("SELECT 1", time.Microsecond), "SELECT 1")
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Core_FormatLiteral(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		core := &Core{}
+		t.Assert(core.FormatLiteral(nil), "NULL")
+		t.Assert(core.FormatLiteral(gvar.New(nil)), "NULL")
+		t.Assert(core.FormatLiteral(gvar.New(true)), "TRUE")
+		t.Assert(core.FormatLiteral(gvar.New(-12)), "-12")
+		t.Assert(core.FormatLiteral(1.5), "1.5")
+		t.Assert(core.FormatLiteral([]byte{0x01, 0xab}), "X'01ab'")
+		t.Assert(core.FormatLiteral(`it's\`), `'it''s\'`)
+	})
+}
+
+func Test_dumpSqlParser(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		parser := &dumpSqlParser{reader: bufio.NewReader(strings.NewReader(`
+-- Dump of table user
+INSERT INTO "user"(` + "`id`" + `,[name],flag,data,score) VALUES
+(1,'it''s;
+--',TRUE,X'01ab',1.25),
+(-2,NULL,FALSE,NULL,18446744073709551615);
+-- Dump of table empty`))}
+		table, list, err := parser.nextStatement()
+		t.AssertNil(err)
+		t.Assert(table, "user")
+		t.Assert(len(list), 2)
+		t.Assert(list[0], Map{"id": 1, "name": "it's;\n--", "flag": true, "data": []byte{0x01, 0xab}, "score": "1.25"})
+		t.Assert(list[1]["id"], -2)
+		t.Assert(list[1]["name"], nil)
+		t.Assert(list[1]["score"], uint64(18446744073709551615))
+
+		_, _, err = parser.nextStatement()
+		t.Assert(err, io.EOF)
+	})
+	// Dialects of the databases.
+	gtest.C(t, func(t *gtest.T) {
+		parser := &dumpSqlParser{reader: bufio.NewReader(strings.NewReader(`-- Backslash escapes: on
+INSERT INTO ` + "`db`.`user`(`id`,`name`,`data`)" + ` VALUES(1,'a\\b\'c''d',X'01ab');`))}
+		table, list, err := parser.nextStatement()
+		t.AssertNil(err)
+		t.Assert(table, "db.user")
+		t.Assert(list[0], Map{"id": 1, "name": `a\b'c'd`, "data": []byte{0x01, 0xab}})
+
+		parser = &dumpSqlParser{reader: bufio.NewReader(strings.NewReader(`
+INSERT INTO "user"(id,name,data,bin,time) VALUES(1,N'a\b',0x01ab,HEXTORAW('01AB'),TIMESTAMP '2006-01-02 15:04:05'),
+(2,'\x01ab'::bytea,'\x01ab'::text,NULL,'2006-01-02');`))}
+		table, list, err = parser.nextStatement()
+		t.AssertNil(err)
+		t.Assert(table, "user")
+		t.Assert(list[0], Map{
+			"id": 1, "name": `a\b`, "data": []byte{0x01, 0xab}, "bin": []byte{0x01, 0xab}, "time": "2006-01-02 15:04:05",
+		})
+		t.Assert(list[1]["name"], []byte{0x01, 0xab})
+		t.Assert(list[1]["data"], `\x01ab`)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		for _, content := range []string{
+			"UPDATE user SET id=1;",
+			"INSERT INTO user(id) VALUES(1,2);",
+			"INSERT INTO user(id) VALUES(abc);",
+			"INSERT INTO user(id) VALUES('1);",
+		} {
+			parser := &dumpSqlParser{reader: bufio.NewReader(strings.NewReader(content))}
+			_, _, err := parser.nextStatement()
+			t.AssertNE(err, nil)
+			t.AssertNE(err, io.EOF)
+		}
+	})
+}
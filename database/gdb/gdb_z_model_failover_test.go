@@ -0,0 +1,64 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_failoverBreaker(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			option = FailoverOption{
+				Threshold:     2,
+				ProbeInterval: 50 * time.Millisecond,
+			}
+			breaker = &failoverBreaker{state: FailoverStateClosed}
+		)
+		allowed, changed := breaker.allow(option)
+		t.Assert(allowed, true)
+		t.Assert(changed, false)
+
+		// It opens after consecutive failures reach the threshold.
+		state, changed := breaker.report(option, true)
+		t.Assert(state, FailoverStateClosed)
+		t.Assert(changed, false)
+		state, changed = breaker.report(option, true)
+		t.Assert(state, FailoverStateOpen)
+		t.Assert(changed, true)
+
+		allowed, _ = breaker.allow(option)
+		t.Assert(allowed, false)
+
+		// Only one probing read is allowed in half-open state.
+		time.Sleep(60 * time.Millisecond)
+		allowed, changed = breaker.allow(option)
+		t.Assert(allowed, true)
+		t.Assert(changed, true)
+		allowed, _ = breaker.allow(option)
+		t.Assert(allowed, false)
+
+		// Failed probing opens it again.
+		state, changed = breaker.report(option, true)
+		t.Assert(state, FailoverStateOpen)
+		t.Assert(changed, true)
+		allowed, _ = breaker.allow(option)
+		t.Assert(allowed, false)
+
+		// Succeeded probing closes it.
+		time.Sleep(60 * time.Millisecond)
+		allowed, _ = breaker.allow(option)
+		t.Assert(allowed, true)
+		state, changed = breaker.report(option, false)
+		t.Assert(state, FailoverStateClosed)
+		t.Assert(changed, true)
+		t.Assert(breaker.failures, 0)
+	})
+}
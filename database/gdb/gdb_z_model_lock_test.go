@@ -0,0 +1,53 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_formatLockClause(t *testing.T) {
+	quote := func(s string) string { return `"` + s + `"` }
+	gtest.C(t, func(t *gtest.T) {
+		clause, err := formatLockClause("sqlite", LockForUpdate, LockOption{}, quote)
+		t.AssertNil(err)
+		t.Assert(clause, LockForUpdate)
+
+		clause, err = formatLockClause("pgsql", LockForUpdate, LockOption{SkipLocked: true}, quote)
+		t.AssertNil(err)
+		t.Assert(clause, "FOR UPDATE SKIP LOCKED")
+
+		clause, err = formatLockClause("pgsql", LockForUpdate, LockOption{NoWait: true, Of: []string{"user"}}, quote)
+		t.AssertNil(err)
+		t.Assert(clause, `FOR UPDATE OF "user" NOWAIT`)
+
+		clause, err = formatLockClause("mysql", LockInShareMode, LockOption{SkipLocked: true}, quote)
+		t.AssertNil(err)
+		t.Assert(clause, "FOR SHARE SKIP LOCKED")
+
+		clause, err = formatLockClause("mariadb", LockInShareMode, LockOption{NoWait: true}, quote)
+		t.AssertNil(err)
+		t.Assert(clause, "LOCK IN SHARE MODE NOWAIT")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		_, err := formatLockClause("pgsql", LockForUpdate, LockOption{SkipLocked: true, NoWait: true}, quote)
+		t.Assert(gerror.Code(err), gcode.CodeInvalidParameter)
+
+		_, err = formatLockClause("sqlite", LockForUpdate, LockOption{SkipLocked: true}, quote)
+		t.Assert(gerror.Code(err), gcode.CodeNotSupported)
+
+		_, err = formatLockClause("mariadb", LockForUpdate, LockOption{Of: []string{"user"}}, quote)
+		t.Assert(gerror.Code(err), gcode.CodeNotSupported)
+
+		_, err = formatLockClause("oracle", LockInShareMode, LockOption{NoWait: true}, quote)
+		t.Assert(gerror.Code(err), gcode.CodeNotSupported)
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_formatPercentileField(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		field, err := formatPercentileField("pgsql", `"latency"`, 0.95)
+		t.AssertNil(err)
+		t.Assert(field, `PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY "latency")`)
+
+		field, err = formatPercentileField("Oracle", `"LATENCY"`, 0.5)
+		t.AssertNil(err)
+		t.Assert(field, `PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY "LATENCY")`)
+
+		field, err = formatPercentileField("clickhouse", "`latency`", 0.99)
+		t.AssertNil(err)
+		t.Assert(field, "quantile(0.99)(`latency`)")
+
+		field, err = formatPercentileField("clickhouse", "`latency`", 1)
+		t.AssertNil(err)
+		t.Assert(field, "quantile(1)(`latency`)")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		_, err := formatPercentileField("pgsql", `"latency"`, 1.5)
+		t.Assert(gerror.Code(err), gcode.CodeInvalidParameter)
+
+		_, err = formatPercentileField("mysql", "`latency`", 0.95)
+		t.Assert(gerror.Code(err), gcode.CodeNotSupported)
+	})
+}
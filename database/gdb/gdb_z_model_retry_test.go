@@ -0,0 +1,63 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_IsRetryableError(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(IsRetryableError(nil), false)
+		t.Assert(IsRetryableError(driver.ErrBadConn), true)
+		t.Assert(IsRetryableError(fmt.Errorf("query failed: %w", io.ErrUnexpectedEOF)), true)
+		t.Assert(IsRetryableError(gerror.NewCode(gcode.CodeDbDeadlock)), true)
+		t.Assert(IsRetryableError(gerror.WrapCode(
+			gcode.CodeDbOperationError,
+			&classifiedError{error: errors.New("Error 2006: MySQL server has gone away"), code: gcode.CodeDbConnectionLost},
+		)), true)
+		t.Assert(IsRetryableError(gerror.WrapCode(
+			gcode.CodeDbOperationError,
+			&classifiedError{error: errors.New("Error 1062: Duplicate entry"), code: gcode.CodeDbDuplicateKey},
+		)), false)
+		t.Assert(IsRetryableError(errors.New("connection reset by peer")), false)
+	})
+}
+
+func Test_RetryOption_getBackoff(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(RetryOption{}.getBackoff(1), time.Duration(0))
+	})
+	gtest.C(t, func(t *gtest.T) {
+		option := RetryOption{
+			Interval:    100 * time.Millisecond,
+			MaxInterval: 300 * time.Millisecond,
+		}
+		for i := 0; i < 10; i++ {
+			backoff := option.getBackoff(0)
+			t.AssertGE(backoff, 50*time.Millisecond)
+			t.AssertLT(backoff, 100*time.Millisecond)
+
+			backoff = option.getBackoff(1)
+			t.AssertGE(backoff, 100*time.Millisecond)
+			t.AssertLT(backoff, 200*time.Millisecond)
+
+			backoff = option.getBackoff(5)
+			t.AssertGE(backoff, 150*time.Millisecond)
+			t.AssertLT(backoff, 300*time.Millisecond)
+		}
+	})
+}
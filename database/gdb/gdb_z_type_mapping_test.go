@@ -0,0 +1,104 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+type MappingTestBase struct {
+	Id int
+}
+
+type mappingTestUser struct {
+	MappingTestBase
+	Name     string `db:"user_name"`
+	NickName string `orm:"nick"`
+}
+
+func Test_MappingOption_PriorityTags(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			user   *mappingTestUser
+			record = Record{
+				"id":        gvar.New(1),
+				"user_name": gvar.New("john"),
+				"nick":      gvar.New("j"),
+			}
+		)
+		err := record.Struct(&user, MappingOption{PriorityTags: []string{"db"}})
+		t.AssertNil(err)
+		t.Assert(user.Id, 1)
+		t.Assert(user.Name, "john")
+		t.Assert(user.NickName, "j")
+	})
+}
+
+func Test_MappingOption_KeyNormalizer(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			users  []mappingTestUser
+			result = Result{
+				Record{"u_id": gvar.New(1), "u_nick": gvar.New("a")},
+				Record{"u_id": gvar.New(2), "u_nick": gvar.New("b")},
+			}
+		)
+		err := result.Structs(&users, MappingOption{
+			KeyNormalizer: func(key string) string {
+				return strings.TrimPrefix(key, "u_")
+			},
+		})
+		t.AssertNil(err)
+		t.Assert(len(users), 2)
+		t.Assert(users[0].Id, 1)
+		t.Assert(users[0].NickName, "a")
+		t.Assert(users[1].Id, 2)
+		t.Assert(users[1].NickName, "b")
+		// The original result is not changed.
+		t.Assert(result[0]["u_id"], 1)
+	})
+}
+
+func Test_MappingOption_Strict(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			user   *mappingTestUser
+			record = Record{
+				"id":        gvar.New(1),
+				"user_name": gvar.New("john"),
+				"nick_name": gvar.New("j"),
+			}
+		)
+		err := record.Struct(&user, MappingOption{PriorityTags: []string{"db"}, Strict: true})
+		t.AssertNil(err)
+		t.Assert(user.Name, "john")
+		t.Assert(user.NickName, "j")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			users  []*mappingTestUser
+			result = Result{
+				Record{"id": gvar.New(1), "nick": gvar.New("j"), "email": gvar.New("j@a.com"), "age": gvar.New(1)},
+			}
+		)
+		err := result.Structs(&users, MappingOption{Strict: true})
+		t.AssertNE(err, nil)
+		t.Assert(gerror.Code(err), gcode.CodeInvalidParameter)
+		t.Assert(strings.Contains(err.Error(), `"age,email"`), true)
+
+		// Non-strict mode drops the unmapped columns silently.
+		err = result.Structs(&users, MappingOption{})
+		t.AssertNil(err)
+		t.Assert(users[0].NickName, "j")
+	})
+}
@@ -0,0 +1,76 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func newExportTestResult() Result {
+	return Result{
+		Record{"id": gvar.New(1), "name": gvar.New("john"), "remark": gvar.New(`say "hi", bye`)},
+		Record{"id": gvar.New(2), "name": gvar.New("smith"), "remark": nil},
+	}
+}
+
+func Test_Result_ToCSV(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var buffer = bytes.NewBuffer(nil)
+		err := newExportTestResult().ToCSV(buffer)
+		t.AssertNil(err)
+		t.Assert(buffer.String(), "id,name,remark\n1,john,\"say \"\"hi\"\", bye\"\n2,smith,\n")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var buffer = bytes.NewBuffer(nil)
+		err := newExportTestResult().ToCSV(buffer, CSVOption{
+			Columns: []ExportColumn{
+				{Field: "name", Title: "Name"},
+				{Field: "id", Title: "ID", Format: func(value Value) string {
+					return "#" + value.String()
+				}},
+			},
+			Comma:   ';',
+			UseCRLF: true,
+		})
+		t.AssertNil(err)
+		t.Assert(buffer.String(), "Name;ID\r\njohn;#1\r\nsmith;#2\r\n")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var buffer = bytes.NewBuffer(nil)
+		err := newExportTestResult()[:1].ToCSV(buffer, CSVOption{
+			Columns:  []ExportColumn{{Field: "id"}},
+			NoHeader: true,
+		})
+		t.AssertNil(err)
+		t.Assert(buffer.String(), "1\n")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var buffer = bytes.NewBuffer(nil)
+		t.AssertNil(Result{}.ToCSV(buffer))
+		t.Assert(buffer.String(), "")
+	})
+}
+
+func Test_Result_ToNDJSON(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var buffer = bytes.NewBuffer(nil)
+		err := newExportTestResult().ToNDJSON(buffer)
+		t.AssertNil(err)
+		t.Assert(buffer.String(), `{"id":1,"name":"john","remark":"say \"hi\", bye"}`+"\n"+
+			`{"id":2,"name":"smith","remark":null}`+"\n")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		var buffer = bytes.NewBuffer(nil)
+		err := newExportTestResult().ToNDJSON(buffer, ExportColumn{Field: "id", Title: "ID"})
+		t.AssertNil(err)
+		t.Assert(buffer.String(), `{"ID":1}`+"\n"+`{"ID":2}`+"\n")
+	})
+}
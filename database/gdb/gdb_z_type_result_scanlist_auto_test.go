@@ -0,0 +1,88 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdb
+
+import (
+	"testing"
+
+	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+type scanListAutoUser struct {
+	Uid  int
+	Name string
+}
+
+type scanListAutoUserDetail struct {
+	Uid     int
+	Address string
+}
+
+type scanListAutoUserScore struct {
+	Id    int
+	Uid   int
+	Score int
+}
+
+type scanListAutoEntity struct {
+	User       *scanListAutoUser
+	UserDetail *scanListAutoUserDetail  `orm:"from:User, link:uid=Uid"`
+	UserScores []*scanListAutoUserScore `orm:"from:User, link:uid=Uid"`
+}
+
+func Test_Result_ScanListAuto(t *testing.T) {
+	var (
+		users = Result{
+			Record{"uid": gvar.New(1), "name": gvar.New("john")},
+			Record{"uid": gvar.New(2), "name": gvar.New("smith")},
+		}
+		details = Result{
+			Record{"uid": gvar.New(1), "address": gvar.New("address_1")},
+			Record{"uid": gvar.New(2), "address": gvar.New("address_2")},
+		}
+		scores = Result{
+			Record{"id": gvar.New(1), "uid": gvar.New(1), "score": gvar.New(80)},
+			Record{"id": gvar.New(2), "uid": gvar.New(1), "score": gvar.New(90)},
+			Record{"id": gvar.New(3), "uid": gvar.New(2), "score": gvar.New(100)},
+		}
+	)
+	// Bind with attribute name.
+	gtest.C(t, func(t *gtest.T) {
+		var entities []*scanListAutoEntity
+		t.AssertNil(users.ScanListAuto(&entities, "User"))
+		t.AssertNil(details.ScanListAuto(&entities, "UserDetail"))
+		t.AssertNil(scores.ScanListAuto(&entities, "UserScores"))
+		t.Assert(len(entities), 2)
+		t.Assert(entities[0].User.Name, "john")
+		t.Assert(entities[0].UserDetail.Address, "address_1")
+		t.Assert(len(entities[0].UserScores), 2)
+		t.Assert(entities[0].UserScores[1].Score, 90)
+		t.Assert(entities[1].User.Name, "smith")
+		t.Assert(entities[1].UserDetail.Address, "address_2")
+		t.Assert(len(entities[1].UserScores), 1)
+		t.Assert(entities[1].UserScores[0].Score, 100)
+	})
+	// Bind with inferred attribute.
+	gtest.C(t, func(t *gtest.T) {
+		var entities []scanListAutoEntity
+		t.AssertNil(users.ScanListAuto(&entities))
+		t.AssertNil(details.ScanListAuto(&entities))
+		t.AssertNil(scores.ScanListAuto(&entities))
+		t.Assert(len(entities), 2)
+		t.Assert(entities[1].User.Uid, 2)
+		t.Assert(entities[1].UserDetail.Address, "address_2")
+		t.Assert(entities[1].UserScores[0].Id, 3)
+	})
+	// Ambiguous and invalid attributes.
+	gtest.C(t, func(t *gtest.T) {
+		var entities []scanListAutoEntity
+		t.AssertNE(Result{Record{"uid": gvar.New(1)}}.ScanListAuto(&entities), nil)
+		t.AssertNE(Result{Record{"unknown": gvar.New(1)}}.ScanListAuto(&entities), nil)
+		t.AssertNE(users.ScanListAuto(&entities, "Unknown"), nil)
+	})
+}
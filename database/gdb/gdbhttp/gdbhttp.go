@@ -0,0 +1,96 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+// Package gdbhttp provides the HTTP helpers of gdb, like the middleware running requests in transaction.
+package gdbhttp
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/net/ghttp"
+)
+
+// TransactionOption is the option for MiddlewareTransaction.
+type TransactionOption struct {
+	// TxOptions is the options of the transaction, like isolation level and read-only.
+	// Its propagation is gdb.PropagationRequired in default, so that the handlers calling
+	// Transaction of the same database group join the request transaction.
+	TxOptions gdb.TxOptions
+
+	// Methods are the request methods the middleware handles, which are all methods in default.
+	Methods []string
+}
+
+// errTransactionRollback is the internal error rolling back the transaction of non-2xx responses.
+var errTransactionRollback = gerror.NewCode(gcode.CodeOperationFailed, "transaction rolled back for response status")
+
+// MiddlewareTransaction returns a middleware that executes each request in a transaction of `db`,
+// which is injected into the request context, so that the models created with the request context
+// like `db.Model(table).Ctx(r.Context())` join the transaction automatically.
+//
+// The transaction is committed if the response status is 2xx without error, and is rolled back
+// if the handler returns error, panics, or responds with other status.
+// It responds with status 500 if the transaction fails to be committed.
+//
+// It is usually registered for the route groups that write data, eg:
+//
+//	s.Group("/api", func(group *ghttp.RouterGroup) {
+//		group.Middleware(gdbhttp.MiddlewareTransaction(g.DB()))
+//	})
+func MiddlewareTransaction(db gdb.DB, option ...TransactionOption) ghttp.HandlerFunc {
+	var opt TransactionOption
+	if len(option) > 0 {
+		opt = option[0]
+	}
+	if opt.TxOptions.Propagation == "" {
+		opt.TxOptions.Propagation = gdb.PropagationRequired
+	}
+	methods := make(map[string]struct{}, len(opt.Methods))
+	for _, method := range opt.Methods {
+		methods[strings.ToUpper(method)] = struct{}{}
+	}
+	return func(r *ghttp.Request) {
+		if _, ok := methods[r.Method]; len(methods) > 0 && !ok {
+			r.Middleware.Next()
+			return
+		}
+		var (
+			originalCtx = r.Context()
+			executed    bool
+		)
+		err := db.TransactionWithOptions(originalCtx, opt.TxOptions, func(ctx context.Context, tx gdb.TX) error {
+			executed = true
+			r.SetCtx(ctx)
+			r.Middleware.Next()
+			if err := r.GetError(); err != nil {
+				return err
+			}
+			if status := r.Response.Status; status != 0 && (status < 200 || status >= 300) {
+				return errTransactionRollback
+			}
+			return nil
+		})
+		// The transaction is done, which should not be used by the following handlers.
+		r.SetCtx(gdb.WithoutTX(r.Context(), db.GetGroup()))
+		switch {
+		case err == nil, err == errTransactionRollback:
+		case !executed:
+			// It fails to begin the transaction.
+			r.SetError(err)
+			r.Response.WriteStatus(http.StatusInternalServerError)
+		case r.GetError() == nil:
+			// It fails to commit the transaction.
+			r.SetError(err)
+			r.Response.ClearBuffer()
+			r.Response.WriteStatus(http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gdebug
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"runtime"
+	"runtime/pprof"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/json"
+)
+
+// BundleCollector is the function collecting the runtime information of a component for
+// profiling snapshot bundle. The returned value is encoded as JSON in the bundle.
+type BundleCollector func(ctx context.Context) (any, error)
+
+var (
+	// bundleCollectors is the registered collectors for profiling snapshot bundle.
+	bundleCollectors = make(map[string]BundleCollector)
+	// bundleMu is the mutex for bundleCollectors.
+	bundleMu sync.RWMutex
+	// bundleProfiles is the runtime profiles in the bundle, along with their debug levels.
+	bundleProfiles = []struct {
+		Name  string
+		Debug int
+	}{
+		{Name: "goroutine", Debug: 2},
+		{Name: "heap", Debug: 0},
+		{Name: "block", Debug: 0},
+		{Name: "mutex", Debug: 0},
+	}
+)
+
+// RegisterBundleCollector registers the collector with `name` for profiling snapshot bundle,
+// which overwrites the existing one of the same name. The components like database and cache
+// register their collectors for statistics when they are imported.
+//
+// Note that the collector should not return any secret like password, as the bundle is usually
+// attached to support tickets.
+func RegisterBundleCollector(name string, collector BundleCollector) {
+	bundleMu.Lock()
+	defer bundleMu.Unlock()
+	bundleCollectors[name] = collector
+}
+
+// WriteBundle captures the profiling snapshot of current process and writes it as a tar.gz archive
+// to `writer`. The bundle contains the goroutine, heap, block and mutex profiles, the runtime
+// information and the results of all registered collectors.
+//
+// The error of single collector does not stop the bundle writing, which is recorded in the bundle
+// as the content of the collector instead.
+func WriteBundle(ctx context.Context, writer io.Writer) (err error) {
+	var (
+		now       = time.Now()
+		gzWriter  = gzip.NewWriter(writer)
+		tarWriter = tar.NewWriter(gzWriter)
+		buffer    = bytes.NewBuffer(nil)
+	)
+	defer func() {
+		if closeErr := tarWriter.Close(); err == nil && closeErr != nil {
+			err = gerror.Wrap(closeErr, `close tar writer failed`)
+		}
+		if closeErr := gzWriter.Close(); err == nil && closeErr != nil {
+			err = gerror.Wrap(closeErr, `close gzip writer failed`)
+		}
+	}()
+	// Runtime profiles.
+	for _, item := range bundleProfiles {
+		profile := pprof.Lookup(item.Name)
+		if profile == nil {
+			continue
+		}
+		buffer.Reset()
+		if err = profile.WriteTo(buffer, item.Debug); err != nil {
+			return gerror.Wrapf(err, `write profile "%s" failed`, item.Name)
+		}
+		fileName := item.Name + ".pb.gz"
+		if item.Debug > 0 {
+			fileName = item.Name + ".txt"
+		}
+		if err = writeBundleFile(tarWriter, fileName, buffer.Bytes(), now); err != nil {
+			return err
+		}
+	}
+	// Runtime information.
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	if err = writeBundleJson(tarWriter, "runtime.json", map[string]any{
+		"time":       now,
+		"goVersion":  runtime.Version(),
+		"goOS":       runtime.GOOS,
+		"goArch":     runtime.GOARCH,
+		"numCPU":     runtime.NumCPU(),
+		"gomaxprocs": runtime.GOMAXPROCS(0),
+		"goroutines": runtime.NumGoroutine(),
+		"binary":     BinVersion(),
+		"memStats":   memStats,
+	}, now); err != nil {
+		return err
+	}
+	// Registered collectors.
+	bundleMu.RLock()
+	var (
+		names      = make([]string, 0, len(bundleCollectors))
+		collectors = make(map[string]BundleCollector, len(bundleCollectors))
+	)
+	for name, collector := range bundleCollectors {
+		names = append(names, name)
+		collectors[name] = collector
+	}
+	bundleMu.RUnlock()
+	sort.Strings(names)
+	for _, name := range names {
+		data, collectErr := collectors[name](ctx)
+		if collectErr != nil {
+			data = map[string]any{"error": collectErr.Error()}
+		}
+		if err = writeBundleJson(tarWriter, name+".json", data, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeBundleJson encodes `data` as indented JSON and writes it as file `name` into the bundle.
+func writeBundleJson(tarWriter *tar.Writer, name string, data any, modTime time.Time) error {
+	content, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return gerror.Wrapf(err, `encode bundle file "%s" failed`, name)
+	}
+	return writeBundleFile(tarWriter, name, content, modTime)
+}
+
+// writeBundleFile writes `content` as file `name` into the bundle.
+func writeBundleFile(tarWriter *tar.Writer, name string, content []byte, modTime time.Time) error {
+	err := tarWriter.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(content)),
+		ModTime: modTime,
+	})
+	if err != nil {
+		return gerror.Wrapf(err, `write bundle file header "%s" failed`, name)
+	}
+	if _, err = tarWriter.Write(content); err != nil {
+		return gerror.Wrapf(err, `write bundle file "%s" failed`, name)
+	}
+	return nil
+}
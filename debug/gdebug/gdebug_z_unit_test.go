@@ -7,7 +7,13 @@
 package gdebug_test
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/gogf/gf/v2/debug/gdebug"
@@ -99,3 +105,38 @@ func Test_BinVersionMd5(t *testing.T) {
 		t.AssertGT(len(gdebug.BinVersionMd5()), 0)
 	})
 }
+
+func Test_WriteBundle(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		gdebug.RegisterBundleCollector("test-ok", func(ctx context.Context) (any, error) {
+			return map[string]any{"k": "v"}, nil
+		})
+		gdebug.RegisterBundleCollector("test-error", func(ctx context.Context) (any, error) {
+			return nil, errors.New("collect failed")
+		})
+		buffer := bytes.NewBuffer(nil)
+		t.AssertNil(gdebug.WriteBundle(context.TODO(), buffer))
+
+		gzReader, err := gzip.NewReader(buffer)
+		t.AssertNil(err)
+		var (
+			files     = make(map[string]string)
+			tarReader = tar.NewReader(gzReader)
+		)
+		for {
+			header, err := tarReader.Next()
+			if err == io.EOF {
+				break
+			}
+			t.AssertNil(err)
+			content, err := io.ReadAll(tarReader)
+			t.AssertNil(err)
+			files[header.Name] = string(content)
+		}
+		t.Assert(gstr.Contains(files["goroutine.txt"], "Test_WriteBundle"), true)
+		t.AssertNE(files["heap.pb.gz"], "")
+		t.Assert(gstr.Contains(files["runtime.json"], "goVersion"), true)
+		t.Assert(gstr.Contains(files["test-ok.json"], `"k": "v"`), true)
+		t.Assert(gstr.Contains(files["test-error.json"], "collect failed"), true)
+	})
+}
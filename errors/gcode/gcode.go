@@ -26,28 +26,34 @@ type Code interface {
 // ================================================================================================================
 
 var (
-	CodeNil                       = localCode{-1, "", nil}                             // No error code specified.
-	CodeOK                        = localCode{0, "OK", nil}                            // It is OK.
-	CodeInternalError             = localCode{50, "Internal Error", nil}               // An error occurred internally.
-	CodeValidationFailed          = localCode{51, "Validation Failed", nil}            // Data validation failed.
-	CodeDbOperationError          = localCode{52, "Database Operation Error", nil}     // Database operation error.
-	CodeInvalidParameter          = localCode{53, "Invalid Parameter", nil}            // The given parameter for current operation is invalid.
-	CodeMissingParameter          = localCode{54, "Missing Parameter", nil}            // Parameter for current operation is missing.
-	CodeInvalidOperation          = localCode{55, "Invalid Operation", nil}            // The function cannot be used like this.
-	CodeInvalidConfiguration      = localCode{56, "Invalid Configuration", nil}        // The configuration is invalid for current operation.
-	CodeMissingConfiguration      = localCode{57, "Missing Configuration", nil}        // The configuration is missing for current operation.
-	CodeNotImplemented            = localCode{58, "Not Implemented", nil}              // The operation is not implemented yet.
-	CodeNotSupported              = localCode{59, "Not Supported", nil}                // The operation is not supported yet.
-	CodeOperationFailed           = localCode{60, "Operation Failed", nil}             // I tried, but I cannot give you what you want.
-	CodeNotAuthorized             = localCode{61, "Not Authorized", nil}               // Not Authorized.
-	CodeSecurityReason            = localCode{62, "Security Reason", nil}              // Security Reason.
-	CodeServerBusy                = localCode{63, "Server Is Busy", nil}               // Server is busy, please try again later.
-	CodeUnknown                   = localCode{64, "Unknown Error", nil}                // Unknown error.
-	CodeNotFound                  = localCode{65, "Not Found", nil}                    // Resource does not exist.
-	CodeInvalidRequest            = localCode{66, "Invalid Request", nil}              // Invalid request.
-	CodeNecessaryPackageNotImport = localCode{67, "Necessary Package Not Import", nil} // It needs necessary package import.
-	CodeInternalPanic             = localCode{68, "Internal Panic", nil}               // A panic occurred internally.
-	CodeBusinessValidationFailed  = localCode{300, "Business Validation Failed", nil}  // Business validation failed.
+	CodeNil                       = localCode{-1, "", nil}                               // No error code specified.
+	CodeOK                        = localCode{0, "OK", nil}                              // It is OK.
+	CodeInternalError             = localCode{50, "Internal Error", nil}                 // An error occurred internally.
+	CodeValidationFailed          = localCode{51, "Validation Failed", nil}              // Data validation failed.
+	CodeDbOperationError          = localCode{52, "Database Operation Error", nil}       // Database operation error.
+	CodeInvalidParameter          = localCode{53, "Invalid Parameter", nil}              // The given parameter for current operation is invalid.
+	CodeMissingParameter          = localCode{54, "Missing Parameter", nil}              // Parameter for current operation is missing.
+	CodeInvalidOperation          = localCode{55, "Invalid Operation", nil}              // The function cannot be used like this.
+	CodeInvalidConfiguration      = localCode{56, "Invalid Configuration", nil}          // The configuration is invalid for current operation.
+	CodeMissingConfiguration      = localCode{57, "Missing Configuration", nil}          // The configuration is missing for current operation.
+	CodeNotImplemented            = localCode{58, "Not Implemented", nil}                // The operation is not implemented yet.
+	CodeNotSupported              = localCode{59, "Not Supported", nil}                  // The operation is not supported yet.
+	CodeOperationFailed           = localCode{60, "Operation Failed", nil}               // I tried, but I cannot give you what you want.
+	CodeNotAuthorized             = localCode{61, "Not Authorized", nil}                 // Not Authorized.
+	CodeSecurityReason            = localCode{62, "Security Reason", nil}                // Security Reason.
+	CodeServerBusy                = localCode{63, "Server Is Busy", nil}                 // Server is busy, please try again later.
+	CodeUnknown                   = localCode{64, "Unknown Error", nil}                  // Unknown error.
+	CodeNotFound                  = localCode{65, "Not Found", nil}                      // Resource does not exist.
+	CodeInvalidRequest            = localCode{66, "Invalid Request", nil}                // Invalid request.
+	CodeNecessaryPackageNotImport = localCode{67, "Necessary Package Not Import", nil}   // It needs necessary package import.
+	CodeInternalPanic             = localCode{68, "Internal Panic", nil}                 // A panic occurred internally.
+	CodeDbDuplicateKey            = localCode{69, "Database Duplicate Key", nil}         // Unique or primary key constraint is violated.
+	CodeDbForeignKeyViolation     = localCode{70, "Database Foreign Key Violation", nil} // Foreign key constraint is violated.
+	CodeDbDeadlock                = localCode{71, "Database Deadlock", nil}              // Transaction is aborted for deadlock.
+	CodeDbSerializationFailure    = localCode{72, "Database Serialization Failure", nil} // Transaction is aborted for serialization failure.
+	CodeDbConnectionLost          = localCode{73, "Database Connection Lost", nil}       // Connection to database is lost.
+	CodeDbSyntaxError             = localCode{74, "Database Syntax Error", nil}          // Statement has syntax error.
+	CodeBusinessValidationFailed  = localCode{300, "Business Validation Failed", nil}    // Business validation failed.
 )
 
 // New creates and returns an error code.
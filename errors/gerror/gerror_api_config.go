@@ -0,0 +1,79 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gerror
+
+import (
+	"sync/atomic"
+
+	"github.com/gogf/gf/v2/internal/errors"
+)
+
+// StackConfig is the configuration for error stack printing.
+type StackConfig struct {
+	// Brief specifies trimming the stack frames of the framework internal packages.
+	// It's true in default, which can also be configured using command option or environment
+	// "gf.gerror.stack.mode" with value "brief" or "detail". It keeps current mode if it is nil.
+	Brief *bool
+
+	// Filters trims the stack frames whose file path contains any of the given keywords,
+	// eg: "/vendor/", "/pkg/mod/".
+	Filters []string
+
+	// MaxDepth caps the frame count for stack of each error, 0 means no limitation.
+	MaxDepth int
+
+	// SnippetFrames specifies capturing source code snippets for top N frames of the
+	// stack of each error, 0 means disabled. Note that the snippets are only available
+	// if the source files exist on the running machine.
+	SnippetFrames int
+
+	// SnippetLines is the line count captured before and after the frame line, it's 2 in default.
+	SnippetLines int
+}
+
+const (
+	defaultSnippetLines = 2
+)
+
+// stackConfig is the configuration for error stack printing.
+var stackConfig atomic.Pointer[StackConfig]
+
+func init() {
+	stackConfig.Store(&StackConfig{
+		SnippetLines: defaultSnippetLines,
+	})
+}
+
+// SetStackConfig sets the configuration for error stack printing, which takes effect
+// on all errors when their stacks are printed.
+func SetStackConfig(config StackConfig) {
+	if config.SnippetLines <= 0 {
+		config.SnippetLines = defaultSnippetLines
+	}
+	if config.Brief != nil {
+		if *config.Brief {
+			errors.SetStackMode(errors.StackModeBrief)
+		} else {
+			errors.SetStackMode(errors.StackModeDetail)
+		}
+	}
+	// The stack mode is stored by package errors, not the configuration.
+	config.Brief = nil
+	config.Filters = append([]string(nil), config.Filters...)
+	stackConfig.Store(&config)
+}
+
+// GetStackConfig returns the configuration for error stack printing.
+func GetStackConfig() StackConfig {
+	var (
+		config = *stackConfig.Load()
+		brief  = errors.IsStackModeBrief()
+	)
+	config.Brief = &brief
+	config.Filters = append([]string(nil), config.Filters...)
+	return config
+}
@@ -7,9 +7,11 @@
 package gerror
 
 import (
+	"bufio"
 	"bytes"
 	"container/list"
 	"fmt"
+	"os"
 	"runtime"
 	"strings"
 
@@ -28,6 +30,8 @@ type stackInfo struct {
 type stackLine struct {
 	Function string // Function name, which contains its full package path.
 	FileLine string // FileLine is the source file name and its line number of Function.
+	File     string // File is the source file name of Function.
+	Line     int    // Line is the line number in the source file of Function.
 }
 
 // Stack returns the error stack information as string.
@@ -40,6 +44,7 @@ func (err *Error) Stack() string {
 		index            = 1
 		infos            []*stackInfo
 		isStackModeBrief = errors.IsStackModeBrief()
+		config           = stackConfig.Load()
 	)
 	for loop != nil {
 		info := &stackInfo{
@@ -48,7 +53,7 @@ func (err *Error) Stack() string {
 		}
 		index++
 		infos = append(infos, info)
-		loopLinesOfStackInfo(loop.stack, info, isStackModeBrief)
+		loopLinesOfStackInfo(loop.stack, info, isStackModeBrief, config.Filters)
 		if loop.error != nil {
 			if e, ok := loop.error.(*Error); ok {
 				loop = e
@@ -65,7 +70,10 @@ func (err *Error) Stack() string {
 		}
 	}
 	filterLinesOfStackInfos(infos)
-	return formatStackInfos(infos)
+	if config.MaxDepth > 0 {
+		limitLinesOfStackInfos(infos, config.MaxDepth)
+	}
+	return formatStackInfos(infos, config)
 }
 
 // filterLinesOfStackInfos removes repeated lines, which exist in subsequent stacks, from top errors.
@@ -99,20 +107,32 @@ func filterLinesOfStackInfos(infos []*stackInfo) {
 	}
 }
 
+// limitLinesOfStackInfos removes the lines exceeding `maxDepth` from each stack.
+func limitLinesOfStackInfos(infos []*stackInfo, maxDepth int) {
+	for _, info := range infos {
+		if info.Lines == nil {
+			continue
+		}
+		for info.Lines.Len() > maxDepth {
+			info.Lines.Remove(info.Lines.Back())
+		}
+	}
+}
+
 // formatStackInfos formats and returns error stack information as string.
-func formatStackInfos(infos []*stackInfo) string {
+func formatStackInfos(infos []*stackInfo, config *StackConfig) string {
 	buffer := bytes.NewBuffer(nil)
 	for i, info := range infos {
 		fmt.Fprintf(buffer, "%d. %s\n", i+1, info.Message)
 		if info.Lines != nil && info.Lines.Len() > 0 {
-			formatStackLines(buffer, info.Lines)
+			formatStackLines(buffer, info.Lines, config)
 		}
 	}
 	return buffer.String()
 }
 
 // formatStackLines formats and returns error stack lines as string.
-func formatStackLines(buffer *bytes.Buffer, lines *list.List) string {
+func formatStackLines(buffer *bytes.Buffer, lines *list.List, config *StackConfig) string {
 	var (
 		line   *stackLine
 		space  = "  "
@@ -129,12 +149,46 @@ func formatStackLines(buffer *bytes.Buffer, lines *list.List) string {
 			"   %d).%s%s\n        %s\n",
 			i+1, space, line.Function, line.FileLine,
 		)
+		if i < config.SnippetFrames {
+			formatSourceSnippet(buffer, line.File, line.Line, config.SnippetLines)
+		}
 	}
 	return buffer.String()
 }
 
+// formatSourceSnippet formats the source lines around line `line` of `file`,
+// it does nothing if the source file cannot be read.
+func formatSourceSnippet(buffer *bytes.Buffer, file string, line, around int) {
+	f, err := os.Open(file)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	var (
+		scanner = bufio.NewScanner(f)
+		start   = max(line-around, 1)
+		end     = line + around
+		width   = len(fmt.Sprint(end))
+		current = 0
+	)
+	for scanner.Scan() {
+		current++
+		if current < start {
+			continue
+		}
+		if current > end {
+			break
+		}
+		marker := " "
+		if current == line {
+			marker = ">"
+		}
+		fmt.Fprintf(buffer, "        %s %*d | %s\n", marker, width, current, scanner.Text())
+	}
+}
+
 // loopLinesOfStackInfo iterates the stack info lines and produces the stack line info.
-func loopLinesOfStackInfo(st stack, info *stackInfo, isStackModeBrief bool) {
+func loopLinesOfStackInfo(st stack, info *stackInfo, isStackModeBrief bool, filters []string) {
 	if st == nil {
 		return
 	}
@@ -152,6 +206,10 @@ func loopLinesOfStackInfo(st stack, info *stackInfo, isStackModeBrief bool) {
 					continue
 				}
 			}
+			// Custom stack filtering.
+			if isStackLineFiltered(file, filters) {
+				continue
+			}
 			// Avoid stack string like "`autogenerated`"
 			if strings.Contains(file, "<") {
 				continue
@@ -168,7 +226,19 @@ func loopLinesOfStackInfo(st stack, info *stackInfo, isStackModeBrief bool) {
 			info.Lines.PushBack(&stackLine{
 				Function: fn.Name(),
 				FileLine: fmt.Sprintf(`%s:%d`, file, line),
+				File:     file,
+				Line:     line,
 			})
 		}
 	}
 }
+
+// isStackLineFiltered checks whether the stack line of `file` should be filtered by `filters`.
+func isStackLineFiltered(file string, filters []string) bool {
+	for _, filter := range filters {
+		if filter != "" && strings.Contains(file, filter) {
+			return true
+		}
+	}
+	return false
+}
@@ -9,6 +9,7 @@ package gerror_test
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/gogf/gf/v2/errors/gcode"
@@ -318,6 +319,51 @@ func Test_Stack(t *testing.T) {
 	})
 }
 
+func Test_StackConfig(t *testing.T) {
+	config := gerror.GetStackConfig()
+	defer gerror.SetStackConfig(config)
+
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(*config.Brief, true)
+		t.Assert(config.SnippetLines, 2)
+
+		// The zero value keeps the brief mode.
+		gerror.SetStackConfig(gerror.StackConfig{})
+		t.Assert(*gerror.GetStackConfig().Brief, true)
+
+		brief := false
+		gerror.SetStackConfig(gerror.StackConfig{Brief: &brief})
+		t.Assert(*gerror.GetStackConfig().Brief, false)
+		stack := gerror.Stack(gerror.New("1"))
+		t.Assert(strings.Contains(stack, "/test/gtest/"), true)
+		t.Assert(strings.Count(stack, "1). ") > 0, true)
+
+		gerror.SetStackConfig(gerror.StackConfig{
+			MaxDepth: 1,
+		})
+		stack = gerror.Stack(gerror.New("1"))
+		t.Assert(strings.Count(stack, "). "), 1)
+
+		gerror.SetStackConfig(gerror.StackConfig{
+			Filters: []string{"/test/gtest/"},
+		})
+		stack = gerror.Stack(gerror.New("1"))
+		t.Assert(strings.Contains(stack, "/test/gtest/"), false)
+	})
+
+	gtest.C(t, func(t *gtest.T) {
+		gerror.SetStackConfig(gerror.StackConfig{
+			MaxDepth:      2,
+			SnippetFrames: 1,
+			SnippetLines:  1,
+		})
+		stack := gerror.Stack(gerror.New("1"))
+		t.Assert(strings.Count(stack, " | "), 3)
+		t.Assert(strings.Count(stack, "> "), 1)
+		t.Assert(gerror.GetStackConfig().SnippetFrames, 1)
+	})
+}
+
 func Test_Current(t *testing.T) {
 	gtest.C(t, func(t *gtest.T) {
 		t.Assert(gerror.Current(nil), nil)
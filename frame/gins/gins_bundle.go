@@ -0,0 +1,69 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gins
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/debug/gdebug"
+	"github.com/gogf/gf/v2/internal/instance"
+	"github.com/gogf/gf/v2/util/gutil"
+)
+
+func init() {
+	gdebug.RegisterBundleCollector("config", collectBundleConfig)
+	gdebug.RegisterBundleCollector("database", collectBundleDatabase)
+}
+
+// collectBundleConfig dumps the default configuration with secrets redacted for profiling snapshot bundle.
+func collectBundleConfig(ctx context.Context) (any, error) {
+	config := Config()
+	if config == nil {
+		return nil, nil
+	}
+	data, err := config.Data(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return gutil.MapRedact(data), nil
+}
+
+// collectBundleDatabase collects the connection pool statistics of the created database instances
+// for profiling snapshot bundle.
+func collectBundleDatabase(ctx context.Context) (any, error) {
+	var (
+		groups = make([]string, 0)
+		result = make(map[string]any)
+	)
+	for group := range gdb.GetAllConfig() {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	for _, group := range groups {
+		db, ok := instance.Get(fmt.Sprintf("%s.%s", frameCoreComponentNameDatabase, group)).(gdb.DB)
+		if !ok || db == nil {
+			continue
+		}
+		nodes := make([]map[string]any, 0)
+		for _, item := range db.Stats(ctx) {
+			node := item.Node()
+			nodes = append(nodes, map[string]any{
+				"type":  node.Type,
+				"role":  node.Role,
+				"host":  node.Host,
+				"port":  node.Port,
+				"name":  node.Name,
+				"stats": item.Stats(),
+			})
+		}
+		result[group] = nodes
+	}
+	return result, nil
+}
@@ -0,0 +1,171 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gi18n
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Plural categories of CLDR plural rules.
+const (
+	PluralZero  = "zero"
+	PluralOne   = "one"
+	PluralFew   = "few"
+	PluralMany  = "many"
+	PluralOther = "other"
+)
+
+// Tp is alias of TranslatePlural for convenience.
+func Tp(ctx context.Context, key string, count int, values ...any) string {
+	return Instance().TranslatePlural(ctx, key, count, values...)
+}
+
+// TranslatePlural translates the pluralized content of `key` for `count` with configured language.
+// See Manager.TranslatePlural.
+func TranslatePlural(ctx context.Context, key string, count int, values ...any) string {
+	return Instance().TranslatePlural(ctx, key, count, values...)
+}
+
+// Tp is alias of TranslatePlural for convenience.
+func (m *Manager) Tp(ctx context.Context, key string, count int, values ...any) string {
+	return m.TranslatePlural(ctx, key, count, values...)
+}
+
+// TranslatePlural translates the pluralized content of `key` for `count` with configured language.
+//
+// It searches the content with key "key.CATEGORY" in priority, in which the CATEGORY is the plural
+// category of `count` for the language, like "one", "few", "many" and "other". It uses "key.zero" if
+// `count` is 0 and it is configured, and it falls back to "key.other" and then "key".
+//
+// The content is formatted with `count` as the first value and `values` as the others if it contains
+// formatting verbs, for example:
+//
+//	"cart.items.one"   = "%d item in cart"
+//	"cart.items.other" = "%d items in cart"
+//
+// The content without formatting verbs is returned as it is, so a literal "%" like "100% off" is kept.
+func (m *Manager) TranslatePlural(ctx context.Context, key string, count int, values ...any) string {
+	var (
+		content  string
+		category = PluralCategory(m.getLanguage(ctx), count)
+	)
+	if count == 0 {
+		content = m.GetContent(ctx, key+"."+PluralZero)
+	}
+	if content == "" {
+		content = m.GetContent(ctx, key+"."+category)
+	}
+	if content == "" && category != PluralOther {
+		content = m.GetContent(ctx, key+"."+PluralOther)
+	}
+	if content == "" {
+		content = m.Translate(ctx, key)
+	}
+	if !hasFormatVerb(content) {
+		return content
+	}
+	return fmt.Sprintf(content, append([]any{count}, values...)...)
+}
+
+// hasFormatVerb checks whether `content` contains formatting verbs consuming arguments, like "%d" and "%.2f".
+// The "%%" is not a verb, and neither is the "%" not followed by a verb, like "100%" and "100% off".
+func hasFormatVerb(content string) bool {
+	for i := 0; i < len(content); i++ {
+		if content[i] != '%' {
+			continue
+		}
+		j := i + 1
+		for j < len(content) && strings.IndexByte("+-#0123456789.[]", content[j]) >= 0 {
+			j++
+		}
+		if j >= len(content) {
+			return false
+		}
+		if content[j] == '%' {
+			i = j
+			continue
+		}
+		if strings.IndexByte("vTtbcdoOqxXUeEfFgGsp", content[j]) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// getLanguage returns the translating language of the manager, which is from context in priority.
+func (m *Manager) getLanguage(ctx context.Context) string {
+	if lang := LanguageFromCtx(ctx); lang != "" {
+		return lang
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.options.Language
+}
+
+// PluralCategory returns the plural category of `count` for `language` following the CLDR plural rules
+// of cardinal integers. It supports the common languages and uses the English rules for the others.
+func PluralCategory(language string, count int) string {
+	if count < 0 {
+		count = -count
+	}
+	var (
+		mod10  = count % 10
+		mod100 = count % 100
+	)
+	language = strings.ToLower(language)
+	if index := strings.IndexAny(language, "-_"); index > 0 {
+		language = language[:index]
+	}
+	switch language {
+	case "zh", "ja", "ko", "th", "vi", "id", "ms", "lo", "my":
+		return PluralOther
+
+	case "fr":
+		if count == 0 || count == 1 {
+			return PluralOne
+		}
+		return PluralOther
+
+	case "ru", "uk", "be", "sr", "hr", "bs":
+		switch {
+		case mod10 == 1 && mod100 != 11:
+			return PluralOne
+		case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+			return PluralFew
+		default:
+			return PluralMany
+		}
+
+	case "pl":
+		switch {
+		case count == 1:
+			return PluralOne
+		case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+			return PluralFew
+		default:
+			return PluralMany
+		}
+
+	case "cs", "sk":
+		switch {
+		case count == 1:
+			return PluralOne
+		case count >= 2 && count <= 4:
+			return PluralFew
+		default:
+			return PluralOther
+		}
+
+	default:
+		if count == 1 {
+			return PluralOne
+		}
+		return PluralOther
+	}
+}
@@ -288,3 +288,41 @@ func Test_Issue_Yaml(t *testing.T) {
 		t.Assert(i18n.T(ctx, "{#resourceUsage.workflow}"), "workflow")
 	})
 }
+
+func Test_TranslatePlural(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			ctx  = context.Background()
+			i18n = gi18n.New(gi18n.Options{
+				Path:     gtest.DataPath("i18n-plural"),
+				Language: "en",
+			})
+		)
+		t.Assert(i18n.Tp(ctx, "cart.items", 0), "Your cart is empty")
+		t.Assert(i18n.Tp(ctx, "cart.items", 1), "1 item in cart")
+		t.Assert(i18n.Tp(ctx, "cart.items", 5), "5 items in cart")
+		t.Assert(i18n.Tp(ctx, "messages", 1, "john"), "1 messages from john")
+		t.Assert(i18n.Tp(ctx, "none", 2), "none")
+		t.Assert(i18n.Tp(ctx, "discount", 2), "100% off")
+		t.Assert(i18n.Tp(ctx, "progress", 50), "50% done")
+
+		ctx = gi18n.WithLanguage(ctx, "ru")
+		t.Assert(i18n.Tp(ctx, "cart.items", 1), "1 товар в корзине")
+		t.Assert(i18n.Tp(ctx, "cart.items", 3), "3 товара в корзине")
+		t.Assert(i18n.Tp(ctx, "cart.items", 11), "11 товаров в корзине")
+		t.Assert(i18n.Tp(ctx, "cart.items", 21), "21 товар в корзине")
+
+		ctx = gi18n.WithLanguage(ctx, "zh-CN")
+		t.Assert(i18n.Tp(ctx, "cart.items", 1), "购物车中有1件商品")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gi18n.PluralCategory("en-US", 1), gi18n.PluralOne)
+		t.Assert(gi18n.PluralCategory("en", 0), gi18n.PluralOther)
+		t.Assert(gi18n.PluralCategory("fr", 0), gi18n.PluralOne)
+		t.Assert(gi18n.PluralCategory("ru", 22), gi18n.PluralFew)
+		t.Assert(gi18n.PluralCategory("ru", 12), gi18n.PluralMany)
+		t.Assert(gi18n.PluralCategory("pl", 25), gi18n.PluralMany)
+		t.Assert(gi18n.PluralCategory("cs", 3), gi18n.PluralFew)
+		t.Assert(gi18n.PluralCategory("zh_CN", 1), gi18n.PluralOther)
+	})
+}
@@ -47,15 +47,26 @@ func Copy(src any) any {
 			dst      = reflect.New(original.Type()).Elem() // Make a copy of the same type as the original.
 		)
 		// Recursively copy the original.
-		copyRecursive(original, dst)
+		copyRecursive(original, dst, make(visitedMap))
 		// Return the copy as an interface.
 		return dst.Interface()
 	}
 }
 
+// visitedKey is the key of the copied reference value, which is its address and type.
+type visitedKey struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+// visitedMap stores the copied reference values to their copies,
+// which makes the circular references copied as the circular references of the copies.
+type visitedMap map[visitedKey]reflect.Value
+
 // copyRecursive does the actual copying of the interface. It currently has
 // limited support for what it can handle. Add as needed.
-func copyRecursive(original, cpy reflect.Value) {
+func copyRecursive(original, cpy reflect.Value, visited visitedMap) {
 	// check for implement deepcopy.Interface
 	if original.CanInterface() && original.IsValid() && !original.IsZero() {
 		if copier, ok := original.Interface().(Interface); ok {
@@ -74,8 +85,14 @@ func copyRecursive(original, cpy reflect.Value) {
 		if !originalValue.IsValid() {
 			return
 		}
+		key := visitedKey{ptr: original.Pointer(), typ: original.Type()}
+		if v, ok := visited[key]; ok {
+			cpy.Set(v)
+			return
+		}
 		cpy.Set(reflect.New(originalValue.Type()))
-		copyRecursive(originalValue, cpy.Elem())
+		visited[key] = cpy
+		copyRecursive(originalValue, cpy.Elem(), visited)
 
 	case reflect.Interface:
 		// If this is a nil, don't do anything
@@ -87,7 +104,7 @@ func copyRecursive(original, cpy reflect.Value) {
 
 		// Get the value by calling Elem().
 		copyValue := reflect.New(originalValue.Type()).Elem()
-		copyRecursive(originalValue, copyValue)
+		copyRecursive(originalValue, copyValue, visited)
 		cpy.Set(copyValue)
 
 	case reflect.Struct:
@@ -104,28 +121,40 @@ func copyRecursive(original, cpy reflect.Value) {
 			if original.Type().Field(i).PkgPath != "" {
 				continue
 			}
-			copyRecursive(original.Field(i), cpy.Field(i))
+			copyRecursive(original.Field(i), cpy.Field(i), visited)
 		}
 
 	case reflect.Slice:
 		if original.IsNil() {
 			return
 		}
+		key := visitedKey{ptr: original.Pointer(), typ: original.Type(), len: original.Len()}
+		if v, ok := visited[key]; ok {
+			cpy.Set(v)
+			return
+		}
 		// Make a new slice and copy each element.
 		cpy.Set(reflect.MakeSlice(original.Type(), original.Len(), original.Cap()))
+		visited[key] = cpy
 		for i := 0; i < original.Len(); i++ {
-			copyRecursive(original.Index(i), cpy.Index(i))
+			copyRecursive(original.Index(i), cpy.Index(i), visited)
 		}
 
 	case reflect.Map:
 		if original.IsNil() {
 			return
 		}
+		visitKey := visitedKey{ptr: original.Pointer(), typ: original.Type()}
+		if v, ok := visited[visitKey]; ok {
+			cpy.Set(v)
+			return
+		}
 		cpy.Set(reflect.MakeMap(original.Type()))
+		visited[visitKey] = cpy
 		for _, key := range original.MapKeys() {
 			originalValue := original.MapIndex(key)
 			copyValue := reflect.New(originalValue.Type()).Elem()
-			copyRecursive(originalValue, copyValue)
+			copyRecursive(originalValue, copyValue, visited)
 			copyKey := Copy(key.Interface())
 			cpy.SetMapIndex(reflect.ValueOf(copyKey), copyValue)
 		}
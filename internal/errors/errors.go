@@ -8,6 +8,8 @@
 package errors
 
 import (
+	"sync/atomic"
+
 	"github.com/gogf/gf/v2/internal/command"
 )
 
@@ -32,15 +34,16 @@ const (
 	StackModeDetail StackMode = "detail"
 )
 
-// stackModeConfigured is the configured error stack mode variable.
-// It is brief stack mode in default.
-var stackModeConfigured = StackModeBrief
+// stackModeConfigured is the configured error stack mode variable, which stores StackMode.
+// It is brief stack mode in default, and it is stored atomically as it can be changed at runtime.
+var stackModeConfigured atomic.Value
 
 func init() {
+	var mode = StackModeBrief
 	// Deprecated.
 	briefSetting := command.GetOptWithEnv(commandEnvKeyForBrief)
 	if briefSetting == "1" || briefSetting == "true" {
-		stackModeConfigured = StackModeBrief
+		mode = StackModeBrief
 	}
 
 	// The error stack mode is configured using command line arguments or environments.
@@ -49,12 +52,21 @@ func init() {
 		stackModeSettingMode := StackMode(stackModeSetting)
 		switch stackModeSettingMode {
 		case StackModeBrief, StackModeDetail:
-			stackModeConfigured = stackModeSettingMode
+			mode = stackModeSettingMode
 		}
 	}
+	stackModeConfigured.Store(mode)
 }
 
 // IsStackModeBrief returns whether current error stack mode is in brief mode.
 func IsStackModeBrief() bool {
-	return stackModeConfigured == StackModeBrief
+	return stackModeConfigured.Load() == StackModeBrief
+}
+
+// SetStackMode sets the error stack mode for the process.
+func SetStackMode(mode StackMode) {
+	switch mode {
+	case StackModeBrief, StackModeDetail:
+		stackModeConfigured.Store(mode)
+	}
 }
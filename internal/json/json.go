@@ -20,6 +20,9 @@ import (
 // be used to delay JSON decoding or precompute a JSON encoding.
 type RawMessage = json.RawMessage
 
+// Number represents a JSON number literal, which is decoded by UnmarshalUseNumber for numbers.
+type Number = json.Number
+
 // Marshal adapts to json/encoding Marshal API.
 //
 // Marshal returns the JSON encoding of v, adapts to json/encoding Marshal API
@@ -34,6 +34,7 @@ type Client struct {
 	retryCount        int               // Retry count when request fails.
 	noUrlEncode       bool              // No url encoding for request parameters.
 	retryInterval     time.Duration     // Retry interval when request fails.
+	budgetMinTimeout  time.Duration     // Minimum remaining context budget for each attempt of request.
 	middlewareHandler []HandlerFunc     // Interceptor handlers
 	discovery         gsvc.Discovery    // Discovery for service.
 	builder           gsel.Builder      // Builder for request balance.
@@ -51,6 +52,8 @@ const (
 	httpHeaderContentTypeJson = `application/json`
 	httpHeaderContentTypeXml  = `application/xml`
 	httpHeaderContentTypeForm = `application/x-www-form-urlencoded`
+	httpHeaderIdempotencyKey  = `Idempotency-Key`
+	httpHeaderRequestId       = `X-Request-Id`
 )
 
 var (
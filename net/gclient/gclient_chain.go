@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/gogf/gf/v2/net/gsvc"
+	"github.com/gogf/gf/v2/util/guid"
 )
 
 // Prefix is a chaining function,
@@ -39,6 +40,20 @@ func (c *Client) HeaderRaw(headers string) *Client {
 	return newClient
 }
 
+// IdempotencyKey is a chaining function,
+// which sets the "Idempotency-Key" header for next request. It generates a unique key if `key` is not given.
+// The key is kept for all the retries of the request, so that the server can replay the response
+// of the request instead of processing it repeatedly.
+func (c *Client) IdempotencyKey(key ...string) *Client {
+	newClient := c.Clone()
+	if len(key) > 0 && key[0] != "" {
+		newClient.SetHeader(httpHeaderIdempotencyKey, key[0])
+	} else {
+		newClient.SetHeader(httpHeaderIdempotencyKey, guid.S())
+	}
+	return newClient
+}
+
 // Discovery is a chaining function, which sets the discovery for client.
 // You can use `Discovery(nil)` to disable discovery feature for current client.
 func (c *Client) Discovery(discovery gsvc.Discovery) *Client {
@@ -108,6 +123,14 @@ func (c *Client) Retry(retryCount int, retryInterval time.Duration) *Client {
 	return newClient
 }
 
+// BudgetMinTimeout is a chaining function,
+// which sets the minimum remaining context budget for each attempt of next request.
+func (c *Client) BudgetMinTimeout(t time.Duration) *Client {
+	newClient := c.Clone()
+	newClient.SetBudgetMinTimeout(t)
+	return newClient
+}
+
 // Proxy is a chaining function,
 // which sets proxy for next request.
 // Make sure you pass the correct `proxyURL`.
@@ -115,6 +115,13 @@ func (c *Client) SetRetry(retryCount int, retryInterval time.Duration) *Client {
 	return c
 }
 
+// SetBudgetMinTimeout sets the minimum remaining context budget for each attempt of request, see gctx.WithBudget.
+// The request fails fast with gctx.ErrBudgetExhausted if the remaining budget is less than it.
+func (c *Client) SetBudgetMinTimeout(t time.Duration) *Client {
+	c.budgetMinTimeout = t
+	return c
+}
+
 // SetRedirectLimit limits the number of jumps.
 func (c *Client) SetRedirectLimit(redirectLimit int) *Client {
 	c.CheckRedirect = func(req *http.Request, via []*http.Request) error {
@@ -0,0 +1,312 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/crypto/gsha256"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/text/gregex"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+const (
+	// downloadTempFileSuffix is the file name suffix of the partial file during downloading.
+	downloadTempFileSuffix = ".download"
+	// downloadValidatorFileSuffix is the file name suffix of the file keeping the validator of the partial file,
+	// which is the ETag or Last-Modified of the response creating the partial file.
+	downloadValidatorFileSuffix = ".validator"
+	// downloadBufferSize is the buffer size for reading response body.
+	downloadBufferSize = 32 * 1024
+	// defaultDownloadProgressInterval is the default min interval of progress callbacks.
+	defaultDownloadProgressInterval = 200 * time.Millisecond
+)
+
+// DownloadOption is the option for Client.Download.
+type DownloadOption struct {
+	// Resume specifies resuming the download from the partial file of last failed downloading
+	// using HTTP Range request with If-Range of the ETag or Last-Modified of the partial file.
+	// It starts over if the server does not support Range request, the file has changed on the
+	// server, or there's no ETag or Last-Modified recorded for the partial file.
+	Resume bool
+
+	// Checksum is the expected SHA-256 checksum in hex of the whole file, which is verified
+	// after downloading. The downloaded file is removed if the verification fails.
+	Checksum string
+
+	// RateLimit is the max downloading speed in bytes per second, no limit if it is not positive.
+	RateLimit int64
+
+	// Progress is the callback for downloading progress, which is called at most once in
+	// ProgressInterval and always called when downloading completes.
+	Progress func(progress DownloadProgress)
+
+	// ProgressInterval is the min interval of Progress callbacks, default is 200ms.
+	ProgressInterval time.Duration
+}
+
+// DownloadProgress is the progress of downloading.
+type DownloadProgress struct {
+	Downloaded int64         // Downloaded bytes, including the resumed part.
+	Total      int64         // Total bytes of the file, which is -1 if unknown.
+	Resumed    int64         // Bytes resumed from the partial file.
+	Elapsed    time.Duration // Elapsed time of current downloading.
+}
+
+// Percent returns the downloading percent in range [0, 100], which is -1 if the total size is unknown.
+func (p DownloadProgress) Percent() float64 {
+	if p.Total < 0 {
+		return -1
+	}
+	if p.Total == 0 {
+		return 100
+	}
+	return float64(p.Downloaded) * 100 / float64(p.Total)
+}
+
+// Download downloads the file of `url` and saves it to local `path` using GET method.
+//
+// The content is written to the partial file `path`+".download" during downloading, which is
+// renamed to `path` after downloading and checksum verification completes. The partial file
+// is kept if downloading fails, which can be resumed in next downloading with option Resume.
+func (c *Client) Download(ctx context.Context, url, path string, option ...DownloadOption) (err error) {
+	var (
+		opt           DownloadOption
+		tempPath      = path + downloadTempFileSuffix
+		validatorPath = tempPath + downloadValidatorFileSuffix
+		startTime     = time.Now()
+		offset        int64
+		validator     string
+	)
+	if len(option) > 0 {
+		opt = option[0]
+	}
+	if opt.ProgressInterval <= 0 {
+		opt.ProgressInterval = defaultDownloadProgressInterval
+	}
+	if err = gfile.Mkdir(gfile.Dir(path)); err != nil {
+		return err
+	}
+	if opt.Resume && gfile.IsFile(tempPath) {
+		// The partial file can only be resumed with the validator of it.
+		if validator = strings.TrimSpace(gfile.GetContents(validatorPath)); validator != "" {
+			offset = gfile.Size(tempPath)
+		}
+	}
+	if offset == 0 {
+		if err = removeDownloadFiles(tempPath, validatorPath); err != nil {
+			return err
+		}
+	}
+	client := c
+	if offset > 0 {
+		client = c.Header(map[string]string{
+			"Range":    fmt.Sprintf("bytes=%d-", offset),
+			"If-Range": validator,
+		})
+	}
+	resp, err := client.Get(ctx, url)
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+
+	var (
+		total = resp.ContentLength
+		flag  = os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	)
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// The server does not support Range request or the file has changed, it starts over.
+		offset = 0
+		if validator = getDownloadValidator(resp.Header); validator != "" {
+			err = gfile.PutContents(validatorPath, validator)
+		} else {
+			err = removeDownloadFiles(validatorPath)
+		}
+		if err != nil {
+			return err
+		}
+
+	case http.StatusPartialContent:
+		start, size := parseContentRange(resp.Header.Get("Content-Range"))
+		if start != offset {
+			return gerror.NewCodef(
+				gcode.CodeInvalidOperation,
+				`invalid Content-Range "%s" for resuming from offset %d`,
+				resp.Header.Get("Content-Range"), offset,
+			)
+		}
+		// The total size from Content-Range is the size of the whole file.
+		total = size
+		flag = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The partial file might be already complete, which is the same size as the whole file.
+		if offset == 0 {
+			return gerror.NewCodef(gcode.CodeInvalidOperation, `download "%s" failed: %s`, url, resp.Status)
+		}
+		if size := parseUnsatisfiedContentRange(resp.Header.Get("Content-Range")); size != offset {
+			if err = removeDownloadFiles(tempPath, validatorPath); err != nil {
+				return err
+			}
+			return gerror.NewCodef(
+				gcode.CodeInvalidOperation,
+				`invalid Content-Range "%s" for partial file of %d bytes`,
+				resp.Header.Get("Content-Range"), offset,
+			)
+		}
+		total = offset
+		flag = os.O_WRONLY | os.O_APPEND
+		resp.Body = http.NoBody
+
+	default:
+		return gerror.NewCodef(gcode.CodeInvalidOperation, `download "%s" failed: %s`, url, resp.Status)
+	}
+	file, err := gfile.OpenFile(tempPath, flag, gfile.DefaultPermOpen)
+	if err != nil {
+		return err
+	}
+	progress := DownloadProgress{
+		Downloaded: offset,
+		Total:      total,
+		Resumed:    offset,
+	}
+	err = copyDownloadBody(file, resp.Body, opt, startTime, &progress)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = gerror.Wrapf(closeErr, `close file "%s" failed`, tempPath)
+	}
+	if err != nil {
+		return err
+	}
+	if progress.Total >= 0 && progress.Downloaded != progress.Total {
+		return gerror.NewCodef(
+			gcode.CodeInvalidOperation,
+			`download "%s" incomplete: %d of %d bytes downloaded`, url, progress.Downloaded, progress.Total,
+		)
+	}
+	if opt.Checksum != "" {
+		checksum, err := gsha256.EncryptFile(tempPath)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(checksum, opt.Checksum) {
+			_ = removeDownloadFiles(tempPath, validatorPath)
+			return gerror.NewCodef(
+				gcode.CodeInvalidOperation,
+				`checksum mismatch for "%s": expected %s, got %s`, url, opt.Checksum, checksum,
+			)
+		}
+	}
+	if err = gfile.Rename(tempPath, path); err != nil {
+		return err
+	}
+	return removeDownloadFiles(validatorPath)
+}
+
+// removeDownloadFiles removes the partial file or its validator file of given `paths` if they exist.
+func removeDownloadFiles(paths ...string) error {
+	for _, filePath := range paths {
+		if !gfile.Exists(filePath) {
+			continue
+		}
+		if err := gfile.RemoveFile(filePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getDownloadValidator returns the validator for If-Range request from the response header,
+// which is the strong ETag or else the Last-Modified, as weak ETag cannot be used in If-Range.
+func getDownloadValidator(header http.Header) string {
+	if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+		return etag
+	}
+	return header.Get("Last-Modified")
+}
+
+// copyDownloadBody copies `body` to `writer` with rate limiting and progress reporting.
+func copyDownloadBody(
+	writer io.Writer, body io.Reader, opt DownloadOption, startTime time.Time, progress *DownloadProgress,
+) error {
+	var (
+		buffer       = make([]byte, downloadBufferSize)
+		copyStart    = time.Now()
+		copied       int64
+		lastProgress time.Time
+	)
+	if opt.RateLimit > 0 && opt.RateLimit < downloadBufferSize {
+		buffer = buffer[:opt.RateLimit]
+	}
+	for {
+		n, readErr := body.Read(buffer)
+		if n > 0 {
+			if _, err := writer.Write(buffer[:n]); err != nil {
+				return gerror.Wrap(err, `write downloading content failed`)
+			}
+			copied += int64(n)
+			progress.Downloaded += int64(n)
+			if opt.RateLimit > 0 {
+				// It sleeps until the average speed falls to the limit.
+				expected := time.Duration(float64(copied) / float64(opt.RateLimit) * float64(time.Second))
+				if wait := expected - time.Since(copyStart); wait > 0 {
+					time.Sleep(wait)
+				}
+			}
+			if opt.Progress != nil && time.Since(lastProgress) >= opt.ProgressInterval {
+				lastProgress = time.Now()
+				progress.Elapsed = time.Since(startTime)
+				opt.Progress(*progress)
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return gerror.Wrap(readErr, `read downloading content failed`)
+		}
+	}
+	if opt.Progress != nil {
+		progress.Elapsed = time.Since(startTime)
+		opt.Progress(*progress)
+	}
+	return nil
+}
+
+// parseContentRange parses the header value of Content-Range like "bytes 100-199/1000",
+// which returns the start offset and total size. The total size is -1 if it is unknown.
+func parseContentRange(contentRange string) (start, total int64) {
+	match, _ := gregex.MatchString(`^bytes\s+(\d+)-\d+/(\d+|\*)$`, strings.TrimSpace(contentRange))
+	if len(match) < 3 {
+		return -1, -1
+	}
+	start = gconv.Int64(match[1])
+	if match[2] == "*" {
+		return start, -1
+	}
+	return start, gconv.Int64(match[2])
+}
+
+// parseUnsatisfiedContentRange parses the header value of Content-Range like "bytes */1000"
+// of the response of unsatisfiable range, which returns the total size or -1 if it is invalid.
+func parseUnsatisfiedContentRange(contentRange string) (total int64) {
+	match, _ := gregex.MatchString(`^bytes\s+\*/(\d+)$`, strings.TrimSpace(contentRange))
+	if len(match) < 2 {
+		return -1
+	}
+	return gconv.Int64(match[1])
+}
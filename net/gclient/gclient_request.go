@@ -24,6 +24,7 @@ import (
 	"github.com/gogf/gf/v2/internal/httputil"
 	"github.com/gogf/gf/v2/internal/json"
 	"github.com/gogf/gf/v2/internal/utils"
+	"github.com/gogf/gf/v2/os/gctx"
 	"github.com/gogf/gf/v2/os/gfile"
 	"github.com/gogf/gf/v2/os/gtime"
 	"github.com/gogf/gf/v2/text/gregex"
@@ -132,7 +133,12 @@ func (c *Client) DoRequest(
 	if err != nil {
 		return nil, err
 	}
+	return c.doRequest(req, requestStartTime)
+}
 
+// doRequest sends the prepared request through metrics and client middlewares,
+// and returns the response object.
+func (c *Client) doRequest(req *http.Request, requestStartTime *gtime.Time) (resp *Response, err error) {
 	// Metrics.
 	c.handleMetricsBeforeRequest(req)
 	defer c.handleMetricsAfterRequestDone(req, requestStartTime)
@@ -144,7 +150,7 @@ func (c *Client) DoRequest(
 		mdlHandlers = append(mdlHandlers, func(cli *Client, r *http.Request) (*Response, error) {
 			return cli.callRequest(r)
 		})
-		ctx = context.WithValue(req.Context(), clientMiddlewareKey, &clientMiddleware{
+		ctx := context.WithValue(req.Context(), clientMiddlewareKey, &clientMiddleware{
 			client:       c,
 			handlers:     mdlHandlers,
 			handlerIndex: -1,
@@ -349,6 +355,10 @@ func (c *Client) prepareRequest(ctx context.Context, method, url string, data ..
 			req.Header.Set(k, v)
 		}
 	}
+	// Request id propagation, which does not override the custom request id header.
+	if requestId := gctx.RequestId(ctx); requestId != "" && req.Header.Get(httpHeaderRequestId) == "" {
+		req.Header.Set(httpHeaderRequestId, requestId)
+	}
 	// It's necessary set the req.Host if you want to custom the host value of the request.
 	// It uses the "Host" value from header if it's not empty.
 	if reqHeaderHost := req.Header.Get(httpHeaderHost); reqHeaderHost != "" {
@@ -387,12 +397,21 @@ func (c *Client) callRequest(req *http.Request) (resp *Response, err error) {
 	resp.requestBody = reqBodyContent
 	for {
 		req.Body = utils.NewReadCloser(reqBodyContent, false)
-		if resp.Response, err = c.Do(req); err != nil {
+		hopReq, cancel, hopErr := c.withBudgetHop(req)
+		if hopErr != nil {
+			return resp, gerror.Wrapf(hopErr, `request failed`)
+		}
+		if resp.Response, err = c.Do(hopReq); err != nil {
+			cancel()
 			err = gerror.Wrapf(err, `request failed`)
 			// The response might not be nil when err != nil.
 			if resp.Response != nil {
 				_ = resp.Body.Close()
 			}
+			// It stops retrying if the context budget cannot afford another attempt.
+			if budget := gctx.GetBudget(req.Context()); budget != nil && !budget.Allows(c.retryInterval) {
+				break
+			}
 			if c.retryCount > 0 {
 				c.retryCount--
 				time.Sleep(c.retryInterval)
@@ -401,8 +420,38 @@ func (c *Client) callRequest(req *http.Request) (resp *Response, err error) {
 				break
 			}
 		} else {
+			// The hop deadline lives along with the response body.
+			resp.Body = &budgetHopBody{ReadCloser: resp.Body, cancel: cancel}
 			break
 		}
 	}
 	return resp, err
 }
+
+// budgetHopBody is the response body canceling the hop context of the request when it is closed.
+type budgetHopBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close implements io.Closer.
+func (b *budgetHopBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// withBudgetHop returns the request for one attempt, whose deadline is the less one of the client
+// timeout and the remaining budget of the request context, see gctx.WithBudgetHop.
+// It returns gctx.ErrBudgetExhausted if the remaining budget is less than the budget minimum timeout.
+// The request is returned as it is if there's no budget in the request context.
+func (c *Client) withBudgetHop(req *http.Request) (*http.Request, context.CancelFunc, error) {
+	if gctx.GetBudget(req.Context()) == nil {
+		return req, func() {}, nil
+	}
+	ctx, cancel, err := gctx.WithBudgetHop(req.Context(), c.Client.Timeout, c.budgetMinTimeout)
+	if err != nil {
+		return req, cancel, err
+	}
+	return req.WithContext(ctx), cancel, nil
+}
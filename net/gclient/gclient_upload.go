@@ -0,0 +1,251 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gclient
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+const (
+	// HeaderUploadId is the header of the upload id, which is the same for all parts of an upload.
+	HeaderUploadId = "X-Upload-Id"
+	// HeaderUploadPart is the header of the part number, starting from 1.
+	HeaderUploadPart = "X-Upload-Part"
+	// HeaderUploadPartChecksum is the header of the SHA-256 checksum in hex of the part content.
+	HeaderUploadPartChecksum = "X-Upload-Part-Checksum"
+	// HeaderUploadChecksum is the header of the SHA-256 checksum in hex of the whole content,
+	// which is only sent with the last part.
+	HeaderUploadChecksum = "X-Upload-Checksum"
+)
+
+const (
+	defaultUploadPartSize      = 5 * 1024 * 1024
+	defaultUploadPartRetry     = 3
+	defaultUploadRetryInterval = time.Second
+	httpHeaderContentRange     = "Content-Range"
+	httpHeaderContentTypeOctet = "application/octet-stream"
+)
+
+// UploadOption is the option for Client.Upload.
+type UploadOption struct {
+	// Method is the HTTP method of part requests, default is PUT.
+	Method string
+
+	// UploadId is the id of the upload sent in header HeaderUploadId, which is generated if empty.
+	UploadId string
+
+	// PartSize is the content size of each part, default is 5MB.
+	// Note that a part is buffered in memory for retrying, but the whole content never is.
+	PartSize int64
+
+	// PartRetry is the retry count for each failed part, default is 3.
+	// Negative value disables the retrying.
+	PartRetry int
+
+	// RetryInterval is the interval between part retries, default is 1 second.
+	RetryInterval time.Duration
+
+	// PartURL returns the URL of the part request, which is usually the presigned URL for
+	// S3-style multipart upload. It uses the url of Upload with query "partNumber" if nil.
+	PartURL func(ctx context.Context, part UploadPart) (string, error)
+
+	// Progress is the callback after each part is uploaded.
+	Progress func(part UploadPart)
+}
+
+// UploadPart is the uploaded part of an upload.
+type UploadPart struct {
+	Number   int    // Part number, starting from 1.
+	Offset   int64  // Offset of the part in the whole content.
+	Size     int64  // Content size of the part.
+	Checksum string // SHA-256 checksum in hex of the part content.
+	ETag     string // ETag header of the part response, which is used for completing S3-style upload.
+}
+
+// UploadResult is the result of an upload.
+type UploadResult struct {
+	UploadId string       // Id of the upload.
+	Size     int64        // Size of the whole content.
+	Checksum string       // SHA-256 checksum in hex of the whole content.
+	Parts    []UploadPart // Uploaded parts in order.
+}
+
+// Upload uploads the content streaming from `reader` to `url` in parts, which never reads the
+// whole content into memory. Each part is sent as a single request with headers Content-Range,
+// HeaderUploadId, HeaderUploadPart and HeaderUploadPartChecksum, and the last part also carries
+// the checksum of the whole content in header HeaderUploadChecksum. The failed part is retried
+// individually, and the upload fails if any part still fails after retries.
+//
+// The part requests are sent through the client, so the client configurations like headers
+// and middlewares also apply to part requests.
+func (c *Client) Upload(ctx context.Context, url string, reader io.Reader, option ...UploadOption) (*UploadResult, error) {
+	var opt UploadOption
+	if len(option) > 0 {
+		opt = option[0]
+	}
+	if opt.Method == "" {
+		opt.Method = http.MethodPut
+	}
+	if opt.UploadId == "" {
+		opt.UploadId = guid.S()
+	}
+	if opt.PartSize <= 0 {
+		opt.PartSize = defaultUploadPartSize
+	}
+	if opt.PartRetry == 0 {
+		opt.PartRetry = defaultUploadPartRetry
+	}
+	if opt.RetryInterval <= 0 {
+		opt.RetryInterval = defaultUploadRetryInterval
+	}
+	var (
+		result = &UploadResult{
+			UploadId: opt.UploadId,
+		}
+		bufReader = bufio.NewReader(reader)
+		buffer    = make([]byte, opt.PartSize)
+		hash      = sha256.New()
+	)
+	for number := 1; ; number++ {
+		n, err := io.ReadFull(bufReader, buffer)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return nil, gerror.Wrapf(err, `read content of part %d failed`, number)
+		}
+		// It peeks the next byte to know whether current part is the last one.
+		isLast := err != nil
+		if !isLast {
+			if _, peekErr := bufReader.Peek(1); peekErr != nil {
+				if peekErr != io.EOF {
+					return nil, gerror.Wrapf(peekErr, `read content of part %d failed`, number+1)
+				}
+				isLast = true
+			}
+		}
+		var (
+			content = buffer[:n]
+			partSum = sha256.Sum256(content)
+			part    = UploadPart{
+				Number:   number,
+				Offset:   result.Size,
+				Size:     int64(n),
+				Checksum: hex.EncodeToString(partSum[:]),
+			}
+			uploadChecksum string
+		)
+		hash.Write(content)
+		result.Size += part.Size
+		if isLast {
+			result.Checksum = hex.EncodeToString(hash.Sum(nil))
+			uploadChecksum = result.Checksum
+		}
+		// Note that an empty content is uploaded as a single empty part.
+		if part.ETag, err = c.uploadPart(ctx, url, content, part, uploadChecksum, isLast, result.Size, opt); err != nil {
+			return nil, err
+		}
+		result.Parts = append(result.Parts, part)
+		if opt.Progress != nil {
+			opt.Progress(part)
+		}
+		if isLast {
+			break
+		}
+	}
+	return result, nil
+}
+
+// uploadPart sends the part request with retries, and returns the ETag of the response.
+func (c *Client) uploadPart(
+	ctx context.Context, url string, content []byte, part UploadPart,
+	uploadChecksum string, isLast bool, uploadedSize int64, opt UploadOption,
+) (etag string, err error) {
+	partURL := url
+	if opt.PartURL != nil {
+		if partURL, err = opt.PartURL(ctx, part); err != nil {
+			return "", gerror.Wrapf(err, `get URL of part %d failed`, part.Number)
+		}
+	} else if gstr.Contains(url, "?") {
+		partURL = fmt.Sprintf(`%s&partNumber=%d`, url, part.Number)
+	} else {
+		partURL = fmt.Sprintf(`%s?partNumber=%d`, url, part.Number)
+	}
+	// The total size in Content-Range is unknown until the last part.
+	total := "*"
+	if isLast {
+		total = strconv.FormatInt(uploadedSize, 10)
+	}
+	contentRange := fmt.Sprintf(`bytes %d-%d/%s`, part.Offset, part.Offset+part.Size-1, total)
+	if part.Size == 0 {
+		contentRange = fmt.Sprintf(`bytes */%s`, total)
+	}
+	for retry := 0; ; retry++ {
+		etag, err = c.sendUploadPart(ctx, partURL, content, part, contentRange, uploadChecksum, opt)
+		if err == nil {
+			return etag, nil
+		}
+		if retry >= opt.PartRetry || ctx.Err() != nil {
+			return "", gerror.Wrapf(err, `upload part %d failed after %d retries`, part.Number, retry)
+		}
+		select {
+		case <-ctx.Done():
+			return "", gerror.Wrapf(ctx.Err(), `upload part %d failed`, part.Number)
+		case <-time.After(opt.RetryInterval):
+		}
+	}
+}
+
+// sendUploadPart sends the part request once.
+func (c *Client) sendUploadPart(
+	ctx context.Context, url string, content []byte, part UploadPart,
+	contentRange, uploadChecksum string, opt UploadOption,
+) (string, error) {
+	var requestStartTime = gtime.Now()
+	req, err := c.prepareRequest(ctx, opt.Method, url)
+	if err != nil {
+		return "", err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(content))
+	req.ContentLength = int64(len(content))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(content)), nil
+	}
+	if req.Header.Get(httpHeaderContentType) == "" {
+		req.Header.Set(httpHeaderContentType, httpHeaderContentTypeOctet)
+	}
+	req.Header.Set(httpHeaderContentRange, contentRange)
+	req.Header.Set(HeaderUploadId, opt.UploadId)
+	req.Header.Set(HeaderUploadPart, strconv.Itoa(part.Number))
+	req.Header.Set(HeaderUploadPartChecksum, part.Checksum)
+	if uploadChecksum != "" {
+		req.Header.Set(HeaderUploadChecksum, uploadChecksum)
+	}
+	resp, err := c.doRequest(req, requestStartTime)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return "", gerror.NewCodef(
+			gcode.CodeInvalidOperation, `unexpected response status "%s": %s`, resp.Status, resp.ReadAllString(),
+		)
+	}
+	return resp.Header.Get("ETag"), nil
+}
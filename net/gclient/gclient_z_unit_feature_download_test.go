@@ -0,0 +1,227 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gclient_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/crypto/gsha256"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/gclient"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Client_Download(t *testing.T) {
+	var (
+		dir     = gfile.Temp(guid.S())
+		srcPath = gfile.Join(dir, "src.txt")
+		content = gstr.Repeat("0123456789", 10000)
+	)
+	gtest.AssertNil(gfile.PutContents(srcPath, content))
+	defer gfile.Remove(dir)
+
+	s := g.Server(guid.S())
+	s.BindHandler("/file", func(r *ghttp.Request) {
+		r.Response.ServeFile(srcPath)
+	})
+	s.BindHandler("/no-range", func(r *ghttp.Request) {
+		r.Response.Write(content)
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+
+	time.Sleep(100 * time.Millisecond)
+	var (
+		url          = fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+		checksum     = gsha256.Encrypt(content)
+		lastModified = gfile.MTime(srcPath).UTC().Format(http.TimeFormat)
+	)
+	// Download with checksum and progress.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			path     = gfile.Join(dir, guid.S(), "dst.txt")
+			progress gclient.DownloadProgress
+		)
+		err := g.Client().Download(ctx, url+"/file", path, gclient.DownloadOption{
+			Checksum: gstr.ToUpper(checksum),
+			Progress: func(p gclient.DownloadProgress) {
+				progress = p
+			},
+		})
+		t.AssertNil(err)
+		t.Assert(gfile.GetContents(path), content)
+		t.Assert(gfile.Exists(path+".download"), false)
+		t.Assert(progress.Downloaded, len(content))
+		t.Assert(progress.Total, len(content))
+		t.Assert(progress.Percent(), 100)
+	})
+	// Resume from partial file.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			path     = gfile.Join(dir, guid.S(), "dst.txt")
+			progress gclient.DownloadProgress
+		)
+		t.AssertNil(gfile.PutContents(path+".download", content[:12345]))
+		t.AssertNil(gfile.PutContents(path+".download.validator", lastModified))
+		err := g.Client().Download(ctx, url+"/file", path, gclient.DownloadOption{
+			Resume:   true,
+			Checksum: checksum,
+			Progress: func(p gclient.DownloadProgress) {
+				progress = p
+			},
+		})
+		t.AssertNil(err)
+		t.Assert(gfile.GetContents(path), content)
+		t.Assert(progress.Resumed, 12345)
+		t.Assert(progress.Downloaded, len(content))
+		t.Assert(gfile.Exists(path+".download.validator"), false)
+	})
+	// Resume from partial file of interrupted downloading.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			path        = gfile.Join(dir, guid.S(), "dst.txt")
+			progress    gclient.DownloadProgress
+			ctx, cancel = context.WithCancel(ctx)
+		)
+		defer cancel()
+		err := g.Client().Download(ctx, url+"/file", path, gclient.DownloadOption{
+			RateLimit:        int64(len(content)) * 4,
+			ProgressInterval: time.Nanosecond,
+			Progress: func(p gclient.DownloadProgress) {
+				if p.Downloaded >= 20000 {
+					cancel()
+				}
+			},
+		})
+		t.AssertNE(err, nil)
+		t.Assert(gfile.Exists(path), false)
+		t.Assert(gfile.GetContents(path+".download.validator"), lastModified)
+
+		err = g.Client().Download(context.Background(), url+"/file", path, gclient.DownloadOption{
+			Resume:   true,
+			Checksum: checksum,
+			Progress: func(p gclient.DownloadProgress) {
+				progress = p
+			},
+		})
+		t.AssertNil(err)
+		t.Assert(gfile.GetContents(path), content)
+		t.AssertGT(progress.Resumed, 0)
+	})
+	// Resume from complete partial file.
+	gtest.C(t, func(t *gtest.T) {
+		path := gfile.Join(dir, guid.S(), "dst.txt")
+		t.AssertNil(gfile.PutContents(path+".download", content))
+		t.AssertNil(gfile.PutContents(path+".download.validator", lastModified))
+		err := g.Client().Download(ctx, url+"/file", path, gclient.DownloadOption{
+			Resume:   true,
+			Checksum: checksum,
+		})
+		t.AssertNil(err)
+		t.Assert(gfile.GetContents(path), content)
+	})
+	// Partial file larger than the whole file is not complete.
+	gtest.C(t, func(t *gtest.T) {
+		path := gfile.Join(dir, guid.S(), "dst.txt")
+		t.AssertNil(gfile.PutContents(path+".download", content+"invalid"))
+		t.AssertNil(gfile.PutContents(path+".download.validator", lastModified))
+		err := g.Client().Download(ctx, url+"/file", path, gclient.DownloadOption{
+			Resume: true,
+		})
+		t.AssertNE(err, nil)
+		t.Assert(gfile.Exists(path), false)
+		t.Assert(gfile.Exists(path+".download"), false)
+	})
+	// Resume starts over if the file has changed.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			path     = gfile.Join(dir, guid.S(), "dst.txt")
+			progress gclient.DownloadProgress
+		)
+		t.AssertNil(gfile.PutContents(path+".download", "invalid"))
+		t.AssertNil(gfile.PutContents(path+".download.validator", "Sun, 22 Nov 2020 12:23:45 GMT"))
+		err := g.Client().Download(ctx, url+"/file", path, gclient.DownloadOption{
+			Resume:   true,
+			Checksum: checksum,
+			Progress: func(p gclient.DownloadProgress) {
+				progress = p
+			},
+		})
+		t.AssertNil(err)
+		t.Assert(gfile.GetContents(path), content)
+		t.Assert(progress.Resumed, 0)
+	})
+	// Resume starts over if there's no validator of the partial file.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			path     = gfile.Join(dir, guid.S(), "dst.txt")
+			progress gclient.DownloadProgress
+		)
+		t.AssertNil(gfile.PutContents(path+".download", content[:12345]))
+		err := g.Client().Download(ctx, url+"/file", path, gclient.DownloadOption{
+			Resume:   true,
+			Checksum: checksum,
+			Progress: func(p gclient.DownloadProgress) {
+				progress = p
+			},
+		})
+		t.AssertNil(err)
+		t.Assert(gfile.GetContents(path), content)
+		t.Assert(progress.Resumed, 0)
+	})
+	// Resume starts over if server does not support Range request.
+	gtest.C(t, func(t *gtest.T) {
+		path := gfile.Join(dir, guid.S(), "dst.txt")
+		t.AssertNil(gfile.PutContents(path+".download", "invalid"))
+		t.AssertNil(gfile.PutContents(path+".download.validator", lastModified))
+		err := g.Client().Download(ctx, url+"/no-range", path, gclient.DownloadOption{
+			Resume:   true,
+			Checksum: checksum,
+		})
+		t.AssertNil(err)
+		t.Assert(gfile.GetContents(path), content)
+	})
+	// Checksum mismatch.
+	gtest.C(t, func(t *gtest.T) {
+		path := gfile.Join(dir, guid.S(), "dst.txt")
+		err := g.Client().Download(ctx, url+"/file", path, gclient.DownloadOption{
+			Checksum: gsha256.Encrypt("invalid"),
+		})
+		t.AssertNE(err, nil)
+		t.Assert(gfile.Exists(path), false)
+		t.Assert(gfile.Exists(path+".download"), false)
+	})
+	// Rate limit.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			path  = gfile.Join(dir, guid.S(), "dst.txt")
+			start = time.Now()
+		)
+		err := g.Client().Download(ctx, url+"/file", path, gclient.DownloadOption{
+			RateLimit: int64(len(content)) * 4,
+		})
+		t.AssertNil(err)
+		t.AssertGE(time.Since(start), 200*time.Millisecond)
+		t.Assert(gfile.GetContents(path), content)
+	})
+	// Not found.
+	gtest.C(t, func(t *gtest.T) {
+		path := gfile.Join(dir, guid.S(), "dst.txt")
+		err := g.Client().Download(ctx, url+"/none", path)
+		t.AssertNE(err, nil)
+		t.Assert(gfile.Exists(path), false)
+	})
+}
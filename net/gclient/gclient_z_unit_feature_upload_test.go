@@ -0,0 +1,135 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gclient_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/crypto/gsha256"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/gclient"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Client_Upload(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		uploads  = make(map[string]*bytes.Buffer)
+		attempts = make(map[string]int)
+	)
+	s := g.Server(guid.S())
+	s.BindHandler("/upload", func(r *ghttp.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		var (
+			uploadId = r.Header.Get(gclient.HeaderUploadId)
+			part     = r.Header.Get(gclient.HeaderUploadPart)
+			body     = r.GetBody()
+		)
+		gtest.Assert(r.Get("partNumber").String(), part)
+		gtest.Assert(r.Header.Get(gclient.HeaderUploadPartChecksum), gsha256.Encrypt(body))
+		// The second part fails at its first attempt.
+		attempts[uploadId+part]++
+		if part == "2" && attempts[uploadId+part] == 1 {
+			r.Response.WriteStatus(http.StatusServiceUnavailable)
+			return
+		}
+		if uploads[uploadId] == nil {
+			uploads[uploadId] = bytes.NewBuffer(nil)
+		}
+		uploads[uploadId].Write(body)
+		if checksum := r.Header.Get(gclient.HeaderUploadChecksum); checksum != "" {
+			gtest.Assert(checksum, gsha256.Encrypt(uploads[uploadId].Bytes()))
+			gtest.Assert(gstr.HasSuffix(r.Header.Get("Content-Range"), fmt.Sprintf("/%d", uploads[uploadId].Len())), true)
+		} else {
+			gtest.Assert(gstr.HasSuffix(r.Header.Get("Content-Range"), "/*"), true)
+		}
+		r.Response.Header().Set("ETag", "etag-"+part)
+	})
+	s.BindHandler("/fail", func(r *ghttp.Request) {
+		r.Response.WriteStatus(http.StatusInternalServerError)
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+
+	time.Sleep(100 * time.Millisecond)
+	url := fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			content = gstr.Repeat("0123456789", 2500)
+			parts   = make([]int, 0)
+		)
+		result, err := g.Client().Upload(ctx, url+"/upload", strings.NewReader(content), gclient.UploadOption{
+			PartSize:      10000,
+			RetryInterval: time.Millisecond,
+			Progress: func(part gclient.UploadPart) {
+				parts = append(parts, part.Number)
+			},
+		})
+		t.AssertNil(err)
+		t.Assert(result.Size, len(content))
+		t.Assert(result.Checksum, gsha256.Encrypt(content))
+		t.Assert(len(result.Parts), 3)
+		t.Assert(result.Parts[1].Offset, 10000)
+		t.Assert(result.Parts[1].ETag, "etag-2")
+		t.Assert(result.Parts[2].Size, 5000)
+		t.Assert(parts, []int{1, 2, 3})
+		t.Assert(uploads[result.UploadId].String(), content)
+		t.Assert(attempts[result.UploadId+"2"], 2)
+	})
+	// Content size is multiple of part size.
+	gtest.C(t, func(t *gtest.T) {
+		content := gstr.Repeat("0123456789", 2000)
+		result, err := g.Client().Upload(ctx, url+"/upload", strings.NewReader(content), gclient.UploadOption{
+			PartSize:      10000,
+			RetryInterval: time.Millisecond,
+		})
+		t.AssertNil(err)
+		t.Assert(len(result.Parts), 2)
+		t.Assert(uploads[result.UploadId].String(), content)
+	})
+	// Empty content.
+	gtest.C(t, func(t *gtest.T) {
+		result, err := g.Client().Upload(ctx, url+"/upload", strings.NewReader(""))
+		t.AssertNil(err)
+		t.Assert(result.Size, 0)
+		t.Assert(len(result.Parts), 1)
+		t.Assert(result.Checksum, gsha256.Encrypt(""))
+	})
+	// Custom part URL.
+	gtest.C(t, func(t *gtest.T) {
+		result, err := g.Client().Upload(ctx, url+"/none", strings.NewReader("hello"), gclient.UploadOption{
+			UploadId: "custom",
+			PartURL: func(ctx context.Context, part gclient.UploadPart) (string, error) {
+				return fmt.Sprintf("%s/upload?partNumber=%d", url, part.Number), nil
+			},
+		})
+		t.AssertNil(err)
+		t.Assert(result.UploadId, "custom")
+		t.Assert(uploads["custom"].String(), "hello")
+	})
+	// Part fails after retries.
+	gtest.C(t, func(t *gtest.T) {
+		_, err := g.Client().Upload(ctx, url+"/fail", strings.NewReader("hello"), gclient.UploadOption{
+			PartRetry:     2,
+			RetryInterval: time.Millisecond,
+		})
+		t.AssertNE(err, nil)
+		t.Assert(gstr.Contains(err.Error(), "after 2 retries"), true)
+	})
+}
@@ -18,11 +18,13 @@ import (
 
 	"github.com/gorilla/websocket"
 
+	"github.com/gogf/gf/v2/container/gtype"
 	"github.com/gogf/gf/v2/debug/gdebug"
 	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/net/gclient"
 	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/os/gctx"
 	"github.com/gogf/gf/v2/os/gfile"
 	"github.com/gogf/gf/v2/test/gtest"
 	"github.com/gogf/gf/v2/text/gstr"
@@ -255,6 +257,37 @@ func Test_Client_Chain_Timeout(t *testing.T) {
 	})
 }
 
+func Test_Client_Budget(t *testing.T) {
+	var hits = gtype.NewInt()
+	s := g.Server(guid.S())
+	s.BindHandler("/budget", func(r *ghttp.Request) {
+		hits.Add(1)
+		r.Response.Write("ok")
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+
+	time.Sleep(100 * time.Millisecond)
+	gtest.C(t, func(t *gtest.T) {
+		c := g.Client().BudgetMinTimeout(500 * time.Millisecond)
+		c.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		// The remaining budget is less than the minimum timeout.
+		budgetCtx, cancel := gctx.WithBudget(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_, err := c.Get(budgetCtx, "/budget")
+		t.Assert(gerror.Is(err, gctx.ErrBudgetExhausted), true)
+		t.Assert(hits.Val(), 0)
+
+		// The response body is readable within the hop deadline.
+		budgetCtx, cancel = gctx.WithBudget(context.Background(), 2*time.Second)
+		defer cancel()
+		t.Assert(c.GetContent(budgetCtx, "/budget"), "ok")
+		t.Assert(hits.Val(), 1)
+	})
+}
+
 func Test_Client_Chain_ContentJson(t *testing.T) {
 	s := g.Server(guid.S())
 	s.BindHandler("/json", func(r *ghttp.Request) {
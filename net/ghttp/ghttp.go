@@ -47,6 +47,7 @@ type (
 		serviceMu        sync.Mutex                // Concurrent safety for operations of attribute service.
 		service          gsvc.Service              // The service for Registry.
 		registrar        gsvc.Registrar            // Registrar for service register.
+		apiVersions      *gmap.StrAnyMap           // Registered API versions, mapping group prefix to its version array.
 	}
 
 	// Router object.
@@ -83,6 +84,7 @@ type (
 		Value           reflect.Value    // Reflect value information for current handler, which is used for extensions of the handler feature.
 		IsStrictRoute   bool             // Whether strict route matching is enabled.
 		ReqStructFields []gstructs.Field // Request struct fields.
+		Guard           *requestGuard    // Request payload guard generated from request struct.
 	}
 
 	// HandlerItem is the registered handler for route handling,
@@ -98,6 +100,7 @@ type (
 		InitFunc   HandlerFunc     // Initialization function when request enters the object (only available for object register type).
 		ShutFunc   HandlerFunc     // Shutdown function when request leaves out the object (only available for object register type).
 		Middleware []HandlerFunc   // Bound middleware array.
+		Security   []string        // OpenAPI security scheme names bound by router group.
 		HookName   HookName        // Hook type name, only available for the hook type.
 		Router     *Router         // Router object.
 		Source     string          // Registering source file `path:line`.
@@ -158,6 +161,8 @@ const (
 	contentTypeJavascript              = "application/javascript"
 	swaggerUIPackedPath                = "/goframe/swaggerui"
 	responseHeaderTraceID              = "Trace-ID"
+	headerRequestId                    = "X-Request-Id"
+	headerApiVersion                   = "X-Api-Version"
 	specialMethodNameInit              = "Init"
 	specialMethodNameShut              = "Shut"
 	specialMethodNameIndex             = "Index"
@@ -0,0 +1,28 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"time"
+
+	"github.com/gogf/gf/v2/os/gctx"
+)
+
+// MiddlewareBudget returns a middleware that attaches a time budget of `total` duration
+// to the request context. The budget is shared by all downstream calls using the request
+// context, like gclient requests and gdb statements, which stops retries and queries
+// from exceeding the SLA of the caller.
+//
+// See gctx.WithBudget.
+func MiddlewareBudget(total time.Duration) HandlerFunc {
+	return func(r *Request) {
+		ctx, cancel := gctx.WithBudget(r.Context(), total)
+		defer cancel()
+		r.SetCtx(ctx)
+		r.Middleware.Next()
+	}
+}
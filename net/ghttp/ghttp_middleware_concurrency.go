@@ -0,0 +1,131 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ConcurrencyLimitOption is the option for MiddlewareConcurrencyLimit.
+type ConcurrencyLimitOption struct {
+	// MaxConcurrent is the maximum number of in-flight requests, which should be greater than 0.
+	MaxConcurrent int
+
+	// MaxWaiting is the maximum number of requests waiting in queue for a free slot.
+	// The requests are rejected immediately if it is 0 and there's no free slot.
+	MaxWaiting int
+
+	// WaitTimeout is the maximum duration a request waits in queue.
+	// It waits until the request context is done if it is 0.
+	WaitTimeout time.Duration
+
+	// PerRoute specifies limiting the requests per route (method and route pattern).
+	// It uses one global limiter for all requests passing the middleware if it is false.
+	PerRoute bool
+
+	// StatusCode is the response status code for rejected requests.
+	// It is http.StatusTooManyRequests in default, http.StatusServiceUnavailable is also commonly used.
+	StatusCode int
+
+	// RetryAfter is the value of the "Retry-After" header for rejected requests.
+	// It is not set if it is 0.
+	RetryAfter time.Duration
+}
+
+// concurrencyLimiter limits the in-flight requests using a semaphore channel.
+type concurrencyLimiter struct {
+	slots   chan struct{} // Semaphore slots for in-flight requests.
+	waiting atomic.Int64  // Number of requests waiting for a free slot.
+}
+
+// MiddlewareConcurrencyLimit returns a middleware that caps the concurrent in-flight requests
+// with optional waiting queue and timeout, which protects downstream resources like
+// the gdb connection pools during traffic spikes.
+//
+// The rejected requests are responded with status code of `option.StatusCode`
+// and header "Retry-After" if `option.RetryAfter` is configured.
+func MiddlewareConcurrencyLimit(option ConcurrencyLimitOption) HandlerFunc {
+	if option.MaxConcurrent <= 0 {
+		option.MaxConcurrent = math.MaxInt32
+	}
+	if option.StatusCode == 0 {
+		option.StatusCode = http.StatusTooManyRequests
+	}
+	var (
+		limiters      sync.Map
+		globalLimiter = newConcurrencyLimiter(option.MaxConcurrent)
+	)
+	return func(r *Request) {
+		limiter := globalLimiter
+		if option.PerRoute && r.Router != nil {
+			key := r.Router.Method + ":" + r.Router.Uri
+			v, ok := limiters.Load(key)
+			if !ok {
+				v, _ = limiters.LoadOrStore(key, newConcurrencyLimiter(option.MaxConcurrent))
+			}
+			limiter = v.(*concurrencyLimiter)
+		}
+		if !limiter.acquire(r, option) {
+			if option.RetryAfter > 0 {
+				seconds := int(math.Ceil(option.RetryAfter.Seconds()))
+				r.Response.Header().Set("Retry-After", strconv.Itoa(seconds))
+			}
+			r.Response.WriteStatus(option.StatusCode)
+			return
+		}
+		defer limiter.release()
+		r.Middleware.Next()
+	}
+}
+
+func newConcurrencyLimiter(maxConcurrent int) *concurrencyLimiter {
+	return &concurrencyLimiter{
+		slots: make(chan struct{}, maxConcurrent),
+	}
+}
+
+// acquire tries acquiring a slot for the request, it waits in queue if configured.
+// It returns false if the request should be rejected.
+func (l *concurrencyLimiter) acquire(r *Request, option ConcurrencyLimitOption) bool {
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	default:
+	}
+	if option.MaxWaiting <= 0 {
+		return false
+	}
+	if l.waiting.Add(1) > int64(option.MaxWaiting) {
+		l.waiting.Add(-1)
+		return false
+	}
+	defer l.waiting.Add(-1)
+	var timeoutChan <-chan time.Time
+	if option.WaitTimeout > 0 {
+		timer := time.NewTimer(option.WaitTimeout)
+		defer timer.Stop()
+		timeoutChan = timer.C
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	case <-timeoutChan:
+		return false
+	case <-r.Context().Done():
+		return false
+	}
+}
+
+// release releases the slot acquired.
+func (l *concurrencyLimiter) release() {
+	<-l.slots
+}
@@ -0,0 +1,37 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"net/http"
+)
+
+// MiddlewareETag is a middleware that generates ETag for the buffered response content of GET/HEAD
+// requests, and responds status 304 without content if the content is not modified for the client,
+// which cuts bandwidth for polling clients.
+// Note that it does not handle the responses if:
+// 1. The request method is neither GET nor HEAD
+// 2. The response status is not 200
+// 3. The response is empty or has ETag header already, for example set by Response.CheckNotModified
+//
+// It should be registered before MiddlewareHandlerResponse to handle its content:
+//
+//	group.Middleware(ghttp.MiddlewareETag, ghttp.MiddlewareHandlerResponse)
+func MiddlewareETag(r *Request) {
+	r.Middleware.Next()
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return
+	}
+	if r.Response.Status != 0 && r.Response.Status != http.StatusOK {
+		return
+	}
+	if r.Response.Header().Get("ETag") != "" || r.Response.BufferLength() == 0 {
+		return
+	}
+	r.Response.CheckNotModified(GenerateETag(r.Response.Buffer()))
+}
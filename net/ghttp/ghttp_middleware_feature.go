@@ -0,0 +1,26 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"github.com/gogf/gf/v2/os/gfeature"
+	"github.com/gogf/gf/v2/util/gmode"
+)
+
+// MiddlewareFeatureOverride injects the feature flag overrides from request header
+// gfeature.OverrideHeader into request context, eg: "X-Feature-Flags: newCheckout=true,pageSize=20".
+//
+// It takes effect only in non-product mode, so that the developers and testers can switch
+// features per request without changing the configuration.
+func MiddlewareFeatureOverride(r *Request) {
+	if !gmode.IsProduct() {
+		if header := r.Header.Get(gfeature.OverrideHeader); header != "" {
+			r.SetCtx(gfeature.WithOverrides(r.Context(), gfeature.ParseOverrides(header)))
+		}
+	}
+	r.Middleware.Next()
+}
@@ -0,0 +1,196 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/internal/intlog"
+	"github.com/gogf/gf/v2/internal/json"
+	"github.com/gogf/gf/v2/os/gcache"
+)
+
+// IdempotencyOption is the option for MiddlewareIdempotency.
+type IdempotencyOption struct {
+	// Cache is the storage of the idempotent responses, which uses memory adapter in default.
+	// Use redis adapter by gcache.NewAdapterRedis for sharing the responses among server instances.
+	Cache *gcache.Cache
+
+	// TTL is the duration the responses are stored for replaying, which is 24 hours in default.
+	TTL time.Duration
+
+	// LockTTL is the maximum duration a request holds its key while being processed,
+	// which releases the key of crashed processing. It is 1 minute in default.
+	LockTTL time.Duration
+
+	// Header is the request header name of the idempotency key, which is "Idempotency-Key" in default.
+	Header string
+
+	// Methods are the request methods the middleware handles, which are POST and PATCH in default.
+	Methods []string
+
+	// Scope returns the identity of the client the key belongs to, like the authenticated user id,
+	// so that the same key of different clients never replays each other's responses.
+	// It uses the Authorization header, or else the session id, or else the client ip in default.
+	Scope func(r *Request) string
+}
+
+// idempotencyRecord is the stored processing state or response of an idempotency key.
+type idempotencyRecord struct {
+	BodyHash string              `json:"bodyHash"` // BodyHash is the sha256 hash of the request body.
+	Done     bool                `json:"done"`     // Done marks the response is stored, or else it's being processed.
+	Status   int                 `json:"status"`   // Status is the status code of the response.
+	Header   map[string][]string `json:"header"`   // Header is the header of the response.
+	Body     []byte              `json:"body"`     // Body is the content of the response.
+}
+
+const (
+	defaultIdempotencyHeader  = "Idempotency-Key"
+	defaultIdempotencyTTL     = 24 * time.Hour
+	defaultIdempotencyLockTTL = time.Minute
+	idempotencyCacheKeyPrefix = "ghttp.idempotency:"
+	idempotencyReplayedHeader = "Idempotent-Replayed"
+)
+
+// MiddlewareIdempotency returns a middleware that stores the responses of requests carrying
+// idempotency key header, and replays the stored responses on the retried requests with the same key,
+// which makes the retries of payment-style endpoints safe.
+//
+// The key is scoped by the client identity of IdempotencyOption.Scope, request method and path.
+// The retried request is responded with:
+// 1. The stored response with header "Idempotent-Replayed: true" if the original request is done.
+// 2. Status 409 if the original request is still being processed.
+// 3. Status 422 if the request body is different from the original request.
+//
+// Note that the responses with status code >= 500 are not stored, so that the failed requests can be retried.
+func MiddlewareIdempotency(option ...IdempotencyOption) HandlerFunc {
+	var opt IdempotencyOption
+	if len(option) > 0 {
+		opt = option[0]
+	}
+	if opt.Cache == nil {
+		opt.Cache = gcache.New()
+	}
+	if opt.TTL <= 0 {
+		opt.TTL = defaultIdempotencyTTL
+	}
+	if opt.LockTTL <= 0 {
+		opt.LockTTL = defaultIdempotencyLockTTL
+	}
+	if opt.Header == "" {
+		opt.Header = defaultIdempotencyHeader
+	}
+	if len(opt.Methods) == 0 {
+		opt.Methods = []string{http.MethodPost, http.MethodPatch}
+	}
+	if opt.Scope == nil {
+		opt.Scope = defaultIdempotencyScope
+	}
+	methods := make(map[string]struct{}, len(opt.Methods))
+	for _, method := range opt.Methods {
+		methods[strings.ToUpper(method)] = struct{}{}
+	}
+	return func(r *Request) {
+		var (
+			ctx = r.Context()
+			key = r.Header.Get(opt.Header)
+		)
+		if _, ok := methods[r.Method]; !ok || key == "" {
+			r.Middleware.Next()
+			return
+		}
+		var (
+			bodySum  = sha256.Sum256(r.GetBody())
+			bodyHash = hex.EncodeToString(bodySum[:])
+			scopeSum = sha256.Sum256([]byte(opt.Scope(r)))
+			cacheKey = idempotencyCacheKeyPrefix + hex.EncodeToString(scopeSum[:]) + ":" +
+				r.Method + ":" + r.URL.Path + ":" + key
+		)
+		lockContent, _ := json.Marshal(idempotencyRecord{BodyHash: bodyHash})
+		locked, err := opt.Cache.SetIfNotExist(ctx, cacheKey, string(lockContent), opt.LockTTL)
+		if err != nil {
+			intlog.Errorf(ctx, `%+v`, err)
+			r.Middleware.Next()
+			return
+		}
+		if !locked {
+			v, err := opt.Cache.Get(ctx, cacheKey)
+			if err != nil {
+				intlog.Errorf(ctx, `%+v`, err)
+				r.Middleware.Next()
+				return
+			}
+			var record *idempotencyRecord
+			if !v.IsNil() {
+				if err = json.Unmarshal(v.Bytes(), &record); err != nil {
+					intlog.Errorf(ctx, `%+v`, err)
+				}
+			}
+			// The record might be expired or removed just now, it responds conflict for simplicity
+			// and the client can retry later.
+			switch {
+			case record == nil || !record.Done:
+				r.Response.WriteStatus(http.StatusConflict, "request with the same idempotency key is being processed")
+			case record.BodyHash != bodyHash:
+				r.Response.WriteStatus(
+					http.StatusUnprocessableEntity,
+					"idempotency key is already used for a different request body",
+				)
+			default:
+				for k, values := range record.Header {
+					r.Response.Header()[k] = values
+				}
+				r.Response.Header().Set(idempotencyReplayedHeader, "true")
+				r.Response.WriteHeader(record.Status)
+				r.Response.Write(record.Body)
+			}
+			return
+		}
+
+		r.Middleware.Next()
+
+		status := r.Response.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		if status >= http.StatusInternalServerError {
+			if _, err = opt.Cache.Remove(ctx, cacheKey); err != nil {
+				intlog.Errorf(ctx, `%+v`, err)
+			}
+			return
+		}
+		recordContent, err := json.Marshal(idempotencyRecord{
+			BodyHash: bodyHash,
+			Done:     true,
+			Status:   status,
+			Header:   r.Response.Header().Clone(),
+			Body:     r.Response.Buffer(),
+		})
+		if err == nil {
+			err = opt.Cache.Set(ctx, cacheKey, string(recordContent), opt.TTL)
+		}
+		if err != nil {
+			intlog.Errorf(ctx, `%+v`, err)
+		}
+	}
+}
+
+// defaultIdempotencyScope returns the client identity of the request for idempotency key,
+// which is the Authorization header, or else the session id, or else the client ip.
+func defaultIdempotencyScope(r *Request) string {
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		return "authorization:" + authorization
+	}
+	if sessionId := r.Cookie.GetSessionId(); sessionId != "" {
+		return "session:" + sessionId
+	}
+	return "ip:" + r.GetClientIp()
+}
@@ -0,0 +1,376 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/internal/intlog"
+	"github.com/gogf/gf/v2/internal/json"
+	"github.com/gogf/gf/v2/os/gcache"
+	"github.com/gogf/gf/v2/os/gctx"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+// OutputCacheOption is the option for OutputCache.
+type OutputCacheOption struct {
+	// Cache is the storage of the cached responses, which uses memory adapter in default.
+	// Use redis adapter by gcache.NewAdapterRedis for sharing the responses among server instances.
+	Cache *gcache.Cache
+
+	// TTL is the duration the cached response is fresh, which is 1 minute in default.
+	TTL time.Duration
+
+	// StaleWhileRevalidate is the duration after TTL that the stale response is still served,
+	// while the response is revalidated in background. It is disabled in default.
+	StaleWhileRevalidate time.Duration
+
+	// VaryHeaders are the request header names that the cache key varies by, like "Accept-Language".
+	// The requests with credential headers "Authorization" or "Cookie" are not cached,
+	// unless the header is in VaryHeaders, which caches the responses for each credential separately.
+	VaryHeaders []string
+
+	// Methods are the request methods the middleware handles, which are GET and HEAD in default.
+	Methods []string
+
+	// Tags returns the tags of the response of the request, which can be purged by OutputCache.PurgeTag.
+	Tags func(r *Request) []string
+}
+
+// OutputCache caches the responses of routes, keyed by request method, path, query and vary headers.
+// The cached responses can be purged by path, by tag or all at once.
+//
+// Example:
+//
+//	cache := ghttp.NewOutputCache(ghttp.OutputCacheOption{TTL: time.Minute})
+//	group.Middleware(cache.Middleware)
+//	...
+//	cache.Purge(ctx, "/article/list")
+type OutputCache struct {
+	option OutputCacheOption
+}
+
+// outputCacheRecord is the cached response.
+type outputCacheRecord struct {
+	Status      int                 `json:"status"`      // Status is the status code of the response.
+	Header      map[string][]string `json:"header"`      // Header is the header of the response.
+	Body        []byte              `json:"body"`        // Body is the content of the response.
+	FreshUntil  time.Time           `json:"freshUntil"`  // FreshUntil is the time the response becomes stale.
+	TagVersions map[string]string   `json:"tagVersions"` // TagVersions are the tag versions when the response is cached.
+}
+
+// outputCacheCredentialHeaders are the request headers carrying the credentials of clients.
+var outputCacheCredentialHeaders = []string{"Authorization", "Cookie"}
+
+// outputCacheRevalidateCtxKey is the context key marking the background revalidating request.
+type outputCacheRevalidateCtxKey struct{}
+
+const (
+	defaultOutputCacheTTL        = time.Minute
+	outputCacheKeyPrefix         = "ghttp.output_cache:"
+	outputCacheVersionKeyPrefix  = "ghttp.output_cache.version:"
+	outputCacheRevalidatePrefix  = "ghttp.output_cache.revalidate:"
+	outputCacheRevalidateLockTTL = 30 * time.Second
+	outputCacheAllVersionName    = "*"
+
+	// OutputCacheHeader is the response header of the cache status, which is "HIT", "STALE" or "MISS".
+	OutputCacheHeader = "X-Cache"
+)
+
+// NewOutputCache creates and returns a new output cache.
+func NewOutputCache(option ...OutputCacheOption) *OutputCache {
+	var opt OutputCacheOption
+	if len(option) > 0 {
+		opt = option[0]
+	}
+	if opt.Cache == nil {
+		opt.Cache = gcache.New()
+	}
+	if opt.TTL <= 0 {
+		opt.TTL = defaultOutputCacheTTL
+	}
+	if len(opt.Methods) == 0 {
+		opt.Methods = []string{http.MethodGet, http.MethodHead}
+	}
+	for i, method := range opt.Methods {
+		opt.Methods[i] = strings.ToUpper(method)
+	}
+	return &OutputCache{
+		option: opt,
+	}
+}
+
+// MiddlewareOutputCache returns a middleware that caches the responses using a new OutputCache.
+// Use NewOutputCache instead if the cached responses need purging.
+func MiddlewareOutputCache(option ...OutputCacheOption) HandlerFunc {
+	return NewOutputCache(option...).Middleware
+}
+
+// Middleware is the middleware that responds the cached response if it is fresh, or else calls
+// the next handler and caches its response.
+//
+// The stale response is responded within StaleWhileRevalidate duration after it becomes stale,
+// and meanwhile only one request revalidates it in background.
+//
+// Note that only the responses with status 200 and without setting cookies are cached,
+// and the responses with "Cache-Control" of "no-store" or "private" are not cached.
+// The requests with credential headers not in VaryHeaders are neither served from cache nor cached.
+func (c *OutputCache) Middleware(r *Request) {
+	if !c.isMethodAllowed(r.Method) || c.hasCredential(r) {
+		r.Middleware.Next()
+		return
+	}
+	var (
+		ctx          = r.Context()
+		cacheKey     = c.cacheKey(ctx, r)
+		isRevalidate = ctx.Value(outputCacheRevalidateCtxKey{}) != nil
+	)
+	if !isRevalidate {
+		record := c.getRecord(ctx, cacheKey)
+		if record != nil {
+			if time.Now().Before(record.FreshUntil) {
+				c.writeRecord(r, record, "HIT")
+				return
+			}
+			c.revalidate(r, cacheKey)
+			c.writeRecord(r, record, "STALE")
+			return
+		}
+		r.Response.Header().Set(OutputCacheHeader, "MISS")
+	}
+
+	r.Middleware.Next()
+
+	if !c.isCacheable(r) {
+		return
+	}
+	var tagVersions map[string]string
+	if c.option.Tags != nil {
+		if tags := c.option.Tags(r); len(tags) > 0 {
+			tagVersions = make(map[string]string, len(tags))
+			for _, tag := range tags {
+				tagVersions[tag] = c.getVersion(ctx, "tag:"+tag)
+			}
+		}
+	}
+	header := r.Response.Header().Clone()
+	header.Del(OutputCacheHeader)
+	recordContent, err := json.Marshal(outputCacheRecord{
+		Status:      http.StatusOK,
+		Header:      header,
+		Body:        r.Response.Buffer(),
+		FreshUntil:  time.Now().Add(c.option.TTL),
+		TagVersions: tagVersions,
+	})
+	if err == nil {
+		err = c.option.Cache.Set(ctx, cacheKey, string(recordContent), c.option.TTL+c.option.StaleWhileRevalidate)
+	}
+	if err != nil {
+		intlog.Errorf(ctx, `%+v`, err)
+	}
+}
+
+// Purge purges the cached responses of request `paths`, including all their queries and vary headers.
+func (c *OutputCache) Purge(ctx context.Context, paths ...string) error {
+	for _, path := range paths {
+		if err := c.option.Cache.Set(ctx, c.versionKey("path:"+path), guid.S(), 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PurgeTag purges the cached responses having any of `tags`.
+func (c *OutputCache) PurgeTag(ctx context.Context, tags ...string) error {
+	for _, tag := range tags {
+		if err := c.option.Cache.Set(ctx, c.versionKey("tag:"+tag), guid.S(), 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PurgeAll purges all the cached responses.
+func (c *OutputCache) PurgeAll(ctx context.Context) error {
+	return c.option.Cache.Set(ctx, c.versionKey(outputCacheAllVersionName), guid.S(), 0)
+}
+
+// cacheKey builds the cache key of the request, which contains the versions of the path and all,
+// so that purging is done by changing the versions instead of deleting keys.
+func (c *OutputCache) cacheKey(ctx context.Context, r *Request) string {
+	var (
+		builder = strings.Builder{}
+		query   = r.URL.Query()
+		names   = make([]string, 0, len(query))
+	)
+	builder.WriteString(r.Method + "\n" + r.URL.Path + "\n")
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		builder.WriteString(name + "=" + strings.Join(query[name], ",") + "\n")
+	}
+	for _, name := range c.option.VaryHeaders {
+		builder.WriteString(name + ":" + r.Header.Get(name) + "\n")
+	}
+	builder.WriteString(c.getVersion(ctx, outputCacheAllVersionName) + "\n")
+	builder.WriteString(c.getVersion(ctx, "path:"+r.URL.Path))
+	sum := sha256.Sum256([]byte(builder.String()))
+	return outputCacheKeyPrefix + r.URL.Path + ":" + hex.EncodeToString(sum[:])
+}
+
+// getRecord retrieves the cached record, which returns nil if it does not exist or any of its tags is purged.
+func (c *OutputCache) getRecord(ctx context.Context, cacheKey string) *outputCacheRecord {
+	v, err := c.option.Cache.Get(ctx, cacheKey)
+	if err != nil {
+		intlog.Errorf(ctx, `%+v`, err)
+		return nil
+	}
+	if v.IsNil() {
+		return nil
+	}
+	var record *outputCacheRecord
+	if err = json.Unmarshal(v.Bytes(), &record); err != nil {
+		intlog.Errorf(ctx, `%+v`, err)
+		return nil
+	}
+	for tag, version := range record.TagVersions {
+		if c.getVersion(ctx, "tag:"+tag) != version {
+			return nil
+		}
+	}
+	return record
+}
+
+// writeRecord writes the cached record as response.
+func (c *OutputCache) writeRecord(r *Request, record *outputCacheRecord, cacheStatus string) {
+	for k, values := range record.Header {
+		r.Response.Header()[k] = values
+	}
+	r.Response.Header().Set(OutputCacheHeader, cacheStatus)
+	r.Response.WriteHeader(record.Status)
+	r.Response.Write(record.Body)
+}
+
+// revalidate revalidates the cached response in background by serving a copy of the request,
+// which is done by only one request at the same time.
+func (c *OutputCache) revalidate(r *Request, cacheKey string) {
+	ctx := r.Context()
+	locked, err := c.option.Cache.SetIfNotExist(
+		ctx, outputCacheRevalidatePrefix+cacheKey, 1, outputCacheRevalidateLockTTL,
+	)
+	if err != nil {
+		intlog.Errorf(ctx, `%+v`, err)
+		return
+	}
+	if !locked {
+		return
+	}
+	var (
+		revalidateCtx = context.WithValue(gctx.NeverDone(ctx), outputCacheRevalidateCtxKey{}, struct{}{})
+		request       = r.Request.Clone(revalidateCtx)
+		server        = r.Server
+	)
+	request.Body = http.NoBody
+	go func() {
+		defer func() {
+			if _, err := c.option.Cache.Remove(revalidateCtx, outputCacheRevalidatePrefix+cacheKey); err != nil {
+				intlog.Errorf(revalidateCtx, `%+v`, err)
+			}
+		}()
+		server.ServeHTTP(newOutputCacheDiscardWriter(), request)
+	}()
+}
+
+// isCacheable checks whether the response of the request can be cached.
+func (c *OutputCache) isCacheable(r *Request) bool {
+	if r.Response.Status != 0 && r.Response.Status != http.StatusOK {
+		return false
+	}
+	header := r.Response.Header()
+	if header.Get("Set-Cookie") != "" || (r.Cookie != nil && r.Cookie.isModified()) {
+		return false
+	}
+	cacheControl := strings.ToLower(header.Get("Cache-Control"))
+	return !strings.Contains(cacheControl, "no-store") && !strings.Contains(cacheControl, "private")
+}
+
+// hasCredential checks whether the request carries credential headers which the cache key does not vary by,
+// of which the responses might be personalized and should not be shared among clients.
+func (c *OutputCache) hasCredential(r *Request) bool {
+	for _, name := range outputCacheCredentialHeaders {
+		if r.Header.Get(name) == "" {
+			continue
+		}
+		var varied bool
+		for _, vary := range c.option.VaryHeaders {
+			if strings.EqualFold(vary, name) {
+				varied = true
+				break
+			}
+		}
+		if !varied {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *OutputCache) isMethodAllowed(method string) bool {
+	for _, v := range c.option.Methods {
+		if v == method {
+			return true
+		}
+	}
+	return false
+}
+
+// getVersion retrieves the version of `name`, which is empty if it is never purged.
+func (c *OutputCache) getVersion(ctx context.Context, name string) string {
+	v, err := c.option.Cache.Get(ctx, c.versionKey(name))
+	if err != nil {
+		intlog.Errorf(ctx, `%+v`, err)
+		return ""
+	}
+	return v.String()
+}
+
+func (c *OutputCache) versionKey(name string) string {
+	return outputCacheVersionKeyPrefix + name
+}
+
+// outputCacheDiscardWriter is the http.ResponseWriter discarding all content,
+// which is used for background revalidating.
+type outputCacheDiscardWriter struct {
+	header http.Header
+}
+
+func newOutputCacheDiscardWriter() *outputCacheDiscardWriter {
+	return &outputCacheDiscardWriter{
+		header: make(http.Header),
+	}
+}
+
+// Header implements interface http.ResponseWriter.
+func (w *outputCacheDiscardWriter) Header() http.Header {
+	return w.header
+}
+
+// Write implements interface http.ResponseWriter.
+func (w *outputCacheDiscardWriter) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+// WriteHeader implements interface http.ResponseWriter.
+func (w *outputCacheDiscardWriter) WriteHeader(int) {}
@@ -16,6 +16,7 @@ import (
 	"github.com/gogf/gf/v2/os/gres"
 	"github.com/gogf/gf/v2/os/gsession"
 	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/os/gvfs"
 	"github.com/gogf/gf/v2/os/gview"
 	"github.com/gogf/gf/v2/text/gregex"
 	"github.com/gogf/gf/v2/text/gstr"
@@ -53,6 +54,7 @@ type Request struct {
 	formMap         map[string]any       // Form parameters map, which is nil if there's no form of data from the client.
 	bodyMap         map[string]any       // Body parameters map, which might be nil if their nobody content.
 	error           error                // Current executing error of the request.
+	panicError      error                // The error recovered from the panic of the handlers, which is reported by PanicReporter.
 	exitAll         bool                 // A bool marking whether current request is exited.
 	parsedHost      string               // The parsed host name for current host used by GetHost function.
 	clientIp        string               // The parsed client ip for current host used by GetClientIp function.
@@ -61,11 +63,14 @@ type Request struct {
 	viewObject      *gview.View          // Custom template view engine object for this response.
 	viewParams      gview.Params         // Custom template view variables for this response.
 	originUrlPath   string               // Original URL path that passed from client.
+	apiVersion      string               // API version of the router group serving the request.
+	apiDeprecated   bool                 // A bool marking whether the router group serving the request is deprecated.
 }
 
 // staticFile is the file struct for static file service.
 type staticFile struct {
 	File  *gres.File // Resource file object.
+	VFS   gvfs.VFS   // Virtual filesystem object, the Path is the file name in it if it is set.
 	Path  string     // File path.
 	IsDir bool       // Is directory.
 }
@@ -0,0 +1,143 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/os/gstructs"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gmeta"
+	"github.com/gogf/gf/v2/util/gtag"
+	"github.com/gogf/gf/v2/util/gutil"
+	"github.com/gogf/gf/v2/util/gvalid"
+)
+
+// requestGuard is the cheap pre-check of request payload for strict route, which is generated
+// from the request struct when the route is registered. It rejects the malformed requests before
+// the full parsing, converting and validation of the request struct.
+//
+// Example:
+//
+//	type CreateReq struct {
+//	    g.Meta `path:"/user" method:"post" consumes:"application/json" maxBodySize:"64KB"`
+//	    Name   string `v:"required"`
+//	}
+type requestGuard struct {
+	MaxBodySize  int64    // Max body size in bytes from meta tag `maxBodySize`.
+	ContentTypes []string // Allowed content types from meta tag `consumes` or `mime`.
+	Required     []string // Parameter names that are required by validation rule "required".
+}
+
+// newRequestGuard creates and returns the request guard from the request struct `object` and its `fields`.
+// It returns nil if there's nothing to check.
+func newRequestGuard(object any, fields []gstructs.Field) *requestGuard {
+	guard := &requestGuard{}
+	if v := gmeta.Get(object, gtag.MaxBodySize); !v.IsEmpty() {
+		guard.MaxBodySize = gfile.StrToSize(v.String())
+	}
+	contentTypes := gmeta.Get(object, gtag.Consumes).String()
+	if contentTypes == "" {
+		contentTypes = gmeta.Get(object, gtag.Mime).String()
+	}
+	if contentTypes != "" {
+		guard.ContentTypes = gstr.SplitAndTrim(contentTypes, ",")
+	}
+	for _, field := range fields {
+		if field.IsEmbedded() {
+			continue
+		}
+		// The parameters from header and cookie are not checked.
+		if in := field.Tag(gtag.In); in == "header" || in == "cookie" {
+			continue
+		}
+		// The parameters having default value are never missing.
+		if field.Tag(gtag.DefaultShort) != "" || field.Tag(gtag.Default) != "" {
+			continue
+		}
+		var rule string
+		for _, tag := range gvalid.GetTags() {
+			if rule = field.Tag(tag); rule != "" {
+				break
+			}
+		}
+		if rule == "" {
+			continue
+		}
+		if _, rule, _ = gvalid.ParseTagValue(rule); gvalid.ContainsRule(rule, "required") {
+			guard.Required = append(guard.Required, field.TagPriorityName())
+		}
+	}
+	if guard.MaxBodySize <= 0 && len(guard.ContentTypes) == 0 && len(guard.Required) == 0 {
+		return nil
+	}
+	return guard
+}
+
+// Check checks the request payload, which writes the HTTP status and returns error if check fails.
+func (g *requestGuard) Check(r *Request) error {
+	if g.MaxBodySize > 0 {
+		if r.ContentLength > g.MaxBodySize {
+			r.Response.WriteHeader(http.StatusRequestEntityTooLarge)
+			return gerror.NewCodef(
+				gcode.CodeInvalidRequest,
+				`request body size %d exceeds the limit %d`, r.ContentLength, g.MaxBodySize,
+			)
+		}
+		if r.ContentLength < 0 {
+			// The body size is unknown, it limits the reading instead.
+			r.Body = http.MaxBytesReader(nil, r.Body, g.MaxBodySize)
+		}
+	}
+	if len(g.ContentTypes) > 0 && r.ContentLength != 0 && !g.isAllowedContentType(r.Header.Get("Content-Type")) {
+		r.Response.WriteHeader(http.StatusUnsupportedMediaType)
+		return gerror.NewCodef(
+			gcode.CodeInvalidRequest,
+			`unsupported content type "%s", the allowed content types are: %s`,
+			r.Header.Get("Content-Type"), strings.Join(g.ContentTypes, ", "),
+		)
+	}
+	if len(g.Required) > 0 {
+		params := r.GetRequestMap()
+		for _, name := range g.Required {
+			if _, ok := params[name]; ok {
+				continue
+			}
+			if gutil.MapContainsPossibleKey(params, name) {
+				continue
+			}
+			r.Response.WriteHeader(http.StatusBadRequest)
+			return gerror.NewCodef(gcode.CodeMissingParameter, `required parameter "%s" is missing`, name)
+		}
+	}
+	return nil
+}
+
+// isAllowedContentType checks whether `contentType` matches any of the allowed content types,
+// which supports wildcard like "image/*" and "*/*".
+func (g *requestGuard) isAllowedContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range g.ContentTypes {
+		allowed, _, _ = strings.Cut(allowed, ";")
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		switch {
+		case allowed == "*/*" || allowed == mediaType:
+			return true
+		case strings.HasSuffix(allowed, "/*") && strings.HasPrefix(mediaType, allowed[:len(allowed)-1]):
+			return true
+		}
+	}
+	return false
+}
@@ -109,6 +109,7 @@ func (m *middleware) Next() {
 				// of the real error point.
 				m.request.error = gerror.WrapCodeSkip(gcode.CodeInternalError, 1, exception, "")
 			}
+			m.request.panicError = m.request.error
 			m.request.Response.WriteStatus(http.StatusInternalServerError, exception)
 			loop = false
 		})
@@ -10,6 +10,7 @@ import (
 	"context"
 	"io"
 	"mime/multipart"
+	"path"
 	"strconv"
 	"strings"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/gogf/gf/v2/internal/json"
 	"github.com/gogf/gf/v2/os/gfile"
 	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/os/gvfs"
 	"github.com/gogf/gf/v2/util/grand"
 )
 
@@ -26,6 +28,7 @@ import (
 type UploadFile struct {
 	*multipart.FileHeader `json:"-"`
 	ctx                   context.Context
+	vfs                   gvfs.VFS // Virtual filesystem for saving the file, it saves to local disk if nil.
 }
 
 // MarshalJSON implements the interface MarshalJSON for json.Marshal.
@@ -40,6 +43,9 @@ type UploadFiles []*UploadFile
 //
 // The parameter `dirPath` should be a directory path, or it returns error.
 //
+// If the server configures UploadVFS, the file is saved to the directory of the virtual filesystem,
+// see SaveVFS.
+//
 // Note that it will OVERWRITE the target file if there's already a same name file exist.
 func (f *UploadFile) Save(dirPath string, randomlyRename ...bool) (filename string, err error) {
 	if f == nil {
@@ -48,6 +54,9 @@ func (f *UploadFile) Save(dirPath string, randomlyRename ...bool) (filename stri
 			"file is empty, maybe you retrieve it from invalid field name or form enctype",
 		)
 	}
+	if f.vfs != nil {
+		return f.SaveVFS(f.vfs, dirPath, randomlyRename...)
+	}
 	if !gfile.Exists(dirPath) {
 		if err = gfile.Mkdir(dirPath); err != nil {
 			return
@@ -63,12 +72,7 @@ func (f *UploadFile) Save(dirPath string, randomlyRename ...bool) (filename stri
 	}
 	defer file.Close()
 
-	name := gfile.Basename(f.Filename)
-	if len(randomlyRename) > 0 && randomlyRename[0] {
-		name = strings.ToLower(strconv.FormatInt(gtime.TimestampNano(), 36) + grand.S(6))
-		name = name + gfile.Ext(f.Filename)
-	}
-	filePath := gfile.Join(dirPath, name)
+	filePath := gfile.Join(dirPath, f.getSaveName(randomlyRename...))
 	newFile, err := gfile.Create(filePath)
 	if err != nil {
 		return "", err
@@ -82,6 +86,47 @@ func (f *UploadFile) Save(dirPath string, randomlyRename ...bool) (filename stri
 	return gfile.Basename(filePath), nil
 }
 
+// SaveVFS saves the single uploading file to directory path of the virtual filesystem `vfs`,
+// like the object storages, and returns the saved file name.
+//
+// The parameter `dirPath` is the slash-separated directory path in `vfs`.
+//
+// Note that it will OVERWRITE the target file if there's already a same name file exist.
+func (f *UploadFile) SaveVFS(vfs gvfs.VFS, dirPath string, randomlyRename ...bool) (filename string, err error) {
+	if f == nil {
+		return "", gerror.NewCode(
+			gcode.CodeMissingParameter,
+			"file is empty, maybe you retrieve it from invalid field name or form enctype",
+		)
+	}
+	file, err := f.Open()
+	if err != nil {
+		err = gerror.Wrapf(err, `UploadFile.Open failed`)
+		return "", err
+	}
+	defer file.Close()
+
+	var (
+		name     = f.getSaveName(randomlyRename...)
+		filePath = path.Join(dirPath, name)
+	)
+	intlog.Printf(f.ctx, `save upload file to vfs: %s`, filePath)
+	if err = vfs.Put(f.ctx, filePath, file); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// getSaveName returns the file name for saving, which is randomly generated if `randomlyRename` is true.
+func (f *UploadFile) getSaveName(randomlyRename ...bool) string {
+	name := gfile.Basename(f.Filename)
+	if len(randomlyRename) > 0 && randomlyRename[0] {
+		name = strings.ToLower(strconv.FormatInt(gtime.TimestampNano(), 36) + grand.S(6))
+		name = name + gfile.Ext(f.Filename)
+	}
+	return name
+}
+
 // Save saves all uploading files to specified directory path and returns the saved file names.
 //
 // The parameter `dirPath` should be a directory path or it returns error.
@@ -133,6 +178,7 @@ func (r *Request) GetUploadFiles(name string) UploadFiles {
 		for k, v := range multipartFiles {
 			uploadFiles[k] = &UploadFile{
 				ctx:        r.Context(),
+				vfs:        r.Server.config.UploadVFS,
 				FileHeader: v,
 			}
 		}
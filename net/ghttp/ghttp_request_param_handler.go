@@ -11,6 +11,12 @@ func (r *Request) GetHandlerResponse() any {
 	return r.handlerResponse
 }
 
+// GetApiVersion retrieves and returns the API version of the router group serving this request,
+// which is registered using RouterGroup.Version. It returns empty string if it is not a versioned route.
+func (r *Request) GetApiVersion() string {
+	return r.apiVersion
+}
+
 // GetServeHandler retrieves and returns the user defined handler used to serve this request.
 func (r *Request) GetServeHandler() *HandlerItemParsed {
 	return r.serveHandler
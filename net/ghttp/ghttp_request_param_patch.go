@@ -0,0 +1,459 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/json"
+	"github.com/gogf/gf/v2/os/gstructs"
+	"github.com/gogf/gf/v2/util/gtag"
+)
+
+const (
+	// ContentTypeMergePatch is the content type of JSON Merge Patch, see RFC 7386.
+	ContentTypeMergePatch = "application/merge-patch+json"
+	// ContentTypeJsonPatch is the content type of JSON Patch, see RFC 6902.
+	ContentTypeJsonPatch = "application/json-patch+json"
+)
+
+// jsonPatchOperation is the operation of JSON Patch.
+type jsonPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	From  string `json:"from"`
+	Value any    `json:"value"`
+}
+
+// Patch applies the request body as patch onto the entity struct `pointer`, which is
+// JSON Patch if the request content type is ContentTypeJsonPatch, or else JSON Merge Patch.
+// See ApplyMergePatch and ApplyJsonPatch.
+func (r *Request) Patch(pointer any, allowedFields ...string) (changed map[string]any, err error) {
+	if strings.Contains(r.Header.Get("Content-Type"), ContentTypeJsonPatch) {
+		return r.ApplyJsonPatch(pointer, allowedFields...)
+	}
+	return r.ApplyMergePatch(pointer, allowedFields...)
+}
+
+// ApplyMergePatch applies the request body as JSON Merge Patch (RFC 7386) onto the entity struct
+// `pointer`, and returns the changed fields of the struct.
+//
+// The optional parameter `allowedFields` is the whitelist of the top level json field names that
+// can be patched, it returns error of code CodeInvalidParameter if any other field is patched.
+// The field removed by the patch is reset to its zero value.
+//
+// The key of returned `changed` map is the `orm` tag name of the field, or else its json name,
+// so that it can be used for updating the entity in database, eg:
+//
+//	changed, err := r.ApplyMergePatch(user, "name", "email")
+//	if err != nil {
+//		return err
+//	}
+//	_, err = dao.User.Ctx(ctx).Data(changed).WherePri(user.Id).Update()
+//
+// Note that it returns empty `changed` if nothing is changed, which cannot be used for updating.
+// The field removed by the patch is also in `changed` with its zero value, which is nil for pointer
+// field, so do not use OmitNil or OmitEmpty for the update if such field should be reset in database.
+func (r *Request) ApplyMergePatch(pointer any, allowedFields ...string) (changed map[string]any, err error) {
+	var patch any
+	if err = json.UnmarshalUseNumber(r.GetBody(), &patch); err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInvalidParameter, err, `invalid merge patch`)
+	}
+	patchMap, ok := patch.(map[string]any)
+	if !ok {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, `invalid merge patch, it should be a JSON object`)
+	}
+	for name := range patchMap {
+		if err = checkPatchField(name, allowedFields); err != nil {
+			return nil, err
+		}
+	}
+	return applyPatchDocument(pointer, func(document map[string]any) (map[string]any, error) {
+		merged, _ := mergePatch(document, patchMap).(map[string]any)
+		return merged, nil
+	})
+}
+
+// ApplyJsonPatch applies the request body as JSON Patch (RFC 6902) onto the entity struct `pointer`,
+// and returns the changed fields of the struct. The patch is applied atomically, which means
+// `pointer` is not changed if any operation fails.
+//
+// The optional parameter `allowedFields` is the whitelist of the top level json field names that
+// can be patched, see ApplyMergePatch.
+func (r *Request) ApplyJsonPatch(pointer any, allowedFields ...string) (changed map[string]any, err error) {
+	var operations []jsonPatchOperation
+	if err = json.UnmarshalUseNumber(r.GetBody(), &operations); err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInvalidParameter, err, `invalid json patch`)
+	}
+	for _, operation := range operations {
+		paths := []string{operation.Path}
+		if operation.Op == "move" || operation.Op == "copy" {
+			paths = append(paths, operation.From)
+		}
+		for _, path := range paths {
+			tokens, err := parseJsonPointer(path)
+			if err != nil {
+				return nil, err
+			}
+			if len(tokens) == 0 {
+				return nil, gerror.NewCode(gcode.CodeInvalidParameter, `json patch on the whole entity is not allowed`)
+			}
+			if err = checkPatchField(tokens[0], allowedFields); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return applyPatchDocument(pointer, func(document map[string]any) (map[string]any, error) {
+		var (
+			node     any = document
+			applyErr error
+		)
+		for _, operation := range operations {
+			if node, applyErr = applyJsonPatchOperation(node, operation); applyErr != nil {
+				return nil, gerror.WrapCodef(
+					gcode.CodeInvalidParameter, applyErr,
+					`json patch operation "%s" on path "%s" failed`, operation.Op, operation.Path,
+				)
+			}
+		}
+		patched, ok := node.(map[string]any)
+		if !ok {
+			return nil, gerror.NewCode(gcode.CodeInvalidParameter, `invalid json patch result, it should be a JSON object`)
+		}
+		return patched, nil
+	})
+}
+
+// checkPatchField checks whether the field `name` is allowed to be patched.
+func checkPatchField(name string, allowedFields []string) error {
+	if len(allowedFields) == 0 {
+		return nil
+	}
+	for _, field := range allowedFields {
+		if field == name {
+			return nil
+		}
+	}
+	return gerror.NewCodef(gcode.CodeInvalidParameter, `field "%s" is not allowed to be patched`, name)
+}
+
+// applyPatchDocument converts the entity struct `pointer` to JSON document, patches the document
+// using `patch`, and then converts the patched document back to `pointer`.
+// It returns the changed fields of the struct.
+func applyPatchDocument(
+	pointer any, patch func(document map[string]any) (map[string]any, error),
+) (changed map[string]any, err error) {
+	reflectValue := reflect.ValueOf(pointer)
+	if reflectValue.Kind() != reflect.Pointer || reflectValue.IsNil() || reflectValue.Elem().Kind() != reflect.Struct {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`invalid parameter type "%T", it should be type of *struct`, pointer,
+		)
+	}
+	content, err := json.Marshal(pointer)
+	if err != nil {
+		return nil, err
+	}
+	var document map[string]any
+	if err = json.UnmarshalUseNumber(content, &document); err != nil {
+		return nil, err
+	}
+	if document, err = patch(document); err != nil {
+		return nil, err
+	}
+	if content, err = json.Marshal(document); err != nil {
+		return nil, err
+	}
+	// The fields ignored by json and the unexported fields are kept,
+	// and the other fields are reset as they are all in the patched document.
+	var (
+		original = reflectValue.Elem()
+		patched  = reflect.New(original.Type())
+	)
+	patched.Elem().Set(original)
+	patchedFields, err := getPatchFields(patched.Interface())
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range patchedFields {
+		if field.TagJsonName() != "-" {
+			field.Value.Set(reflect.Zero(field.Value.Type()))
+		}
+	}
+	if err = json.Unmarshal(content, patched.Interface()); err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInvalidParameter, err, `invalid patched entity`)
+	}
+	originalFields, err := getPatchFields(pointer)
+	if err != nil {
+		return nil, err
+	}
+	changed = make(map[string]any)
+	for i, field := range originalFields {
+		if field.TagJsonName() == "-" {
+			continue
+		}
+		var (
+			oldValue = field.Value.Interface()
+			newValue = patchedFields[i].Value.Interface()
+		)
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		name := strings.Split(field.Tag(gtag.ORM), ",")[0]
+		if name == "" {
+			name = field.TagJsonName()
+		}
+		if name == "" {
+			name = field.Name()
+		}
+		changed[name] = newValue
+	}
+	original.Set(patched.Elem())
+	return changed, nil
+}
+
+// getPatchFields returns the exported fields of struct `pointer`, the fields of embedded
+// structs without tag are retrieved recursively like json.
+func getPatchFields(pointer any) ([]gstructs.Field, error) {
+	fields, err := gstructs.Fields(gstructs.FieldsInput{
+		Pointer:         pointer,
+		RecursiveOption: gstructs.RecursiveOptionEmbeddedNoTag,
+	})
+	if err != nil {
+		return nil, err
+	}
+	exportedFields := fields[:0]
+	for _, field := range fields {
+		if field.IsExported() {
+			exportedFields = append(exportedFields, field)
+		}
+	}
+	return exportedFields, nil
+}
+
+// mergePatch applies merge `patch` onto `target` as RFC 7386 describes.
+func mergePatch(target, patch any) any {
+	patchMap, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	targetMap, ok := target.(map[string]any)
+	if !ok {
+		targetMap = make(map[string]any)
+	}
+	for key, value := range patchMap {
+		if value == nil {
+			delete(targetMap, key)
+		} else {
+			targetMap[key] = mergePatch(targetMap[key], value)
+		}
+	}
+	return targetMap
+}
+
+// applyJsonPatchOperation applies one JSON Patch `operation` onto `document` as RFC 6902 describes.
+func applyJsonPatchOperation(document any, operation jsonPatchOperation) (any, error) {
+	tokens, err := parseJsonPointer(operation.Path)
+	if err != nil {
+		return nil, err
+	}
+	switch operation.Op {
+	case "add":
+		return jsonPatchAdd(document, tokens, operation.Value, false)
+
+	case "remove":
+		document, _, err = jsonPatchRemove(document, tokens)
+		return document, err
+
+	case "replace":
+		return jsonPatchAdd(document, tokens, operation.Value, true)
+
+	case "move", "copy":
+		fromTokens, err := parseJsonPointer(operation.From)
+		if err != nil {
+			return nil, err
+		}
+		var value any
+		if operation.Op == "move" {
+			document, value, err = jsonPatchRemove(document, fromTokens)
+		} else {
+			value, err = jsonPatchGet(document, fromTokens)
+			if err == nil {
+				// Deep copy of the value, as it is a JSON value.
+				var content []byte
+				if content, err = json.Marshal(value); err == nil {
+					err = json.UnmarshalUseNumber(content, &value)
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return jsonPatchAdd(document, tokens, value, false)
+
+	case "test":
+		value, err := jsonPatchGet(document, tokens)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(value, operation.Value) {
+			return nil, gerror.NewCode(gcode.CodeInvalidParameter, `value not equal`)
+		}
+		return document, nil
+
+	default:
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid json patch operation "%s"`, operation.Op)
+	}
+}
+
+// parseJsonPointer parses the JSON Pointer (RFC 6901) `pointer` to its reference tokens.
+func parseJsonPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if pointer[0] != '/' {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid json pointer "%s"`, pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// jsonPatchGet returns the value referenced by `tokens` in `node`.
+func jsonPatchGet(node any, tokens []string) (any, error) {
+	for _, token := range tokens {
+		switch v := node.(type) {
+		case map[string]any:
+			value, ok := v[token]
+			if !ok {
+				return nil, newJsonPatchPathError(tokens)
+			}
+			node = value
+		case []any:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, newJsonPatchPathError(tokens)
+			}
+			node = v[index]
+		default:
+			return nil, newJsonPatchPathError(tokens)
+		}
+	}
+	return node, nil
+}
+
+// jsonPatchAdd adds `value` at the location referenced by `tokens` in `node`, which replaces
+// the existing value at the location if `replace` is true, and returns the updated node.
+func jsonPatchAdd(node any, tokens []string, value any, replace bool) (any, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	var (
+		token = tokens[0]
+		last  = len(tokens) == 1
+	)
+	switch v := node.(type) {
+	case map[string]any:
+		child, ok := v[token]
+		if last {
+			if replace && !ok {
+				return nil, newJsonPatchPathError(tokens)
+			}
+			v[token] = value
+			return v, nil
+		}
+		if !ok {
+			return nil, newJsonPatchPathError(tokens)
+		}
+		child, err := jsonPatchAdd(child, tokens[1:], value, replace)
+		if err != nil {
+			return nil, err
+		}
+		v[token] = child
+		return v, nil
+
+	case []any:
+		if last && token == "-" && !replace {
+			return append(v, value), nil
+		}
+		index, err := strconv.Atoi(token)
+		if err != nil || index < 0 || index > len(v) || (index == len(v) && (replace || !last)) {
+			return nil, newJsonPatchPathError(tokens)
+		}
+		if last && !replace {
+			v = append(v, nil)
+			copy(v[index+1:], v[index:])
+			v[index] = value
+			return v, nil
+		}
+		if v[index], err = jsonPatchAdd(v[index], tokens[1:], value, replace); err != nil {
+			return nil, err
+		}
+		return v, nil
+
+	default:
+		return nil, newJsonPatchPathError(tokens)
+	}
+}
+
+// jsonPatchRemove removes the value at the location referenced by `tokens` in `node`,
+// and returns the updated node and the removed value.
+func jsonPatchRemove(node any, tokens []string) (updated, removed any, err error) {
+	if len(tokens) == 0 {
+		return nil, nil, gerror.NewCode(gcode.CodeInvalidParameter, `cannot remove the whole document`)
+	}
+	var (
+		token = tokens[0]
+		last  = len(tokens) == 1
+	)
+	switch v := node.(type) {
+	case map[string]any:
+		child, ok := v[token]
+		if !ok {
+			return nil, nil, newJsonPatchPathError(tokens)
+		}
+		if last {
+			delete(v, token)
+			return v, child, nil
+		}
+		if v[token], removed, err = jsonPatchRemove(child, tokens[1:]); err != nil {
+			return nil, nil, err
+		}
+		return v, removed, nil
+
+	case []any:
+		index, err := strconv.Atoi(token)
+		if err != nil || index < 0 || index >= len(v) {
+			return nil, nil, newJsonPatchPathError(tokens)
+		}
+		if last {
+			removed = v[index]
+			return append(v[:index], v[index+1:]...), removed, nil
+		}
+		if v[index], removed, err = jsonPatchRemove(v[index], tokens[1:]); err != nil {
+			return nil, nil, err
+		}
+		return v, removed, nil
+
+	default:
+		return nil, nil, newJsonPatchPathError(tokens)
+	}
+}
+
+// newJsonPatchPathError creates the error for the nonexistent location of `tokens`,
+// which are the remaining tokens of the path.
+func newJsonPatchPathError(tokens []string) error {
+	return gerror.NewCodef(
+		gcode.CodeInvalidParameter, `location "/%s" does not exist`, strings.Join(tokens, "/"),
+	)
+}
@@ -90,14 +90,18 @@ func (r *Response) ServeFileDownload(path string, name ...string) {
 	}
 	r.Header().Set("Content-Type", "application/force-download")
 	r.Header().Set("Accept-Ranges", "bytes")
+	r.setContentDisposition(downloadName)
+	r.Header().Set("Access-Control-Expose-Headers", "Content-Disposition")
+	r.Server.serveFile(r.Request, serveFile)
+}
+
+// setContentDisposition sets the attachment header of the downloading file `downloadName`.
+func (r *Response) setContentDisposition(downloadName string) {
 	if utils.IsASCII(downloadName) {
 		r.Header().Set("Content-Disposition", fmt.Sprintf(`attachment;filename=%s`, url.QueryEscape(downloadName)))
 	} else {
 		r.Header().Set("Content-Disposition", fmt.Sprintf(`attachment;filename*=UTF-8''%s`, url.QueryEscape(downloadName)))
 	}
-
-	r.Header().Set("Access-Control-Expose-Headers", "Content-Disposition")
-	r.Server.serveFile(r.Request, serveFile)
 }
 
 // RedirectTo redirects the client to another location.
@@ -0,0 +1,128 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/internal/json"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+// GenerateETag generates and returns the strong ETag of the content, which is the quoted
+// hex string of the sha256 hash prefix of the content.
+func GenerateETag(content []byte) string {
+	sum := sha256.Sum256(content)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// CheckNotModified sets the ETag and the optional Last-Modified headers, and checks the conditional
+// request headers If-None-Match and If-Modified-Since. It clears the buffer and responds status 304
+// and returns true if the client's cached content is not modified, in which case the handler should
+// return without writing the content.
+//
+// The `etag` can be a version string provided by caller, which is quoted automatically if it is not quoted.
+//
+// Example:
+//
+//	if r.Response.CheckNotModified(article.Version, article.UpdatedAt) {
+//	    return
+//	}
+func (r *Response) CheckNotModified(etag string, lastModified ...time.Time) bool {
+	if etag != "" {
+		etag = formatETag(etag)
+		r.Header().Set("ETag", etag)
+	}
+	var modTime time.Time
+	if len(lastModified) > 0 && !lastModified[0].IsZero() {
+		modTime = lastModified[0]
+		r.Header().Set("Last-Modified", modTime.UTC().Format(http.TimeFormat))
+	}
+	if !isNotModified(r.Request.Request, etag, modTime) {
+		return false
+	}
+	r.ClearBuffer()
+	r.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// WriteJsonWithETag writes `content` to the response with JSON format and ETag header, or responds
+// status 304 without content if it is not modified for the client.
+// The ETag is generated from the optional `version`, or else from the serialized JSON content.
+func (r *Response) WriteJsonWithETag(content any, version ...string) {
+	// The content serializing is not necessary if the version is given and not modified.
+	if len(version) > 0 && version[0] != "" {
+		if r.CheckNotModified(version[0]) {
+			return
+		}
+		r.WriteJson(content)
+		return
+	}
+	var body []byte
+	switch content.(type) {
+	case string, []byte:
+		body = gconv.Bytes(content)
+	default:
+		var err error
+		if body, err = json.Marshal(content); err != nil {
+			panic(gerror.Wrap(err, `WriteJsonWithETag failed`))
+		}
+	}
+	if r.CheckNotModified(GenerateETag(body)) {
+		return
+	}
+	r.Header().Set("Content-Type", contentTypeJson)
+	r.Write(body)
+}
+
+// formatETag quotes the etag if it is not quoted.
+func formatETag(etag string) string {
+	if strings.HasPrefix(etag, `"`) || strings.HasPrefix(etag, `W/"`) {
+		return etag
+	}
+	return fmt.Sprintf(`"%s"`, etag)
+}
+
+// isNotModified checks whether the content of `etag` and `modTime` is not modified according to
+// the conditional request headers. The If-Modified-Since is ignored if If-None-Match exists.
+func isNotModified(r *http.Request, etag string, modTime time.Time) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		return etag != "" && matchETag(ifNoneMatch, etag)
+	}
+	if ifModifiedSince := r.Header.Get("If-Modified-Since"); ifModifiedSince != "" && !modTime.IsZero() {
+		t, err := http.ParseTime(ifModifiedSince)
+		if err != nil {
+			return false
+		}
+		// The http time has only second precision.
+		return !modTime.Truncate(time.Second).After(t)
+	}
+	return false
+}
+
+// matchETag checks whether the If-None-Match header value matches `etag` using weak comparison.
+func matchETag(ifNoneMatch, etag string) bool {
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, v := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(v), "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
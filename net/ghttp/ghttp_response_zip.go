@@ -0,0 +1,136 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"archive/zip"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/os/gres"
+)
+
+// ZipEntry is the entry of zip archive served by Response.ServeZip.
+type ZipEntry struct {
+	Name    string    // Name is the file path in archive, like "attachments/a.pdf".
+	Path    string    // Path is the local or resource file path of the content, which is used if Reader is nil.
+	Reader  io.Reader // Reader is the content reader of the entry, which has priority over Path.
+	ModTime time.Time // ModTime is the modification time of the entry, it's the file time or current time in default.
+	Store   bool      // Store stores the content without compression, which is for already compressed files.
+}
+
+// ZipEntryCallback is the callback function after each entry of the zip archive is written or fails.
+// The parameter `written` is the bytes of the entry content written, and `err` is the error of the entry.
+// The returned error aborts the archive, or else the failed entry is skipped and the archive continues.
+type ZipEntryCallback func(entry ZipEntry, written int64, err error) error
+
+// ServeZip streams a zip archive built from `entries` to the client as downloading file `name`,
+// without buffering the archive content to memory or disk.
+// It calls the optional `callback` after each entry is written, in which the failed entry can be
+// skipped by returning nil error. The failed entry aborts the archive if no callback is given.
+//
+// Note that the response status and headers are sent before the archive content, so the returned
+// error cannot change the response status, which is returned for logging purpose.
+func (r *Response) ServeZip(name string, entries []ZipEntry, callback ...ZipEntryCallback) (err error) {
+	if !strings.HasSuffix(strings.ToLower(name), ".zip") {
+		name += ".zip"
+	}
+	r.Header().Set("Content-Type", "application/zip")
+	r.setContentDisposition(name)
+	r.Header().Set("Access-Control-Expose-Headers", "Content-Disposition")
+	r.RawWriter().WriteHeader(200)
+
+	var (
+		ctx       = r.Request.Context()
+		zipWriter = zip.NewWriter(r.RawWriter())
+	)
+	defer func() {
+		if closeErr := zipWriter.Close(); closeErr != nil && err == nil {
+			err = gerror.Wrap(closeErr, `close zip writer failed`)
+		}
+	}()
+	for _, entry := range entries {
+		if err = ctx.Err(); err != nil {
+			return gerror.Wrap(err, `zip streaming canceled`)
+		}
+		written, entryErr := r.writeZipEntry(zipWriter, entry)
+		if len(callback) > 0 && callback[0] != nil {
+			if err = callback[0](entry, written, entryErr); err != nil {
+				return err
+			}
+			continue
+		}
+		if entryErr != nil {
+			return entryErr
+		}
+	}
+	return nil
+}
+
+// writeZipEntry writes single entry to the zip archive, and returns the written bytes of the content.
+func (r *Response) writeZipEntry(zipWriter *zip.Writer, entry ZipEntry) (written int64, err error) {
+	var (
+		reader  = entry.Reader
+		modTime = entry.ModTime
+	)
+	if entry.Name == "" {
+		return 0, gerror.NewCode(gcode.CodeInvalidParameter, `zip entry name should not be empty`)
+	}
+	if reader == nil {
+		if entry.Path == "" {
+			return 0, gerror.NewCodef(gcode.CodeInvalidParameter, `zip entry "%s" has neither reader nor path`, entry.Name)
+		}
+		if file := gres.Get(entry.Path); file != nil {
+			readCloser, openErr := file.Open()
+			if openErr != nil {
+				return 0, gerror.Wrapf(openErr, `open resource file "%s" failed`, entry.Path)
+			}
+			defer readCloser.Close()
+			reader = readCloser
+			if modTime.IsZero() {
+				modTime = file.FileInfo().ModTime()
+			}
+		} else {
+			file, openErr := gfile.Open(entry.Path)
+			if openErr != nil {
+				return 0, openErr
+			}
+			defer file.Close()
+			reader = file
+			if modTime.IsZero() {
+				if info, statErr := file.Stat(); statErr == nil {
+					modTime = info.ModTime()
+				}
+			}
+		}
+	}
+	if modTime.IsZero() {
+		modTime = time.Now()
+	}
+	header := &zip.FileHeader{
+		Name:     strings.TrimLeft(strings.ReplaceAll(entry.Name, "\\", "/"), "/"),
+		Method:   zip.Deflate,
+		Modified: modTime,
+	}
+	if entry.Store {
+		header.Method = zip.Store
+	}
+	header.SetMode(os.FileMode(0644))
+	entryWriter, err := zipWriter.CreateHeader(header)
+	if err != nil {
+		return 0, gerror.Wrapf(err, `create zip entry "%s" failed`, entry.Name)
+	}
+	if written, err = io.Copy(entryWriter, reader); err != nil {
+		return written, gerror.Wrapf(err, `write zip entry "%s" failed`, entry.Name)
+	}
+	return written, nil
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/olekukonko/tablewriter/tw"
 
 	"github.com/gogf/gf/v2/container/garray"
+	"github.com/gogf/gf/v2/container/gmap"
 	"github.com/gogf/gf/v2/container/gset"
 	"github.com/gogf/gf/v2/container/gtype"
 	"github.com/gogf/gf/v2/debug/gdebug"
@@ -109,6 +110,7 @@ func GetServer(name ...any) *Server {
 			routesMap:        make(map[string][]*HandlerItem),
 			openapi:          goai.New(),
 			registrar:        gsvc.GetRegistry(),
+			apiVersions:      gmap.NewStrAnyMap(true),
 		}
 		// Initialize the server using default configurations.
 		if err := s.SetConfig(NewConfig()); err != nil {
@@ -172,12 +174,18 @@ func (s *Server) Start() error {
 		s.config.SessionMaxAge,
 		s.config.SessionStorage,
 	)
+	s.sessionManager.AddEventHandler(s.config.SessionEventHandler)
 
 	// PProf feature.
 	if s.config.PProfEnabled {
 		s.EnablePProf(s.config.PProfPattern)
 	}
 
+	// Build information feature.
+	if s.config.BuildInfoEnabled {
+		s.EnableBuildInfo(s.config.BuildInfoPrefix)
+	}
+
 	// Default HTTP handler.
 	if s.config.Handler == nil {
 		s.config.Handler = s.ServeHTTP
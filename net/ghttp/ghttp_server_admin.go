@@ -8,10 +8,12 @@ package ghttp
 
 import (
 	"context"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/os/glog"
 	"github.com/gogf/gf/v2/os/gproc"
 	"github.com/gogf/gf/v2/os/gtimer"
 	"github.com/gogf/gf/v2/os/gview"
@@ -42,6 +44,10 @@ please make sure it is running using standalone binary not from IDE or "go run"
                 <p>
 <a href="{{$.uri}}/shutdown">Shutdown</a>
 graceful shutdown the server
+</p>
+                <p>
+<a href="{{$.uri}}/log-level">Log Level</a>
+logging level overrides of modules
 </p>
             </body>
             </html>
@@ -81,6 +87,31 @@ func (p *utilAdmin) Shutdown(r *Request) {
 	r.Response.WriteExit("server shutdown")
 }
 
+// LogLevel manages the logging level overrides of modules, which responds the current overrides in JSON.
+//
+// Method POST/PUT sets the level string `level` for logger module `module`,
+// which is automatically reverted after the optional duration `ttl`, eg: 10m.
+// Method DELETE removes the override of logger module `module`.
+func (p *utilAdmin) LogLevel(r *Request) {
+	module := r.Get("module").String()
+	switch r.Method {
+	case http.MethodPost, http.MethodPut:
+		if module == "" {
+			r.Response.WriteStatusExit(http.StatusBadRequest, "parameter module is required")
+		}
+		ttl := r.Get("ttl").Duration()
+		if err := glog.SetModuleLevelStr(module, r.Get("level").String(), ttl); err != nil {
+			r.Response.WriteStatusExit(http.StatusBadRequest, err.Error())
+		}
+	case http.MethodDelete:
+		if module == "" {
+			r.Response.WriteStatusExit(http.StatusBadRequest, "parameter module is required")
+		}
+		glog.RemoveModuleLevel(module)
+	}
+	r.Response.WriteJsonExit(glog.GetModuleLevels())
+}
+
 // EnableAdmin enables the administration feature for the process.
 // The optional parameter `pattern` specifies the URI for the administration page.
 func (s *Server) EnableAdmin(pattern ...string) {
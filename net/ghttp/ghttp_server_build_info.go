@@ -0,0 +1,141 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2"
+	"github.com/gogf/gf/v2/crypto/gsha256"
+	"github.com/gogf/gf/v2/internal/intlog"
+	"github.com/gogf/gf/v2/internal/json"
+	"github.com/gogf/gf/v2/os/gbuild"
+	"github.com/gogf/gf/v2/os/gcfg"
+)
+
+// HealthzInfo is the response content of the healthz handler.
+type HealthzInfo struct {
+	Status  string `json:"status"`  // Status is always "ok" if the server is serving.
+	Version string `json:"version"` // Version is the built version of the binary.
+	Commit  string `json:"commit"`  // Commit is the git commit hash of the binary.
+	Uptime  string `json:"uptime"`  // Uptime is the running duration of the process.
+}
+
+// VersionInfo is the response content of the version handler.
+type VersionInfo struct {
+	Version        string            `json:"version"`        // Version is the built version of the binary.
+	Commit         string            `json:"commit"`         // Commit is the git commit hash of the binary.
+	Dirty          bool              `json:"dirty"`          // Dirty specifies whether the binary is built with uncommitted changes.
+	Git            string            `json:"git"`            // Git is the git commit datetime and hash of the binary.
+	BuiltTime      string            `json:"builtTime"`      // BuiltTime is the built datetime of the binary.
+	GoFrame        string            `json:"goframe"`        // GoFrame is the GoFrame version of the binary.
+	Golang         string            `json:"golang"`         // Golang is the Golang version of the binary.
+	ConfigChecksum string            `json:"configChecksum"` // ConfigChecksum is the sha256 checksum of the default configuration.
+	Dependencies   map[string]string `json:"dependencies"`   // Dependencies is the module dependencies of the binary.
+	Data           map[string]any    `json:"data"`           // Data is the custom build-in variables of the binary.
+}
+
+const (
+	defaultHealthzPattern = "/healthz"
+	defaultVersionPattern = "/version"
+)
+
+var (
+	// processStartTime is the start time of current process for uptime calculating.
+	processStartTime = time.Now()
+)
+
+// EnableBuildInfo enables the "/healthz" and "/version" handlers exposing the build information of
+// the binary for server. The optional parameter `prefix` specifies the uri prefix of the handlers.
+func (s *Server) EnableBuildInfo(prefix ...string) {
+	s.Domain(DefaultDomainName).EnableBuildInfo(prefix...)
+}
+
+// EnableBuildInfo enables the "/healthz" and "/version" handlers exposing the build information of
+// the binary for server of specified domain.
+func (d *Domain) EnableBuildInfo(prefix ...string) {
+	var uri string
+	if len(prefix) > 0 && prefix[0] != "" {
+		_, _, uri, _ = d.server.parsePattern(prefix[0])
+		uri = strings.TrimRight(uri, "/")
+	}
+	d.BindHandler(uri+defaultHealthzPattern, HealthzHandler)
+	d.BindHandler(uri+defaultVersionPattern, VersionHandler)
+}
+
+// HealthzHandler is the handler responding the health status and brief build information as json.
+func HealthzHandler(r *Request) {
+	r.Response.WriteJsonExit(GetHealthzInfo())
+}
+
+// VersionHandler is the handler responding the detailed build information as json,
+// which is for version auditing of the deployed binaries.
+func VersionHandler(r *Request) {
+	r.Response.WriteJsonExit(GetVersionInfo(r.Context()))
+}
+
+// GetHealthzInfo returns the health status and brief build information of current process.
+func GetHealthzInfo() HealthzInfo {
+	return HealthzInfo{
+		Status:  "ok",
+		Version: gbuild.Get(gbuild.BuiltVersion).String(),
+		Commit:  gbuild.Commit(),
+		Uptime:  time.Since(processStartTime).Truncate(time.Second).String(),
+	}
+}
+
+// GetVersionInfo returns the detailed build information of current process.
+func GetVersionInfo(ctx context.Context) VersionInfo {
+	var (
+		info    = gbuild.Info()
+		golang  = info.Golang
+		goframe = info.GoFrame
+	)
+	// The versions are injected only by "gf build".
+	if golang == "" {
+		golang = runtime.Version()
+	}
+	if goframe == "" {
+		goframe = gf.VERSION
+	}
+	return VersionInfo{
+		Version:        info.Version,
+		Commit:         gbuild.Commit(),
+		Dirty:          gbuild.Dirty(),
+		Git:            info.Git,
+		BuiltTime:      info.Time,
+		GoFrame:        goframe,
+		Golang:         golang,
+		ConfigChecksum: getConfigChecksum(ctx),
+		Dependencies:   gbuild.Dependencies(),
+		Data:           info.Data,
+	}
+}
+
+// getConfigChecksum returns the sha256 checksum of the default configuration content,
+// or empty string if there's no configuration.
+func getConfigChecksum(ctx context.Context) string {
+	config := gcfg.Instance()
+	if !config.Available(ctx) {
+		return ""
+	}
+	data, err := config.Data(ctx)
+	if err != nil {
+		intlog.Errorf(ctx, `%+v`, err)
+		return ""
+	}
+	// The map keys are sorted in json encoding, so the checksum is stable.
+	content, err := json.Marshal(data)
+	if err != nil {
+		intlog.Errorf(ctx, `%+v`, err)
+		return ""
+	}
+	return gsha256.Encrypt(content)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/gogf/gf/v2/os/glog"
 	"github.com/gogf/gf/v2/os/gres"
 	"github.com/gogf/gf/v2/os/gsession"
+	"github.com/gogf/gf/v2/os/gvfs"
 	"github.com/gogf/gf/v2/os/gview"
 	"github.com/gogf/gf/v2/text/gstr"
 	"github.com/gogf/gf/v2/util/gconv"
@@ -150,6 +151,10 @@ type ServerConfig struct {
 	// It is automatically set enabled if any static path is set.
 	FileServerEnabled bool `json:"fileServerEnabled"`
 
+	// UploadVFS specifies the virtual filesystem for saving the uploaded files by UploadFile.Save,
+	// like the object storages. The uploaded files are saved to local disk if it is nil.
+	UploadVFS gvfs.VFS `json:"-"`
+
 	// ======================================================================================================
 	// Cookie.
 	// ======================================================================================================
@@ -194,6 +199,10 @@ type ServerConfig struct {
 	// SessionStorage specifies the session storage.
 	SessionStorage gsession.Storage `json:"sessionStorage"`
 
+	// SessionEventHandler specifies the handler for session events like created, destroyed and expired,
+	// which is commonly used for audit logging.
+	SessionEventHandler gsession.EventHandler `json:"-"`
+
 	// SessionCookieMaxAge specifies the cookie ttl for session id.
 	// If it is set 0, it means it expires along with browser session.
 	SessionCookieMaxAge time.Duration `json:"sessionCookieMaxAge"`
@@ -215,6 +224,15 @@ type ServerConfig struct {
 	AccessLogEnabled bool         `json:"accessLogEnabled"` // AccessLogEnabled enables access logging content to files.
 	AccessLogPattern string       `json:"accessLogPattern"` // AccessLogPattern specifies the error log file pattern like: access-{Ymd}.log
 
+	// PanicReporter specifies the reporter that receives the structured reports of the panics recovered
+	// from request handling, which is usually used for delivering panics to error tracking services.
+	PanicReporter PanicReporter `json:"-"`
+
+	// PanicReportInterval specifies the minimum interval of reporting the panics with the same fingerprint,
+	// which avoids flooding the reporter with the same panic. It reports all panics if it is not positive.
+	// It's 1 minute in default.
+	PanicReportInterval time.Duration `json:"panicReportInterval"`
+
 	// ======================================================================================================
 	// PProf.
 	// ======================================================================================================
@@ -222,6 +240,13 @@ type ServerConfig struct {
 	PProfEnabled bool   `json:"pprofEnabled"` // PProfEnabled enables PProf feature.
 	PProfPattern string `json:"pprofPattern"` // PProfPattern specifies the PProf service pattern for router.
 
+	// ======================================================================================================
+	// Build information.
+	// ======================================================================================================
+
+	BuildInfoEnabled bool   `json:"buildInfoEnabled"` // BuildInfoEnabled enables "/healthz" and "/version" handlers of build information.
+	BuildInfoPrefix  string `json:"buildInfoPrefix"`  // BuildInfoPrefix specifies the uri prefix of the build information handlers.
+
 	// ======================================================================================================
 	// API & Swagger.
 	// ======================================================================================================
@@ -252,6 +277,17 @@ type ServerConfig struct {
 	// It's `8MB` in default.
 	ClientMaxBodySize int64 `json:"clientMaxBodySize"`
 
+	// RequestGuard enables the cheap pre-checks of request payload for strict routes before request parsing,
+	// which checks the body size, content type and required parameters according to the request struct.
+	// See MaxBodySize and Consumes tags of package gtag.
+	RequestGuard bool `json:"requestGuard"`
+
+	// RequestIdEnabled enables the request id generation and propagation, which uses the request id from
+	// the "X-Request-Id" header of the request or generates a new one, and writes it back to the response
+	// header. The request id is carried in the request context, which is printed by glog, passed to the
+	// upstream services by gclient and attached to the SQL statements as comments by gdb.
+	RequestIdEnabled bool `json:"requestIdEnabled"`
+
 	// FormParsingMemory specifies max memory buffer size in bytes which can be used for
 	// parsing multimedia form.
 	// It can be configured in configuration file using string like: 1m, 10m, 500kb etc.
@@ -306,6 +342,7 @@ func NewConfig() ServerConfig {
 		ErrorLogPattern:         "error-{Ymd}.log",
 		AccessLogEnabled:        false,
 		AccessLogPattern:        "access-{Ymd}.log",
+		PanicReportInterval:     time.Minute,
 		DumpRouterMap:           true,
 		ClientMaxBodySize:       8 * 1024 * 1024, // 8MB
 		FormParsingMemory:       1024 * 1024,     // 1MB
@@ -6,7 +6,11 @@
 
 package ghttp
 
-import "github.com/gogf/gf/v2/os/glog"
+import (
+	"time"
+
+	"github.com/gogf/gf/v2/os/glog"
+)
 
 // SetLogPath sets the log path for server.
 // It logs content to file only if the log path is set.
@@ -61,6 +65,16 @@ func (s *Server) SetErrorStack(enabled bool) {
 	s.config.ErrorStack = enabled
 }
 
+// SetPanicReporter sets the PanicReporter for server.
+func (s *Server) SetPanicReporter(reporter PanicReporter) {
+	s.config.PanicReporter = reporter
+}
+
+// SetPanicReportInterval sets the PanicReportInterval for server.
+func (s *Server) SetPanicReportInterval(interval time.Duration) {
+	s.config.PanicReportInterval = interval
+}
+
 // GetLogPath returns the log path.
 func (s *Server) GetLogPath() string {
 	return s.config.LogPath
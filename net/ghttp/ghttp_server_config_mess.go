@@ -22,6 +22,16 @@ func (s *Server) SetClientMaxBodySize(maxSize int64) {
 	s.config.ClientMaxBodySize = maxSize
 }
 
+// SetRequestGuard sets the RequestGuard for server.
+func (s *Server) SetRequestGuard(enabled bool) {
+	s.config.RequestGuard = enabled
+}
+
+// SetRequestIdEnabled sets the RequestIdEnabled for server.
+func (s *Server) SetRequestIdEnabled(enabled bool) {
+	s.config.RequestIdEnabled = enabled
+}
+
 // SetFormParsingMemory sets the FormParsingMemory for server.
 func (s *Server) SetFormParsingMemory(maxMemory int64) {
 	s.config.FormParsingMemory = maxMemory
@@ -27,6 +27,11 @@ func (s *Server) SetSessionStorage(storage gsession.Storage) {
 	s.config.SessionStorage = storage
 }
 
+// SetSessionEventHandler sets the SessionEventHandler for server.
+func (s *Server) SetSessionEventHandler(handler gsession.EventHandler) {
+	s.config.SessionEventHandler = handler
+}
+
 // SetSessionCookieOutput sets the SetSessionCookieOutput for server.
 func (s *Server) SetSessionCookieOutput(enabled bool) {
 	s.config.SessionCookieOutput = enabled
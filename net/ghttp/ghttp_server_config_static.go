@@ -15,13 +15,15 @@ import (
 	"github.com/gogf/gf/v2/container/garray"
 	"github.com/gogf/gf/v2/os/gfile"
 	"github.com/gogf/gf/v2/os/gres"
+	"github.com/gogf/gf/v2/os/gvfs"
 	"github.com/gogf/gf/v2/util/gconv"
 )
 
 // staticPathItem is the item struct for static path configuration.
 type staticPathItem struct {
-	Prefix string // The router URI.
-	Path   string // The static path.
+	Prefix string   // The router URI.
+	Path   string   // The static path.
+	VFS    gvfs.VFS `json:"-"` // The virtual filesystem, which is used instead of Path if it is set.
 }
 
 // SetIndexFiles sets the index files for server.
@@ -95,10 +97,28 @@ func (s *Server) AddStaticPath(prefix string, path string) {
 			realPath = p
 		}
 	}
-	addItem := staticPathItem{
+	s.addStaticPathItem(staticPathItem{
 		Prefix: prefix,
 		Path:   realPath,
-	}
+	})
+}
+
+// AddStaticVFS sets the uri to virtual filesystem mapping for static file service,
+// so that the static files can be served from the object storages.
+func (s *Server) AddStaticVFS(prefix string, vfs gvfs.VFS) {
+	s.addStaticPathItem(staticPathItem{
+		Prefix: prefix,
+		VFS:    vfs,
+	})
+}
+
+// SetUploadVFS sets the virtual filesystem for saving the uploaded files by UploadFile.Save.
+func (s *Server) SetUploadVFS(vfs gvfs.VFS) {
+	s.config.UploadVFS = vfs
+}
+
+// addStaticPathItem adds the static path item, which keeps the items sorted by the length of prefix.
+func (s *Server) addStaticPathItem(addItem staticPathItem) {
 	if len(s.config.StaticPaths) > 0 {
 		s.config.StaticPaths = append(s.config.StaticPaths, addItem)
 		// Sort the array by length of prefix from short to long.
@@ -191,6 +191,16 @@ func (c *Cookie) RemoveCookie(key, domain, path string) {
 	c.SetCookie(key, "", domain, path, -24*time.Hour)
 }
 
+// isModified checks whether any cookie is set or removed by server in current request.
+func (c *Cookie) isModified() bool {
+	for _, v := range c.data {
+		if !v.FromClient {
+			return true
+		}
+	}
+	return false
+}
+
 // Flush outputs the cookie items to the client.
 func (c *Cookie) Flush() {
 	if len(c.data) == 0 {
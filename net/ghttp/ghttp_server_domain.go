@@ -43,6 +43,7 @@ func (d *Domain) doBindHandler(ctx context.Context, in doBindHandlerInput) {
 			Pattern:    patternBindDomain(in.Pattern, domain),
 			FuncInfo:   in.FuncInfo,
 			Middleware: in.Middleware,
+			Security:   in.Security,
 			Source:     in.Source,
 		})
 	}
@@ -63,6 +64,7 @@ func (d *Domain) doBindObject(ctx context.Context, in doBindObjectInput) {
 			Object:     in.Object,
 			Method:     in.Method,
 			Middleware: in.Middleware,
+			Security:   in.Security,
 			Source:     in.Source,
 		})
 	}
@@ -83,6 +85,7 @@ func (d *Domain) doBindObjectMethod(ctx context.Context, in doBindObjectMethodIn
 			Object:     in.Object,
 			Method:     in.Method,
 			Middleware: in.Middleware,
+			Security:   in.Security,
 			Source:     in.Source,
 		})
 	}
@@ -103,6 +106,7 @@ func (d *Domain) doBindObjectRest(ctx context.Context, in doBindObjectInput) {
 			Object:     in.Object,
 			Method:     in.Method,
 			Middleware: in.Middleware,
+			Security:   in.Security,
 			Source:     in.Source,
 		})
 	}
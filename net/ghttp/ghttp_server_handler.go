@@ -7,8 +7,12 @@
 package ghttp
 
 import (
+	"bytes"
+	"context"
+	"io"
 	"net/http"
 	"os"
+	"path"
 	"sort"
 	"strings"
 
@@ -20,6 +24,7 @@ import (
 	"github.com/gogf/gf/v2/os/gres"
 	"github.com/gogf/gf/v2/os/gspath"
 	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/os/gvfs"
 	"github.com/gogf/gf/v2/text/gstr"
 )
 
@@ -46,6 +51,16 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	)
 	defer s.handleAfterRequestDone(request)
 
+	// Request id generation and propagation.
+	if s.config.RequestIdEnabled {
+		s.handleRequestId(request)
+	}
+
+	// API version negotiation using request header.
+	if !s.apiVersions.IsEmpty() {
+		s.handleApiVersionNegotiation(request)
+	}
+
 	// ============================================================
 	// Priority:
 	// Static File > Dynamic Service > Static Directory
@@ -54,7 +69,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Search the static file with most high priority,
 	// which also handle the index files feature.
 	if s.config.FileServerEnabled {
-		request.StaticFile = s.searchStaticFile(r.URL.Path)
+		request.StaticFile = s.searchStaticFile(r.Context(), r.URL.Path)
 		if request.StaticFile != nil {
 			request.isFileRequest = true
 		}
@@ -179,21 +194,20 @@ func (s *Server) handleAfterRequestDone(request *Request) {
 			request.Response.WriteStatus(http.StatusInternalServerError)
 			if v, ok := exception.(error); ok {
 				if code := gerror.Code(v); code != gcode.CodeNil {
-					s.handleErrorLog(v, request)
+					request.panicError = v
 				} else {
-					s.handleErrorLog(
-						gerror.WrapCodeSkip(gcode.CodeInternalPanic, 1, v, ""),
-						request,
-					)
+					request.panicError = gerror.WrapCodeSkip(gcode.CodeInternalPanic, 1, v, "")
 				}
 			} else {
-				s.handleErrorLog(
-					gerror.NewCodeSkipf(gcode.CodeInternalPanic, 1, "%+v", exception),
-					request,
-				)
+				request.panicError = gerror.NewCodeSkipf(gcode.CodeInternalPanic, 1, "%+v", exception)
 			}
+			s.handleErrorLog(request.panicError, request)
 		}
 	}
+	// panic reporting.
+	if request.panicError != nil {
+		s.handlePanicReport(request.panicError, request)
+	}
 	// access log handling.
 	s.handleAccessLog(request)
 	// Close the session, which automatically update the TTL
@@ -221,7 +235,8 @@ func (s *Server) handleAfterRequestDone(request *Request) {
 
 // searchStaticFile searches the file with given URI.
 // It returns a file struct specifying the file information.
-func (s *Server) searchStaticFile(uri string) *staticFile {
+// The context `ctx` is used for searching the virtual filesystems.
+func (s *Server) searchStaticFile(ctx context.Context, uri string) *staticFile {
 	var (
 		file *gres.File
 		path string
@@ -235,6 +250,12 @@ func (s *Server) searchStaticFile(uri string) *staticFile {
 				if len(uri) > len(item.Prefix) && uri[len(item.Prefix)] != '/' {
 					continue
 				}
+				if item.VFS != nil {
+					if f := s.searchVFSFile(ctx, item.VFS, uri[len(item.Prefix):]); f != nil {
+						return f
+					}
+					continue
+				}
 				file = gres.GetWithIndex(item.Path+uri[len(item.Prefix):], s.config.IndexFiles)
 				if file != nil {
 					return &staticFile{
@@ -282,6 +303,32 @@ func (s *Server) searchStaticFile(uri string) *staticFile {
 	return nil
 }
 
+// searchVFSFile searches the file with given name in the virtual filesystem,
+// it searches the index files if the name is a directory.
+func (s *Server) searchVFSFile(ctx context.Context, vfs gvfs.VFS, name string) *staticFile {
+	name = strings.Trim(name, "/")
+	if name == "" {
+		name = "."
+	}
+	info, err := gvfs.StatCtx(ctx, vfs, name)
+	if err != nil {
+		return nil
+	}
+	if info.IsDir() {
+		for _, indexFile := range s.config.IndexFiles {
+			indexName := path.Join(name, indexFile)
+			if indexInfo, err := gvfs.StatCtx(ctx, vfs, indexName); err == nil && !indexInfo.IsDir() {
+				return &staticFile{VFS: vfs, Path: indexName}
+			}
+		}
+	}
+	return &staticFile{
+		VFS:   vfs,
+		Path:  name,
+		IsDir: info.IsDir(),
+	}
+}
+
 // serveFile serves the static file for the client.
 // The optional parameter `allowIndex` specifies if allowing directory listing if `f` is a directory.
 func (s *Server) serveFile(r *Request, f *staticFile, allowIndex ...bool) {
@@ -299,6 +346,11 @@ func (s *Server) serveFile(r *Request, f *staticFile, allowIndex ...bool) {
 		}
 		return
 	}
+	// Use file from virtual filesystem.
+	if f.VFS != nil {
+		s.serveVFSFile(r, f)
+		return
+	}
 	// Use file from dist.
 	file, err := os.Open(f.Path)
 	if err != nil {
@@ -325,6 +377,40 @@ func (s *Server) serveFile(r *Request, f *staticFile, allowIndex ...bool) {
 	}
 }
 
+// serveVFSFile serves the file from the virtual filesystem for the client.
+// The directory listing is not supported for virtual filesystem.
+func (s *Server) serveVFSFile(r *Request, f *staticFile) {
+	if f.IsDir {
+		r.Response.WriteStatus(http.StatusForbidden)
+		return
+	}
+	file, err := gvfs.OpenCtx(r.Context(), f.VFS, f.Path)
+	if err != nil {
+		r.Response.WriteStatus(http.StatusNotFound)
+		return
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	info, err := file.Stat()
+	if err != nil {
+		r.Response.WriteStatus(http.StatusInternalServerError)
+		return
+	}
+	// Clear the response buffer before file serving.
+	r.Response.ClearBuffer()
+	if content, ok := file.(io.ReadSeeker); ok {
+		r.Response.ServeContent(info.Name(), info.ModTime(), content)
+		return
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		r.Response.WriteStatus(http.StatusInternalServerError)
+		return
+	}
+	r.Response.ServeContent(info.Name(), info.ModTime(), bytes.NewReader(data))
+}
+
 // listDir lists the sub files of specified directory as HTML content to the client.
 func (s *Server) listDir(r *Request, f http.File) {
 	files, err := f.Readdir(-1)
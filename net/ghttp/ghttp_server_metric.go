@@ -23,6 +23,7 @@ type localMetricManager struct {
 	HttpServerRequestDurationTotal gmetric.Counter
 	HttpServerRequestBodySize      gmetric.Counter
 	HttpServerResponseBodySize     gmetric.Counter
+	HttpServerRequestApiVersion    gmetric.Counter
 }
 
 const (
@@ -34,6 +35,8 @@ const (
 	metricAttrKeyErrorCode              = "error.code"
 	metricAttrKeyHttpResponseStatusCode = "http.response.status_code"
 	metricAttrKeyNetworkProtocolVersion = "network.protocol.version"
+	metricAttrKeyApiVersion             = "api.version"
+	metricAttrKeyApiDeprecated          = "api.deprecated"
 )
 
 var (
@@ -114,6 +117,14 @@ func newMetricManager() *localMetricManager {
 				Attributes: gmetric.Attributes{},
 			},
 		),
+		HttpServerRequestApiVersion: meter.MustCounter(
+			"http.server.request.api_version",
+			gmetric.MetricOption{
+				Help:       "Total processed request number of each API version.",
+				Unit:       "",
+				Attributes: gmetric.Attributes{},
+			},
+		),
 	}
 	return mm
 }
@@ -254,3 +265,27 @@ func (s *Server) handleMetricsAfterRequestDone(r *Request) {
 		histogramOption,
 	)
 }
+
+func (s *Server) handleMetricsApiVersion(r *Request) {
+	if !gmetric.IsEnabled() {
+		return
+	}
+	var (
+		ctx     = r.Context()
+		attrMap = metricManager.GetMetricAttributeMap(r)
+	)
+	attrMap.Sets(gmetric.AttributeMap{
+		metricAttrKeyApiVersion:    r.apiVersion,
+		metricAttrKeyApiDeprecated: r.apiDeprecated,
+	})
+	metricManager.HttpServerRequestApiVersion.Inc(ctx, gmetric.Option{
+		Attributes: attrMap.Pick(
+			metricAttrKeyServerAddress,
+			metricAttrKeyServerPort,
+			metricAttrKeyHttpRoute,
+			metricAttrKeyHttpRequestMethod,
+			metricAttrKeyApiVersion,
+			metricAttrKeyApiDeprecated,
+		),
+	})
+}
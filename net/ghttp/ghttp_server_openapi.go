@@ -35,9 +35,10 @@ func (s *Server) initOpenApi() {
 			}
 			for _, method := range methods {
 				err = s.openapi.Add(goai.AddInput{
-					Path:   item.Route,
-					Method: method,
-					Object: item.Handler.Info.Value.Interface(),
+					Path:     item.Route,
+					Method:   method,
+					Object:   item.Handler.Info.Value.Interface(),
+					Security: item.Handler.Security,
 				})
 				if err != nil {
 					s.Logger().Fatalf(ctx, `%+v`, err)
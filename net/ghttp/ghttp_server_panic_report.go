@@ -0,0 +1,150 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/crypto/gsha1"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/net/gtrace"
+	"github.com/gogf/gf/v2/os/gctx"
+	"github.com/gogf/gf/v2/text/gregex"
+)
+
+// PanicReporter is the interface for reporting the panics recovered from request handling,
+// which can be implemented for error tracking services like Sentry.
+//
+// Note that ReportPanic is called synchronously after the response is written, the implementation
+// should deliver the report asynchronously if it takes long.
+type PanicReporter interface {
+	ReportPanic(ctx context.Context, report *PanicReport)
+}
+
+// PanicReporterFunc is the function adapter implementing PanicReporter.
+type PanicReporterFunc func(ctx context.Context, report *PanicReport)
+
+// PanicReport is the structured report of the panic recovered from request handling.
+type PanicReport struct {
+	Fingerprint string             // Fingerprint is the hash identifying the panics of the same cause and stack.
+	Error       error              // Error is the recovered panic error, which contains the stack.
+	Message     string             // Message is the message of the panic cause.
+	Stack       string             // Stack is the stack of the panic.
+	Request     PanicReportRequest // Request is the summary of the request that panics.
+	Time        time.Time          // Time is the time when the panic is recovered.
+}
+
+// PanicReportRequest is the summary of the request in PanicReport,
+// which contains no header, cookie or body content that might be sensitive.
+type PanicReportRequest struct {
+	Method    string // Method is the HTTP method of the request.
+	Host      string // Host is the host of the request.
+	Url       string // Url is the request URL containing the query string.
+	Route     string // Route is the route pattern that serves the request.
+	ClientIp  string // ClientIp is the client ip of the request.
+	UserAgent string // UserAgent is the user agent of the request.
+	Referer   string // Referer is the referer of the request.
+	TraceId   string // TraceId is the trace id of the request, only available if tracing is enabled.
+	RequestId string // RequestId is the request id of the request, only available if RequestIdEnabled is enabled.
+}
+
+const (
+	// panicReportCacheKeyPrefix is the cache key prefix for limiting panic reports of the same fingerprint.
+	panicReportCacheKeyPrefix = "PanicReport:"
+	// panicStackFunctionPattern matches the function name of the stack line like "   1).  main.main".
+	panicStackFunctionPattern = `^\s*\d+\)\.\s+(\S+)\s*$`
+	// panicMessageNumberPattern matches the numbers in panic message, which are masked for fingerprint.
+	panicMessageNumberPattern = `\d+`
+)
+
+// ReportPanic implements the interface PanicReporter.
+func (f PanicReporterFunc) ReportPanic(ctx context.Context, report *PanicReport) {
+	f(ctx, report)
+}
+
+// handlePanicReport reports the panic recovered from the request handling using PanicReporter,
+// which reports the panic of the same fingerprint only once in PanicReportInterval.
+func (s *Server) handlePanicReport(err error, r *Request) {
+	reporter := s.config.PanicReporter
+	if reporter == nil {
+		return
+	}
+	var (
+		ctx    = r.Context()
+		report = newPanicReport(err, r)
+	)
+	if interval := s.config.PanicReportInterval; interval > 0 {
+		ok, cacheErr := s.serveCache.SetIfNotExist(
+			ctx, panicReportCacheKeyPrefix+report.Fingerprint, struct{}{}, interval,
+		)
+		if cacheErr != nil {
+			s.Logger().Errorf(ctx, `%+v`, cacheErr)
+		}
+		if !ok {
+			return
+		}
+	}
+	// It protects the request handling from the panic of reporter.
+	defer func() {
+		if exception := recover(); exception != nil {
+			s.Logger().Errorf(ctx, `panic reporting failed: %+v`, exception)
+		}
+	}()
+	reporter.ReportPanic(ctx, report)
+}
+
+// newPanicReport creates and returns the structured report of the panic error for the request.
+func newPanicReport(err error, r *Request) *PanicReport {
+	var (
+		stack   = gerror.Stack(err)
+		message = err.Error()
+	)
+	if cause := gerror.Cause(err); cause != nil {
+		message = cause.Error()
+	}
+	report := &PanicReport{
+		Fingerprint: panicFingerprint(message, stack),
+		Error:       err,
+		Message:     message,
+		Stack:       stack,
+		Request: PanicReportRequest{
+			Method:    r.Method,
+			Host:      r.Host,
+			Url:       r.URL.String(),
+			ClientIp:  r.GetClientIp(),
+			UserAgent: r.UserAgent(),
+			Referer:   r.Referer(),
+			TraceId:   gtrace.GetTraceID(r.Context()),
+			RequestId: gctx.RequestId(r.Context()),
+		},
+		Time: time.Now(),
+	}
+	if handler := r.GetServeHandler(); handler != nil && handler.Handler.Router != nil {
+		report.Request.Route = handler.Handler.Router.Uri
+	}
+	return report
+}
+
+// panicFingerprint calculates the fingerprint of the panic using its message and stack.
+//
+// The numbers in the message and the file lines in the stack are ignored, so that the panics of the
+// same cause have the same fingerprint, even if they contain different values like indexes or
+// the source code is changed in other places.
+func panicFingerprint(message, stack string) string {
+	var builder strings.Builder
+	maskedMessage, _ := gregex.ReplaceString(panicMessageNumberPattern, "?", message)
+	builder.WriteString(maskedMessage)
+	for _, line := range strings.Split(stack, "\n") {
+		if match, _ := gregex.MatchString(panicStackFunctionPattern, line); len(match) > 1 {
+			builder.WriteString("\n")
+			builder.WriteString(match[1])
+		}
+	}
+	return gsha1.Encrypt(builder.String())
+}
@@ -7,10 +7,13 @@
 package ghttp
 
 import (
+	"fmt"
 	netpprof "net/http/pprof"
 	runpprof "runtime/pprof"
 	"strings"
+	"time"
 
+	"github.com/gogf/gf/v2/debug/gdebug"
 	"github.com/gogf/gf/v2/internal/intlog"
 	"github.com/gogf/gf/v2/os/gview"
 )
@@ -52,6 +55,7 @@ func (d *Domain) EnablePProf(pattern ...string) {
 		group.ALL("/profile", up.Profile)
 		group.ALL("/symbol", up.Symbol)
 		group.ALL("/trace", up.Trace)
+		group.ALL("/bundle", up.Bundle)
 	})
 }
 
@@ -84,6 +88,7 @@ func (p *utilPProf) Index(r *Request) {
 					{{end}}
                 </table>
                 <br><a href="{{$uri}}goroutine?debug=2">full goroutine stack dump</a><br>
+                <br><a href="{{$uri}}bundle">profiling snapshot bundle</a><br>
             </body>
             </html>
             `, data)
@@ -127,3 +132,18 @@ func (p *utilPProf) Symbol(r *Request) {
 func (p *utilPProf) Trace(r *Request) {
 	netpprof.Trace(r.Response.Writer, r.Request)
 }
+
+// Bundle responds with the profiling snapshot bundle as a tar.gz archive, which contains the goroutine,
+// heap, block and mutex profiles, the runtime information, the statistics of framework components and
+// the configuration dump with secrets redacted. It is usually used for attaching to support tickets.
+//
+// Note that the block and mutex profiles are empty unless their profiling rates are set
+// by runtime.SetBlockProfileRate and runtime.SetMutexProfileFraction.
+func (p *utilPProf) Bundle(r *Request) {
+	fileName := fmt.Sprintf(`pprof-bundle-%s.tar.gz`, time.Now().Format("20060102150405"))
+	r.Response.Header().Set("Content-Type", "application/gzip")
+	r.Response.Header().Set("Content-Disposition", fmt.Sprintf(`attachment;filename="%s"`, fileName))
+	if err := gdebug.WriteBundle(r.Context(), r.Response.Writer); err != nil {
+		intlog.Errorf(r.Context(), `%+v`, err)
+	}
+}
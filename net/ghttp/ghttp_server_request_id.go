@@ -0,0 +1,43 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"github.com/gogf/gf/v2/os/gctx"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+// maxRequestIdLength is the max length of the request id from client.
+const maxRequestIdLength = 128
+
+// handleRequestId retrieves the request id from the request header, or generates a new one if the
+// header is absent or invalid, and then sets it to the request context and the response header.
+func (s *Server) handleRequestId(r *Request) {
+	requestId := r.Header.Get(headerRequestId)
+	if !isValidRequestId(requestId) {
+		requestId = guid.S()
+	}
+	r.SetCtx(gctx.WithRequestId(r.Context(), requestId))
+	r.Response.Header().Set(headerRequestId, requestId)
+}
+
+// isValidRequestId checks whether the request id from client is valid, which contains only letters,
+// digits and characters "-", "_", ".", ":", as it is printed in logs and attached to SQL statements.
+func isValidRequestId(requestId string) bool {
+	if requestId == "" || len(requestId) > maxRequestIdLength {
+		return false
+	}
+	for i := 0; i < len(requestId); i++ {
+		c := requestId[i]
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == ':' {
+			continue
+		}
+		return false
+	}
+	return true
+}
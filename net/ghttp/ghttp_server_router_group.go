@@ -26,6 +26,7 @@ type (
 		domain     *Domain       // Domain.
 		prefix     string        // Prefix for sub-route.
 		middleware []HandlerFunc // Middleware array.
+		security   []string      // OpenAPI security scheme names.
 	}
 
 	// preBindItem is item for lazy registering feature of router group. preBindItem is not really registered
@@ -129,6 +130,10 @@ func (g *RouterGroup) Group(prefix string, groups ...func(group *RouterGroup)) *
 		group.middleware = make([]HandlerFunc, len(g.middleware))
 		copy(group.middleware, g.middleware)
 	}
+	if len(g.security) > 0 {
+		group.security = make([]string, len(g.security))
+		copy(group.security, g.security)
+	}
 	if len(groups) > 0 {
 		for _, v := range groups {
 			v(group)
@@ -145,8 +150,10 @@ func (g *RouterGroup) Clone() *RouterGroup {
 		domain:     g.domain,
 		prefix:     g.prefix,
 		middleware: make([]HandlerFunc, len(g.middleware)),
+		security:   make([]string, len(g.security)),
 	}
 	copy(newGroup.middleware, g.middleware)
+	copy(newGroup.security, g.security)
 	return newGroup
 }
 
@@ -265,6 +272,17 @@ func (g *RouterGroup) Middleware(handlers ...HandlerFunc) *RouterGroup {
 	return g
 }
 
+// Security binds one or more OpenAPI security scheme names to the router group, which are documented as
+// the security requirements of the routes registered after it in the group and its subgroups, if the request
+// structures of the routes do not configure `security` in their Meta tag.
+//
+// Note that the security schemes should be defined in the components of the OpenAPI specification,
+// and it only affects the documentation but not the authentication of the requests.
+func (g *RouterGroup) Security(names ...string) *RouterGroup {
+	g.security = append(g.security, names...)
+	return g
+}
+
 // preBindToLocalArray adds the route registering parameters to an internal variable array for lazily registering feature.
 func (g *RouterGroup) preBindToLocalArray(bindType string, pattern string, object any, params ...any) *RouterGroup {
 	_, file, line := gdebug.CallerWithFilter([]string{consts.StackFilterKeyForGoFrame})
@@ -341,6 +359,7 @@ func (g *RouterGroup) doBindRoutersToServer(ctx context.Context, item *preBindIt
 				Pattern:    pattern,
 				FuncInfo:   funcInfo,
 				Middleware: g.middleware,
+				Security:   g.security,
 				Source:     source,
 			}
 			if g.domain != nil {
@@ -357,6 +376,7 @@ func (g *RouterGroup) doBindRoutersToServer(ctx context.Context, item *preBindIt
 						Object:     object,
 						Method:     extras[0],
 						Middleware: g.middleware,
+						Security:   g.security,
 						Source:     source,
 					}
 					if g.domain != nil {
@@ -371,6 +391,7 @@ func (g *RouterGroup) doBindRoutersToServer(ctx context.Context, item *preBindIt
 						Object:     object,
 						Method:     extras[0],
 						Middleware: g.middleware,
+						Security:   g.security,
 						Source:     source,
 					}
 					if g.domain != nil {
@@ -386,6 +407,7 @@ func (g *RouterGroup) doBindRoutersToServer(ctx context.Context, item *preBindIt
 					Object:     object,
 					Method:     "",
 					Middleware: g.middleware,
+					Security:   g.security,
 					Source:     source,
 				}
 				// Finally, it treats the `object` as the Object registering type.
@@ -404,6 +426,7 @@ func (g *RouterGroup) doBindRoutersToServer(ctx context.Context, item *preBindIt
 			Object:     object,
 			Method:     "",
 			Middleware: g.middleware,
+			Security:   g.security,
 			Source:     source,
 		}
 		if g.domain != nil {
@@ -0,0 +1,138 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/container/garray"
+	"github.com/gogf/gf/v2/text/gregex"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+// DeprecateOption is the option for deprecating the routes of router group.
+type DeprecateOption struct {
+	Sunset time.Time // Sunset is the time when the routes become unavailable, which is responded in header "Sunset".
+	Link   string    // Link is the URL of the deprecation or migration document, which is responded in header "Link".
+}
+
+const (
+	// apiVersionAcceptPattern matches the version of the vendor media type in header "Accept",
+	// eg: "application/vnd.goframe.v2+json".
+	apiVersionAcceptPattern = `application/vnd\.[\w\-.]*?\.?(v\d[\w\-.]*)\+\w+`
+	// apiVersionParamPattern matches the version parameter of the media type in header "Accept",
+	// eg: "application/json; version=2".
+	apiVersionParamPattern = `;\s*version=([\w\-.]+)`
+)
+
+// Version creates and returns a subgroup for the API `version`, which has the version as its prefix,
+// eg: "/api/v2" for version "v2" of group "/api".
+//
+// Besides the path, the versioned routes can also be requested using the path without version, of which
+// the version is negotiated using header "X-Api-Version: v2", or header "Accept" with the vendor media type
+// like "application/vnd.goframe.v2+json" or the version parameter like "application/json; version=2".
+// The request is served by the unversioned route if there's no version negotiated.
+func (g *RouterGroup) Version(version string, groups ...func(group *RouterGroup)) *RouterGroup {
+	version = strings.Trim(version, "/")
+	g.server.apiVersions.GetOrSetFuncLock(g.getPrefix(), func() any {
+		return garray.NewStrArray(true)
+	}).(*garray.StrArray).Append(version)
+
+	group := g.Group("/" + version)
+	group.Middleware(func(r *Request) {
+		r.apiVersion = version
+		r.Middleware.Next()
+		r.Server.handleMetricsApiVersion(r)
+	})
+	for _, v := range groups {
+		v(group)
+	}
+	return group
+}
+
+// Deprecate marks the routes of the router group deprecated, which responds header "Deprecation: true",
+// and also header "Sunset" and "Link" with relation type "deprecation" if they are given in `option`.
+//
+// Note that like Middleware, it affects only the routes registered after it in the group.
+func (g *RouterGroup) Deprecate(option ...DeprecateOption) *RouterGroup {
+	var deprecateOption DeprecateOption
+	if len(option) > 0 {
+		deprecateOption = option[0]
+	}
+	return g.Middleware(func(r *Request) {
+		r.apiDeprecated = true
+		header := r.Response.Header()
+		header.Set("Deprecation", "true")
+		if !deprecateOption.Sunset.IsZero() {
+			header.Set("Sunset", deprecateOption.Sunset.UTC().Format(http.TimeFormat))
+		}
+		if deprecateOption.Link != "" {
+			header.Add("Link", fmt.Sprintf(`<%s>; rel="deprecation"`, deprecateOption.Link))
+		}
+		r.Middleware.Next()
+	})
+}
+
+// handleApiVersionNegotiation rewrites the path of the request to the versioned path if the request path
+// belongs to a group having versions but contains no version, and the version is negotiated using the headers.
+func (s *Server) handleApiVersionNegotiation(r *Request) {
+	var (
+		path     = r.URL.Path
+		prefix   string
+		versions *garray.StrArray
+	)
+	// It uses the longest group prefix matching the path.
+	s.apiVersions.RLockFunc(func(m map[string]any) {
+		for k, v := range m {
+			if (k == "" || path == k || gstr.HasPrefix(path, k+"/")) && (versions == nil || len(k) > len(prefix)) {
+				prefix, versions = k, v.(*garray.StrArray)
+			}
+		}
+	})
+	if versions == nil {
+		return
+	}
+	var (
+		subPath           = path[len(prefix):]
+		versionedPathPart = gstr.TrimLeft(subPath, "/")
+	)
+	if index := strings.IndexByte(versionedPathPart, '/'); index != -1 {
+		versionedPathPart = versionedPathPart[:index]
+	}
+	if versions.Contains(versionedPathPart) {
+		return
+	}
+	// The response varies on the version negotiation headers for the path without version.
+	r.Response.Header().Add("Vary", headerApiVersion+", Accept")
+	for _, version := range getRequestedApiVersions(r) {
+		for _, v := range []string{version, "v" + version} {
+			if versions.Contains(v) {
+				r.URL.Path = prefix + "/" + v + subPath
+				return
+			}
+		}
+	}
+}
+
+// getRequestedApiVersions returns the API versions requested by the client in the request headers.
+func getRequestedApiVersions(r *Request) []string {
+	var versions []string
+	if version := strings.TrimSpace(r.Header.Get(headerApiVersion)); version != "" {
+		versions = append(versions, version)
+	}
+	if accept := r.Header.Get("Accept"); accept != "" {
+		for _, pattern := range []string{apiVersionAcceptPattern, apiVersionParamPattern} {
+			if match, _ := gregex.MatchString(pattern, accept); len(match) > 1 {
+				versions = append(versions, match[1])
+			}
+		}
+	}
+	return versions
+}
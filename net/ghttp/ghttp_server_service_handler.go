@@ -43,6 +43,7 @@ type doBindHandlerInput struct {
 	Pattern    string
 	FuncInfo   handlerFuncInfo
 	Middleware []HandlerFunc
+	Security   []string
 	Source     string
 }
 
@@ -58,6 +59,7 @@ func (s *Server) doBindHandler(ctx context.Context, in doBindHandlerInput) {
 			Type:       HandlerTypeHandler,
 			Info:       in.FuncInfo,
 			Middleware: in.Middleware,
+			Security:   in.Security,
 			Source:     in.Source,
 		},
 	})
@@ -235,6 +237,7 @@ func (s *Server) checkAndCreateFuncInfo(
 		return funcInfo, err
 	}
 	funcInfo.ReqStructFields = fields
+	funcInfo.Guard = newRequestGuard(inputObjectPtr, fields)
 	funcInfo.Func = createRouterFunc(funcInfo)
 	return
 }
@@ -248,6 +251,11 @@ func createRouterFunc(funcInfo handlerFuncInfo) func(r *Request) {
 				reflect.ValueOf(r.Context()),
 			}
 		)
+		if funcInfo.Guard != nil && r.Server.config.RequestGuard {
+			if r.error = funcInfo.Guard.Check(r); r.error != nil {
+				return
+			}
+		}
 		if funcInfo.Type.NumIn() == 2 {
 			var inputObject reflect.Value
 			if funcInfo.Type.In(1).Kind() == reflect.Pointer {
@@ -0,0 +1,60 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/encoding/gjson"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/glog"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Admin_LogLevel(t *testing.T) {
+	defer glog.ClearModuleLevels()
+	s := g.Server(guid.S())
+	s.EnableAdmin()
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d/debug/admin", s.GetListenedPort()))
+
+		t.Assert(client.GetContent(ctx, "/log-level"), "[]")
+
+		content := client.PostContent(ctx, "/log-level", g.Map{
+			"module": "admin-test",
+			"level":  "debug",
+			"ttl":    "10m",
+		})
+		j, err := gjson.LoadContent([]byte(content))
+		t.AssertNil(err)
+		t.Assert(j.Get("0.module"), "admin-test")
+		t.Assert(j.Get("0.level"), glog.LEVEL_ALL|glog.LEVEL_PANI|glog.LEVEL_FATA)
+		t.Assert(len(glog.GetModuleLevels()), 1)
+
+		t.Assert(client.PostContent(ctx, "/log-level", g.Map{
+			"module": "admin-test",
+			"level":  "none-exist",
+		}), "invalid level string: none-exist")
+		t.Assert(client.PostContent(ctx, "/log-level", g.Map{
+			"level": "debug",
+		}), "parameter module is required")
+
+		t.Assert(client.DeleteContent(ctx, "/log-level", g.Map{
+			"module": "admin-test",
+		}), "[]")
+		t.Assert(len(glog.GetModuleLevels()), 0)
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2"
+	"github.com/gogf/gf/v2/encoding/gjson"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func TestServer_EnableBuildInfo(t *testing.T) {
+	s := g.Server(guid.S())
+	s.EnableBuildInfo()
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		j, err := gjson.LoadContent(client.GetBytes(ctx, "/healthz"))
+		t.AssertNil(err)
+		t.Assert(j.Get("status"), "ok")
+		t.Assert(j.Contains("commit"), true)
+		t.Assert(j.Contains("uptime"), true)
+
+		j, err = gjson.LoadContent(client.GetBytes(ctx, "/version"))
+		t.AssertNil(err)
+		t.Assert(j.Get("golang"), runtime.Version())
+		t.Assert(j.Get("goframe"), gf.VERSION)
+		t.Assert(j.Get("dirty"), false)
+		t.Assert(j.Contains("configChecksum"), true)
+		t.Assert(j.Contains("dependencies"), true)
+	})
+}
+
+func TestServer_EnableBuildInfo_Prefix(t *testing.T) {
+	s := g.Server(guid.S())
+	s.SetConfigWithMap(g.Map{
+		"buildInfoEnabled": true,
+		"buildInfoPrefix":  "/internal/",
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		r, err := client.Get(ctx, "/healthz")
+		t.AssertNil(err)
+		t.Assert(r.StatusCode, 404)
+		r.Close()
+
+		j, err := gjson.LoadContent(client.GetBytes(ctx, "/internal/healthz"))
+		t.AssertNil(err)
+		t.Assert(j.Get("status"), "ok")
+		j, err = gjson.LoadContent(client.GetBytes(ctx, "/internal/version"))
+		t.AssertNil(err)
+		t.Assert(j.Get("golang"), runtime.Version())
+	})
+}
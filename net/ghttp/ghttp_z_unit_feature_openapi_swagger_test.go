@@ -15,6 +15,7 @@ import (
 	"github.com/gogf/gf/v2/errors/gerror"
 	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/net/goai"
 	"github.com/gogf/gf/v2/test/gtest"
 	"github.com/gogf/gf/v2/text/gstr"
 	"github.com/gogf/gf/v2/util/gmeta"
@@ -210,3 +211,45 @@ func openApiBasicAuth(r *ghttp.Request) {
 		return
 	}
 }
+
+func Test_OpenApi_Group_Security(t *testing.T) {
+	type PublicReq struct {
+		gmeta.Meta `path:"/public" method:"get"`
+	}
+	type AdminReq struct {
+		gmeta.Meta `path:"/admin" method:"get"`
+	}
+	type KeyReq struct {
+		gmeta.Meta `path:"/key" method:"get" security:"apiKey"`
+	}
+	type TestRes struct{}
+
+	s := g.Server(guid.S())
+	s.SetOpenApiPath("/api.json")
+	s.Use(ghttp.MiddlewareHandlerResponse)
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.GET("/public", func(ctx context.Context, req *PublicReq) (res *TestRes, err error) {
+			return
+		})
+		group.Group("/v1", func(group *ghttp.RouterGroup) {
+			group.Security("bearerAuth")
+			group.GET("/admin", func(ctx context.Context, req *AdminReq) (res *TestRes, err error) {
+				return
+			})
+			group.GET("/key", func(ctx context.Context, req *KeyReq) (res *TestRes, err error) {
+				return
+			})
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		paths := s.GetOpenApi().Paths
+		t.Assert(paths["/public"].Get.Security, nil)
+		t.Assert(*paths["/v1/admin"].Get.Security, goai.SecurityRequirements{{"bearerAuth": {}}})
+		t.Assert(*paths["/v1/key"].Get.Security, goai.SecurityRequirements{{"apiKey": {}}})
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/container/gtype"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_PanicReport(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		reports []*ghttp.PanicReport
+	)
+	s := g.Server(guid.S())
+	s.SetPanicReporter(ghttp.PanicReporterFunc(func(ctx context.Context, report *ghttp.PanicReport) {
+		mu.Lock()
+		defer mu.Unlock()
+		reports = append(reports, report)
+	}))
+	s.SetRequestIdEnabled(true)
+	s.BindHandler("/index/{id}", func(r *ghttp.Request) {
+		var items []int
+		_ = items[r.Get("id").Int()]
+	})
+	s.BindHandler("/error", func(r *ghttp.Request) {
+		panic("custom error")
+	})
+	s.BindHandler("/ok", func(r *ghttp.Request) {
+		r.Response.Write("ok")
+	})
+	s.SetDumpRouterMap(false)
+	s.SetLogStdout(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		r, err := client.Header(g.MapStrStr{"X-Request-Id": "panic-1"}).Get(ctx, "/index/1?name=john")
+		t.AssertNil(err)
+		t.Assert(r.StatusCode, http.StatusInternalServerError)
+		r.Close()
+
+		// The panic of the same fingerprint is not reported again in the interval,
+		// even if the panic message contains different numbers.
+		r, err = client.Get(ctx, "/index/2")
+		t.AssertNil(err)
+		t.Assert(r.StatusCode, http.StatusInternalServerError)
+		r.Close()
+
+		r, err = client.Get(ctx, "/error")
+		t.AssertNil(err)
+		t.Assert(r.StatusCode, http.StatusInternalServerError)
+		r.Close()
+
+		t.Assert(client.GetContent(ctx, "/ok"), "ok")
+		time.Sleep(100 * time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+		t.Assert(len(reports), 2)
+
+		report := reports[0]
+		t.AssertNE(report.Fingerprint, "")
+		t.AssertNE(report.Error, nil)
+		t.Assert(strings.Contains(report.Message, "index out of range"), true)
+		t.Assert(strings.Contains(report.Stack, "ghttp_z_unit_feature_panic_report_test.go"), true)
+		t.Assert(report.Request.Method, http.MethodGet)
+		t.Assert(report.Request.Url, "/index/1?name=john")
+		t.Assert(report.Request.Route, "/index/{id}")
+		t.Assert(report.Request.ClientIp, "127.0.0.1")
+		t.Assert(report.Request.RequestId, "panic-1")
+		t.Assert(report.Time.IsZero(), false)
+
+		t.AssertNE(reports[1].Fingerprint, report.Fingerprint)
+		t.Assert(strings.Contains(reports[1].Message, "custom error"), true)
+		t.Assert(reports[1].Request.Route, "/error")
+	})
+}
+
+func Test_PanicReport_Interval(t *testing.T) {
+	var count = gtype.NewInt()
+	s := g.Server(guid.S())
+	s.SetPanicReporter(ghttp.PanicReporterFunc(func(ctx context.Context, report *ghttp.PanicReport) {
+		count.Add(1)
+		panic("reporter error")
+	}))
+	s.SetPanicReportInterval(0)
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareHandlerResponse)
+		group.ALL("/error", func(r *ghttp.Request) {
+			panic("custom error")
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.SetLogStdout(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		// All panics are reported without interval, and the panic of reporter does not break serving.
+		for i := 0; i < 3; i++ {
+			r, err := client.Get(ctx, "/error")
+			t.AssertNil(err)
+			t.Assert(r.StatusCode, http.StatusInternalServerError)
+			r.Close()
+		}
+		time.Sleep(100 * time.Millisecond)
+		t.Assert(count.Val(), 3)
+	})
+}
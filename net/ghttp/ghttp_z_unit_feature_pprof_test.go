@@ -9,7 +9,11 @@
 package ghttp_test
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"fmt"
+	"io"
 	"testing"
 	"time"
 
@@ -65,3 +69,43 @@ func TestServer_StartPProfServer(t *testing.T) {
 		}
 	})
 }
+
+func TestServer_PProfBundle(t *testing.T) {
+	C(t, func(t *T) {
+		s := g.Server(guid.S())
+		s.EnablePProf("/pprof")
+		s.SetDumpRouterMap(false)
+		s.Start()
+		defer s.Shutdown()
+		time.Sleep(100 * time.Millisecond)
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		r, err := client.Get(ctx, "/pprof/bundle")
+		t.AssertNil(err)
+		defer r.Close()
+		t.Assert(r.StatusCode, 200)
+		t.Assert(r.Header.Get("Content-Type"), "application/gzip")
+
+		gzReader, err := gzip.NewReader(bytes.NewReader(r.ReadAll()))
+		t.AssertNil(err)
+		var (
+			files     = make([]string, 0)
+			tarReader = tar.NewReader(gzReader)
+		)
+		for {
+			header, err := tarReader.Next()
+			if err == io.EOF {
+				break
+			}
+			t.AssertNil(err)
+			files = append(files, header.Name)
+		}
+		for _, name := range []string{
+			"goroutine.txt", "heap.pb.gz", "block.pb.gz", "mutex.pb.gz",
+			"runtime.json", "config.json", "database.json", "gcache.json",
+		} {
+			t.AssertIN(name, files)
+		}
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+type testGuardReq struct {
+	g.Meta `path:"/user" method:"post" consumes:"application/json" maxBodySize:"64"`
+	Name   string `json:"name" v:"required|length:2,10"`
+	Age    int    `json:"age"  v:"min:1"`
+	Role   string `json:"role" v:"required" d:"user"`
+}
+
+type testGuardRes struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+type testGuardController struct{}
+
+func (c *testGuardController) Create(ctx context.Context, req *testGuardReq) (res *testGuardRes, err error) {
+	return &testGuardRes{Name: req.Name, Role: req.Role}, nil
+}
+
+func Test_RequestGuard(t *testing.T) {
+	s := g.Server(guid.S())
+	s.SetRequestGuard(true)
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareHandlerResponse)
+		group.Bind(new(testGuardController))
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		r, err := client.ContentJson().Post(ctx, "/user", g.Map{"name": "john"})
+		t.AssertNil(err)
+		t.Assert(r.StatusCode, http.StatusOK)
+		t.Assert(r.ReadAllString(), `{"code":0,"message":"OK","data":{"name":"john","role":"user"}}`)
+		r.Close()
+
+		// Missing required parameter.
+		r, err = client.ContentJson().Post(ctx, "/user", g.Map{"age": 1})
+		t.AssertNil(err)
+		t.Assert(r.StatusCode, http.StatusBadRequest)
+		t.Assert(strings.Contains(r.ReadAllString(), `required parameter \"name\" is missing`), true)
+		r.Close()
+
+		// Unsupported content type.
+		r, err = client.ContentType("application/xml").Post(ctx, "/user", "<name>john</name>")
+		t.AssertNil(err)
+		t.Assert(r.StatusCode, http.StatusUnsupportedMediaType)
+		r.Close()
+
+		// Body size exceeds the limit.
+		r, err = client.ContentJson().Post(ctx, "/user", g.Map{"name": strings.Repeat("a", 100)})
+		t.AssertNil(err)
+		t.Assert(r.StatusCode, http.StatusRequestEntityTooLarge)
+		r.Close()
+	})
+
+	// The guard is disabled in default.
+	gtest.C(t, func(t *gtest.T) {
+		s.SetRequestGuard(false)
+		defer s.SetRequestGuard(true)
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		r, err := client.ContentJson().Post(ctx, "/user", g.Map{"age": 1})
+		t.AssertNil(err)
+		t.Assert(r.StatusCode, http.StatusOK)
+		t.Assert(strings.Contains(r.ReadAllString(), `"code":51`), true)
+		r.Close()
+	})
+}
@@ -0,0 +1,93 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/os/gctx"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_RequestId(t *testing.T) {
+	s := g.Server(guid.S())
+	s.SetRequestIdEnabled(true)
+	s.BindHandler("/", func(r *ghttp.Request) {
+		r.Response.Write(gctx.RequestId(r.Context()))
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		// It generates request id if there's no request id header.
+		r, err := client.Get(ctx, "/")
+		t.AssertNil(err)
+		requestId := r.Header.Get("X-Request-Id")
+		t.AssertNE(requestId, "")
+		t.Assert(r.ReadAllString(), requestId)
+		r.Close()
+
+		// It uses the request id from header.
+		r, err = client.Header(g.MapStrStr{"X-Request-Id": "abc-123"}).Get(ctx, "/")
+		t.AssertNil(err)
+		t.Assert(r.Header.Get("X-Request-Id"), "abc-123")
+		t.Assert(r.ReadAllString(), "abc-123")
+		r.Close()
+
+		// It generates request id if the request id header is invalid.
+		r, err = client.Header(g.MapStrStr{"X-Request-Id": "abc 123*/"}).Get(ctx, "/")
+		t.AssertNil(err)
+		t.AssertNE(r.Header.Get("X-Request-Id"), "abc 123*/")
+		t.Assert(r.ReadAllString(), r.Header.Get("X-Request-Id"))
+		r.Close()
+
+		r, err = client.Header(g.MapStrStr{"X-Request-Id": strings.Repeat("a", 129)}).Get(ctx, "/")
+		t.AssertNil(err)
+		t.AssertNE(r.Header.Get("X-Request-Id"), strings.Repeat("a", 129))
+		r.Close()
+
+		// It propagates the request id from context using client.
+		r, err = client.Get(gctx.WithRequestId(ctx, "propagated-id"), "/")
+		t.AssertNil(err)
+		t.Assert(r.Header.Get("X-Request-Id"), "propagated-id")
+		t.Assert(r.ReadAllString(), "propagated-id")
+		r.Close()
+	})
+}
+
+func Test_RequestId_Disabled(t *testing.T) {
+	s := g.Server(guid.S())
+	s.BindHandler("/", func(r *ghttp.Request) {
+		r.Response.Write(gctx.RequestId(r.Context()))
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		r, err := client.Header(g.MapStrStr{"X-Request-Id": "abc-123"}).Get(ctx, "/")
+		t.AssertNil(err)
+		t.Assert(r.Header.Get("X-Request-Id"), "")
+		t.Assert(r.ReadAllString(), "")
+		r.Close()
+	})
+}
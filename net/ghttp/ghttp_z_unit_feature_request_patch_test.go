@@ -0,0 +1,105 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/encoding/gjson"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+type patchEntity struct {
+	Id       int      `json:"id"       orm:"id"`
+	Name     string   `json:"name"     orm:"user_name"`
+	Email    *string  `json:"email"    orm:"email"`
+	Tags     []string `json:"tags"     orm:"tags"`
+	Password string   `json:"-"        orm:"password"`
+}
+
+func Test_Request_Patch(t *testing.T) {
+	s := g.Server(guid.S())
+	s.BindHandler("/user", func(r *ghttp.Request) {
+		email := "john@example.com"
+		entity := &patchEntity{
+			Id:       1,
+			Name:     "john",
+			Email:    &email,
+			Tags:     []string{"a", "b"},
+			Password: "123",
+		}
+		changed, err := r.Patch(entity, "name", "email", "tags")
+		if err != nil {
+			r.Response.WriteExit(err.Error())
+		}
+		r.Response.WriteJsonExit(g.Map{
+			"changed":  changed,
+			"entity":   entity,
+			"password": entity.Password,
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	prefix := fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort())
+	// JSON Merge Patch.
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client().Prefix(prefix).ContentType(ghttp.ContentTypeMergePatch)
+		j, err := gjson.LoadContent([]byte(client.PatchContent(ctx, "/user", `{"name":"smith","email":null}`)))
+		t.AssertNil(err)
+		t.Assert(j.Get("changed.user_name"), "smith")
+		t.Assert(j.Contains("changed.email"), true)
+		t.Assert(j.Get("changed.email").IsNil(), true)
+		t.Assert(j.Contains("changed.tags"), false)
+		t.Assert(j.Get("entity.id"), 1)
+		t.Assert(j.Get("entity.name"), "smith")
+		t.Assert(j.Get("entity.tags"), g.Slice{"a", "b"})
+		t.Assert(j.Get("password"), "123")
+
+		t.Assert(
+			client.PatchContent(ctx, "/user", `{"id":2}`),
+			`field "id" is not allowed to be patched`,
+		)
+		t.Assert(
+			client.PatchContent(ctx, "/user", `[]`),
+			`invalid merge patch, it should be a JSON object`,
+		)
+	})
+	// JSON Patch.
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client().Prefix(prefix).ContentType(ghttp.ContentTypeJsonPatch)
+		j, err := gjson.LoadContent([]byte(client.PatchContent(ctx, "/user", `[
+			{"op":"test","path":"/name","value":"john"},
+			{"op":"replace","path":"/name","value":"smith"},
+			{"op":"add","path":"/tags/1","value":"c"},
+			{"op":"add","path":"/tags/-","value":"d"},
+			{"op":"remove","path":"/tags/0"}
+		]`)))
+		t.AssertNil(err)
+		t.Assert(j.Get("changed.user_name"), "smith")
+		t.Assert(j.Get("changed.tags"), g.Slice{"c", "b", "d"})
+		t.Assert(j.Contains("changed.email"), false)
+		t.Assert(j.Get("entity.tags"), g.Slice{"c", "b", "d"})
+
+		t.Assert(client.PatchContent(ctx, "/user", `[
+			{"op":"test","path":"/name","value":"smith"}
+		]`), `json patch operation "test" on path "/name" failed: value not equal`)
+		t.Assert(client.PatchContent(ctx, "/user", `[
+			{"op":"replace","path":"/tags/5","value":"x"}
+		]`), `json patch operation "replace" on path "/tags/5" failed: location "/5" does not exist`)
+		t.Assert(client.PatchContent(ctx, "/user", `[
+			{"op":"copy","from":"/id","path":"/name"}
+		]`), `field "id" is not allowed to be patched`)
+	})
+}
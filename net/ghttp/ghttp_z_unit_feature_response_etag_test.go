@@ -0,0 +1,154 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Response_WriteJsonWithETag(t *testing.T) {
+	modTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := g.Server(guid.S())
+	s.BindHandler("/hash", func(r *ghttp.Request) {
+		r.Response.WriteJsonWithETag(g.Map{"id": 1})
+	})
+	s.BindHandler("/version", func(r *ghttp.Request) {
+		r.Response.WriteJsonWithETag(g.Map{"id": 2}, "v2")
+	})
+	s.BindHandler("/modified", func(r *ghttp.Request) {
+		if r.Response.CheckNotModified("", modTime) {
+			return
+		}
+		r.Response.Write("content")
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		response, err := client.Get(ctx, "/hash")
+		t.AssertNil(err)
+		etag := response.Header.Get("ETag")
+		t.Assert(response.StatusCode, http.StatusOK)
+		t.Assert(response.ReadAllString(), `{"id":1}`)
+		t.Assert(etag, ghttp.GenerateETag([]byte(`{"id":1}`)))
+		response.Close()
+
+		response, err = client.Header(g.MapStrStr{"If-None-Match": etag}).Get(ctx, "/hash")
+		t.AssertNil(err)
+		t.Assert(response.StatusCode, http.StatusNotModified)
+		t.Assert(response.ReadAllString(), "")
+		response.Close()
+
+		response, err = client.Header(g.MapStrStr{"If-None-Match": `"other", W/` + etag}).Get(ctx, "/hash")
+		t.AssertNil(err)
+		t.Assert(response.StatusCode, http.StatusNotModified)
+		response.Close()
+
+		response, err = client.Header(g.MapStrStr{"If-None-Match": `"other"`}).Get(ctx, "/hash")
+		t.AssertNil(err)
+		t.Assert(response.StatusCode, http.StatusOK)
+		response.Close()
+	})
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		response, err := client.Get(ctx, "/version")
+		t.AssertNil(err)
+		t.Assert(response.Header.Get("ETag"), `"v2"`)
+		t.Assert(response.ReadAllString(), `{"id":2}`)
+		response.Close()
+
+		response, err = client.Header(g.MapStrStr{"If-None-Match": `"v2"`}).Get(ctx, "/version")
+		t.AssertNil(err)
+		t.Assert(response.StatusCode, http.StatusNotModified)
+		response.Close()
+
+		response, err = client.Header(g.MapStrStr{"If-None-Match": `"v2"`}).Post(ctx, "/version")
+		t.AssertNil(err)
+		t.Assert(response.StatusCode, http.StatusOK)
+		response.Close()
+	})
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		response, err := client.Get(ctx, "/modified")
+		t.AssertNil(err)
+		t.Assert(response.Header.Get("Last-Modified"), modTime.Format(http.TimeFormat))
+		t.Assert(response.ReadAllString(), "content")
+		response.Close()
+
+		response, err = client.Header(g.MapStrStr{
+			"If-Modified-Since": modTime.Format(http.TimeFormat),
+		}).Get(ctx, "/modified")
+		t.AssertNil(err)
+		t.Assert(response.StatusCode, http.StatusNotModified)
+		response.Close()
+
+		response, err = client.Header(g.MapStrStr{
+			"If-Modified-Since": modTime.Add(-time.Hour).Format(http.TimeFormat),
+		}).Get(ctx, "/modified")
+		t.AssertNil(err)
+		t.Assert(response.StatusCode, http.StatusOK)
+		response.Close()
+	})
+}
+
+func Test_Middleware_ETag(t *testing.T) {
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareETag)
+		group.GET("/data", func(r *ghttp.Request) {
+			r.Response.Write("data")
+		})
+		group.GET("/error", func(r *ghttp.Request) {
+			r.Response.WriteStatus(http.StatusBadRequest, "error")
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		response, err := client.Get(ctx, "/data")
+		t.AssertNil(err)
+		etag := response.Header.Get("ETag")
+		t.Assert(etag, ghttp.GenerateETag([]byte("data")))
+		t.Assert(response.ReadAllString(), "data")
+		response.Close()
+
+		response, err = client.Header(g.MapStrStr{"If-None-Match": etag}).Get(ctx, "/data")
+		t.AssertNil(err)
+		t.Assert(response.StatusCode, http.StatusNotModified)
+		t.Assert(response.ReadAllString(), "")
+		response.Close()
+
+		response, err = client.Get(ctx, "/error")
+		t.AssertNil(err)
+		t.Assert(response.StatusCode, http.StatusBadRequest)
+		t.Assert(response.Header.Get("ETag"), "")
+		response.Close()
+	})
+}
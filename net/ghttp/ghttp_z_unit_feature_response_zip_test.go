@@ -0,0 +1,100 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Response_ServeZip(t *testing.T) {
+	var (
+		filePath = gfile.Temp(guid.S(), "file.txt")
+		skipped  = make([]string, 0)
+	)
+	gtest.AssertNil(gfile.PutContents(filePath, "file content"))
+	defer gfile.Remove(gfile.Dir(filePath))
+
+	s := g.Server(guid.S())
+	s.BindHandler("/zip", func(r *ghttp.Request) {
+		_ = r.Response.ServeZip("attachments", []ghttp.ZipEntry{
+			{Name: "a/file.txt", Path: filePath},
+			{Name: "b/reader.txt", Reader: strings.NewReader("reader content"), Store: true},
+			{Name: "c/missing.txt", Path: "/none-exist-file"},
+		}, func(entry ghttp.ZipEntry, written int64, err error) error {
+			if err != nil {
+				skipped = append(skipped, entry.Name)
+			}
+			return nil
+		})
+	})
+	s.BindHandler("/zip-abort", func(r *ghttp.Request) {
+		_ = r.Response.ServeZip("abort.zip", []ghttp.ZipEntry{
+			{Name: "a.txt", Reader: strings.NewReader("a")},
+			{Name: "missing.txt", Path: "/none-exist-file"},
+			{Name: "b.txt", Reader: strings.NewReader("b")},
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		response, err := client.Get(ctx, "/zip")
+		t.AssertNil(err)
+		defer response.Close()
+		t.Assert(response.Header.Get("Content-Type"), "application/zip")
+		t.Assert(response.Header.Get("Content-Disposition"), "attachment;filename=attachments.zip")
+
+		content := response.ReadAll()
+		reader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+		t.AssertNil(err)
+		t.Assert(len(reader.File), 2)
+		t.Assert(reader.File[0].Name, "a/file.txt")
+		t.Assert(reader.File[0].Method, zip.Deflate)
+		t.Assert(readZipFile(reader.File[0]), "file content")
+		t.Assert(reader.File[1].Name, "b/reader.txt")
+		t.Assert(reader.File[1].Method, zip.Store)
+		t.Assert(readZipFile(reader.File[1]), "reader content")
+		t.Assert(skipped, []string{"c/missing.txt"})
+	})
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		content := client.GetBytes(ctx, "/zip-abort")
+		reader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+		t.AssertNil(err)
+		t.Assert(len(reader.File), 1)
+		t.Assert(reader.File[0].Name, "a.txt")
+	})
+}
+
+func readZipFile(file *zip.File) string {
+	reader, err := file.Open()
+	if err != nil {
+		return err.Error()
+	}
+	defer reader.Close()
+	content, _ := io.ReadAll(reader)
+	return string(content)
+}
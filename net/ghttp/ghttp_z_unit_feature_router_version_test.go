@@ -0,0 +1,97 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Router_Version(t *testing.T) {
+	sunset := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := g.Server(guid.S())
+	s.Group("/api", func(group *ghttp.RouterGroup) {
+		group.GET("/user", func(r *ghttp.Request) {
+			r.Response.Write("user:" + r.GetApiVersion())
+		})
+		group.Version("v1", func(group *ghttp.RouterGroup) {
+			group.Deprecate(ghttp.DeprecateOption{
+				Sunset: sunset,
+				Link:   "https://goframe.org/migration",
+			})
+			group.GET("/user", func(r *ghttp.Request) {
+				r.Response.Write("user:" + r.GetApiVersion())
+			})
+		})
+		group.Version("v2", func(group *ghttp.RouterGroup) {
+			group.GET("/user", func(r *ghttp.Request) {
+				r.Response.Write("user:" + r.GetApiVersion())
+			})
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		// Path based version.
+		t.Assert(client.GetContent(ctx, "/api/user"), "user:")
+		t.Assert(client.GetContent(ctx, "/api/v1/user"), "user:v1")
+		t.Assert(client.GetContent(ctx, "/api/v2/user"), "user:v2")
+
+		// Header based version.
+		t.Assert(client.Header(g.MapStrStr{"X-Api-Version": "v2"}).GetContent(ctx, "/api/user"), "user:v2")
+		t.Assert(client.Header(g.MapStrStr{"X-Api-Version": "1"}).GetContent(ctx, "/api/user"), "user:v1")
+		t.Assert(client.Header(g.MapStrStr{"X-Api-Version": "v3"}).GetContent(ctx, "/api/user"), "user:")
+
+		// Media type based version.
+		t.Assert(
+			client.Header(g.MapStrStr{"Accept": "application/vnd.goframe.v2+json"}).GetContent(ctx, "/api/user"),
+			"user:v2",
+		)
+		t.Assert(
+			client.Header(g.MapStrStr{"Accept": "application/json; version=1"}).GetContent(ctx, "/api/user"),
+			"user:v1",
+		)
+
+		// The version in path has higher priority.
+		t.Assert(client.Header(g.MapStrStr{"X-Api-Version": "v1"}).GetContent(ctx, "/api/v2/user"), "user:v2")
+	})
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		r, err := client.Get(ctx, "/api/v1/user")
+		t.AssertNil(err)
+		t.Assert(r.Header.Get("Deprecation"), "true")
+		t.Assert(r.Header.Get("Sunset"), sunset.Format(http.TimeFormat))
+		t.Assert(r.Header.Get("Link"), `<https://goframe.org/migration>; rel="deprecation"`)
+		r.Close()
+
+		r, err = client.Get(ctx, "/api/v2/user")
+		t.AssertNil(err)
+		t.Assert(r.Header.Get("Deprecation"), "")
+		r.Close()
+
+		r, err = client.Get(ctx, "/api/user")
+		t.AssertNil(err)
+		t.Assert(r.Header.Get("Vary"), "X-Api-Version, Accept")
+		r.Close()
+	})
+}
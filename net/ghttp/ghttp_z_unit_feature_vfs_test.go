@@ -0,0 +1,89 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/os/gvfs"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Static_VFS(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		root := gfile.Temp(gtime.TimestampNanoStr())
+		defer gfile.Remove(root)
+		vfs := gvfs.NewLocal(root)
+		t.AssertNil(gvfs.PutContents(ctx, vfs, "index.html", []byte("index")))
+		t.AssertNil(gvfs.PutContents(ctx, vfs, "css/style.css", []byte("style")))
+
+		s := g.Server(guid.S())
+		s.AddStaticVFS("/assets", vfs)
+		s.SetDumpRouterMap(false)
+		s.Start()
+		defer s.Shutdown()
+		time.Sleep(100 * time.Millisecond)
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		t.Assert(client.GetContent(ctx, "/assets"), "index")
+		t.Assert(client.GetContent(ctx, "/assets/css/style.css"), "style")
+		t.Assert(client.GetContent(ctx, "/assets/css"), "Forbidden")
+		t.Assert(client.GetContent(ctx, "/assets/none.css"), "Not Found")
+		// It cannot access the files outside the root of the virtual filesystem.
+		t.Assert(client.GetContent(ctx, "/assets/../../index.html"), "index")
+
+		res, err := client.Get(ctx, "/assets/css/style.css")
+		t.AssertNil(err)
+		defer res.Close()
+		t.Assert(res.Header.Get("Content-Type"), "text/css; charset=utf-8")
+	})
+}
+
+func Test_Params_File_VFS(t *testing.T) {
+	root := gfile.Temp(gtime.TimestampNanoStr())
+	defer gfile.Remove(root)
+	vfs := gvfs.NewLocal(root)
+
+	s := g.Server(guid.S())
+	s.SetUploadVFS(vfs)
+	s.BindHandler("/upload", func(r *ghttp.Request) {
+		file := r.GetUploadFile("file")
+		if file == nil {
+			r.Response.WriteExit("upload file cannot be empty")
+		}
+		if name, err := file.Save("uploads/images"); err == nil {
+			r.Response.WriteExit(name)
+		}
+		r.Response.WriteExit("upload failed")
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		srcPath := gtest.DataPath("upload", "file1.txt")
+		content := client.PostContent(ctx, "/upload", g.Map{
+			"file": "@file:" + srcPath,
+		})
+		t.Assert(content, "file1.txt")
+
+		data, err := gvfs.GetContents(vfs, "uploads/images/file1.txt")
+		t.AssertNil(err)
+		t.Assert(data, gfile.GetContents(srcPath))
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Middleware_ConcurrencyLimit(t *testing.T) {
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareConcurrencyLimit(ghttp.ConcurrencyLimitOption{
+			MaxConcurrent: 1,
+			PerRoute:      true,
+			RetryAfter:    time.Second,
+		}))
+		group.ALL("/slow", func(r *ghttp.Request) {
+			time.Sleep(500 * time.Millisecond)
+			r.Response.Write("slow")
+		})
+		group.ALL("/fast", func(r *ghttp.Request) {
+			r.Response.Write("fast")
+		})
+	})
+	s.Group("/queue", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareConcurrencyLimit(ghttp.ConcurrencyLimitOption{
+			MaxConcurrent: 1,
+			MaxWaiting:    1,
+			WaitTimeout:   2 * time.Second,
+			StatusCode:    http.StatusServiceUnavailable,
+		}))
+		group.ALL("/", func(r *ghttp.Request) {
+			time.Sleep(200 * time.Millisecond)
+			r.Response.Write("queue")
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	// Rejected immediately without queue.
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		go client.GetContent(ctx, "/slow")
+		time.Sleep(100 * time.Millisecond)
+
+		resp, err := client.Get(ctx, "/slow")
+		t.AssertNil(err)
+		defer resp.Close()
+		t.Assert(resp.StatusCode, http.StatusTooManyRequests)
+		t.Assert(resp.Header.Get("Retry-After"), "1")
+
+		// Other routes are limited separately.
+		t.Assert(client.GetContent(ctx, "/fast"), "fast")
+	})
+	// Waiting in queue.
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		var (
+			wg       sync.WaitGroup
+			mu       sync.Mutex
+			statuses = make([]int, 0)
+		)
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := client.Get(ctx, "/queue/")
+				if err != nil {
+					return
+				}
+				defer resp.Close()
+				mu.Lock()
+				statuses = append(statuses, resp.StatusCode)
+				mu.Unlock()
+			}()
+			time.Sleep(50 * time.Millisecond)
+		}
+		wg.Wait()
+		t.AssertIN(http.StatusServiceUnavailable, statuses)
+		var okCount int
+		for _, status := range statuses {
+			if status == http.StatusOK {
+				okCount++
+			}
+		}
+		t.Assert(okCount, 2)
+	})
+}
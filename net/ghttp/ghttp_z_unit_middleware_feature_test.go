@@ -0,0 +1,58 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/os/gfeature"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gmode"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Middleware_FeatureOverride(t *testing.T) {
+	feature := gfeature.New(gfeature.NewAdapterMemory(map[string]any{
+		"newCheckout": false,
+		"pageSize":    10,
+	}))
+	s := g.Server(guid.S())
+	s.Use(ghttp.MiddlewareFeatureOverride)
+	s.BindHandler("/", func(r *ghttp.Request) {
+		ctx := r.Context()
+		r.Response.Writef("%v-%d", feature.Enabled(ctx, "newCheckout"), feature.Int(ctx, "pageSize"))
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		t.Assert(client.GetContent(ctx, "/"), "false-10")
+		t.Assert(client.Header(g.MapStrStr{
+			gfeature.OverrideHeader: "newCheckout, pageSize=20",
+		}).GetContent(ctx, "/"), "true-20")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		mode := gmode.Mode()
+		gmode.SetProduct()
+		defer gmode.Set(mode)
+
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+		t.Assert(client.Header(g.MapStrStr{
+			gfeature.OverrideHeader: "newCheckout",
+		}).GetContent(ctx, "/"), "false-10")
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Middleware_Idempotency(t *testing.T) {
+	var (
+		payCount  atomic.Int64
+		failCount atomic.Int64
+	)
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareIdempotency())
+		group.POST("/pay", func(r *ghttp.Request) {
+			r.Response.Header().Set("X-Pay-Id", fmt.Sprint(payCount.Add(1)))
+			r.Response.WriteStatus(http.StatusCreated, fmt.Sprintf("paid:%d", payCount.Load()))
+		})
+		group.POST("/slow", func(r *ghttp.Request) {
+			time.Sleep(500 * time.Millisecond)
+			r.Response.Write("slow")
+		})
+		group.POST("/fail", func(r *ghttp.Request) {
+			if failCount.Add(1) == 1 {
+				r.Response.WriteStatus(http.StatusInternalServerError, "fail")
+				return
+			}
+			r.Response.Write("ok")
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	// Replays the stored response.
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client().IdempotencyKey()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		for i := 0; i < 3; i++ {
+			response, err := client.Post(ctx, "/pay", "amount=100")
+			t.AssertNil(err)
+			t.Assert(response.StatusCode, http.StatusCreated)
+			t.Assert(response.Header.Get("X-Pay-Id"), "1")
+			t.Assert(response.ReadAllString(), "paid:1")
+			if i > 0 {
+				t.Assert(response.Header.Get("Idempotent-Replayed"), "true")
+			}
+			response.Close()
+		}
+		t.Assert(payCount.Load(), 1)
+
+		// Different request body with the same key.
+		response, err := client.Post(ctx, "/pay", "amount=200")
+		t.AssertNil(err)
+		t.Assert(response.StatusCode, http.StatusUnprocessableEntity)
+		response.Close()
+
+		// Different key.
+		t.Assert(client.IdempotencyKey().PostContent(ctx, "/pay", "amount=100"), "paid:2")
+		// No key.
+		t.Assert(g.Client().PostContent(
+			ctx, fmt.Sprintf("http://127.0.0.1:%d/pay", s.GetListenedPort()), "amount=100",
+		), "paid:3")
+	})
+	// The same key of different clients is not replayed.
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client().IdempotencyKey("shared-key")
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		count := payCount.Load()
+		t.Assert(client.HeaderRaw("Authorization: Bearer alice").PostContent(ctx, "/pay", "amount=1"), fmt.Sprintf("paid:%d", count+1))
+		t.Assert(client.HeaderRaw("Authorization: Bearer bob").PostContent(ctx, "/pay", "amount=1"), fmt.Sprintf("paid:%d", count+2))
+		t.Assert(client.HeaderRaw("Authorization: Bearer alice").PostContent(ctx, "/pay", "amount=1"), fmt.Sprintf("paid:%d", count+1))
+	})
+	// Conflicts while the request is being processed.
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client().IdempotencyKey("slow-key")
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		go client.PostContent(ctx, "/slow")
+		time.Sleep(100 * time.Millisecond)
+		response, err := client.Post(ctx, "/slow")
+		t.AssertNil(err)
+		t.Assert(response.StatusCode, http.StatusConflict)
+		response.Close()
+
+		time.Sleep(600 * time.Millisecond)
+		t.Assert(client.PostContent(ctx, "/slow"), "slow")
+	})
+	// Server errors are not stored.
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client().IdempotencyKey()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		response, err := client.Post(ctx, "/fail")
+		t.AssertNil(err)
+		t.Assert(response.StatusCode, http.StatusInternalServerError)
+		response.Close()
+
+		t.Assert(client.PostContent(ctx, "/fail"), "ok")
+		t.Assert(client.PostContent(ctx, "/fail"), "ok")
+		t.Assert(failCount.Load(), 2)
+	})
+}
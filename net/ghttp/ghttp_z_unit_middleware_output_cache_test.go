@@ -0,0 +1,179 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp_test
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Middleware_OutputCache(t *testing.T) {
+	var (
+		count atomic.Int64
+		cache = ghttp.NewOutputCache(ghttp.OutputCacheOption{
+			TTL:         time.Minute,
+			VaryHeaders: []string{"Accept-Language"},
+			Tags: func(r *ghttp.Request) []string {
+				return []string{"article"}
+			},
+		})
+	)
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(cache.Middleware)
+		group.GET("/article", func(r *ghttp.Request) {
+			r.Response.Header().Set("X-Article", "1")
+			r.Response.Writef("%s:%s:%d", r.Get("id"), r.Header.Get("Accept-Language"), count.Add(1))
+		})
+		group.GET("/cookie", func(r *ghttp.Request) {
+			r.Cookie.Set("name", "john")
+			r.Response.Write(count.Add(1))
+		})
+		group.GET("/private", func(r *ghttp.Request) {
+			r.Response.Header().Set("Cache-Control", "private")
+			r.Response.Write(count.Add(1))
+		})
+		group.POST("/article", func(r *ghttp.Request) {
+			r.Response.Write(count.Add(1))
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		response, err := client.Get(ctx, "/article?id=1")
+		t.AssertNil(err)
+		t.Assert(response.Header.Get(ghttp.OutputCacheHeader), "MISS")
+		t.Assert(response.ReadAllString(), "1::1")
+		response.Close()
+
+		response, err = client.Get(ctx, "/article?id=1")
+		t.AssertNil(err)
+		t.Assert(response.Header.Get(ghttp.OutputCacheHeader), "HIT")
+		t.Assert(response.Header.Get("X-Article"), "1")
+		t.Assert(response.ReadAllString(), "1::1")
+		response.Close()
+
+		// Query and vary headers.
+		t.Assert(client.GetContent(ctx, "/article?id=2"), "2::2")
+		t.Assert(client.Header(g.MapStrStr{"Accept-Language": "en"}).GetContent(ctx, "/article?id=1"), "1:en:3")
+		t.Assert(client.Header(g.MapStrStr{"Accept-Language": "en"}).GetContent(ctx, "/article?id=1"), "1:en:3")
+
+		// Not cached.
+		t.Assert(client.PostContent(ctx, "/article"), "4")
+		t.Assert(client.PostContent(ctx, "/article"), "5")
+		t.Assert(client.GetContent(ctx, "/cookie"), "6")
+		t.Assert(client.GetContent(ctx, "/cookie"), "7")
+
+		// Purge by path.
+		t.AssertNil(cache.Purge(ctx, "/article"))
+		t.Assert(client.GetContent(ctx, "/article?id=1"), "1::8")
+		t.Assert(client.GetContent(ctx, "/article?id=1"), "1::8")
+
+		// Purge by tag.
+		t.AssertNil(cache.PurgeTag(ctx, "article"))
+		t.Assert(client.GetContent(ctx, "/article?id=1"), "1::9")
+		t.Assert(client.GetContent(ctx, "/article?id=1"), "1::9")
+
+		// Purge all.
+		t.AssertNil(cache.PurgeAll(ctx))
+		t.Assert(client.GetContent(ctx, "/article?id=1"), "1::10")
+		t.Assert(client.GetContent(ctx, "/article?id=1"), "1::10")
+
+		// The requests with credentials and the private responses are not cached.
+		t.Assert(client.HeaderRaw("Authorization: Bearer alice").GetContent(ctx, "/article?id=1"), "1::11")
+		t.Assert(client.HeaderRaw("Authorization: Bearer bob").GetContent(ctx, "/article?id=1"), "1::12")
+		t.Assert(client.Cookie(g.MapStrStr{"sid": "alice"}).GetContent(ctx, "/article?id=1"), "1::13")
+		t.Assert(client.GetContent(ctx, "/private"), "14")
+		t.Assert(client.GetContent(ctx, "/private"), "15")
+		t.Assert(client.GetContent(ctx, "/article?id=1"), "1::10")
+	})
+}
+
+func Test_Middleware_OutputCache_VaryCredential(t *testing.T) {
+	var count atomic.Int64
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareOutputCache(ghttp.OutputCacheOption{
+			VaryHeaders: []string{"authorization"},
+		}))
+		group.GET("/", func(r *ghttp.Request) {
+			r.Response.Write(count.Add(1))
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		t.Assert(client.HeaderRaw("Authorization: Bearer alice").GetContent(ctx, "/"), "1")
+		t.Assert(client.HeaderRaw("Authorization: Bearer bob").GetContent(ctx, "/"), "2")
+		t.Assert(client.HeaderRaw("Authorization: Bearer alice").GetContent(ctx, "/"), "1")
+		t.Assert(client.HeaderRaw("Authorization: Bearer bob").GetContent(ctx, "/"), "2")
+	})
+}
+
+func Test_Middleware_OutputCache_StaleWhileRevalidate(t *testing.T) {
+	var count atomic.Int64
+	s := g.Server(guid.S())
+	s.Group("/", func(group *ghttp.RouterGroup) {
+		group.Middleware(ghttp.MiddlewareOutputCache(ghttp.OutputCacheOption{
+			TTL:                  200 * time.Millisecond,
+			StaleWhileRevalidate: time.Minute,
+		}))
+		group.GET("/", func(r *ghttp.Request) {
+			time.Sleep(100 * time.Millisecond)
+			r.Response.Write(count.Add(1))
+		})
+	})
+	s.SetDumpRouterMap(false)
+	s.Start()
+	defer s.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	gtest.C(t, func(t *gtest.T) {
+		client := g.Client()
+		client.SetPrefix(fmt.Sprintf("http://127.0.0.1:%d", s.GetListenedPort()))
+
+		t.Assert(client.GetContent(ctx, "/"), "1")
+		time.Sleep(300 * time.Millisecond)
+
+		// The stale response is responded without waiting, and revalidated in background.
+		start := time.Now()
+		response, err := client.Get(ctx, "/")
+		t.AssertNil(err)
+		t.Assert(response.Header.Get(ghttp.OutputCacheHeader), "STALE")
+		t.Assert(response.ReadAllString(), "1")
+		t.AssertLT(time.Since(start), 100*time.Millisecond)
+		response.Close()
+		t.Assert(client.GetContent(ctx, "/"), "1")
+
+		time.Sleep(200 * time.Millisecond)
+		response, err = client.Get(ctx, "/")
+		t.AssertNil(err)
+		t.Assert(response.Header.Get(ghttp.OutputCacheHeader), "HIT")
+		t.Assert(response.ReadAllString(), "2")
+		response.Close()
+		t.Assert(count.Load(), 2)
+	})
+}
@@ -33,6 +33,7 @@ type OpenApiV3 struct {
 	Paths        Paths                 `json:"paths"`
 	Security     *SecurityRequirements `json:"security,omitempty"`
 	Servers      *Servers              `json:"servers,omitempty"`
+	Webhooks     Paths                 `json:"webhooks,omitempty"`
 	Tags         *Tags                 `json:"tags,omitempty"`
 	ExternalDocs *ExternalDocs         `json:"externalDocs,omitempty"`
 }
@@ -97,10 +98,11 @@ func New() *OpenApiV3 {
 
 // AddInput is the structured parameter for function OpenApiV3.Add.
 type AddInput struct {
-	Path   string // Path specifies the custom path if this is not configured in Meta of struct tag.
-	Prefix string // Prefix specifies the custom route path prefix, which will be added with the path tag in Meta of struct tag.
-	Method string // Method specifies the custom HTTP method if this is not configured in Meta of struct tag.
-	Object any    // Object can be an instance of struct or a route function.
+	Path     string   // Path specifies the custom path if this is not configured in Meta of struct tag.
+	Prefix   string   // Prefix specifies the custom route path prefix, which will be added with the path tag in Meta of struct tag.
+	Method   string   // Method specifies the custom HTTP method if this is not configured in Meta of struct tag.
+	Object   any      // Object can be an instance of struct or a route function.
+	Security []string // Security specifies the security scheme names if this is not configured in Meta of struct tag.
 }
 
 // Add adds an instance of struct or a route function to OpenApiV3 definition implements.
@@ -121,6 +123,7 @@ func (oai *OpenApiV3) Add(in AddInput) error {
 			Prefix:   in.Prefix,
 			Method:   in.Method,
 			Function: in.Object,
+			Security: in.Security,
 		})
 
 	default:
@@ -7,7 +7,13 @@
 package goai
 
 import (
+	"net/http"
+	"reflect"
+
 	"github.com/gogf/gf/v2/internal/json"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gmeta"
+	"github.com/gogf/gf/v2/util/gtag"
 )
 
 // Callback is specified by OpenAPI/Swagger standard version 3.0.
@@ -20,9 +26,116 @@ type CallbackRef struct {
 	Value *Callback
 }
 
+// EnhancedCallbackType is the structure for certain callback of the operation, which is the request
+// sent by the server to the URL provided by the client, usually after an asynchronous processing.
+type EnhancedCallbackType struct {
+	// Expression is the runtime expression of the callback URL, eg: "{$request.body#/callbackUrl}".
+	Expression string
+	// Method is the HTTP method of the callback request, it's "POST" in default.
+	Method string
+	// Request is the structure of the callback request body sent by the server.
+	Request any
+	// Response is the structure of the response expected from the callback URL, which is optional.
+	Response any
+}
+
+// IEnhanceCallbacks is used to enhance the documentation of the operation with callbacks.
+// Normal request structure could implement this interface to provide its callbacks keyed by name.
+type IEnhanceCallbacks interface {
+	EnhanceCallbacks() map[string]EnhancedCallbackType
+}
+
 func (r CallbackRef) MarshalJSON() ([]byte, error) {
 	if r.Ref != "" {
 		return formatRefToBytes(r.Ref), nil
 	}
 	return json.Marshal(r.Value)
 }
+
+// newCallbacks creates and returns the callbacks of the operation from the enhanced callbacks.
+func (oai *OpenApiV3) newCallbacks(enhancedCallbacks map[string]EnhancedCallbackType) (Callbacks, error) {
+	callbacks := make(Callbacks)
+	for name, enhancedCallback := range enhancedCallbacks {
+		operation, err := oai.newOutgoingOperation(enhancedCallback.Request, enhancedCallback.Response)
+		if err != nil {
+			return nil, err
+		}
+		var path = &Path{}
+		if err = setPathOperation(path, oai.getOutgoingMethod(enhancedCallback.Method), operation); err != nil {
+			return nil, err
+		}
+		callbacks[name] = &CallbackRef{
+			Value: &Callback{enhancedCallback.Expression: path},
+		}
+	}
+	return callbacks, nil
+}
+
+// newOutgoingOperation creates and returns the operation of the request sent by the server, like the
+// callbacks and webhooks. The common request and response structures are not applied to the operation,
+// as the request and response are not handled by the server.
+func (oai *OpenApiV3) newOutgoingOperation(request, response any) (*Operation, error) {
+	var operation = &Operation{
+		Responses:   map[string]ResponseRef{},
+		XExtensions: make(XExtensions),
+	}
+	if request != nil {
+		if err := oai.addSchema(request); err != nil {
+			return nil, err
+		}
+		if metaMap := gmeta.Data(request); len(metaMap) > 0 {
+			if err := oai.tagMapToOperation(metaMap, operation); err != nil {
+				return nil, err
+			}
+		}
+		operation.RequestBody = &RequestBodyRef{
+			Value: &RequestBody{
+				Required: true,
+				Content:  oai.newOutgoingContent(request, oai.Config.ReadContentTypes),
+			},
+		}
+	}
+	responseOk := &Response{
+		XExtensions: make(XExtensions),
+	}
+	if response != nil {
+		if err := oai.addSchema(response); err != nil {
+			return nil, err
+		}
+		if metaMap := gmeta.Data(response); len(metaMap) > 0 {
+			if err := oai.tagMapToResponse(metaMap, responseOk); err != nil {
+				return nil, err
+			}
+		}
+		responseOk.Content = oai.newOutgoingContent(response, oai.Config.WriteContentTypes)
+	}
+	operation.Responses[responseOkKey] = ResponseRef{Value: responseOk}
+	return operation, nil
+}
+
+// newOutgoingContent creates and returns the content referring to the schema of `object`,
+// of which the MIME types are from the mime tag in Meta of `object` or `defaultContentTypes`.
+func (oai *OpenApiV3) newOutgoingContent(object any, defaultContentTypes []string) Content {
+	var (
+		content      = make(Content)
+		contentTypes = defaultContentTypes
+		schemaName   = oai.golangTypeToSchemaName(reflect.TypeOf(object))
+	)
+	if tagMimeValue := gmeta.Get(object, gtag.Mime).String(); tagMimeValue != "" {
+		contentTypes = gstr.SplitAndTrim(tagMimeValue, ",")
+	}
+	for _, contentType := range contentTypes {
+		content[contentType] = MediaType{
+			Schema: &SchemaRef{Ref: schemaName},
+		}
+	}
+	return content
+}
+
+// getOutgoingMethod returns the HTTP method of the request sent by the server, which is POST in default.
+func (oai *OpenApiV3) getOutgoingMethod(method string) string {
+	if method == "" {
+		return http.MethodPost
+	}
+	return method
+}
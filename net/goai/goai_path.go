@@ -49,10 +49,11 @@ const (
 )
 
 type addPathInput struct {
-	Path     string // Precise route path.
-	Prefix   string // Route path prefix.
-	Method   string // Route method.
-	Function any    // Uniformed function.
+	Path     string   // Precise route path.
+	Prefix   string   // Route path prefix.
+	Method   string   // Route method.
+	Function any      // Uniformed function.
+	Security []string // Security scheme names if it is not configured in Meta of struct tag.
 }
 
 func (oai *OpenApiV3) addPath(in addPathInput) error {
@@ -162,6 +163,9 @@ func (oai *OpenApiV3) addPath(in addPathInput) error {
 	// multi schema separate with comma, e.g. `security: apiKey1,apiKey2`
 	TagNameSecurity := gmeta.Get(inputObject.Interface(), gtag.Security).String()
 	securities := gstr.SplitAndTrim(TagNameSecurity, ",")
+	if len(securities) == 0 {
+		securities = in.Security
+	}
 	for _, sec := range securities {
 		seRequirement[sec] = []string{}
 	}
@@ -274,46 +278,67 @@ func (oai *OpenApiV3) addPath(in addPathInput) error {
 		}
 	}
 
+	// =================================================================================================================
+	// Callbacks.
+	// =================================================================================================================
+	if enhancedCallbacks, ok := inputObject.Addr().Interface().(IEnhanceCallbacks); ok {
+		callbacks, err := oai.newCallbacks(enhancedCallbacks.EnhanceCallbacks())
+		if err != nil {
+			return err
+		}
+		if len(callbacks) > 0 {
+			operation.Callbacks = &callbacks
+		}
+	}
+
 	// Remove operation body duplicated properties.
 	oai.removeOperationDuplicatedProperties(&operation)
 
 	// Assign to certain operation attribute.
-	switch gstr.ToUpper(in.Method) {
+	if err := setPathOperation(&path, in.Method, &operation); err != nil {
+		return err
+	}
+	oai.Paths[in.Path] = path
+	return nil
+}
+
+// setPathOperation assigns `operation` to the attribute of `path` for the HTTP `method`.
+func setPathOperation(path *Path, method string, operation *Operation) error {
+	switch gstr.ToUpper(method) {
 	case http.MethodGet:
 		// GET operations cannot have a requestBody.
 		operation.RequestBody = nil
-		path.Get = &operation
+		path.Get = operation
 
 	case http.MethodPut:
-		path.Put = &operation
+		path.Put = operation
 
 	case http.MethodPost:
-		path.Post = &operation
+		path.Post = operation
 
 	case http.MethodDelete:
 		// DELETE operations cannot have a requestBody.
 		operation.RequestBody = nil
-		path.Delete = &operation
+		path.Delete = operation
 
 	case http.MethodConnect:
 		// Nothing to do for Connect.
 
 	case http.MethodHead:
-		path.Head = &operation
+		path.Head = operation
 
 	case http.MethodOptions:
-		path.Options = &operation
+		path.Options = operation
 
 	case http.MethodPatch:
-		path.Patch = &operation
+		path.Patch = operation
 
 	case http.MethodTrace:
-		path.Trace = &operation
+		path.Trace = operation
 
 	default:
-		return gerror.NewCodef(gcode.CodeInvalidParameter, `invalid method "%s"`, in.Method)
+		return gerror.NewCodef(gcode.CodeInvalidParameter, `invalid method "%s"`, method)
 	}
-	oai.Paths[in.Path] = path
 	return nil
 }
 
@@ -22,3 +22,12 @@ type ServerVariable struct {
 
 // Servers is specified by OpenAPI/Swagger standard version 3.0.
 type Servers []Server
+
+// AddServer adds a server to OpenApiV3 definition implements, which is usually used for documenting the
+// servers of multiple environments, like production, staging and sandbox.
+func (oai *OpenApiV3) AddServer(server Server) {
+	if oai.Servers == nil {
+		oai.Servers = &Servers{}
+	}
+	*oai.Servers = append(*oai.Servers, server)
+}
@@ -0,0 +1,43 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package goai
+
+import (
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// AddWebhookInput is the structured parameter for function OpenApiV3.AddWebhook.
+type AddWebhookInput struct {
+	Name     string // Name is the unique name of the webhook, eg: "userCreated".
+	Method   string // Method is the HTTP method of the webhook request, it's "POST" in default.
+	Request  any    // Request is the structure of the webhook request body sent by the server.
+	Response any    // Response is the structure of the response expected from the receiver, which is optional.
+}
+
+// AddWebhook adds a webhook to OpenApiV3 definition implements, which is the request sent by the server
+// to the receivers registered out of band, eg: the event notifications subscribed in the management console.
+//
+// Note that the webhooks are defined in "webhooks" of the document, which is introduced in OpenAPI 3.1.
+func (oai *OpenApiV3) AddWebhook(in AddWebhookInput) error {
+	if in.Name == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, `webhook name should not be empty`)
+	}
+	operation, err := oai.newOutgoingOperation(in.Request, in.Response)
+	if err != nil {
+		return err
+	}
+	if oai.Webhooks == nil {
+		oai.Webhooks = make(Paths)
+	}
+	path := oai.Webhooks[in.Name]
+	if err = setPathOperation(&path, oai.getOutgoingMethod(in.Method), operation); err != nil {
+		return err
+	}
+	oai.Webhooks[in.Name] = path
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/gogf/gf/v2/net/ghttp"
 	"github.com/gogf/gf/v2/net/goai"
 	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
 	"github.com/gogf/gf/v2/util/gmeta"
 	"github.com/gogf/gf/v2/util/gtag"
 )
@@ -1377,3 +1378,144 @@ func Test_ValidationRules(t *testing.T) {
 		t.Assert(schema.Properties.Get("Address").Value.MaxLength, 64)
 	})
 }
+
+type testCallbackEvent struct {
+	gmeta.Meta `summary:"Order paid event"`
+	OrderId    int64  `json:"orderId" description:"Order id"`
+	Status     string `json:"status"  description:"Order status"`
+}
+
+type testCallbackAck struct {
+	Received bool `json:"received"`
+}
+
+type testCallbackReq struct {
+	gmeta.Meta  `path:"/order" method:"POST"`
+	CallbackUrl string `json:"callbackUrl" v:"required"`
+}
+
+type testCallbackRes struct {
+	OrderId int64 `json:"orderId"`
+}
+
+func (r testCallbackReq) EnhanceCallbacks() map[string]goai.EnhancedCallbackType {
+	return map[string]goai.EnhancedCallbackType{
+		"orderPaid": {
+			Expression: "{$request.body#/callbackUrl}",
+			Request:    testCallbackEvent{},
+			Response:   testCallbackAck{},
+		},
+	}
+}
+
+func Test_Callbacks(t *testing.T) {
+	f := func(ctx context.Context, req *testCallbackReq) (res *testCallbackRes, err error) {
+		return
+	}
+	gtest.C(t, func(t *gtest.T) {
+		oai := goai.New()
+		err := oai.Add(goai.AddInput{
+			Object: f,
+		})
+		t.AssertNil(err)
+
+		callbacks := oai.Paths["/order"].Post.Callbacks
+		t.AssertNE(callbacks, nil)
+		t.Assert(len(*callbacks), 1)
+		callbackPath := (*(*callbacks)["orderPaid"].Value)["{$request.body#/callbackUrl}"]
+		t.AssertNE(callbackPath.Post, nil)
+		t.Assert(callbackPath.Post.Summary, "Order paid event")
+		t.Assert(
+			callbackPath.Post.RequestBody.Value.Content["application/json"].Schema.Ref,
+			`github.com.gogf.gf.v2.net.goai_test.testCallbackEvent`,
+		)
+		t.Assert(
+			callbackPath.Post.Responses["200"].Value.Content["application/json"].Schema.Ref,
+			`github.com.gogf.gf.v2.net.goai_test.testCallbackAck`,
+		)
+		t.AssertNE(oai.Components.Schemas.Get(`github.com.gogf.gf.v2.net.goai_test.testCallbackEvent`), nil)
+
+		t.Assert(gstr.Contains(oai.String(), `"callbacks":{"orderPaid":{"{$request.body#/callbackUrl}":{"post":`), true)
+	})
+}
+
+func Test_Webhooks(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		oai := goai.New()
+		err := oai.AddWebhook(goai.AddWebhookInput{
+			Name:    "orderPaid",
+			Request: testCallbackEvent{},
+		})
+		t.AssertNil(err)
+		err = oai.AddWebhook(goai.AddWebhookInput{
+			Name:     "orderPaid",
+			Method:   http.MethodPut,
+			Request:  &testCallbackEvent{},
+			Response: &testCallbackAck{},
+		})
+		t.AssertNil(err)
+		err = oai.AddWebhook(goai.AddWebhookInput{
+			Request: testCallbackEvent{},
+		})
+		t.AssertNE(err, nil)
+
+		t.Assert(len(oai.Webhooks), 1)
+		t.AssertNE(oai.Webhooks["orderPaid"].Post, nil)
+		t.AssertNE(oai.Webhooks["orderPaid"].Put, nil)
+		t.Assert(len(oai.Webhooks["orderPaid"].Post.Responses["200"].Value.Content), 0)
+		t.Assert(
+			oai.Webhooks["orderPaid"].Put.Responses["200"].Value.Content["application/json"].Schema.Ref,
+			`github.com.gogf.gf.v2.net.goai_test.testCallbackAck`,
+		)
+
+		j := gjson.New(oai.String())
+		t.Assert(j.Get(`webhooks.orderPaid.post.summary`), "Order paid event")
+		t.Assert(j.Get(`paths`).IsNil(), true)
+	})
+}
+
+func Test_Servers(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		oai := goai.New()
+		oai.AddServer(goai.Server{URL: "https://api.example.com", Description: "Production"})
+		oai.AddServer(goai.Server{
+			URL:         "https://{env}.api.example.com",
+			Description: "Testing",
+			Variables: map[string]*goai.ServerVariable{
+				"env": {Enum: []string{"staging", "sandbox"}, Default: "staging"},
+			},
+		})
+		t.Assert(len(*oai.Servers), 2)
+
+		j := gjson.New(oai.String())
+		t.Assert(j.Get(`servers.0.description`), "Production")
+		t.Assert(j.Get(`servers.1.variables.env.default`), "staging")
+	})
+}
+
+func Test_AddInput_Security(t *testing.T) {
+	type Req struct {
+		gmeta.Meta `path:"/user" method:"GET"`
+	}
+	type SecurityReq struct {
+		gmeta.Meta `path:"/admin" method:"GET" security:"apiKey"`
+	}
+	type Res struct{}
+
+	gtest.C(t, func(t *gtest.T) {
+		oai := goai.New()
+		err := oai.Add(goai.AddInput{
+			Object:   func(ctx context.Context, req *Req) (res *Res, err error) { return },
+			Security: []string{"bearerAuth"},
+		})
+		t.AssertNil(err)
+		err = oai.Add(goai.AddInput{
+			Object:   func(ctx context.Context, req *SecurityReq) (res *Res, err error) { return },
+			Security: []string{"bearerAuth"},
+		})
+		t.AssertNil(err)
+
+		t.Assert(*oai.Paths["/user"].Get.Security, goai.SecurityRequirements{{"bearerAuth": {}}})
+		t.Assert(*oai.Paths["/admin"].Get.Security, goai.SecurityRequirements{{"apiKey": {}}})
+	})
+}
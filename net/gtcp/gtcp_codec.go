@@ -0,0 +1,321 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gtcp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+const (
+	// defaultCodecMaxFrameSize is the default max frame size of DelimiterCodec and VarintCodec.
+	defaultCodecMaxFrameSize = 0xFFFF
+	// defaultCodecDelimiter is the default delimiter of DelimiterCodec.
+	defaultCodecDelimiter = "\n"
+)
+
+// Codec is the interface for frame codec, which splits the byte stream of connection into frames,
+// so that custom binary protocols do not need handling the buffering and splitting of the stream.
+type Codec interface {
+	// Encode packs the message `data` into a frame for sending.
+	Encode(data []byte) ([]byte, error)
+
+	// Decode reads exactly one frame from `reader` and returns the message of the frame.
+	Decode(reader *bufio.Reader) ([]byte, error)
+}
+
+// LengthFieldCodec is the codec for protocols whose frames are prefixed with a length field:
+// Prefix(Offset bytes)|Length(HeaderSize bytes)|Data(variant).
+//
+// The prefix bytes, like the magic number or version of a device protocol, belong to the message,
+// which means the first Offset bytes of the message are written before the length field in encoding,
+// and are returned along with the data in decoding.
+type LengthFieldCodec struct {
+	// HeaderSize is the size in bytes of the length field, which is 1 to 4.
+	// It's 2 bytes in default.
+	HeaderSize int
+
+	// Offset is the size in bytes of the prefix before the length field.
+	Offset int
+
+	// LittleEndian specifies the length field is encoded using LittleEndian order.
+	// It's BigEndian order in default.
+	LittleEndian bool
+
+	// LengthIncludesHeader specifies the length field counts the prefix and the length field itself,
+	// not only the data field.
+	LengthIncludesHeader bool
+
+	// MaxFrameSize is the max size of the data field for validation.
+	// If it's not manually set, it'll automatically be set correspondingly with the HeaderSize.
+	MaxFrameSize int
+}
+
+// Encode packs the message `data` into a frame with length field.
+func (c LengthFieldCodec) Encode(data []byte) ([]byte, error) {
+	headerSize, maxFrameSize, err := c.getSizes()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < c.Offset {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`data size %d is lesser than the prefix size %d`,
+			len(data), c.Offset,
+		)
+	}
+	size := len(data) - c.Offset
+	if size > maxFrameSize {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`data too long, data size %d exceeds allowed max data size %d`,
+			size, maxFrameSize,
+		)
+	}
+	length := size
+	if c.LengthIncludesHeader {
+		length += c.Offset + headerSize
+		// The length including header might exceed the max value of the length field,
+		// which cannot be truncated, or else the peer splits the stream incorrectly.
+		if maxLength := 1<<(8*headerSize) - 1; length > maxLength {
+			return nil, gerror.NewCodef(
+				gcode.CodeInvalidParameter,
+				`data too long, frame length %d exceeds the max value %d of %d bytes length field`,
+				length, maxLength, headerSize,
+			)
+		}
+	}
+	var (
+		header = make([]byte, 4)
+		frame  = make([]byte, 0, len(data)+headerSize)
+	)
+	if c.LittleEndian {
+		binary.LittleEndian.PutUint32(header, uint32(length))
+		header = header[:headerSize]
+	} else {
+		binary.BigEndian.PutUint32(header, uint32(length))
+		header = header[4-headerSize:]
+	}
+	frame = append(frame, data[:c.Offset]...)
+	frame = append(frame, header...)
+	frame = append(frame, data[c.Offset:]...)
+	return frame, nil
+}
+
+// Decode reads one frame with length field from `reader` and returns its prefix and data.
+func (c LengthFieldCodec) Decode(reader *bufio.Reader) ([]byte, error) {
+	headerSize, maxFrameSize, err := c.getSizes()
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, c.Offset+headerSize)
+	if _, err = io.ReadFull(reader, header); err != nil {
+		return nil, err
+	}
+	var (
+		buffer = make([]byte, 4)
+		length int
+	)
+	if c.LittleEndian {
+		copy(buffer, header[c.Offset:])
+		length = int(binary.LittleEndian.Uint32(buffer))
+	} else {
+		copy(buffer[4-headerSize:], header[c.Offset:])
+		length = int(binary.BigEndian.Uint32(buffer))
+	}
+	if c.LengthIncludesHeader {
+		length -= c.Offset + headerSize
+	}
+	// It here validates the size of the frame,
+	// as the following data of the stream cannot be split correctly if it validates failed.
+	if length < 0 || length > maxFrameSize {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid frame size %d`, length)
+	}
+	frame := make([]byte, c.Offset+length)
+	copy(frame, header[:c.Offset])
+	if _, err = io.ReadFull(reader, frame[c.Offset:]); err != nil {
+		return nil, err
+	}
+	return frame, nil
+}
+
+// getSizes validates and returns the header size and max frame size of the codec.
+func (c LengthFieldCodec) getSizes() (headerSize, maxFrameSize int, err error) {
+	headerSize, maxFrameSize = c.HeaderSize, c.MaxFrameSize
+	if headerSize == 0 {
+		headerSize = pkgHeaderSizeDefault
+	}
+	if headerSize < 0 || headerSize > pkgHeaderSizeMax {
+		return 0, 0, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`invalid length field size %d, it should be between 1 and %d`,
+			headerSize, pkgHeaderSizeMax,
+		)
+	}
+	if c.Offset < 0 {
+		return 0, 0, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid prefix size %d`, c.Offset)
+	}
+	if maxFrameSize == 0 {
+		switch headerSize {
+		case 1:
+			maxFrameSize = 0xFF
+		case 2:
+			maxFrameSize = 0xFFFF
+		case 3:
+			maxFrameSize = 0xFFFFFF
+		default:
+			// math.MaxInt32 not math.MaxUint32
+			maxFrameSize = 0x7FFFFFFF
+		}
+	}
+	return headerSize, maxFrameSize, nil
+}
+
+// DelimiterCodec is the codec for protocols whose frames end with a delimiter:
+// Data(variant)|Delimiter.
+//
+// Note that the message should not contain the delimiter.
+type DelimiterCodec struct {
+	// Delimiter marks the end of each frame, it's "\n" in default.
+	Delimiter []byte
+
+	// MaxFrameSize is the max size of the frame without delimiter, it's 65535 in default.
+	MaxFrameSize int
+}
+
+// Encode appends the delimiter to the message `data`.
+func (c DelimiterCodec) Encode(data []byte) ([]byte, error) {
+	delimiter, maxFrameSize := c.getDelimiter(), c.getMaxFrameSize()
+	if len(data) > maxFrameSize {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`data too long, data size %d exceeds allowed max data size %d`,
+			len(data), maxFrameSize,
+		)
+	}
+	if bytes.Contains(data, delimiter) {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, `data contains the delimiter %q`, delimiter)
+	}
+	frame := make([]byte, 0, len(data)+len(delimiter))
+	frame = append(frame, data...)
+	frame = append(frame, delimiter...)
+	return frame, nil
+}
+
+// Decode reads one frame from `reader` till the delimiter and returns it without the delimiter.
+func (c DelimiterCodec) Decode(reader *bufio.Reader) ([]byte, error) {
+	var (
+		delimiter    = c.getDelimiter()
+		maxFrameSize = c.getMaxFrameSize()
+		lastByte     = delimiter[len(delimiter)-1]
+		frame        []byte
+	)
+	for {
+		line, err := reader.ReadSlice(lastByte)
+		if err != nil && err != bufio.ErrBufferFull {
+			return nil, err
+		}
+		frame = append(frame, line...)
+		found := err == nil && bytes.HasSuffix(frame, delimiter)
+		if found {
+			frame = frame[:len(frame)-len(delimiter)]
+		}
+		if len(frame) > maxFrameSize {
+			return nil, gerror.NewCodef(
+				gcode.CodeInvalidParameter,
+				`frame too long, frame size exceeds allowed max frame size %d`,
+				maxFrameSize,
+			)
+		}
+		if found {
+			return frame, nil
+		}
+	}
+}
+
+func (c DelimiterCodec) getDelimiter() []byte {
+	if len(c.Delimiter) == 0 {
+		return []byte(defaultCodecDelimiter)
+	}
+	return c.Delimiter
+}
+
+func (c DelimiterCodec) getMaxFrameSize() int {
+	if c.MaxFrameSize <= 0 {
+		return defaultCodecMaxFrameSize
+	}
+	return c.MaxFrameSize
+}
+
+// VarintCodec is the codec for protocols whose frames are prefixed with a varint length field:
+// Length(varint)|Data(variant).
+//
+// It is compatible with the length-delimited protobuf message stream, like the one written by
+// writeDelimitedTo and read by parseDelimitedFrom of the protobuf libraries, so that the messages
+// marshaled by protobuf can be sent and received directly.
+type VarintCodec struct {
+	// MaxFrameSize is the max size of the data field for validation, it's 65535 in default.
+	MaxFrameSize int
+}
+
+// Encode prefixes the message `data` with its varint length.
+func (c VarintCodec) Encode(data []byte) ([]byte, error) {
+	maxFrameSize := c.getMaxFrameSize()
+	if len(data) > maxFrameSize {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`data too long, data size %d exceeds allowed max data size %d`,
+			len(data), maxFrameSize,
+		)
+	}
+	frame := make([]byte, 0, len(data)+binary.MaxVarintLen64)
+	frame = binary.AppendUvarint(frame, uint64(len(data)))
+	frame = append(frame, data...)
+	return frame, nil
+}
+
+// Decode reads one frame with varint length field from `reader` and returns its data.
+func (c VarintCodec) Decode(reader *bufio.Reader) ([]byte, error) {
+	length, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, err
+	}
+	if maxFrameSize := c.getMaxFrameSize(); length > uint64(maxFrameSize) {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, `invalid frame size %d`, length)
+	}
+	frame := make([]byte, length)
+	if _, err = io.ReadFull(reader, frame); err != nil {
+		return nil, err
+	}
+	return frame, nil
+}
+
+func (c VarintCodec) getMaxFrameSize() int {
+	if c.MaxFrameSize <= 0 {
+		return defaultCodecMaxFrameSize
+	}
+	return c.MaxFrameSize
+}
+
+// SendFrame encodes `data` into a frame using `codec` and writes it to the connection.
+func (c *Conn) SendFrame(data []byte, codec Codec, retry ...Retry) error {
+	frame, err := codec.Encode(data)
+	if err != nil {
+		return err
+	}
+	return c.Send(frame, retry...)
+}
+
+// RecvFrame reads one frame from the connection and decodes it using `codec`.
+func (c *Conn) RecvFrame(codec Codec) ([]byte, error) {
+	return codec.Decode(c.reader)
+}
@@ -0,0 +1,101 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gtcp
+
+import (
+	"github.com/gogf/gf/v2/container/gmap"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// MessageParser parses the frame and returns the message id and message body of the frame,
+// the message id is usually the command code or message type of the protocol.
+type MessageParser func(frame []byte) (id int, body []byte, err error)
+
+// MessageHandler handles the message body of a frame received from the connection.
+type MessageHandler func(conn *Conn, body []byte)
+
+// Dispatcher receives frames from connections using a Codec,
+// and dispatches them to the handlers by the message id parsed by a MessageParser.
+type Dispatcher struct {
+	codec          Codec                                     // Codec for splitting frames.
+	parser         MessageParser                             // Parser for message id and body.
+	handlers       *gmap.KVMap[int, MessageHandler]          // Message id to handler mapping.
+	defaultHandler MessageHandler                            // Handler for messages without registered handler.
+	errorHandler   func(conn *Conn, frame []byte, err error) // Handler for frames failed dispatching.
+}
+
+// NewDispatcher creates and returns a dispatcher using `codec` for splitting frames and `parser`
+// for parsing the message id and body of the frames.
+// If `parser` is nil, the message id is always 0 and the message body is the whole frame.
+func NewDispatcher(codec Codec, parser MessageParser) *Dispatcher {
+	return &Dispatcher{
+		codec:    codec,
+		parser:   parser,
+		handlers: gmap.NewKVMap[int, MessageHandler](true),
+	}
+}
+
+// Handle registers `handler` for the messages of `id`.
+func (d *Dispatcher) Handle(id int, handler MessageHandler) {
+	d.handlers.Set(id, handler)
+}
+
+// HandleDefault registers `handler` for the messages which have no handler registered.
+func (d *Dispatcher) HandleDefault(handler MessageHandler) {
+	d.defaultHandler = handler
+}
+
+// HandleError registers `handler` for the frames failed dispatching, like parsing failure or
+// no handler for the message id. These frames are ignored if no error handler registered.
+func (d *Dispatcher) HandleError(handler func(conn *Conn, frame []byte, err error)) {
+	d.errorHandler = handler
+}
+
+// Dispatch parses `frame` and calls the handler of its message id.
+func (d *Dispatcher) Dispatch(conn *Conn, frame []byte) error {
+	var (
+		id   int
+		body = frame
+		err  error
+	)
+	if d.parser != nil {
+		if id, body, err = d.parser(frame); err != nil {
+			return gerror.WrapCode(gcode.CodeInvalidParameter, err, `parse message failed`)
+		}
+	}
+	handler := d.handlers.Get(id)
+	if handler == nil {
+		handler = d.defaultHandler
+	}
+	if handler == nil {
+		return gerror.NewCodef(gcode.CodeNotFound, `no handler found for message id %d`, id)
+	}
+	handler(conn, body)
+	return nil
+}
+
+// Serve receives frames from `conn` and dispatches them until the connection is closed or the
+// frame decoding fails, then closes the connection.
+// It can be used as the handler of Server directly.
+func (d *Dispatcher) Serve(conn *Conn) {
+	defer conn.Close()
+	for {
+		frame, err := conn.RecvFrame(d.codec)
+		if err != nil {
+			return
+		}
+		if err = d.Dispatch(conn, frame); err != nil && d.errorHandler != nil {
+			d.errorHandler(conn, frame, err)
+		}
+	}
+}
+
+// Send encodes `data` using the codec of the dispatcher and writes it to `conn`.
+func (d *Dispatcher) Send(conn *Conn, data []byte, retry ...Retry) error {
+	return conn.SendFrame(data, d.codec, retry...)
+}
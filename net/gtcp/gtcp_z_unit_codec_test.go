@@ -0,0 +1,216 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gtcp_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/net/gtcp"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Codec_LengthField(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		codec := gtcp.LengthFieldCodec{}
+		frame, err := codec.Encode([]byte("hello"))
+		t.AssertNil(err)
+		t.Assert(frame, []byte{0, 5, 'h', 'e', 'l', 'l', 'o'})
+
+		data, err := codec.Decode(bufio.NewReader(bytes.NewReader(frame)))
+		t.AssertNil(err)
+		t.Assert(data, "hello")
+
+		_, err = codec.Encode(make([]byte, 65536))
+		t.AssertNE(err, nil)
+		_, err = gtcp.LengthFieldCodec{HeaderSize: 5}.Encode([]byte("hello"))
+		t.AssertNE(err, nil)
+	})
+	// Prefix, LittleEndian and length including header.
+	gtest.C(t, func(t *gtest.T) {
+		codec := gtcp.LengthFieldCodec{
+			HeaderSize:           4,
+			Offset:               2,
+			LittleEndian:         true,
+			LengthIncludesHeader: true,
+		}
+		frame, err := codec.Encode([]byte{0xAA, 0x55, 1, 2, 3})
+		t.AssertNil(err)
+		t.Assert(frame[:2], []byte{0xAA, 0x55})
+		t.Assert(binary.LittleEndian.Uint32(frame[2:6]), 9)
+		t.Assert(frame[6:], []byte{1, 2, 3})
+
+		data, err := codec.Decode(bufio.NewReader(bytes.NewReader(frame)))
+		t.AssertNil(err)
+		t.Assert(data, []byte{0xAA, 0x55, 1, 2, 3})
+
+		_, err = codec.Encode([]byte{0xAA})
+		t.AssertNE(err, nil)
+	})
+	// The length including header exceeds the max value of the length field.
+	gtest.C(t, func(t *gtest.T) {
+		codec := gtcp.LengthFieldCodec{
+			HeaderSize:           1,
+			Offset:               1,
+			LengthIncludesHeader: true,
+		}
+		frame, err := codec.Encode(make([]byte, 254))
+		t.AssertNil(err)
+		t.Assert(frame[1], 255)
+
+		_, err = codec.Encode(make([]byte, 255))
+		t.AssertNE(err, nil)
+	})
+	// Invalid frame size.
+	gtest.C(t, func(t *gtest.T) {
+		codec := gtcp.LengthFieldCodec{MaxFrameSize: 4}
+		_, err := codec.Decode(bufio.NewReader(bytes.NewReader([]byte{0, 5, 'h', 'e', 'l', 'l', 'o'})))
+		t.AssertNE(err, nil)
+	})
+}
+
+func Test_Codec_Delimiter(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		codec := gtcp.DelimiterCodec{Delimiter: []byte("\r\n")}
+		frame1, err := codec.Encode([]byte("hello"))
+		t.AssertNil(err)
+		t.Assert(frame1, "hello\r\n")
+		frame2, err := codec.Encode([]byte("a\rb\nc"))
+		t.AssertNil(err)
+
+		reader := bufio.NewReader(bytes.NewReader(append(frame1, frame2...)))
+		data, err := codec.Decode(reader)
+		t.AssertNil(err)
+		t.Assert(data, "hello")
+		data, err = codec.Decode(reader)
+		t.AssertNil(err)
+		t.Assert(data, "a\rb\nc")
+
+		_, err = codec.Encode([]byte("a\r\nb"))
+		t.AssertNE(err, nil)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		codec := gtcp.DelimiterCodec{MaxFrameSize: 3}
+		_, err := codec.Decode(bufio.NewReader(bytes.NewReader([]byte("hello\n"))))
+		t.AssertNE(err, nil)
+	})
+}
+
+func Test_Codec_Varint(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			codec   = gtcp.VarintCodec{}
+			message = bytes.Repeat([]byte("a"), 300)
+		)
+		frame, err := codec.Encode(message)
+		t.AssertNil(err)
+		t.Assert(frame[:2], []byte{0xAC, 0x02})
+
+		data, err := codec.Decode(bufio.NewReader(bytes.NewReader(frame)))
+		t.AssertNil(err)
+		t.Assert(data, message)
+
+		_, err = gtcp.VarintCodec{MaxFrameSize: 10}.Encode(message)
+		t.AssertNE(err, nil)
+		_, err = gtcp.VarintCodec{MaxFrameSize: 10}.Decode(bufio.NewReader(bytes.NewReader(frame)))
+		t.AssertNE(err, nil)
+	})
+}
+
+func Test_Conn_Frame(t *testing.T) {
+	codec := gtcp.DelimiterCodec{}
+	s := gtcp.NewServer(gtcp.FreePortAddress, func(conn *gtcp.Conn) {
+		defer conn.Close()
+		for {
+			data, err := conn.RecvFrame(codec)
+			if err != nil {
+				break
+			}
+			conn.SendFrame(append([]byte("> "), data...), codec)
+		}
+	})
+	go s.Run()
+	defer s.Close()
+	time.Sleep(100 * time.Millisecond)
+	gtest.C(t, func(t *gtest.T) {
+		conn, err := gtcp.NewConn(s.GetListenedAddress())
+		t.AssertNil(err)
+		defer conn.Close()
+		// Multiple frames in one writing.
+		t.AssertNil(conn.Send([]byte("a\nb\n")))
+		data, err := conn.RecvFrame(codec)
+		t.AssertNil(err)
+		t.Assert(data, "> a")
+		data, err = conn.RecvFrame(codec)
+		t.AssertNil(err)
+		t.Assert(data, "> b")
+		// One frame in multiple writings.
+		t.AssertNil(conn.Send([]byte("he")))
+		time.Sleep(10 * time.Millisecond)
+		t.AssertNil(conn.Send([]byte("llo\n")))
+		data, err = conn.RecvFrame(codec)
+		t.AssertNil(err)
+		t.Assert(data, "> hello")
+	})
+}
+
+func Test_Dispatcher(t *testing.T) {
+	var (
+		codec  = gtcp.LengthFieldCodec{}
+		parser = func(frame []byte) (int, []byte, error) {
+			if len(frame) == 0 {
+				return 0, nil, gerror.New("empty frame")
+			}
+			return int(frame[0]), frame[1:], nil
+		}
+		dispatcher = gtcp.NewDispatcher(codec, parser)
+	)
+	dispatcher.Handle(1, func(conn *gtcp.Conn, body []byte) {
+		dispatcher.Send(conn, append([]byte("ping:"), body...))
+	})
+	dispatcher.Handle(2, func(conn *gtcp.Conn, body []byte) {
+		dispatcher.Send(conn, append([]byte("echo:"), body...))
+	})
+	dispatcher.HandleError(func(conn *gtcp.Conn, frame []byte, err error) {
+		dispatcher.Send(conn, []byte("error"))
+	})
+	s := gtcp.NewServer(gtcp.FreePortAddress, dispatcher.Serve)
+	go s.Run()
+	defer s.Close()
+	time.Sleep(100 * time.Millisecond)
+	gtest.C(t, func(t *gtest.T) {
+		conn, err := gtcp.NewConn(s.GetListenedAddress())
+		t.AssertNil(err)
+		defer conn.Close()
+
+		t.AssertNil(conn.SendFrame([]byte{1, 'a'}, codec))
+		data, err := conn.RecvFrame(codec)
+		t.AssertNil(err)
+		t.Assert(data, "ping:a")
+
+		t.AssertNil(conn.SendFrame([]byte{2, 'b'}, codec))
+		data, err = conn.RecvFrame(codec)
+		t.AssertNil(err)
+		t.Assert(data, "echo:b")
+
+		// No handler.
+		t.AssertNil(conn.SendFrame([]byte{3, 'c'}, codec))
+		data, err = conn.RecvFrame(codec)
+		t.AssertNil(err)
+		t.Assert(data, "error")
+
+		// Parsing failure.
+		t.AssertNil(conn.SendFrame([]byte{}, codec))
+		data, err = conn.RecvFrame(codec)
+		t.AssertNil(err)
+		t.Assert(data, "error")
+	})
+}
@@ -0,0 +1,138 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gudp
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+
+	"github.com/gogf/gf/v2/container/gmap"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/net/gtcp"
+)
+
+const (
+	// defaultDispatchBufferSize is the receiving buffer size of Dispatcher,
+	// which is big enough for any UDP package.
+	defaultDispatchBufferSize = 0xFFFF
+)
+
+// MessageHandler handles the message body of a frame received from remote address.
+type MessageHandler func(conn *ServerConn, remoteAddr *net.UDPAddr, body []byte)
+
+// Dispatcher receives packages from the server connection, splits them into frames using a
+// gtcp.Codec, and dispatches the frames to the handlers by the message id parsed by a gtcp.MessageParser.
+type Dispatcher struct {
+	codec          gtcp.Codec                       // Codec for splitting frames, the whole package is a frame if nil.
+	parser         gtcp.MessageParser               // Parser for message id and body.
+	handlers       *gmap.KVMap[int, MessageHandler] // Message id to handler mapping.
+	defaultHandler MessageHandler                   // Handler for messages without registered handler.
+	errorHandler   func(conn *ServerConn, remoteAddr *net.UDPAddr, data []byte, err error)
+}
+
+// NewDispatcher creates and returns a dispatcher using `codec` for splitting frames of the packages
+// and `parser` for parsing the message id and body of the frames.
+// If `codec` is nil, each package is a frame. If `parser` is nil, the message id is always 0 and
+// the message body is the whole frame.
+func NewDispatcher(codec gtcp.Codec, parser gtcp.MessageParser) *Dispatcher {
+	return &Dispatcher{
+		codec:    codec,
+		parser:   parser,
+		handlers: gmap.NewKVMap[int, MessageHandler](true),
+	}
+}
+
+// Handle registers `handler` for the messages of `id`.
+func (d *Dispatcher) Handle(id int, handler MessageHandler) {
+	d.handlers.Set(id, handler)
+}
+
+// HandleDefault registers `handler` for the messages which have no handler registered.
+func (d *Dispatcher) HandleDefault(handler MessageHandler) {
+	d.defaultHandler = handler
+}
+
+// HandleError registers `handler` for the packages or frames failed dispatching, like decoding or
+// parsing failure, or no handler for the message id. They are ignored if no error handler registered.
+func (d *Dispatcher) HandleError(handler func(conn *ServerConn, remoteAddr *net.UDPAddr, data []byte, err error)) {
+	d.errorHandler = handler
+}
+
+// Dispatch splits `data` into frames and calls the handlers of their message ids.
+// It stops and returns the error if any frame fails decoding or dispatching.
+func (d *Dispatcher) Dispatch(conn *ServerConn, remoteAddr *net.UDPAddr, data []byte) error {
+	if d.codec == nil {
+		return d.dispatchFrame(conn, remoteAddr, data)
+	}
+	reader := bufio.NewReader(bytes.NewReader(data))
+	for {
+		if _, err := reader.Peek(1); err == io.EOF {
+			return nil
+		}
+		frame, err := d.codec.Decode(reader)
+		if err != nil {
+			return gerror.WrapCode(gcode.CodeInvalidParameter, err, `decode frame failed`)
+		}
+		if err = d.dispatchFrame(conn, remoteAddr, frame); err != nil {
+			return err
+		}
+	}
+}
+
+// Serve receives packages from `conn` and dispatches them until the connection is closed,
+// then closes the connection.
+// It can be used as the handler of Server directly.
+func (d *Dispatcher) Serve(conn *ServerConn) {
+	defer conn.Close()
+	for {
+		data, remoteAddr, err := conn.Recv(defaultDispatchBufferSize)
+		if err != nil {
+			return
+		}
+		if err = d.Dispatch(conn, remoteAddr, data); err != nil && d.errorHandler != nil {
+			d.errorHandler(conn, remoteAddr, data, err)
+		}
+	}
+}
+
+// Send encodes `data` using the codec of the dispatcher and writes it to `remoteAddr`.
+func (d *Dispatcher) Send(conn *ServerConn, remoteAddr *net.UDPAddr, data []byte, retry ...Retry) error {
+	if d.codec != nil {
+		frame, err := d.codec.Encode(data)
+		if err != nil {
+			return err
+		}
+		data = frame
+	}
+	return conn.Send(data, remoteAddr, retry...)
+}
+
+// dispatchFrame parses `frame` and calls the handler of its message id.
+func (d *Dispatcher) dispatchFrame(conn *ServerConn, remoteAddr *net.UDPAddr, frame []byte) error {
+	var (
+		id   int
+		body = frame
+		err  error
+	)
+	if d.parser != nil {
+		if id, body, err = d.parser(frame); err != nil {
+			return gerror.WrapCode(gcode.CodeInvalidParameter, err, `parse message failed`)
+		}
+	}
+	handler := d.handlers.Get(id)
+	if handler == nil {
+		handler = d.defaultHandler
+	}
+	if handler == nil {
+		return gerror.NewCodef(gcode.CodeNotFound, `no handler found for message id %d`, id)
+	}
+	handler(conn, remoteAddr, body)
+	return nil
+}
@@ -10,9 +10,12 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"testing"
 	"time"
 
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/net/gtcp"
 	"github.com/gogf/gf/v2/net/gudp"
 	"github.com/gogf/gf/v2/os/glog"
 	"github.com/gogf/gf/v2/test/gtest"
@@ -194,3 +197,47 @@ func Test_Server(t *testing.T) {
 		})
 	})
 }
+
+func Test_Dispatcher(t *testing.T) {
+	var (
+		codec      = gtcp.DelimiterCodec{}
+		dispatcher = gudp.NewDispatcher(codec, func(frame []byte) (int, []byte, error) {
+			if len(frame) == 0 {
+				return 0, nil, gerror.New("empty frame")
+			}
+			return int(frame[0] - '0'), frame[1:], nil
+		})
+	)
+	dispatcher.Handle(1, func(conn *gudp.ServerConn, remoteAddr *net.UDPAddr, body []byte) {
+		dispatcher.Send(conn, remoteAddr, append([]byte("ping:"), body...))
+	})
+	dispatcher.HandleDefault(func(conn *gudp.ServerConn, remoteAddr *net.UDPAddr, body []byte) {
+		dispatcher.Send(conn, remoteAddr, append([]byte("default:"), body...))
+	})
+	s := gudp.NewServer(gudp.FreePortAddress, dispatcher.Serve)
+	go s.Run()
+	defer s.Close()
+	time.Sleep(simpleTimeout)
+	gtest.C(t, func(t *gtest.T) {
+		conn, err := gudp.NewClientConn(s.GetListenedAddress())
+		t.AssertNil(err)
+		defer conn.Close()
+
+		result, err := conn.SendRecv([]byte("1a\n"), -1)
+		t.AssertNil(err)
+		t.Assert(result, "ping:a\n")
+
+		result, err = conn.SendRecv([]byte("2b\n"), -1)
+		t.AssertNil(err)
+		t.Assert(result, "default:b\n")
+
+		// Multiple frames in one package.
+		t.AssertNil(conn.Send([]byte("1c\n1d\n")))
+		result, _, err = conn.Recv(-1)
+		t.AssertNil(err)
+		t.Assert(result, "ping:c\n")
+		result, _, err = conn.Recv(-1)
+		t.AssertNil(err)
+		t.Assert(result, "ping:d\n")
+	})
+}
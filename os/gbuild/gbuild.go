@@ -10,6 +10,8 @@ package gbuild
 import (
 	"context"
 	"runtime"
+	"runtime/debug"
+	"strings"
 
 	"github.com/gogf/gf/v2"
 	"github.com/gogf/gf/v2/container/gvar"
@@ -34,6 +36,7 @@ const (
 	BuiltGit     = `builtGit`
 	BuiltTime    = `builtTime`
 	BuiltVersion = `builtVersion`
+	BuiltDirty   = `builtDirty`
 )
 
 var (
@@ -81,6 +84,60 @@ func Get(name string, def ...any) *gvar.Var {
 	return nil
 }
 
+// Commit returns the git commit hash of the binary.
+// It uses the commit of git info injected by "gf build", or else the vcs revision
+// embedded by "go build".
+func Commit() string {
+	if git := Get(BuiltGit).String(); git != "" {
+		// The git info is like "2006-01-02 15:04:05 commit-hash".
+		fields := strings.Fields(git)
+		return fields[len(fields)-1]
+	}
+	return getVcsSetting("vcs.revision")
+}
+
+// Dirty checks and returns whether the binary is built with uncommitted changes.
+// It uses the dirty flag injected by "gf build", or else the vcs modified flag
+// embedded by "go build".
+func Dirty() bool {
+	if v := Get(BuiltDirty); v != nil {
+		return v.Bool()
+	}
+	return getVcsSetting("vcs.modified") == "true"
+}
+
+// Dependencies returns the module dependencies of the binary as map of module path to version.
+// The replaced module uses the version of its replacement.
+func Dependencies() map[string]string {
+	var dependencies = make(map[string]string)
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return dependencies
+	}
+	for _, dep := range info.Deps {
+		module := dep
+		if dep.Replace != nil {
+			module = dep.Replace
+		}
+		dependencies[dep.Path] = module.Version
+	}
+	return dependencies
+}
+
+// getVcsSetting returns the vcs setting value of the binary embedded by "go build".
+func getVcsSetting(key string) string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == key {
+			return setting.Value
+		}
+	}
+	return ""
+}
+
 // Data returns the custom build-in variables as the map.
 func Data() map[string]any {
 	return builtInVarMap
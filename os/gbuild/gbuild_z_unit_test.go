@@ -42,3 +42,21 @@ func Test_Map(t *testing.T) {
 		t.Assert(gbuild.Data(), map[string]any{})
 	})
 }
+
+func Test_Commit_Dirty(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		// No build variables or vcs info for testing binary.
+		t.Assert(gbuild.Commit(), "")
+		t.Assert(gbuild.Dirty(), false)
+	})
+}
+
+func Test_Dependencies(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		dependencies := gbuild.Dependencies()
+		t.AssertNE(dependencies, nil)
+		for path := range dependencies {
+			t.AssertNE(path, "")
+		}
+	})
+}
@@ -0,0 +1,30 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gcache
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/debug/gdebug"
+)
+
+func init() {
+	gdebug.RegisterBundleCollector("gcache", collectBundleStats)
+}
+
+// collectBundleStats collects the statistics of the default cache for profiling snapshot bundle.
+func collectBundleStats(ctx context.Context) (any, error) {
+	size, err := defaultCache().Size(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"default": map[string]any{
+			"size": size,
+		},
+	}, nil
+}
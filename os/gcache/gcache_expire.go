@@ -0,0 +1,57 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gcache
+
+import (
+	"math"
+	"time"
+
+	"github.com/gogf/gf/v2/util/grand"
+)
+
+// randomFloatMax is the max random integer for generating random float number.
+const randomFloatMax = 1 << 30
+
+// JitterDuration returns `duration` randomly reduced by up to `ratio` of it, which spreads the
+// expirations of the keys set at the same time with the same duration, so that they do not expire
+// at the same moment and cause load spikes on the data source.
+//
+// The parameter `ratio` is in range (0, 1], eg: 0.1 returns a duration in [0.9*duration, duration].
+// It returns `duration` itself if `duration` <= 0 that means no expiring or deleting,
+// or `ratio` <= 0.
+func JitterDuration(duration time.Duration, ratio float64) time.Duration {
+	if duration <= 0 || ratio <= 0 {
+		return duration
+	}
+	if ratio > 1 {
+		ratio = 1
+	}
+	return duration - time.Duration(float64(duration)*ratio*randomFloat())
+}
+
+// ShouldRefreshEarly checks whether the cached value should be refreshed before it expires,
+// using probabilistic early expiration algorithm XFetch, so that only few of the concurrent
+// readers refresh the value before expiration instead of all of them after expiration.
+//
+// The parameter `remaining` is the remaining TTL of the cached value, `delta` is the duration of
+// computing the value, and `beta` controls the eagerness, which is usually 1. Greater `beta`
+// refreshes earlier. The probability of refreshing increases as the remaining TTL decreases.
+//
+// It returns false if `remaining` <= 0, which means the value does not expire or does not exist
+// as the result of GetExpire.
+func ShouldRefreshEarly(remaining, delta time.Duration, beta float64) bool {
+	if remaining <= 0 || delta <= 0 || beta <= 0 {
+		return false
+	}
+	// It uses 1-randomFloat() in range (0, 1] as the logarithm of 0 is negative infinity.
+	return -float64(delta)*beta*math.Log(1-randomFloat()) >= float64(remaining)
+}
+
+// randomFloat returns a random float number in range [0, 1).
+func randomFloat() float64 {
+	return float64(grand.Intn(randomFloatMax)) / randomFloatMax
+}
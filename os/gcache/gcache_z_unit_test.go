@@ -615,3 +615,45 @@ func TestCache_NewWithAdapter(t *testing.T) {
 		t.AssertNE(cache, nil)
 	})
 }
+
+func TestCache_JitterDuration(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gcache.JitterDuration(0, 0.5), time.Duration(0))
+		t.Assert(gcache.JitterDuration(-1, 0.5), time.Duration(-1))
+		t.Assert(gcache.JitterDuration(time.Minute, 0), time.Minute)
+
+		durations := gset.New()
+		for i := 0; i < 100; i++ {
+			duration := gcache.JitterDuration(time.Hour, 0.1)
+			t.AssertLE(duration, time.Hour)
+			t.AssertGE(duration, 54*time.Minute)
+			durations.Add(duration)
+		}
+		t.AssertGT(durations.Size(), 1)
+
+		for i := 0; i < 100; i++ {
+			t.AssertGE(gcache.JitterDuration(time.Second, 2), 0)
+		}
+	})
+}
+
+func TestCache_ShouldRefreshEarly(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gcache.ShouldRefreshEarly(0, time.Second, 1), false)
+		t.Assert(gcache.ShouldRefreshEarly(-1, time.Second, 1), false)
+		t.Assert(gcache.ShouldRefreshEarly(time.Second, 0, 1), false)
+		t.Assert(gcache.ShouldRefreshEarly(time.Second, time.Second, 0), false)
+
+		var nearCount, farCount int
+		for i := 0; i < 1000; i++ {
+			if gcache.ShouldRefreshEarly(10*time.Millisecond, 100*time.Millisecond, 1) {
+				nearCount++
+			}
+			if gcache.ShouldRefreshEarly(time.Hour, 100*time.Millisecond, 1) {
+				farCount++
+			}
+		}
+		t.AssertGT(nearCount, 800)
+		t.Assert(farCount, 0)
+	})
+}
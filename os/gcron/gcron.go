@@ -131,3 +131,36 @@ func StopGracefully() {
 func StopGracefullyNonBlocking() context.Context {
 	return defaultCron.StopGracefullyNonBlocking()
 }
+
+// SetStore sets the persistent job store for default cron.
+func SetStore(store Store) {
+	defaultCron.SetStore(store)
+}
+
+// RegisterHandler registers the job function `job` with `handler` name for the store jobs of default cron.
+func RegisterHandler(handler string, job JobFunc) {
+	defaultCron.RegisterHandler(handler, job)
+}
+
+// AddStoreJob validates and saves `job` to the store, and then synchronizes the jobs from store
+// to default cron.
+func AddStoreJob(ctx context.Context, job StoreJob) error {
+	return defaultCron.AddStoreJob(ctx, job)
+}
+
+// RemoveStoreJob deletes job `name` from the store, and then synchronizes the jobs from store
+// to default cron.
+func RemoveStoreJob(ctx context.Context, name string) error {
+	return defaultCron.RemoveStoreJob(ctx, name)
+}
+
+// SyncStore synchronizes the jobs from store to default cron.
+func SyncStore(ctx context.Context) error {
+	return defaultCron.SyncStore(ctx)
+}
+
+// StartStoreSync synchronizes the jobs from store to default cron immediately,
+// and then periodically every `interval`.
+func StartStoreSync(ctx context.Context, interval time.Duration) error {
+	return defaultCron.StartStoreSync(ctx, interval)
+}
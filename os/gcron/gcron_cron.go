@@ -28,15 +28,25 @@ type Cron struct {
 	jobWaiter   sync.WaitGroup // Graceful shutdown when cron jobs are stopped.
 	running     bool
 	runningLock sync.Mutex
+	node        string // Node name of current process, which is the owner of the run locks in store.
+	store       Store  // Persistent job store, it is nil in default.
+	storeMu     sync.RWMutex
+	storeSync   *gtimer.Entry   // Timer entry for synchronizing jobs from store periodically.
+	storeSyncMu sync.Mutex      // Used for SyncStore concurrent safety.
+	storeJobs   *gmap.StrAnyMap // Jobs synchronized from store, name to *StoreJob.
+	handlers    *gmap.StrAnyMap // Registered job functions for store jobs, handler name to JobFunc.
 }
 
 // New returns a new Cron object with default settings.
 func New() *Cron {
 	return &Cron{
-		idGen:   gtype.NewInt64(),
-		status:  gtype.NewInt(StatusRunning),
-		entries: gmap.NewStrAnyMap(true),
-		running: true,
+		idGen:     gtype.NewInt64(),
+		status:    gtype.NewInt(StatusRunning),
+		entries:   gmap.NewStrAnyMap(true),
+		running:   true,
+		node:      getDefaultNode(),
+		storeJobs: gmap.NewStrAnyMap(true),
+		handlers:  gmap.NewStrAnyMap(true),
 	}
 }
 
@@ -237,6 +247,12 @@ func (c *Cron) Close() {
 	defer c.runningLock.Unlock()
 	c.status.Set(StatusClosed)
 	c.running = false
+	c.storeMu.Lock()
+	if c.storeSync != nil {
+		c.storeSync.Close()
+		c.storeSync = nil
+	}
+	c.storeMu.Unlock()
 }
 
 // Size returns the size of the timed tasks.
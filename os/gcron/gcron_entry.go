@@ -16,7 +16,6 @@ import (
 	"github.com/gogf/gf/v2/container/gtype"
 	"github.com/gogf/gf/v2/errors/gcode"
 	"github.com/gogf/gf/v2/errors/gerror"
-	"github.com/gogf/gf/v2/os/glog"
 	"github.com/gogf/gf/v2/os/gtimer"
 	"github.com/gogf/gf/v2/util/gconv"
 )
@@ -32,6 +31,7 @@ type Entry struct {
 	jobName      string        // Callback function name(address info).
 	times        *gtype.Int    // Running times limit.
 	infinite     *gtype.Bool   // No times limit.
+	stored       bool          // Whether it is synchronized from the store.
 	Name         string        // Entry name.
 	RegisterTime time.Time     // Registered time.
 	Job          JobFunc       `json:"-"` // Callback function.
@@ -45,6 +45,7 @@ type doAddEntryInput struct {
 	Pattern     string          // Pattern is the crontab style string for scheduler.
 	IsSingleton bool            // Singleton specifies whether timed task executing in singleton mode.
 	Infinite    bool            // Infinite specifies whether this entry is running with no times limit.
+	Stored      bool            // Stored specifies whether this entry is synchronized from the store.
 }
 
 // doAddEntry creates and returns a new Entry object.
@@ -69,6 +70,7 @@ func (c *Cron) doAddEntry(in doAddEntryInput) (*Entry, error) {
 		jobName:      runtime.FuncForPC(reflect.ValueOf(in.Job).Pointer()).Name(),
 		times:        gtype.NewInt(in.Times),
 		infinite:     gtype.NewBool(in.Infinite),
+		stored:       in.Stored,
 		RegisterTime: time.Now(),
 		Job:          in.Job,
 	}
@@ -186,6 +188,10 @@ func (e *Entry) checkAndRun(ctx context.Context) {
 				}
 			}
 		}
+		if e.stored {
+			e.runStoreJob(ctx, currentTime)
+			return
+		}
 		e.logDebugf(ctx, `cron job "%s" starts`, e.getJobNameWithPattern())
 		e.Job(ctx)
 	}
@@ -202,9 +208,5 @@ func (e *Entry) logDebugf(ctx context.Context, format string, v ...any) {
 }
 
 func (e *Entry) logErrorf(ctx context.Context, format string, v ...any) {
-	logger := e.cron.GetLogger()
-	if logger == nil {
-		logger = glog.DefaultLogger()
-	}
-	logger.Errorf(ctx, format, v...)
+	e.cron.logErrorf(ctx, format, v...)
 }
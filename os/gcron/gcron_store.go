@@ -0,0 +1,282 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gcron
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/glog"
+	"github.com/gogf/gf/v2/os/gtimer"
+)
+
+// Store is the interface for persistent job store, which keeps the job definitions, run locks and
+// run history, so that the jobs can be managed outside the process, like an admin UI, survive
+// restarts, and run only once for each scheduled time across the cluster.
+type Store interface {
+	// Jobs returns all the job definitions in the store.
+	Jobs(ctx context.Context) ([]*StoreJob, error)
+
+	// SaveJob creates or updates the job definition by its name.
+	SaveJob(ctx context.Context, job *StoreJob) error
+
+	// RemoveJob deletes the job definition of `name`.
+	RemoveJob(ctx context.Context, name string) error
+
+	// Lock acquires the run lock of job `name` for the scheduled time `runTime`.
+	// It returns true only for the first node acquiring the lock of the same job and time,
+	// which is the node running the job for this time.
+	Lock(ctx context.Context, name string, runTime time.Time, node string) (bool, error)
+
+	// AddHistory records the run history of a job.
+	AddHistory(ctx context.Context, history *StoreHistory) error
+
+	// Histories returns the latest `limit` run histories of job `name`, ordered by start time desc.
+	Histories(ctx context.Context, name string, limit int) ([]*StoreHistory, error)
+}
+
+// StoreJob is the job definition in the store.
+//
+// As functions cannot be persisted, the job references its function by the handler name,
+// which should be registered using RegisterHandler on every node.
+type StoreJob struct {
+	Name      string `json:"name"      orm:"name"`      // Unique job name.
+	Pattern   string `json:"pattern"   orm:"pattern"`   // Crontab style pattern.
+	Handler   string `json:"handler"   orm:"handler"`   // Handler name of the job function.
+	Singleton bool   `json:"singleton" orm:"singleton"` // Whether running in singleton mode.
+	Disabled  bool   `json:"disabled"  orm:"disabled"`  // Whether the job is disabled.
+}
+
+// StoreHistory is the run history of a job in the store.
+type StoreHistory struct {
+	Name      string    `json:"name"      orm:"name"`       // Job name.
+	Node      string    `json:"node"      orm:"node"`       // Node name running the job.
+	StartTime time.Time `json:"startTime" orm:"start_time"` // Start time of the running.
+	EndTime   time.Time `json:"endTime"   orm:"end_time"`   // End time of the running.
+	Error     string    `json:"error"     orm:"error"`      // Error of the running, empty if it succeeds.
+}
+
+// SetStore sets the persistent job store for cron.
+// It should be called before SyncStore or StartStoreSync.
+func (c *Cron) SetStore(store Store) {
+	c.storeMu.Lock()
+	defer c.storeMu.Unlock()
+	c.store = store
+}
+
+// GetStore returns the persistent job store of cron.
+func (c *Cron) GetStore() Store {
+	c.storeMu.RLock()
+	defer c.storeMu.RUnlock()
+	return c.store
+}
+
+// SetNode sets the node name of current process, which is recorded in the run locks and history.
+// It's "hostname:pid" in default.
+func (c *Cron) SetNode(node string) {
+	c.storeMu.Lock()
+	defer c.storeMu.Unlock()
+	c.node = node
+}
+
+// GetNode returns the node name of current process.
+func (c *Cron) GetNode() string {
+	c.storeMu.RLock()
+	defer c.storeMu.RUnlock()
+	return c.node
+}
+
+// RegisterHandler registers the job function `job` with `handler` name for the store jobs.
+func (c *Cron) RegisterHandler(handler string, job JobFunc) {
+	c.handlers.Set(handler, job)
+}
+
+// AddStoreJob validates and saves `job` to the store, and then synchronizes the jobs from store.
+func (c *Cron) AddStoreJob(ctx context.Context, job StoreJob) error {
+	store, err := c.getStoreOrError()
+	if err != nil {
+		return err
+	}
+	if job.Name == "" {
+		return gerror.NewCode(gcode.CodeInvalidParameter, `cron job name should not be empty`)
+	}
+	if _, err = newSchedule(job.Pattern); err != nil {
+		return err
+	}
+	if !c.handlers.Contains(job.Handler) {
+		return gerror.NewCodef(gcode.CodeInvalidParameter, `cron job handler "%s" is not registered`, job.Handler)
+	}
+	if v := c.Search(job.Name); v != nil && !v.stored {
+		return gerror.NewCodef(
+			gcode.CodeInvalidOperation,
+			`duplicated cron job name "%s", already exists`,
+			job.Name,
+		)
+	}
+	if err = store.SaveJob(ctx, &job); err != nil {
+		return err
+	}
+	return c.SyncStore(ctx)
+}
+
+// RemoveStoreJob deletes job `name` from the store, and then synchronizes the jobs from store.
+func (c *Cron) RemoveStoreJob(ctx context.Context, name string) error {
+	store, err := c.getStoreOrError()
+	if err != nil {
+		return err
+	}
+	if err = store.RemoveJob(ctx, name); err != nil {
+		return err
+	}
+	return c.SyncStore(ctx)
+}
+
+// SyncStore synchronizes the jobs from store to cron, which adds the new jobs, updates the changed
+// jobs, and removes the deleted or disabled jobs. The jobs whose handler is not registered on
+// current node are ignored with error logged.
+func (c *Cron) SyncStore(ctx context.Context) error {
+	store, err := c.getStoreOrError()
+	if err != nil {
+		return err
+	}
+	c.storeSyncMu.Lock()
+	defer c.storeSyncMu.Unlock()
+	jobs, err := store.Jobs(ctx)
+	if err != nil {
+		return err
+	}
+	jobMap := make(map[string]*StoreJob, len(jobs))
+	for _, job := range jobs {
+		if !job.Disabled {
+			jobMap[job.Name] = job
+		}
+	}
+	// Removes the deleted, disabled or changed jobs.
+	for _, name := range c.storeJobs.Keys() {
+		old := c.storeJobs.Get(name).(*StoreJob)
+		if job, ok := jobMap[name]; ok && *job == *old {
+			delete(jobMap, name)
+			continue
+		}
+		c.storeJobs.Remove(name)
+		if entry := c.Search(name); entry != nil && entry.stored {
+			entry.Close()
+		}
+	}
+	// Adds the new or changed jobs.
+	for name, job := range jobMap {
+		jobFunc, ok := c.handlers.Get(job.Handler).(JobFunc)
+		if !ok {
+			c.logErrorf(ctx, `cron job "%s" ignored as its handler "%s" is not registered`, name, job.Handler)
+			continue
+		}
+		_, err = c.doAddEntry(doAddEntryInput{
+			Name:        name,
+			Job:         jobFunc,
+			Ctx:         ctx,
+			Times:       -1,
+			Pattern:     job.Pattern,
+			IsSingleton: job.Singleton,
+			Infinite:    true,
+			Stored:      true,
+		})
+		if err != nil {
+			c.logErrorf(ctx, `cron job "%s" ignored: %+v`, name, err)
+			continue
+		}
+		c.storeJobs.Set(name, job)
+	}
+	return nil
+}
+
+// StartStoreSync synchronizes the jobs from store immediately, and then periodically every
+// `interval`, so that the changes of the store from other nodes or admin UI take effect.
+// The periodical synchronizing stops when the cron is closed.
+func (c *Cron) StartStoreSync(ctx context.Context, interval time.Duration) error {
+	if err := c.SyncStore(ctx); err != nil {
+		return err
+	}
+	c.storeMu.Lock()
+	defer c.storeMu.Unlock()
+	if c.storeSync != nil {
+		c.storeSync.Close()
+	}
+	c.storeSync = gtimer.AddSingleton(ctx, interval, func(ctx context.Context) {
+		if err := c.SyncStore(ctx); err != nil {
+			c.logErrorf(ctx, `cron jobs synchronizing from store failed: %+v`, err)
+		}
+	})
+	return nil
+}
+
+// runStoreJob runs the store job of the entry if it acquires the run lock of `runTime`,
+// and records the run history to the store.
+func (e *Entry) runStoreJob(ctx context.Context, runTime time.Time) {
+	var (
+		store = e.cron.GetStore()
+		node  = e.cron.GetNode()
+	)
+	if store == nil {
+		e.Job(ctx)
+		return
+	}
+	locked, err := store.Lock(ctx, e.Name, runTime.Truncate(time.Second), node)
+	if err != nil {
+		e.logErrorf(ctx, `cron job "%s" lock failed: %+v`, e.getJobNameWithPattern(), err)
+		return
+	}
+	if !locked {
+		e.logDebugf(ctx, `cron job "%s" is running on other node`, e.getJobNameWithPattern())
+		return
+	}
+	history := &StoreHistory{
+		Name:      e.Name,
+		Node:      node,
+		StartTime: time.Now(),
+	}
+	defer func() {
+		exception := recover()
+		history.EndTime = time.Now()
+		if exception != nil {
+			history.Error = fmt.Sprintf(`%+v`, exception)
+		}
+		if err = store.AddHistory(ctx, history); err != nil {
+			e.logErrorf(ctx, `cron job "%s" history recording failed: %+v`, e.getJobNameWithPattern(), err)
+		}
+		if exception != nil {
+			panic(exception)
+		}
+	}()
+	e.logDebugf(ctx, `cron job "%s" starts`, e.getJobNameWithPattern())
+	e.Job(ctx)
+}
+
+// getStoreOrError returns the store of cron, or an error if no store set.
+func (c *Cron) getStoreOrError() (Store, error) {
+	if store := c.GetStore(); store != nil {
+		return store, nil
+	}
+	return nil, gerror.NewCode(gcode.CodeMissingConfiguration, `cron store is not set`)
+}
+
+func (c *Cron) logErrorf(ctx context.Context, format string, v ...any) {
+	logger := c.GetLogger()
+	if logger == nil {
+		logger = glog.DefaultLogger()
+	}
+	logger.Errorf(ctx, format, v...)
+}
+
+// getDefaultNode returns the default node name of current process.
+func getDefaultNode() string {
+	hostname, _ := os.Hostname()
+	return fmt.Sprintf(`%s:%d`, hostname, os.Getpid())
+}
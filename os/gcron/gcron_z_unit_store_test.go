@@ -0,0 +1,192 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gcron_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/container/gtype"
+	"github.com/gogf/gf/v2/os/gcron"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+// memoryStore is a gcron.Store in memory for testing.
+type memoryStore struct {
+	mu        sync.Mutex
+	jobs      map[string]gcron.StoreJob
+	locks     map[string]string
+	histories []*gcron.StoreHistory
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		jobs:  make(map[string]gcron.StoreJob),
+		locks: make(map[string]string),
+	}
+}
+
+func (s *memoryStore) Jobs(ctx context.Context) ([]*gcron.StoreJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var jobs []*gcron.StoreJob
+	for _, job := range s.jobs {
+		job := job
+		jobs = append(jobs, &job)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].Name < jobs[j].Name })
+	return jobs, nil
+}
+
+func (s *memoryStore) SaveJob(ctx context.Context, job *gcron.StoreJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[job.Name] = *job
+	return nil
+}
+
+func (s *memoryStore) RemoveJob(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.jobs, name)
+	return nil
+}
+
+func (s *memoryStore) Lock(ctx context.Context, name string, runTime time.Time, node string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := fmt.Sprintf("%s-%d", name, runTime.Unix())
+	if _, ok := s.locks[key]; ok {
+		return false, nil
+	}
+	s.locks[key] = node
+	return true, nil
+}
+
+func (s *memoryStore) AddHistory(ctx context.Context, history *gcron.StoreHistory) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.histories = append(s.histories, history)
+	return nil
+}
+
+func (s *memoryStore) Histories(ctx context.Context, name string, limit int) ([]*gcron.StoreHistory, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var histories []*gcron.StoreHistory
+	for i := len(s.histories) - 1; i >= 0 && len(histories) < limit; i-- {
+		if s.histories[i].Name == name {
+			histories = append(histories, s.histories[i])
+		}
+	}
+	return histories, nil
+}
+
+func TestCron_Store(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			cron  = gcron.New()
+			store = newMemoryStore()
+			count = gtype.NewInt()
+		)
+		defer cron.Close()
+		cron.RegisterHandler("count", func(ctx context.Context) {
+			count.Add(1)
+		})
+		cron.RegisterHandler("panic", func(ctx context.Context) {
+			panic("job error")
+		})
+
+		err := cron.AddStoreJob(ctx, gcron.StoreJob{Name: "job", Pattern: "* * * * * *", Handler: "count"})
+		t.AssertNE(err, nil)
+
+		cron.SetStore(store)
+		t.Assert(cron.GetStore(), store)
+
+		err = cron.AddStoreJob(ctx, gcron.StoreJob{Name: "job", Pattern: "* * * * * *", Handler: "none"})
+		t.AssertNE(err, nil)
+		err = cron.AddStoreJob(ctx, gcron.StoreJob{Name: "job", Pattern: "invalid", Handler: "count"})
+		t.AssertNE(err, nil)
+
+		err = cron.AddStoreJob(ctx, gcron.StoreJob{Name: "job", Pattern: "* * * * * *", Handler: "count"})
+		t.AssertNil(err)
+		err = cron.AddStoreJob(ctx, gcron.StoreJob{Name: "panic", Pattern: "* * * * * *", Handler: "panic"})
+		t.AssertNil(err)
+		t.Assert(cron.Size(), 2)
+		t.AssertNE(cron.Search("job"), nil)
+
+		time.Sleep(1500 * time.Millisecond)
+		t.AssertGE(count.Val(), 1)
+
+		histories, err := store.Histories(ctx, "job", 10)
+		t.AssertNil(err)
+		t.AssertGE(len(histories), 1)
+		t.Assert(histories[0].Node, cron.GetNode())
+		t.Assert(histories[0].Error, "")
+
+		histories, err = store.Histories(ctx, "panic", 10)
+		t.AssertNil(err)
+		t.AssertGE(len(histories), 1)
+		t.Assert(histories[0].Error, "job error")
+
+		// Disabled in store.
+		err = store.SaveJob(ctx, &gcron.StoreJob{Name: "job", Pattern: "* * * * * *", Handler: "count", Disabled: true})
+		t.AssertNil(err)
+		t.AssertNil(cron.SyncStore(ctx))
+		t.Assert(cron.Search("job"), nil)
+
+		err = cron.RemoveStoreJob(ctx, "panic")
+		t.AssertNil(err)
+		t.Assert(cron.Size(), 0)
+	})
+}
+
+func TestCron_Store_Cluster(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			store = newMemoryStore()
+			count = gtype.NewInt()
+			job   = func(ctx context.Context) {
+				count.Add(1)
+			}
+			cron1 = gcron.New()
+			cron2 = gcron.New()
+		)
+		defer cron1.Close()
+		defer cron2.Close()
+		cron1.SetNode("node1")
+		cron2.SetNode("node2")
+		for _, cron := range []*gcron.Cron{cron1, cron2} {
+			cron.SetStore(store)
+			cron.RegisterHandler("count", job)
+		}
+		err := store.SaveJob(ctx, &gcron.StoreJob{Name: "job", Pattern: "* * * * * *", Handler: "count"})
+		t.AssertNil(err)
+		t.AssertNil(cron1.StartStoreSync(ctx, 100*time.Millisecond))
+		t.AssertNil(cron2.StartStoreSync(ctx, 100*time.Millisecond))
+		t.Assert(cron1.Size(), 1)
+		t.Assert(cron2.Size(), 1)
+
+		time.Sleep(2500 * time.Millisecond)
+		histories, err := store.Histories(ctx, "job", 100)
+		t.AssertNil(err)
+		// Each scheduled time runs only once across the nodes.
+		t.Assert(count.Val(), len(histories))
+		t.AssertGE(count.Val(), 2)
+		t.AssertLE(count.Val(), 3)
+
+		// Changes of the store are synchronized periodically.
+		t.AssertNil(store.RemoveJob(ctx, "job"))
+		time.Sleep(300 * time.Millisecond)
+		t.Assert(cron1.Size(), 0)
+		t.Assert(cron2.Size(), 0)
+	})
+}
@@ -0,0 +1,147 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+// Package gcrondb implements gcron.Store using database.
+package gcrondb
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/os/gcron"
+)
+
+const (
+	defaultJobTable     = "gcron_job"
+	defaultLockTable    = "gcron_lock"
+	defaultHistoryTable = "gcron_history"
+)
+
+// Store is the gcron.Store implementation using database, the tables should be created before use,
+// eg for MySQL:
+//
+//	CREATE TABLE `gcron_job` (
+//	    `name`      varchar(128) NOT NULL,
+//	    `pattern`   varchar(128) NOT NULL,
+//	    `handler`   varchar(128) NOT NULL,
+//	    `singleton` tinyint      NOT NULL DEFAULT 0,
+//	    `disabled`  tinyint      NOT NULL DEFAULT 0,
+//	    PRIMARY KEY (`name`)
+//	);
+//	CREATE TABLE `gcron_lock` (
+//	    `name`     varchar(128) NOT NULL,
+//	    `run_time` bigint       NOT NULL,
+//	    `node`     varchar(128) NOT NULL,
+//	    PRIMARY KEY (`name`, `run_time`)
+//	);
+//	CREATE TABLE `gcron_history` (
+//	    `id`         bigint       NOT NULL AUTO_INCREMENT,
+//	    `name`       varchar(128) NOT NULL,
+//	    `node`       varchar(128) NOT NULL,
+//	    `start_time` datetime(3)  NOT NULL,
+//	    `end_time`   datetime(3)  NOT NULL,
+//	    `error`      text,
+//	    PRIMARY KEY (`id`),
+//	    KEY `name_start_time` (`name`, `start_time`)
+//	);
+type Store struct {
+	db           gdb.DB
+	jobTable     string
+	lockTable    string
+	historyTable string
+}
+
+var _ gcron.Store = (*Store)(nil)
+
+// Option is the option for Store.
+type Option struct {
+	JobTable     string // Table for job definitions, it's "gcron_job" in default.
+	LockTable    string // Table for run locks, it's "gcron_lock" in default.
+	HistoryTable string // Table for run history, it's "gcron_history" in default.
+}
+
+// New creates and returns a Store using database `db`.
+func New(db gdb.DB, option ...Option) *Store {
+	store := &Store{
+		db:           db,
+		jobTable:     defaultJobTable,
+		lockTable:    defaultLockTable,
+		historyTable: defaultHistoryTable,
+	}
+	if len(option) > 0 {
+		if option[0].JobTable != "" {
+			store.jobTable = option[0].JobTable
+		}
+		if option[0].LockTable != "" {
+			store.lockTable = option[0].LockTable
+		}
+		if option[0].HistoryTable != "" {
+			store.historyTable = option[0].HistoryTable
+		}
+	}
+	return store
+}
+
+// Jobs returns all the job definitions in the store.
+func (s *Store) Jobs(ctx context.Context) (jobs []*gcron.StoreJob, err error) {
+	err = s.db.Model(s.jobTable).Ctx(ctx).OrderAsc("name").Scan(&jobs)
+	return
+}
+
+// SaveJob creates or updates the job definition by its name.
+func (s *Store) SaveJob(ctx context.Context, job *gcron.StoreJob) error {
+	_, err := s.db.Model(s.jobTable).Ctx(ctx).Data(job).OnConflict("name").Save()
+	return err
+}
+
+// RemoveJob deletes the job definition of `name`.
+func (s *Store) RemoveJob(ctx context.Context, name string) error {
+	_, err := s.db.Model(s.jobTable).Ctx(ctx).Where("name", name).Delete()
+	return err
+}
+
+// Lock acquires the run lock of job `name` for the scheduled time `runTime` by inserting the lock
+// record, which is unique by the job name and run time. The expired locks of the job are deleted
+// after the lock is acquired, the failure of which is logged and does not affect the acquired lock.
+func (s *Store) Lock(ctx context.Context, name string, runTime time.Time, node string) (bool, error) {
+	result, err := s.db.Model(s.lockTable).Ctx(ctx).Data(gdb.Map{
+		"name":     name,
+		"run_time": runTime.Unix(),
+		"node":     node,
+	}).InsertIgnore()
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil || affected == 0 {
+		return false, err
+	}
+	_, err = s.db.Model(s.lockTable).Ctx(ctx).
+		Where("name", name).
+		WhereLT("run_time", runTime.Unix()).
+		Delete()
+	if err != nil {
+		s.db.GetLogger().Errorf(ctx, `delete expired locks of job "%s" failed: %+v`, name, err)
+	}
+	return true, nil
+}
+
+// AddHistory records the run history of a job.
+func (s *Store) AddHistory(ctx context.Context, history *gcron.StoreHistory) error {
+	_, err := s.db.Model(s.historyTable).Ctx(ctx).Data(history).Insert()
+	return err
+}
+
+// Histories returns the latest `limit` run histories of job `name`, ordered by start time desc.
+func (s *Store) Histories(ctx context.Context, name string, limit int) (histories []*gcron.StoreHistory, err error) {
+	err = s.db.Model(s.historyTable).Ctx(ctx).
+		Where("name", name).
+		OrderDesc("start_time").
+		Limit(limit).
+		Scan(&histories)
+	return
+}
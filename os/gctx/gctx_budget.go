@@ -0,0 +1,147 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gctx
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// Budget is the time budget of a whole call chain, which is shared by all hops
+// (ghttp -> gclient -> gdb, etc.) using the same context.
+// The gclient requests and gdb statements fail fast with ErrBudgetExhausted if the remaining budget
+// is less than their minimum timeouts, and their retries stop if the budget cannot afford them.
+type Budget struct {
+	total    time.Duration // Effective total budget when it is created.
+	deadline time.Time     // Absolute deadline of the budget.
+}
+
+// ctxKeyBudget is the context key for Budget.
+const ctxKeyBudget StrKey = "GoFrameCtxBudget"
+
+// ErrBudgetExhausted is returned when the remaining budget of the context
+// is not enough for the next hop.
+var ErrBudgetExhausted = gerror.NewWithOption(gerror.Option{
+	Text: "context budget exhausted",
+	Code: gcode.CodeOperationFailed,
+})
+
+// WithBudget creates and returns a context carrying a time budget of `total` duration,
+// which is also the deadline of the returned context.
+//
+// If the parent context has an earlier deadline, the budget is shrunk to the parent deadline.
+// If the parent context already carries a budget with an earlier deadline, the earlier one is kept.
+// The total of the shrunk budget is the duration until the earlier deadline.
+//
+// The returned cancel function should be called to release resources, just like context.WithTimeout.
+func WithBudget(ctx context.Context, total time.Duration) (context.Context, context.CancelFunc) {
+	var (
+		now      = time.Now()
+		deadline = now.Add(total)
+	)
+	if parentDeadline, ok := ctx.Deadline(); ok && parentDeadline.Before(deadline) {
+		deadline = parentDeadline
+	}
+	if parent := GetBudget(ctx); parent != nil && parent.deadline.Before(deadline) {
+		deadline = parent.deadline
+	}
+	if total = deadline.Sub(now); total < 0 {
+		total = 0
+	}
+	budget := &Budget{
+		total:    total,
+		deadline: deadline,
+	}
+	ctx = context.WithValue(ctx, ctxKeyBudget, budget)
+	return context.WithDeadline(ctx, deadline)
+}
+
+// GetBudget retrieves and returns the Budget from context.
+// It returns nil if there's no budget in the context.
+func GetBudget(ctx context.Context) *Budget {
+	if ctx == nil {
+		return nil
+	}
+	if v, ok := ctx.Value(ctxKeyBudget).(*Budget); ok {
+		return v
+	}
+	return nil
+}
+
+// BudgetRemaining returns the remaining budget of given context.
+// The second returned value is false if there's no budget in the context.
+func BudgetRemaining(ctx context.Context) (remaining time.Duration, ok bool) {
+	if budget := GetBudget(ctx); budget != nil {
+		return budget.Remaining(), true
+	}
+	return 0, false
+}
+
+// WithBudgetHop creates a context for the next hop of the call chain.
+//
+// The hop deadline is the less one of `hopTimeout` and the remaining budget of the context.
+// If the remaining budget is less than `minTimeout`, it returns ErrBudgetExhausted without
+// creating the hop context, so that the caller can fail fast instead of starting a call
+// that cannot complete in time.
+//
+// If there's no budget in the context, it only applies `hopTimeout` if it is greater than 0.
+func WithBudgetHop(
+	ctx context.Context, hopTimeout, minTimeout time.Duration,
+) (context.Context, context.CancelFunc, error) {
+	budget := GetBudget(ctx)
+	if budget == nil {
+		if hopTimeout > 0 {
+			newCtx, cancel := context.WithTimeout(ctx, hopTimeout)
+			return newCtx, cancel, nil
+		}
+		return ctx, func() {}, nil
+	}
+	remaining := budget.Remaining()
+	if remaining <= 0 || remaining < minTimeout {
+		return ctx, func() {}, ErrBudgetExhausted
+	}
+	if hopTimeout <= 0 || hopTimeout > remaining {
+		hopTimeout = remaining
+	}
+	newCtx, cancel := context.WithTimeout(ctx, hopTimeout)
+	return newCtx, cancel, nil
+}
+
+// Total returns the effective total budget when it was created, which is less than the given total
+// if the budget was shrunk to the earlier deadline of the parent context.
+func (b *Budget) Total() time.Duration {
+	return b.total
+}
+
+// Deadline returns the absolute deadline of the budget.
+func (b *Budget) Deadline() time.Time {
+	return b.deadline
+}
+
+// Remaining returns the remaining duration of the budget.
+// It returns 0 if the budget is exhausted.
+func (b *Budget) Remaining() time.Duration {
+	remaining := time.Until(b.deadline)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// Exhausted checks and returns whether the budget is exhausted.
+func (b *Budget) Exhausted() bool {
+	return b.Remaining() <= 0
+}
+
+// Allows checks and returns whether the remaining budget is enough for
+// an operation costing duration `d`.
+func (b *Budget) Allows(d time.Duration) bool {
+	return b.Remaining() > d
+}
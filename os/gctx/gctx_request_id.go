@@ -0,0 +1,33 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gctx
+
+import (
+	"context"
+)
+
+// ctxKeyRequestId is the context key for request id.
+const ctxKeyRequestId StrKey = "GoFrameCtxRequestId"
+
+// WithRequestId creates and returns a context carrying the request id, which identifies the request
+// across the services and components, like the logging of glog, the requests of gclient and the SQL
+// statements of gdb.
+func WithRequestId(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, ctxKeyRequestId, requestId)
+}
+
+// RequestId retrieves and returns the request id from context.
+// It returns empty string if there's no request id in the context.
+func RequestId(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if v, ok := ctx.Value(ctxKeyRequestId).(string); ok {
+		return v
+	}
+	return ""
+}
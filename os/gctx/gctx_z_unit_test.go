@@ -9,6 +9,7 @@ package gctx_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/gogf/gf/v2/os/gctx"
 	"github.com/gogf/gf/v2/test/gtest"
@@ -39,3 +40,70 @@ func Test_SetInitCtx(t *testing.T) {
 		t.Assert(gctx.GetInitCtx().Value("TEST"), 1)
 	})
 }
+
+func Test_WithBudget(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		_, ok := gctx.BudgetRemaining(context.Background())
+		t.Assert(ok, false)
+
+		ctx, cancel := gctx.WithBudget(context.Background(), time.Second)
+		defer cancel()
+		remaining, ok := gctx.BudgetRemaining(ctx)
+		t.Assert(ok, true)
+		t.Assert(remaining > 0 && remaining <= time.Second, true)
+		deadline, ok := ctx.Deadline()
+		t.Assert(ok, true)
+		t.Assert(deadline.Equal(gctx.GetBudget(ctx).Deadline()), true)
+	})
+	// Nested budget cannot extend the parent one.
+	gtest.C(t, func(t *gtest.T) {
+		ctx, cancel := gctx.WithBudget(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		ctx, cancel2 := gctx.WithBudget(ctx, time.Hour)
+		defer cancel2()
+		t.Assert(gctx.GetBudget(ctx).Remaining() <= 100*time.Millisecond, true)
+		t.Assert(gctx.GetBudget(ctx).Total() <= 100*time.Millisecond, true)
+		t.Assert(gctx.GetBudget(ctx).Total() > 0, true)
+	})
+	// Budget shrunk by the parent deadline.
+	gtest.C(t, func(t *gtest.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		ctx, cancel2 := gctx.WithBudget(ctx, time.Hour)
+		defer cancel2()
+		t.Assert(gctx.GetBudget(ctx).Total() <= 100*time.Millisecond, true)
+	})
+}
+
+func Test_WithBudgetHop(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		ctx, cancel := gctx.WithBudget(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		hopCtx, hopCancel, err := gctx.WithBudgetHop(ctx, time.Hour, 10*time.Millisecond)
+		defer hopCancel()
+		t.AssertNil(err)
+		deadline, ok := hopCtx.Deadline()
+		t.Assert(ok, true)
+		t.Assert(time.Until(deadline) <= 200*time.Millisecond, true)
+
+		_, _, err = gctx.WithBudgetHop(ctx, time.Second, time.Second)
+		t.Assert(err, gctx.ErrBudgetExhausted)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		hopCtx, hopCancel, err := gctx.WithBudgetHop(context.Background(), 0, time.Second)
+		defer hopCancel()
+		t.AssertNil(err)
+		_, ok := hopCtx.Deadline()
+		t.Assert(ok, false)
+	})
+}
+
+func Test_WithRequestId(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gctx.RequestId(context.Background()), "")
+
+		ctx := gctx.WithRequestId(context.Background(), "123456")
+		t.Assert(gctx.RequestId(ctx), "123456")
+		t.Assert(gctx.RequestId(context.WithValue(ctx, gctx.StrKey("key"), "value")), "123456")
+	})
+}
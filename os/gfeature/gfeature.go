@@ -0,0 +1,243 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+// Package gfeature provides feature flag management with pluggable adapters.
+//
+// The feature flags are searched from the request overrides in context (only available in
+// non-product mode), and then the adapters in order. The value of a flag can be configured
+// for different modes of package gmode, eg:
+//
+//	feature:
+//	  newCheckout:
+//	    develop: true
+//	    default: false
+package gfeature
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/gogf/gf/v2/container/gmap"
+	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/internal/intlog"
+	"github.com/gogf/gf/v2/os/gcfg"
+	"github.com/gogf/gf/v2/util/gmode"
+)
+
+// Feature is the feature flag manager.
+type Feature struct {
+	mu       sync.RWMutex
+	adapters []Adapter // Adapters in priority order.
+}
+
+const (
+	// DefaultInstanceName is the default instance name for instance usage.
+	DefaultInstanceName = "default"
+	// DefaultConfigNode is the configuration node name of feature flags for default instance.
+	DefaultConfigNode = "feature"
+	// ModeDefaultKey is the key of the value for modes that are not configured in mode-aware flag.
+	ModeDefaultKey = "default"
+)
+
+const (
+	tracingEventFeatureFlag            = "feature_flag"
+	tracingEventFeatureFlagKey         = "feature_flag.key"
+	tracingEventFeatureFlagVariant     = "feature_flag.variant"
+	tracingEventFeatureFlagSource      = "feature_flag.source"
+	tracingEventFeatureFlagSourceCtx   = "override"
+	tracingEventFeatureFlagSourceStore = "adapter"
+)
+
+var (
+	// localInstances is the instances map of Feature.
+	localInstances = gmap.NewStrAnyMap(true)
+)
+
+// New creates and returns a feature flag manager with given adapters,
+// which are searched in order for feature flags.
+func New(adapters ...Adapter) *Feature {
+	return &Feature{
+		adapters: adapters,
+	}
+}
+
+// Instance returns an instance of Feature with default settings, which reads feature flags from
+// the configuration node DefaultConfigNode of the default configuration instance.
+// The parameter `name` is the name for the instance.
+func Instance(name ...string) *Feature {
+	var instanceName = DefaultInstanceName
+	if len(name) > 0 && name[0] != "" {
+		instanceName = name[0]
+	}
+	return localInstances.GetOrSetFuncLock(instanceName, func() any {
+		return New(NewAdapterConfig(gcfg.Instance(), DefaultConfigNode))
+	}).(*Feature)
+}
+
+// SetAdapter sets the adapters of the feature flag manager, which are searched in order.
+func (f *Feature) SetAdapter(adapters ...Adapter) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.adapters = adapters
+}
+
+// GetAdapter returns the adapters of the feature flag manager.
+func (f *Feature) GetAdapter() []Adapter {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return append([]Adapter(nil), f.adapters...)
+}
+
+// Get retrieves and returns the value of feature flag `name`.
+// It returns nil if the flag is not found in overrides or any adapter.
+//
+// The evaluation is recorded as an event of the current tracing span if it is recording.
+func (f *Feature) Get(ctx context.Context, name string) (*gvar.Var, error) {
+	if value, ok := OverridesFromCtx(ctx)[name]; ok {
+		v := gvar.New(value)
+		addTracingEvent(ctx, name, v, tracingEventFeatureFlagSourceCtx)
+		return v, nil
+	}
+	for _, adapter := range f.GetAdapter() {
+		value, found, err := adapter.Get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+		if value = resolveModeValue(value); value == nil {
+			// The flag is not configured for current mode.
+			continue
+		}
+		v := gvar.New(value)
+		addTracingEvent(ctx, name, v, tracingEventFeatureFlagSourceStore)
+		return v, nil
+	}
+	return nil, nil
+}
+
+// Enabled checks and returns whether feature flag `name` is enabled.
+// It returns false if the flag is not found.
+func (f *Feature) Enabled(ctx context.Context, name string) bool {
+	return f.Bool(ctx, name)
+}
+
+// Bool retrieves and returns the value of feature flag `name` as bool.
+// It returns `def` if the flag is not found or any error occurs.
+func (f *Feature) Bool(ctx context.Context, name string, def ...bool) bool {
+	if v := f.getVar(ctx, name); v != nil {
+		return v.Bool()
+	}
+	if len(def) > 0 {
+		return def[0]
+	}
+	return false
+}
+
+// Int retrieves and returns the value of feature flag `name` as int.
+// It returns `def` if the flag is not found or any error occurs.
+func (f *Feature) Int(ctx context.Context, name string, def ...int) int {
+	if v := f.getVar(ctx, name); v != nil {
+		return v.Int()
+	}
+	if len(def) > 0 {
+		return def[0]
+	}
+	return 0
+}
+
+// Float64 retrieves and returns the value of feature flag `name` as float64.
+// It returns `def` if the flag is not found or any error occurs.
+func (f *Feature) Float64(ctx context.Context, name string, def ...float64) float64 {
+	if v := f.getVar(ctx, name); v != nil {
+		return v.Float64()
+	}
+	if len(def) > 0 {
+		return def[0]
+	}
+	return 0
+}
+
+// String retrieves and returns the value of feature flag `name` as string.
+// It returns `def` if the flag is not found or any error occurs.
+func (f *Feature) String(ctx context.Context, name string, def ...string) string {
+	if v := f.getVar(ctx, name); v != nil {
+		return v.String()
+	}
+	if len(def) > 0 {
+		return def[0]
+	}
+	return ""
+}
+
+// Duration retrieves and returns the value of feature flag `name` as time.Duration.
+// It returns `def` if the flag is not found or any error occurs.
+func (f *Feature) Duration(ctx context.Context, name string, def ...time.Duration) time.Duration {
+	if v := f.getVar(ctx, name); v != nil {
+		return v.Duration()
+	}
+	if len(def) > 0 {
+		return def[0]
+	}
+	return 0
+}
+
+// getVar retrieves the value of feature flag `name`, which logs the error internally and returns nil.
+func (f *Feature) getVar(ctx context.Context, name string) *gvar.Var {
+	v, err := f.Get(ctx, name)
+	if err != nil {
+		intlog.Errorf(ctx, `get feature flag "%s" failed: %+v`, name, err)
+		return nil
+	}
+	return v
+}
+
+// resolveModeValue returns the value for current mode if `value` is a mode-aware value,
+// which is a map containing keys of gmode names or ModeDefaultKey.
+func resolveModeValue(value any) any {
+	var data map[string]any
+	switch v := value.(type) {
+	case map[string]any:
+		data = v
+	case *gvar.Var:
+		return resolveModeValue(v.Val())
+	default:
+		return value
+	}
+	if len(data) == 0 {
+		return value
+	}
+	for key := range data {
+		switch key {
+		case gmode.DEVELOP, gmode.TESTING, gmode.STAGING, gmode.PRODUCT, ModeDefaultKey:
+		default:
+			// It is not a mode-aware value.
+			return value
+		}
+	}
+	if v, ok := data[gmode.Mode()]; ok {
+		return v
+	}
+	return data[ModeDefaultKey]
+}
+
+// addTracingEvent records the feature flag evaluation to the tracing span in context.
+func addTracingEvent(ctx context.Context, name string, value *gvar.Var, source string) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	span.AddEvent(tracingEventFeatureFlag, trace.WithAttributes(
+		attribute.String(tracingEventFeatureFlagKey, name),
+		attribute.String(tracingEventFeatureFlagVariant, value.String()),
+		attribute.String(tracingEventFeatureFlagSource, source),
+	))
+}
@@ -0,0 +1,16 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gfeature
+
+import "context"
+
+// Adapter is the interface for feature flag storage, like configuration file or remote service.
+type Adapter interface {
+	// Get retrieves and returns the value of feature flag `name`.
+	// The returned `found` is false if the flag is not configured in the adapter.
+	Get(ctx context.Context, name string) (value any, found bool, err error)
+}
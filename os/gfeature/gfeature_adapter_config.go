@@ -0,0 +1,48 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gfeature
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/os/gcfg"
+)
+
+// AdapterConfig is the adapter reading feature flags from static configuration.
+type AdapterConfig struct {
+	config *gcfg.Config
+	node   string
+}
+
+// NewAdapterConfig creates and returns an adapter reading feature flags from configuration node `node`
+// of `config`, which is DefaultConfigNode if not given.
+func NewAdapterConfig(config *gcfg.Config, node ...string) *AdapterConfig {
+	adapter := &AdapterConfig{
+		config: config,
+		node:   DefaultConfigNode,
+	}
+	if len(node) > 0 {
+		adapter.node = node[0]
+	}
+	return adapter
+}
+
+// Get retrieves and returns the value of feature flag `name` from configuration.
+func (a *AdapterConfig) Get(ctx context.Context, name string) (value any, found bool, err error) {
+	if a.config == nil || !a.config.Available(ctx) {
+		return nil, false, nil
+	}
+	pattern := name
+	if a.node != "" {
+		pattern = a.node + "." + name
+	}
+	v, err := a.config.Get(ctx, pattern)
+	if err != nil || v == nil || v.IsNil() {
+		return nil, false, err
+	}
+	return v.Val(), true, nil
+}
@@ -0,0 +1,51 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gfeature
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/container/gmap"
+)
+
+// AdapterMemory is the adapter storing feature flags in memory,
+// which is usually used for testing or flags pushed by remote services.
+type AdapterMemory struct {
+	data *gmap.StrAnyMap
+}
+
+// NewAdapterMemory creates and returns an in-memory adapter with optional initial flags `data`.
+func NewAdapterMemory(data ...map[string]any) *AdapterMemory {
+	adapter := &AdapterMemory{
+		data: gmap.NewStrAnyMap(true),
+	}
+	if len(data) > 0 {
+		adapter.data.Sets(data[0])
+	}
+	return adapter
+}
+
+// Get retrieves and returns the value of feature flag `name`.
+func (a *AdapterMemory) Get(ctx context.Context, name string) (value any, found bool, err error) {
+	value, found = a.data.Search(name)
+	return
+}
+
+// Set sets the value of feature flag `name`.
+func (a *AdapterMemory) Set(name string, value any) {
+	a.data.Set(name, value)
+}
+
+// Replace replaces all the feature flags with `data`.
+func (a *AdapterMemory) Replace(data map[string]any) {
+	a.data.Replace(data)
+}
+
+// Remove removes the feature flags of `names`.
+func (a *AdapterMemory) Remove(names ...string) {
+	a.data.Removes(names)
+}
@@ -0,0 +1,83 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gfeature
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gogf/gf/v2/internal/intlog"
+	"github.com/gogf/gf/v2/os/gtimer"
+)
+
+// RemoteFetcher is the function fetching all feature flags from remote service.
+type RemoteFetcher func(ctx context.Context) (map[string]any, error)
+
+// AdapterRemote is the adapter caching feature flags fetched from remote service,
+// which refreshes the flags periodically in background.
+//
+// It keeps the last fetched flags if refreshing fails, so that the remote service
+// failure does not change the feature flags of running service.
+type AdapterRemote struct {
+	mu      sync.Mutex
+	fetcher RemoteFetcher
+	memory  *AdapterMemory
+	loaded  bool
+	entry   *gtimer.Entry
+}
+
+// NewAdapterRemote creates and returns an adapter for remote feature flags, which are fetched by `fetcher`
+// at the first retrieving and then refreshed every `interval`. It does not refresh periodically if
+// `interval` is not positive.
+func NewAdapterRemote(fetcher RemoteFetcher, interval time.Duration) *AdapterRemote {
+	adapter := &AdapterRemote{
+		fetcher: fetcher,
+		memory:  NewAdapterMemory(),
+	}
+	if interval > 0 {
+		adapter.entry = gtimer.AddSingleton(context.Background(), interval, func(ctx context.Context) {
+			if err := adapter.Refresh(ctx); err != nil {
+				intlog.Errorf(ctx, `refresh remote feature flags failed: %+v`, err)
+			}
+		})
+	}
+	return adapter
+}
+
+// Get retrieves and returns the value of feature flag `name`.
+func (a *AdapterRemote) Get(ctx context.Context, name string) (value any, found bool, err error) {
+	a.mu.Lock()
+	loaded := a.loaded
+	a.mu.Unlock()
+	if !loaded {
+		if err = a.Refresh(ctx); err != nil {
+			return nil, false, err
+		}
+	}
+	return a.memory.Get(ctx, name)
+}
+
+// Refresh fetches the feature flags from remote service immediately.
+func (a *AdapterRemote) Refresh(ctx context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	data, err := a.fetcher(ctx)
+	if err != nil {
+		return err
+	}
+	a.memory.Replace(data)
+	a.loaded = true
+	return nil
+}
+
+// Close stops refreshing the feature flags in background.
+func (a *AdapterRemote) Close() {
+	if a.entry != nil {
+		a.entry.Close()
+	}
+}
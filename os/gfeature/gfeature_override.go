@@ -0,0 +1,65 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gfeature
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gogf/gf/v2/util/gmode"
+)
+
+// OverrideHeader is the HTTP header name for per-request feature flag overrides,
+// which is in format like "newCheckout=true,pageSize=20".
+const OverrideHeader = "X-Feature-Flags"
+
+// ctxKeyForOverrides is the context key for feature flag overrides.
+type ctxKeyForOverrides struct{}
+
+// WithOverrides returns a new context with feature flag `overrides`, which have the highest priority
+// in feature flag retrieving. The overrides are ignored in product mode, as they usually come from
+// request header for developing and testing.
+func WithOverrides(ctx context.Context, overrides map[string]string) context.Context {
+	if gmode.IsProduct() || len(overrides) == 0 {
+		return ctx
+	}
+	merged := make(map[string]string)
+	for k, v := range OverridesFromCtx(ctx) {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, ctxKeyForOverrides{}, merged)
+}
+
+// OverridesFromCtx retrieves and returns the feature flag overrides from context.
+func OverridesFromCtx(ctx context.Context) map[string]string {
+	if ctx == nil || gmode.IsProduct() {
+		return nil
+	}
+	overrides, _ := ctx.Value(ctxKeyForOverrides{}).(map[string]string)
+	return overrides
+}
+
+// ParseOverrides parses the feature flag overrides from string `s` in format like
+// "newCheckout=true,pageSize=20". The flag without value is treated as "true".
+func ParseOverrides(s string) map[string]string {
+	overrides := make(map[string]string)
+	for _, item := range strings.Split(s, ",") {
+		name, value, found := strings.Cut(item, "=")
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !found {
+			value = "true"
+		}
+		overrides[name] = strings.TrimSpace(value)
+	}
+	return overrides
+}
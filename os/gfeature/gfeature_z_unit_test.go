@@ -0,0 +1,155 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gfeature_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdkTrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/gogf/gf/v2/os/gcfg"
+	"github.com/gogf/gf/v2/os/gfeature"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/gmode"
+)
+
+var ctx = context.TODO()
+
+func Test_Feature_Config(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		adapter, err := gcfg.NewAdapterContent(`
+feature:
+  newCheckout: true
+  pageSize: 20
+  timeout: 3s
+  banner: "summer"
+  beta:
+    develop: true
+    default: false
+  testOnly:
+    testing: true
+`)
+		t.AssertNil(err)
+		feature := gfeature.New(gfeature.NewAdapterConfig(gcfg.NewWithAdapter(adapter)))
+		t.Assert(feature.Enabled(ctx, "newCheckout"), true)
+		t.Assert(feature.Int(ctx, "pageSize"), 20)
+		t.Assert(feature.Float64(ctx, "pageSize"), 20)
+		t.Assert(feature.Duration(ctx, "timeout"), 3*time.Second)
+		t.Assert(feature.String(ctx, "banner"), "summer")
+		t.Assert(feature.Enabled(ctx, "none"), false)
+		t.Assert(feature.Int(ctx, "none", 5), 5)
+		t.Assert(feature.String(ctx, "none", "def"), "def")
+
+		// Mode-aware flags.
+		mode := gmode.Mode()
+		defer gmode.Set(mode)
+		gmode.SetDevelop()
+		t.Assert(feature.Enabled(ctx, "beta"), true)
+		t.Assert(feature.Bool(ctx, "testOnly", true), true)
+		gmode.SetProduct()
+		t.Assert(feature.Enabled(ctx, "beta"), false)
+		t.Assert(feature.Bool(ctx, "testOnly", true), true)
+		gmode.SetTesting()
+		t.Assert(feature.Bool(ctx, "testOnly"), true)
+	})
+}
+
+func Test_Feature_Adapters(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			fetchErr error
+			fetched  = 0
+			remote   = gfeature.NewAdapterRemote(func(ctx context.Context) (map[string]any, error) {
+				fetched++
+				if fetchErr != nil {
+					return nil, fetchErr
+				}
+				return map[string]any{"newCheckout": true}, nil
+			}, 0)
+			memory  = gfeature.NewAdapterMemory(map[string]any{"newCheckout": false, "pageSize": 10})
+			feature = gfeature.New(remote, memory)
+		)
+		defer remote.Close()
+		t.Assert(feature.Enabled(ctx, "newCheckout"), true)
+		t.Assert(feature.Int(ctx, "pageSize"), 10)
+		t.Assert(fetched, 1)
+
+		// It keeps the last flags if refreshing fails.
+		fetchErr = errors.New("unavailable")
+		t.AssertNE(remote.Refresh(ctx), nil)
+		t.Assert(feature.Enabled(ctx, "newCheckout"), true)
+
+		memory.Set("pageSize", 30)
+		t.Assert(feature.Int(ctx, "pageSize"), 30)
+		memory.Remove("pageSize")
+		t.Assert(feature.Int(ctx, "pageSize", 1), 1)
+
+		feature.SetAdapter(memory)
+		t.Assert(feature.Enabled(ctx, "newCheckout"), false)
+		t.Assert(len(feature.GetAdapter()), 1)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		remote := gfeature.NewAdapterRemote(func(ctx context.Context) (map[string]any, error) {
+			return nil, errors.New("unavailable")
+		}, 0)
+		feature := gfeature.New(remote)
+		_, err := feature.Get(ctx, "newCheckout")
+		t.AssertNE(err, nil)
+		t.Assert(feature.Bool(ctx, "newCheckout", true), true)
+	})
+}
+
+func Test_Feature_Overrides(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		feature := gfeature.New(gfeature.NewAdapterMemory(map[string]any{"newCheckout": false}))
+		t.Assert(gfeature.ParseOverrides("a, b=2,,c = x "), map[string]string{
+			"a": "true", "b": "2", "c": "x",
+		})
+
+		mode := gmode.Mode()
+		defer gmode.Set(mode)
+		gmode.SetDevelop()
+		overrideCtx := gfeature.WithOverrides(ctx, map[string]string{"newCheckout": "true"})
+		overrideCtx = gfeature.WithOverrides(overrideCtx, map[string]string{"pageSize": "5"})
+		t.Assert(feature.Enabled(overrideCtx, "newCheckout"), true)
+		t.Assert(feature.Int(overrideCtx, "pageSize"), 5)
+		t.Assert(feature.Enabled(ctx, "newCheckout"), false)
+
+		gmode.SetProduct()
+		t.Assert(feature.Enabled(overrideCtx, "newCheckout"), false)
+	})
+}
+
+func Test_Feature_Tracing(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			recorder = tracetest.NewSpanRecorder()
+			provider = sdkTrace.NewTracerProvider(sdkTrace.WithSpanProcessor(recorder))
+			feature  = gfeature.New(gfeature.NewAdapterMemory(map[string]any{"pageSize": 10}))
+		)
+		spanCtx, span := provider.Tracer("test").Start(ctx, "test")
+		t.Assert(feature.Int(spanCtx, "pageSize"), 10)
+		span.End()
+
+		spans := recorder.Ended()
+		t.Assert(len(spans), 1)
+		t.Assert(len(spans[0].Events()), 1)
+		event := spans[0].Events()[0]
+		t.Assert(event.Name, "feature_flag")
+		attributes := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = attr.Value.Emit()
+		}
+		t.Assert(attributes["feature_flag.key"], "pageSize")
+		t.Assert(attributes["feature_flag.variant"], "10")
+		t.Assert(attributes["feature_flag.source"], "adapter")
+	})
+}
@@ -27,5 +27,9 @@ func Instance(name ...string) *Logger {
 	if len(name) > 0 && name[0] != "" {
 		key = name[0]
 	}
-	return instances.GetOrSetFuncLock(key, New)
+	return instances.GetOrSetFuncLock(key, func() *Logger {
+		logger := New()
+		logger.name = key
+		return logger
+	})
 }
@@ -36,6 +36,7 @@ import (
 type Logger struct {
 	parent *Logger // Parent logger, if it is not empty, it means the logger is used in chaining function.
 	config Config  // Logger configuration.
+	name   string  // Logger name, which is used for module level overrides.
 }
 
 const (
@@ -79,6 +80,7 @@ func (l *Logger) Clone() *Logger {
 	return &Logger{
 		config: l.config,
 		parent: l,
+		name:   l.name,
 	}
 }
 
@@ -191,6 +193,8 @@ func (l *Logger) print(ctx context.Context, level int, stack string, values ...a
 		if traceId := spanCtx.TraceID(); traceId.IsValid() {
 			input.TraceId = traceId.String()
 		}
+		// Request id.
+		input.RequestId = gctx.RequestId(ctx)
 		// Context values.
 		if len(l.config.CtxKeys) > 0 {
 			for _, ctxKey := range l.config.CtxKeys {
@@ -271,6 +271,17 @@ func (l *Logger) SetLevelPrint(enabled bool) {
 	l.config.LevelPrint = enabled
 }
 
+// SetName sets the name of the logger, which is used for module level overrides.
+// The logger created by Instance uses the instance name in default.
+func (l *Logger) SetName(name string) {
+	l.name = name
+}
+
+// GetName returns the name of the logger.
+func (l *Logger) GetName() string {
+	return l.name
+}
+
 // SetPrefix sets prefix string for every logging content.
 // Prefix is part of header, which means if header output is shut, no prefix will be output.
 func (l *Logger) SetPrefix(prefix string) {
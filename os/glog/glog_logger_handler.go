@@ -20,10 +20,10 @@ type Handler func(ctx context.Context, in *HandlerInput)
 // HandlerInput is the input parameter struct for logging Handler.
 //
 // The logging content is consisted in:
-// TimeFormat [LevelFormat] {TraceId} {CtxStr} Prefix CallerFunc CallerPath Content Values Stack
+// TimeFormat [LevelFormat] {TraceId} {RequestId} {CtxStr} Prefix CallerFunc CallerPath Content Values Stack
 //
 // The header in the logging content is:
-// TimeFormat [LevelFormat] {TraceId} {CtxStr} Prefix CallerFunc CallerPath
+// TimeFormat [LevelFormat] {TraceId} {RequestId} {CtxStr} Prefix CallerFunc CallerPath
 type HandlerInput struct {
 	internalHandlerInfo
 
@@ -65,6 +65,10 @@ type HandlerInput struct {
 	// Trace id, only available if OpenTelemetry is enabled, or else it's an empty string.
 	TraceId string
 
+	// Request id, only available if the context carries request id using gctx.WithRequestId,
+	// or else it's an empty string.
+	RequestId string
+
 	// Custom prefix string in logging content header part.
 	// Note that, it takes no effect if HeaderPrint is disabled.
 	Prefix string
@@ -175,6 +179,9 @@ func (in *HandlerInput) getDefaultBuffer(withColor bool) *bytes.Buffer {
 	if in.TraceId != "" {
 		in.addStringToBuffer(buffer, "{"+in.TraceId+"}")
 	}
+	if in.RequestId != "" {
+		in.addStringToBuffer(buffer, "{"+in.RequestId+"}")
+	}
 	if in.CtxStr != "" {
 		in.addStringToBuffer(buffer, "{"+in.CtxStr+"}")
 	}
@@ -16,6 +16,7 @@ import (
 type HandlerOutputJson struct {
 	Time       string `json:""`           // Formatted time string, like "2016-01-09 12:00:00".
 	TraceId    string `json:",omitempty"` // Trace id, only available if tracing is enabled.
+	RequestId  string `json:",omitempty"` // Request id, only available if the context carries request id.
 	CtxStr     string `json:",omitempty"` // The retrieved context value string from context, only available if Config.CtxKeys configured.
 	Level      string `json:""`           // Formatted level string, like "DEBU", "ERRO", etc. Eg: ERRO
 	CallerPath string `json:",omitempty"` // The source file path and its line number that calls logging, only available if F_FILE_SHORT or F_FILE_LONG set.
@@ -30,6 +31,7 @@ func HandlerJson(ctx context.Context, in *HandlerInput) {
 	output := HandlerOutputJson{
 		Time:       in.TimeFormat,
 		TraceId:    in.TraceId,
+		RequestId:  in.RequestId,
 		CtxStr:     in.CtxStr,
 		Level:      in.LevelFormat,
 		CallerFunc: in.CallerFunc,
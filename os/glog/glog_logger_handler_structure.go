@@ -27,6 +27,7 @@ const (
 	structureKeyPrefix     = "Prefix"
 	structureKeyContent    = "Content"
 	structureKeyTraceId    = "TraceId"
+	structureKeyRequestId  = "RequestId"
 	structureKeyCallerFunc = "CallerFunc"
 	structureKeyCallerPath = "CallerPath"
 	structureKeyCtxStr     = "CtxStr"
@@ -160,6 +161,9 @@ func (buf *structuredBuffer) Bytes() []byte {
 	if buf.in.TraceId != "" {
 		buf.addValue(structureKeyTraceId, buf.in.TraceId)
 	}
+	if buf.in.RequestId != "" {
+		buf.addValue(structureKeyRequestId, buf.in.RequestId)
+	}
 	if buf.in.CtxStr != "" {
 		buf.addValue(structureKeyCtxStr, buf.in.CtxStr)
 	}
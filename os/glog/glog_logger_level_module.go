@@ -0,0 +1,154 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package glog
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// ModuleLevel is the runtime level override for loggers of a module.
+type ModuleLevel struct {
+	Module string    `json:"module"` // Logger name, or logger name prefix ending with "*".
+	Level  int       `json:"level"`  // Output level overriding the configured one.
+	Expire time.Time `json:"expire"` // Expiration time, zero means never expires.
+}
+
+var (
+	// moduleLevels stores the module to its level override mapping.
+	moduleLevels = make(map[string]ModuleLevel)
+	// moduleLevelsMu is the lock for moduleLevels.
+	moduleLevelsMu sync.RWMutex
+	// moduleLevelsCount is the count of moduleLevels,
+	// which makes the level checking quick if no override is set.
+	moduleLevelsCount atomic.Int32
+)
+
+// SetModuleLevel overrides the output level of loggers in `module` at runtime,
+// which is automatically reverted after `ttl`. It never expires if `ttl` <= 0.
+//
+// The parameter `module` is the logger name, which is the name of Instance,
+// or a logger name prefix ending with "*", eg: "db*". The "*" matches all loggers.
+// If multiple modules match the logger, the longest one takes effect.
+func SetModuleLevel(module string, level int, ttl time.Duration) {
+	item := ModuleLevel{
+		Module: module,
+		Level:  level | LEVEL_CRIT | LEVEL_PANI | LEVEL_FATA,
+	}
+	if ttl > 0 {
+		item.Expire = time.Now().Add(ttl)
+	}
+	moduleLevelsMu.Lock()
+	defer moduleLevelsMu.Unlock()
+	moduleLevels[module] = item
+	moduleLevelsCount.Store(int32(len(moduleLevels)))
+}
+
+// SetModuleLevelStr overrides the output level of loggers in `module` by level string at runtime.
+// See SetModuleLevel.
+func SetModuleLevelStr(module string, levelStr string, ttl time.Duration) error {
+	level, ok := levelStringMap[strings.ToUpper(levelStr)]
+	if !ok {
+		return gerror.NewCodef(gcode.CodeInvalidParameter, `invalid level string: %s`, levelStr)
+	}
+	SetModuleLevel(module, level, ttl)
+	return nil
+}
+
+// RemoveModuleLevel removes the level override of `module`,
+// which reverts the loggers to their configured level.
+func RemoveModuleLevel(module string) {
+	moduleLevelsMu.Lock()
+	defer moduleLevelsMu.Unlock()
+	delete(moduleLevels, module)
+	moduleLevelsCount.Store(int32(len(moduleLevels)))
+}
+
+// ClearModuleLevels removes all the level overrides.
+func ClearModuleLevels() {
+	moduleLevelsMu.Lock()
+	defer moduleLevelsMu.Unlock()
+	moduleLevels = make(map[string]ModuleLevel)
+	moduleLevelsCount.Store(0)
+}
+
+// GetModuleLevels returns all the unexpired level overrides sorted by module.
+func GetModuleLevels() []ModuleLevel {
+	removeExpiredModuleLevels()
+	moduleLevelsMu.RLock()
+	defer moduleLevelsMu.RUnlock()
+	items := make([]ModuleLevel, 0, len(moduleLevels))
+	for _, item := range moduleLevels {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Module < items[j].Module
+	})
+	return items
+}
+
+// getModuleLevel returns the unexpired level override for logger `name`.
+func getModuleLevel(name string) (level int, ok bool) {
+	var (
+		now     = time.Now()
+		matched = -1
+		expired = false
+	)
+	moduleLevelsMu.RLock()
+	for module, item := range moduleLevels {
+		if !item.Expire.IsZero() && now.After(item.Expire) {
+			expired = true
+			continue
+		}
+		if len(module) > matched && matchModule(module, name) {
+			matched = len(module)
+			level = item.Level
+		}
+	}
+	moduleLevelsMu.RUnlock()
+	if expired {
+		removeExpiredModuleLevels()
+	}
+	return level, matched >= 0
+}
+
+// removeExpiredModuleLevels removes the expired level overrides.
+func removeExpiredModuleLevels() {
+	now := time.Now()
+	moduleLevelsMu.Lock()
+	defer moduleLevelsMu.Unlock()
+	for module, item := range moduleLevels {
+		if !item.Expire.IsZero() && now.After(item.Expire) {
+			delete(moduleLevels, module)
+		}
+	}
+	moduleLevelsCount.Store(int32(len(moduleLevels)))
+}
+
+// matchModule checks whether logger `name` belongs to `module`.
+func matchModule(module, name string) bool {
+	if prefix, ok := strings.CutSuffix(module, "*"); ok {
+		return strings.HasPrefix(name, prefix)
+	}
+	return module == name
+}
+
+// getLevel returns the effective output level, which is the level override of its module if any.
+func (l *Logger) getLevel() int {
+	if moduleLevelsCount.Load() > 0 {
+		if level, ok := getModuleLevel(l.name); ok {
+			return level
+		}
+	}
+	return l.config.Level
+}
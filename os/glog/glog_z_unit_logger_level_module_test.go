@@ -0,0 +1,111 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package glog_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/os/glog"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/text/gstr"
+)
+
+func Test_ModuleLevel(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		defer glog.ClearModuleLevels()
+		var (
+			ctx    = context.TODO()
+			w      = bytes.NewBuffer(nil)
+			logger = glog.Instance("module-level-order")
+		)
+		logger.SetWriter(w)
+		logger.SetStdoutPrint(false)
+		logger.SetLevel(glog.LEVEL_PROD)
+		t.Assert(logger.GetName(), "module-level-order")
+
+		logger.Debug(ctx, "debug1")
+		t.Assert(gstr.Contains(w.String(), "debug1"), false)
+
+		glog.SetModuleLevel("module-level-order", glog.LEVEL_ALL, 0)
+		logger.Debug(ctx, "debug2")
+		t.Assert(gstr.Contains(w.String(), "debug2"), true)
+		// Chaining logger.
+		logger.Line().Debug(ctx, "debug3")
+		t.Assert(gstr.Contains(w.String(), "debug3"), true)
+
+		glog.RemoveModuleLevel("module-level-order")
+		logger.Debug(ctx, "debug4")
+		t.Assert(gstr.Contains(w.String(), "debug4"), false)
+		t.Assert(logger.GetLevel(), glog.LEVEL_PROD|glog.LEVEL_PANI|glog.LEVEL_FATA)
+	})
+}
+
+func Test_ModuleLevel_Prefix(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		defer glog.ClearModuleLevels()
+		var (
+			ctx     = context.TODO()
+			w       = bytes.NewBuffer(nil)
+			logger1 = glog.New()
+			logger2 = glog.New()
+		)
+		logger1.SetName("module-level.db")
+		logger1.SetWriter(w)
+		logger1.SetStdoutPrint(false)
+		logger2.SetName("module-level.http")
+		logger2.SetWriter(w)
+		logger2.SetStdoutPrint(false)
+
+		glog.SetModuleLevel("module-level.*", glog.LEVEL_ERRO, 0)
+		logger1.Info(ctx, "info1")
+		logger2.Info(ctx, "info2")
+		t.Assert(gstr.Contains(w.String(), "info1"), false)
+		t.Assert(gstr.Contains(w.String(), "info2"), false)
+
+		// The longest module takes effect.
+		t.AssertNil(glog.SetModuleLevelStr("module-level.db", "info", 0))
+		logger1.Info(ctx, "info3")
+		logger2.Info(ctx, "info4")
+		t.Assert(gstr.Contains(w.String(), "info3"), true)
+		t.Assert(gstr.Contains(w.String(), "info4"), false)
+
+		t.AssertNE(glog.SetModuleLevelStr("module-level.db", "none-exist", 0), nil)
+
+		levels := glog.GetModuleLevels()
+		t.Assert(len(levels), 2)
+		t.Assert(levels[0].Module, "module-level.*")
+		t.Assert(levels[1].Module, "module-level.db")
+	})
+}
+
+func Test_ModuleLevel_TTL(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		defer glog.ClearModuleLevels()
+		var (
+			ctx    = context.TODO()
+			w      = bytes.NewBuffer(nil)
+			logger = glog.New()
+		)
+		logger.SetName("module-level-ttl")
+		logger.SetWriter(w)
+		logger.SetStdoutPrint(false)
+		logger.SetLevel(glog.LEVEL_PROD)
+
+		glog.SetModuleLevel("module-level-ttl", glog.LEVEL_ALL, 100*time.Millisecond)
+		logger.Debug(ctx, "debug1")
+		t.Assert(gstr.Contains(w.String(), "debug1"), true)
+		t.Assert(len(glog.GetModuleLevels()), 1)
+
+		time.Sleep(200 * time.Millisecond)
+		logger.Debug(ctx, "debug2")
+		t.Assert(gstr.Contains(w.String(), "debug2"), false)
+		t.Assert(len(glog.GetModuleLevels()), 0)
+	})
+}
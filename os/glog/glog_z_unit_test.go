@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gctx"
 	"github.com/gogf/gf/v2/os/gfile"
 	"github.com/gogf/gf/v2/os/glog"
 	"github.com/gogf/gf/v2/os/gtime"
@@ -487,3 +488,24 @@ func Test_Concurrent(t *testing.T) {
 		t.Assert(gstr.Count(content, s), c)
 	})
 }
+
+func Test_Ctx_RequestId(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		w := bytes.NewBuffer(nil)
+		l := glog.NewWithWriter(w)
+		ctx := gctx.WithRequestId(context.Background(), "request-1234567890")
+
+		l.Print(ctx, 1, 2, 3)
+		t.Assert(gstr.Count(w.String(), "{request-1234567890}"), 1)
+		t.Assert(gstr.Count(w.String(), "1 2 3"), 1)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		w := bytes.NewBuffer(nil)
+		l := glog.NewWithWriter(w)
+		l.SetHandlers(glog.HandlerJson)
+		ctx := gctx.WithRequestId(context.Background(), "request-1234567890")
+
+		l.Print(ctx, 1, 2, 3)
+		t.Assert(gstr.Count(w.String(), `"RequestId":"request-1234567890"`), 1)
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gsession
+
+import (
+	"context"
+	"time"
+)
+
+// Event is the type of session lifecycle events.
+type Event string
+
+const (
+	// EventCreated is fired when a new session id is created.
+	EventCreated Event = "created"
+
+	// EventDestroyed is fired when a session is destroyed by Session.Destroy.
+	EventDestroyed Event = "destroyed"
+
+	// EventExpired is fired when a session is requested with an id whose session data is expired
+	// in storage. Note that it is detected lazily when the session is accessed, and it is fired only
+	// for storages implementing ExpirationStorage, which can tell expired sessions from unknown ones.
+	EventExpired Event = "expired"
+)
+
+// ExpirationStorage is the optional interface for Storage, which reports whether a session is expired.
+type ExpirationStorage interface {
+	// IsSessionExpired checks whether the session of `sessionId` existed but is expired for `ttl`.
+	// It returns false if the session does not exist or is still alive.
+	IsSessionExpired(ctx context.Context, sessionId string, ttl time.Duration) (bool, error)
+}
+
+// EventHandler is the handler function for session events, which is commonly used for audit logging.
+// It is called synchronously, so it should not block for long.
+type EventHandler func(ctx context.Context, event Event, sessionId string)
+
+// AddEventHandler adds handler for session events of the manager.
+func (m *Manager) AddEventHandler(handler EventHandler) {
+	if handler == nil {
+		return
+	}
+	m.eventMu.Lock()
+	defer m.eventMu.Unlock()
+	m.eventHandlers = append(m.eventHandlers, handler)
+}
+
+// fireEvent calls all the event handlers with given event.
+func (m *Manager) fireEvent(ctx context.Context, event Event, sessionId string) {
+	m.eventMu.RLock()
+	handlers := m.eventHandlers
+	m.eventMu.RUnlock()
+	for _, handler := range handlers {
+		handler(ctx, event, sessionId)
+	}
+}
@@ -8,15 +8,26 @@ package gsession
 
 import (
 	"context"
+	"sync"
 	"time"
+
+	"github.com/gogf/gf/v2/encoding/ghash"
 )
 
 // Manager for sessions.
 type Manager struct {
-	ttl     time.Duration // TTL for sessions.
-	storage Storage       // Storage interface for session storage.
+	ttl           time.Duration                // TTL for sessions.
+	storage       Storage                      // Storage interface for session storage.
+	locks         [sessionLockCount]sync.Mutex // Striped locks for serializing updates of the same session.
+	eventMu       sync.RWMutex                 // Mutex for event handlers.
+	eventHandlers []EventHandler               // Event handlers for session events.
 }
 
+const (
+	// sessionLockCount is the count of the striped locks, which is power of 2.
+	sessionLockCount = 256
+)
+
 // New creates and returns a new session manager.
 func New(ttl time.Duration, storage ...Storage) *Manager {
 	m := &Manager{
@@ -46,6 +57,12 @@ func (m *Manager) New(ctx context.Context, sessionId ...string) *Session {
 	}
 }
 
+// lockOf returns the striped lock of given session id, which is shared by sessions of the same id
+// in current process.
+func (m *Manager) lockOf(sessionId string) *sync.Mutex {
+	return &m.locks[ghash.BKDR([]byte(sessionId))&(sessionLockCount-1)]
+}
+
 // SetStorage sets the session storage for manager.
 func (m *Manager) SetStorage(storage Storage) {
 	m.storage = storage
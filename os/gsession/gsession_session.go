@@ -28,6 +28,12 @@ type Session struct {
 	start   bool            // Used to mark session is started.
 	manager *Manager        // Parent session Manager.
 
+	// updatedKeys and removedKeys are the keys changed in memory data of current session,
+	// which are merged to the latest session data in storage when the session is written to storage.
+	updatedKeys map[string]struct{}
+	removedKeys map[string]struct{}
+	cleared     bool // Used to mark all the session data is removed.
+
 	// idFunc is a callback function used for creating custom session id.
 	// This is called if session id is empty ever when session starts.
 	idFunc func(ttl time.Duration) (id string)
@@ -49,6 +55,9 @@ func (s *Session) init() error {
 				intlog.Errorf(s.ctx, `session restoring failed for id "%s": %+v`, s.id, err)
 				return err
 			}
+			if err == nil && (s.data == nil || s.data.IsEmpty()) && s.isExpired() {
+				s.manager.fireEvent(s.ctx, EventExpired, s.id)
+			}
 		}
 	}
 	// Session id creation.
@@ -69,6 +78,7 @@ func (s *Session) init() error {
 				s.id = NewSessionId()
 			}
 		}
+		s.manager.fireEvent(s.ctx, EventCreated, s.id)
 	}
 	if s.data == nil {
 		s.data = gmap.NewStrAnyMap(true)
@@ -88,10 +98,10 @@ func (s *Session) Close() error {
 	if s.start && s.id != "" {
 		size := s.data.Size()
 		if s.dirty {
-			err := s.manager.storage.SetSession(s.ctx, s.id, s.data, s.manager.ttl)
-			if err != nil && !gerror.Is(err, ErrorDisabled) {
-				return err
-			}
+			lock := s.manager.lockOf(s.id)
+			lock.Lock()
+			defer lock.Unlock()
+			return s.flush()
 		} else if size > 0 {
 			err := s.manager.storage.UpdateTTL(s.ctx, s.id, s.manager.ttl)
 			if err != nil && !gerror.Is(err, ErrorDisabled) {
@@ -112,6 +122,7 @@ func (s *Session) Set(key string, value any) (err error) {
 			return err
 		}
 		s.data.Set(key, value)
+		s.markUpdated(key)
 	}
 	s.dirty = true
 	return nil
@@ -127,6 +138,9 @@ func (s *Session) SetMap(data map[string]any) (err error) {
 			return err
 		}
 		s.data.Sets(data)
+		for key := range data {
+			s.markUpdated(key)
+		}
 	}
 	s.dirty = true
 	return nil
@@ -146,6 +160,7 @@ func (s *Session) Remove(keys ...string) (err error) {
 				return err
 			}
 			s.data.Remove(key)
+			s.markRemoved(key)
 		}
 	}
 	s.dirty = true
@@ -169,6 +184,8 @@ func (s *Session) RemoveAll() (err error) {
 	if s.data != nil {
 		s.data.Clear()
 	}
+	s.resetChanges()
+	s.cleared = true
 	s.dirty = true
 	return nil
 }
@@ -397,6 +414,11 @@ func (s *Session) RegenerateId(deleteOld bool) (newId string, err error) {
 		}
 	}
 
+	if deleteOld {
+		s.manager.fireEvent(s.ctx, EventDestroyed, s.id)
+	}
+	s.manager.fireEvent(s.ctx, EventCreated, newId)
+
 	// Update session id
 	s.id = newId
 	s.dirty = true
@@ -411,3 +433,133 @@ func (s *Session) MustRegenerateId(deleteOld bool) string {
 	}
 	return newId
 }
+
+// Update atomically updates the value of `key` with the returned value of function `f`, which is given
+// the latest value of the key in storage. It prevents lost updates when the same session is modified
+// by parallel requests, like parallel AJAX calls updating a counter or a cart in session.
+//
+// The updates of the same session are serialized in current process, and the session data is written
+// to storage immediately after `f` returns. The value is not updated if `f` returns error.
+func (s *Session) Update(key string, f func(value *gvar.Var) (any, error)) (err error) {
+	if err = s.init(); err != nil {
+		return err
+	}
+	lock := s.manager.lockOf(s.id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	s.data = s.mergeLatestData()
+	value, err := s.Get(key)
+	if err != nil {
+		return err
+	}
+	newValue, err := f(value)
+	if err != nil {
+		return err
+	}
+	if err = s.Set(key, newValue); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+// Destroy removes all data of current session from storage, and resets the session.
+// A new session id is created if the session is used again after destroyed.
+func (s *Session) Destroy() (err error) {
+	if s.id == "" {
+		return nil
+	}
+	if err = s.init(); err != nil {
+		return err
+	}
+	if err = s.manager.storage.RemoveAll(s.ctx, s.id); err != nil && !gerror.Is(err, ErrorDisabled) {
+		return err
+	}
+	s.manager.fireEvent(s.ctx, EventDestroyed, s.id)
+	s.id = ""
+	s.data = nil
+	s.start = false
+	s.dirty = false
+	s.resetChanges()
+	return nil
+}
+
+// flush writes the session data merged with the latest data in storage to storage.
+// Note that the caller should hold the lock of the session.
+func (s *Session) flush() error {
+	data := s.mergeLatestData()
+	err := s.manager.storage.SetSession(s.ctx, s.id, data, s.manager.ttl)
+	if err != nil && !gerror.Is(err, ErrorDisabled) {
+		return err
+	}
+	s.data = data
+	s.dirty = false
+	s.resetChanges()
+	return nil
+}
+
+// mergeLatestData retrieves the latest session data from storage, which might be changed by other
+// requests of the same session, and applies the changes of current session to it.
+// It returns the memory data of current session if the latest data is not available.
+func (s *Session) mergeLatestData() *gmap.StrAnyMap {
+	if s.cleared {
+		return s.data
+	}
+	latest, err := s.manager.storage.GetSession(s.ctx, s.id, s.manager.ttl)
+	if err != nil {
+		if !gerror.Is(err, ErrorDisabled) {
+			intlog.Errorf(s.ctx, `%+v`, err)
+		}
+		return s.data
+	}
+	if latest == nil || latest == s.data {
+		return s.data
+	}
+	for key := range s.updatedKeys {
+		latest.Set(key, s.data.Get(key))
+	}
+	for key := range s.removedKeys {
+		latest.Remove(key)
+	}
+	return latest
+}
+
+// isExpired checks whether the session of current id is expired in storage.
+// It returns false if the storage cannot tell expired sessions from unknown ones.
+func (s *Session) isExpired() bool {
+	storage, ok := s.manager.storage.(ExpirationStorage)
+	if !ok {
+		return false
+	}
+	expired, err := storage.IsSessionExpired(s.ctx, s.id, s.manager.GetTTL())
+	if err != nil {
+		intlog.Errorf(s.ctx, `%+v`, err)
+		return false
+	}
+	return expired
+}
+
+// markUpdated marks the key is updated in memory data of current session.
+func (s *Session) markUpdated(key string) {
+	if s.updatedKeys == nil {
+		s.updatedKeys = make(map[string]struct{})
+	}
+	s.updatedKeys[key] = struct{}{}
+	delete(s.removedKeys, key)
+}
+
+// markRemoved marks the key is removed from memory data of current session.
+func (s *Session) markRemoved(key string) {
+	if s.removedKeys == nil {
+		s.removedKeys = make(map[string]struct{})
+	}
+	s.removedKeys[key] = struct{}{}
+	delete(s.updatedKeys, key)
+}
+
+// resetChanges resets the changes marks of current session.
+func (s *Session) resetChanges() {
+	s.updatedKeys = nil
+	s.removedKeys = nil
+	s.cleared = false
+}
@@ -169,6 +169,16 @@ func (s *StorageFile) GetSession(ctx context.Context, sessionId string, ttl time
 	return nil, nil
 }
 
+// IsSessionExpired checks whether the session file of `sessionId` exists but is expired for `ttl`.
+func (s *StorageFile) IsSessionExpired(ctx context.Context, sessionId string, ttl time.Duration) (bool, error) {
+	content := gfile.GetBytesByTwoOffsetsByPath(s.sessionFilePath(sessionId), 0, 8)
+	if len(content) < 8 {
+		return false, nil
+	}
+	timestampMilli := gbinary.DecodeToInt64(content)
+	return timestampMilli+ttl.Nanoseconds()/1e6 < gtime.TimestampMilli(), nil
+}
+
 // SetSession updates the data map for specified session id.
 // This function is called ever after session, which is changed dirty, is closed.
 // This copy all session data map from memory to storage.
@@ -0,0 +1,65 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gsession
+
+import (
+	"github.com/gogf/gf/v2/container/gvar"
+	"github.com/gogf/gf/v2/util/gconv"
+)
+
+// Get retrieves the value of `key` from session `s` and converts it to type T.
+// It returns `def` if the key does not exist in the session if `def` is given,
+// or else it returns the zero value of T.
+//
+// Example:
+//
+//	userId, err := gsession.Get[int64](r.Session, "userId")
+//	user, err := gsession.Get[*User](r.Session, "user")
+func Get[T any](s *Session, key string, def ...T) (value T, err error) {
+	v, err := s.Get(key)
+	if err != nil {
+		return value, err
+	}
+	if v == nil || v.IsNil() {
+		if len(def) > 0 {
+			return def[0], nil
+		}
+		return value, nil
+	}
+	if typed, ok := v.Val().(T); ok {
+		return typed, nil
+	}
+	err = gconv.Scan(v.Val(), &value)
+	return value, err
+}
+
+// Set sets the typed value of `key` to session `s`.
+func Set[T any](s *Session, key string, value T) error {
+	return s.Set(key, value)
+}
+
+// Update atomically updates the value of `key` of session `s` with the returned value of function `f`,
+// which is given the latest typed value of the key in storage. See Session.Update.
+//
+// Example:
+//
+//	err := gsession.Update(r.Session, "cartCount", func(count int) (int, error) {
+//	    return count + 1, nil
+//	})
+func Update[T any](s *Session, key string, f func(value T) (T, error)) error {
+	return s.Update(key, func(v *gvar.Var) (any, error) {
+		var value T
+		if v != nil && !v.IsNil() {
+			if typed, ok := v.Val().(T); ok {
+				value = typed
+			} else if err := gconv.Scan(v.Val(), &value); err != nil {
+				return nil, err
+			}
+		}
+		return f(value)
+	})
+}
@@ -0,0 +1,175 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gsession_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/os/gsession"
+	"github.com/gogf/gf/v2/test/gtest"
+	"github.com/gogf/gf/v2/util/guid"
+)
+
+func Test_Session_Typed(t *testing.T) {
+	type User struct {
+		Id   int
+		Name string
+	}
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			ctx     = context.TODO()
+			manager = gsession.New(time.Hour, gsession.NewStorageMemory())
+			s       = manager.New(ctx)
+		)
+		t.AssertNil(gsession.Set(s, "count", 10))
+		t.AssertNil(gsession.Set(s, "user", g.Map{"id": 1, "name": "john"}))
+
+		count, err := gsession.Get[int64](s, "count")
+		t.AssertNil(err)
+		t.Assert(count, 10)
+
+		user, err := gsession.Get[*User](s, "user")
+		t.AssertNil(err)
+		t.Assert(user, &User{Id: 1, Name: "john"})
+
+		name, err := gsession.Get[string](s, "none", "default")
+		t.AssertNil(err)
+		t.Assert(name, "default")
+
+		t.AssertNil(gsession.Update(s, "count", func(count int) (int, error) {
+			return count + 1, nil
+		}))
+		count, err = gsession.Get[int64](s, "count")
+		t.AssertNil(err)
+		t.Assert(count, 11)
+	})
+}
+
+func Test_Session_Update_Parallel(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			ctx  = context.TODO()
+			path = gfile.Temp(guid.S())
+			wg   sync.WaitGroup
+		)
+		t.AssertNil(gfile.Mkdir(path))
+		defer gfile.Remove(path)
+		var (
+			manager = gsession.New(time.Hour, gsession.NewStorageFile(path, time.Hour))
+			s       = manager.New(ctx)
+		)
+		t.AssertNil(s.Set("name", "john"))
+		t.AssertNil(s.Close())
+		sessionId := s.MustId()
+
+		// Parallel requests of the same session.
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				session := manager.New(ctx, sessionId)
+				_ = gsession.Update(session, "count", func(count int) (int, error) {
+					return count + 1, nil
+				})
+				_ = session.Close()
+			}()
+		}
+		// Parallel requests setting different keys of the same session.
+		for _, key := range []string{"k1", "k2", "k3"} {
+			wg.Add(1)
+			go func(key string) {
+				defer wg.Done()
+				session := manager.New(ctx, sessionId)
+				_ = session.Set(key, key)
+				_ = session.Close()
+			}(key)
+		}
+		wg.Wait()
+
+		session := manager.New(ctx, sessionId)
+		t.Assert(session.MustGet("count"), 10)
+		t.Assert(session.MustGet("name"), "john")
+		t.Assert(session.MustGet("k1"), "k1")
+		t.Assert(session.MustGet("k2"), "k2")
+		t.Assert(session.MustGet("k3"), "k3")
+	})
+}
+
+func Test_Session_Events(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			ctx     = context.TODO()
+			events  = make([]gsession.Event, 0)
+			ids     = make([]string, 0)
+			manager = gsession.New(time.Hour, gsession.NewStorageMemory())
+		)
+		manager.AddEventHandler(func(ctx context.Context, event gsession.Event, sessionId string) {
+			events = append(events, event)
+			ids = append(ids, sessionId)
+		})
+		s := manager.New(ctx)
+		t.AssertNil(s.Set("k", "v"))
+		t.AssertNil(s.Close())
+		sessionId := s.MustId()
+		t.Assert(events, []gsession.Event{gsession.EventCreated})
+		t.Assert(ids, []string{sessionId})
+
+		s = manager.New(ctx, sessionId)
+		t.Assert(s.MustGet("k"), "v")
+		t.AssertNil(s.Destroy())
+		t.Assert(events, []gsession.Event{gsession.EventCreated, gsession.EventDestroyed})
+		t.Assert(ids[1], sessionId)
+		t.AssertNE(s.MustId(), sessionId)
+		t.Assert(s.MustGet("k"), nil)
+		t.Assert(events[2], gsession.EventCreated)
+
+		// The destroyed session is not expired.
+		s = manager.New(ctx, sessionId)
+		t.Assert(s.MustGet("k"), nil)
+		t.Assert(s.MustData(), g.Map{})
+		t.Assert(len(events), 3)
+	})
+}
+
+func Test_Session_Events_Expired(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			ctx    = context.TODO()
+			path   = gfile.Temp(guid.S())
+			events = make([]gsession.Event, 0)
+			ids    = make([]string, 0)
+		)
+		t.AssertNil(gfile.Mkdir(path))
+		defer gfile.Remove(path)
+		manager := gsession.New(time.Second, gsession.NewStorageFile(path, time.Second))
+		manager.AddEventHandler(func(ctx context.Context, event gsession.Event, sessionId string) {
+			events = append(events, event)
+			ids = append(ids, sessionId)
+		})
+		// Unknown session id.
+		s := manager.New(ctx, "unknown")
+		t.Assert(s.MustGet("k"), nil)
+		t.Assert(len(events), 0)
+
+		s = manager.New(ctx)
+		t.AssertNil(s.Set("k", "v"))
+		t.AssertNil(s.Close())
+		sessionId := s.MustId()
+		t.Assert(events, []gsession.Event{gsession.EventCreated})
+
+		time.Sleep(1500 * time.Millisecond)
+		s = manager.New(ctx, sessionId)
+		t.Assert(s.MustGet("k"), nil)
+		t.Assert(events, []gsession.Event{gsession.EventCreated, gsession.EventExpired})
+		t.Assert(ids[1], sessionId)
+	})
+}
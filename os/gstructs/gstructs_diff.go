@@ -0,0 +1,170 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gstructs
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// DiffField is the changed field between two structs.
+type DiffField struct {
+	Path     string // Field path joined with ".", eg: "address.city".
+	OldValue any    // Field value of the old struct.
+	NewValue any    // Field value of the new struct.
+}
+
+// Diff compares struct `a` and `b` of the same type, and returns their changed fields
+// in field declaration order.
+//
+// The nested struct fields are compared recursively, and the fields of embedded structs
+// are compared as fields of the outer struct. Other fields like slices and maps are compared
+// as a whole.
+//
+// The parameter `tag` specifies the tag name for the field path, eg: "json",
+// it uses the tag value before the first comma as the path name if the tag exists,
+// or else the field name. The field with tag value "-" is ignored.
+// It uses the field name as the path name if `tag` is empty.
+func Diff(a, b any, tag string) ([]DiffField, error) {
+	var (
+		aValue = reflect.ValueOf(a)
+		bValue = reflect.ValueOf(b)
+	)
+	for aValue.Kind() == reflect.Pointer && !aValue.IsNil() {
+		aValue = aValue.Elem()
+	}
+	for bValue.Kind() == reflect.Pointer && !bValue.IsNil() {
+		bValue = bValue.Elem()
+	}
+	if aValue.Kind() != reflect.Struct || bValue.Kind() != reflect.Struct {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`invalid parameters for Diff, both should be type of struct/*struct, but given "%T" and "%T"`,
+			a, b,
+		)
+	}
+	if aValue.Type() != bValue.Type() {
+		return nil, gerror.NewCodef(
+			gcode.CodeInvalidParameter,
+			`invalid parameters for Diff, both should be the same type, but given "%T" and "%T"`,
+			a, b,
+		)
+	}
+	d := &differ{
+		tag:     tag,
+		visited: make(map[[2]uintptr]struct{}),
+	}
+	if a, b := reflect.ValueOf(a), reflect.ValueOf(b); a.Kind() == reflect.Pointer {
+		d.visited[[2]uintptr{a.Pointer(), b.Pointer()}] = struct{}{}
+	}
+	d.diffStruct(aValue, bValue, "")
+	return d.fields, nil
+}
+
+// differ compares the struct fields.
+type differ struct {
+	tag     string                  // Tag name for the field path.
+	fields  []DiffField             // Changed fields.
+	visited map[[2]uintptr]struct{} // Compared struct pointer pairs, which avoids circular references.
+}
+
+// diffStruct compares the fields of struct `a` and `b`.
+func (d *differ) diffStruct(a, b reflect.Value, prefix string) {
+	structType := a.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+		var (
+			aField = a.Field(i)
+			bField = b.Field(i)
+		)
+		if structField.Anonymous && aField.Kind() == reflect.Struct {
+			d.diffStruct(aField, bField, prefix)
+			continue
+		}
+		name := structField.Name
+		if d.tag != "" {
+			if tagValue, ok := structField.Tag.Lookup(d.tag); ok {
+				tagValue = strings.Split(tagValue, ",")[0]
+				if tagValue == "-" {
+					continue
+				}
+				if tagValue != "" {
+					name = tagValue
+				}
+			}
+		}
+		d.diffValue(aField, bField, prefix+name)
+	}
+}
+
+// diffValue compares value `a` and `b` of path `path`.
+func (d *differ) diffValue(a, b reflect.Value, path string) {
+	// Compare the nested struct recursively, the struct pointers are dereferenced if both are not nil.
+	var (
+		aElem = a
+		bElem = b
+	)
+	if aElem.Kind() == reflect.Pointer && !aElem.IsNil() && !bElem.IsNil() {
+		aElem = aElem.Elem()
+		bElem = bElem.Elem()
+	}
+	if aElem.Kind() == reflect.Struct && !isDiffLeafType(aElem.Type()) {
+		if a.Kind() == reflect.Pointer {
+			key := [2]uintptr{a.Pointer(), b.Pointer()}
+			if _, ok := d.visited[key]; ok {
+				return
+			}
+			d.visited[key] = struct{}{}
+		}
+		d.diffStruct(aElem, bElem, path+".")
+		return
+	}
+	if isEqualValue(a, b) {
+		return
+	}
+	d.fields = append(d.fields, DiffField{
+		Path:     path,
+		OldValue: a.Interface(),
+		NewValue: b.Interface(),
+	})
+}
+
+// isDiffLeafType checks whether the struct type is compared as a whole.
+func isDiffLeafType(t reflect.Type) bool {
+	if t == reflect.TypeOf(time.Time{}) {
+		return true
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() {
+			return false
+		}
+	}
+	return true
+}
+
+// isEqualValue checks whether `a` and `b` are equal,
+// it uses the Equal method of the value if any, like time.Time.
+func isEqualValue(a, b reflect.Value) bool {
+	if a.Kind() == reflect.Pointer && (a.IsNil() || b.IsNil()) {
+		return a.IsNil() && b.IsNil()
+	}
+	if method := a.MethodByName("Equal"); method.IsValid() {
+		methodType := method.Type()
+		if methodType.NumIn() == 1 && methodType.NumOut() == 1 &&
+			methodType.In(0) == b.Type() && methodType.Out(0).Kind() == reflect.Bool {
+			return method.Call([]reflect.Value{b})[0].Bool()
+		}
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
@@ -8,6 +8,7 @@ package gstructs_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/gogf/gf/v2/frame/g"
 	"github.com/gogf/gf/v2/os/gstructs"
@@ -575,3 +576,79 @@ func Test_Fields_TagPriorityName(t *testing.T) {
 		t.Assert(fields[1].TagPriorityName(), "age_json")
 	})
 }
+
+func Test_Diff(t *testing.T) {
+	type Address struct {
+		City   string `json:"city"`
+		Street string `json:"street"`
+	}
+	type Base struct {
+		Id int `json:"id"`
+	}
+	type User struct {
+		Base
+		Name     string    `json:"name,omitempty"`
+		Password string    `json:"-"`
+		Tags     []string  `json:"tags"`
+		Address  Address   `json:"address"`
+		Company  *Address  `json:"company"`
+		Parent   *User     `json:"parent"`
+		Birthday time.Time `json:"birthday"`
+	}
+	gtest.C(t, func(t *gtest.T) {
+		now := time.Now()
+		a := &User{
+			Base:     Base{Id: 1},
+			Name:     "john",
+			Password: "123",
+			Tags:     []string{"a"},
+			Address:  Address{City: "Shanghai", Street: "A"},
+			Company:  &Address{City: "Beijing"},
+			Birthday: now,
+		}
+		b := &User{
+			Base:     Base{Id: 2},
+			Name:     "john",
+			Password: "456",
+			Tags:     []string{"a", "b"},
+			Address:  Address{City: "Shenzhen", Street: "A"},
+			Company:  &Address{City: "Beijing"},
+			Birthday: now.UTC(),
+		}
+		a.Parent, b.Parent = a, b
+
+		fields, err := gstructs.Diff(a, b, "json")
+		t.AssertNil(err)
+		t.Assert(len(fields), 3)
+		t.Assert(fields[0].Path, "id")
+		t.Assert(fields[0].OldValue, 1)
+		t.Assert(fields[0].NewValue, 2)
+		t.Assert(fields[1].Path, "tags")
+		t.Assert(fields[1].NewValue, []string{"a", "b"})
+		t.Assert(fields[2].Path, "address.city")
+		t.Assert(fields[2].OldValue, "Shanghai")
+		t.Assert(fields[2].NewValue, "Shenzhen")
+
+		// The parent pointers are compared once as they are not the compared ones.
+		fields, err = gstructs.Diff(*a, *b, "")
+		t.AssertNil(err)
+		t.Assert(len(fields), 8)
+		t.Assert(fields[1].Path, "Password")
+		t.Assert(fields[3].Path, "Address.City")
+		t.Assert(fields[4].Path, "Parent.Id")
+		t.Assert(fields[7].Path, "Parent.Address.City")
+
+		b.Company = nil
+		fields, err = gstructs.Diff(a, b, "json")
+		t.AssertNil(err)
+		t.Assert(len(fields), 4)
+		t.Assert(fields[3].Path, "company")
+		t.Assert(fields[3].NewValue, nil)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		_, err := gstructs.Diff(1, 2, "")
+		t.AssertNE(err, nil)
+		_, err = gstructs.Diff(User{}, Address{}, "")
+		t.AssertNE(err, nil)
+	})
+}
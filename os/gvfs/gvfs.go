@@ -0,0 +1,101 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+// Package gvfs provides virtual filesystem abstraction, which unifies the file operations on local
+// disk, resource manager and object storages like S3 and Aliyun OSS.
+//
+// The file names of VFS are slash-separated paths relative to the root of the filesystem,
+// like "images/logo.png", the leading slash is ignored.
+//
+// Note that gfile and gres are not built on VFS, they are used through the Local and Resource
+// implementations, so that the code using VFS can switch between them and the object storages.
+package gvfs
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// VFS is the interface for virtual filesystem.
+//
+// It implements fs.StatFS for reading, so that it can be used with the standard library directly,
+// like http.FS and fs.ReadFile.
+type VFS interface {
+	// Open opens the file of `name` for reading.
+	Open(name string) (fs.File, error)
+
+	// Stat returns the file information of `name`.
+	Stat(name string) (fs.FileInfo, error)
+
+	// Put creates or overwrites the file of `name` with the content read from `reader`.
+	Put(ctx context.Context, name string, reader io.Reader) error
+
+	// Remove deletes the file of `name`. It does not return error if the file does not exist.
+	Remove(ctx context.Context, name string) error
+}
+
+// CtxVFS is the VFS reading with context, like the object storages reading over network.
+type CtxVFS interface {
+	VFS
+
+	// OpenCtx opens the file of `name` for reading with context `ctx`.
+	OpenCtx(ctx context.Context, name string) (fs.File, error)
+
+	// StatCtx returns the file information of `name` with context `ctx`.
+	StatCtx(ctx context.Context, name string) (fs.FileInfo, error)
+}
+
+// OpenCtx opens the file of `name` of `vfs` for reading with context `ctx`,
+// the context is ignored if `vfs` is not CtxVFS.
+func OpenCtx(ctx context.Context, vfs VFS, name string) (fs.File, error) {
+	if v, ok := vfs.(CtxVFS); ok {
+		return v.OpenCtx(ctx, name)
+	}
+	return vfs.Open(name)
+}
+
+// StatCtx returns the file information of `name` of `vfs` with context `ctx`,
+// the context is ignored if `vfs` is not CtxVFS.
+func StatCtx(ctx context.Context, vfs VFS, name string) (fs.FileInfo, error) {
+	if v, ok := vfs.(CtxVFS); ok {
+		return v.StatCtx(ctx, name)
+	}
+	return vfs.Stat(name)
+}
+
+// GetContents returns the content of file `name` from `vfs`.
+func GetContents(vfs VFS, name string) ([]byte, error) {
+	return fs.ReadFile(vfs, name)
+}
+
+// PutContents creates or overwrites the file `name` of `vfs` with `content`.
+func PutContents(ctx context.Context, vfs VFS, name string, content []byte) error {
+	return vfs.Put(ctx, name, bytes.NewReader(content))
+}
+
+// Exists checks whether the file `name` exists in `vfs`.
+func Exists(vfs VFS, name string) bool {
+	_, err := vfs.Stat(name)
+	return err == nil
+}
+
+// cleanName cleans and validates the file name, which returns the slash-separated path
+// without leading slash, or "." for the root.
+func cleanName(op, name string) (string, error) {
+	name = path.Clean("/" + strings.ReplaceAll(name, `\`, "/"))
+	name = strings.TrimPrefix(name, "/")
+	if name == "" {
+		name = "."
+	}
+	if !fs.ValidPath(name) {
+		return "", &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	return name, nil
+}
@@ -0,0 +1,84 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gvfs
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gfile"
+)
+
+// Local is the VFS implementation using local disk directory.
+type Local struct {
+	root string // Root directory path.
+}
+
+// NewLocal creates and returns a VFS using local directory `root`.
+func NewLocal(root string) *Local {
+	return &Local{
+		root: root,
+	}
+}
+
+// Open opens the file of `name` for reading.
+func (l *Local) Open(name string) (fs.File, error) {
+	filePath, err := l.getPath("open", name)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(filePath)
+}
+
+// Stat returns the file information of `name`.
+func (l *Local) Stat(name string) (fs.FileInfo, error) {
+	filePath, err := l.getPath("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	return os.Stat(filePath)
+}
+
+// Put creates or overwrites the file of `name` with the content read from `reader`,
+// the parent directories are created automatically.
+func (l *Local) Put(ctx context.Context, name string, reader io.Reader) error {
+	filePath, err := l.getPath("put", name)
+	if err != nil {
+		return err
+	}
+	file, err := gfile.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err = io.Copy(file, reader); err != nil {
+		return gerror.Wrapf(err, `io.Copy failed to "%s"`, filePath)
+	}
+	return nil
+}
+
+// Remove deletes the file of `name`.
+func (l *Local) Remove(ctx context.Context, name string) error {
+	filePath, err := l.getPath("remove", name)
+	if err != nil {
+		return err
+	}
+	return gfile.Remove(filePath)
+}
+
+// getPath returns the local file path of `name`.
+func (l *Local) getPath(op, name string) (string, error) {
+	name, err := cleanName(op, name)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(l.root, filepath.FromSlash(name)), nil
+}
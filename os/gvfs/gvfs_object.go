@@ -0,0 +1,412 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gvfs
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+const (
+	// unsignedPayload is the payload hash for the streaming payload which is not signed.
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	// emptyPayloadHash is the hex encoded SHA256 hash of empty payload.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// defaultClient is the HTTP client for the object storages if no client is configured, which limits
+// the time of connecting and waiting for the response header, but not the time of transferring the
+// object body, which is limited by the context of the request.
+var defaultClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   10 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConnsPerHost:   16,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 30 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	},
+}
+
+// objectStore implements the common operations of object storages using their HTTP API,
+// the object storages differ only in the object URL and request signing.
+type objectStore struct {
+	client *http.Client
+	// url returns the URL of object `key`.
+	url func(key string) *url.URL
+	// sign signs the request with the hex encoded SHA256 hash of its payload, which is
+	// unsignedPayload for the streaming payload.
+	sign func(req *http.Request, payloadHash string)
+}
+
+// objectRequest is the input for objectStore.do.
+type objectRequest struct {
+	Method      string
+	Key         string
+	Header      http.Header
+	Body        io.Reader // Body of the request, which is nil for the requests without payload.
+	Size        int64     // Size of Body.
+	PayloadHash string    // Hash of Body, it uses the hash of empty payload if it is empty.
+}
+
+// objectInfo implements fs.FileInfo for the object.
+type objectInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+// objectFile implements fs.File and io.Seeker for the object, which streams the object content
+// from the response body, and requests the content from the new offset with the Range header
+// if it is read after seeking.
+type objectFile struct {
+	store      *objectStore
+	ctx        context.Context
+	key        string
+	info       *objectInfo
+	body       io.ReadCloser // Body of current response, nil if it is not requested.
+	bodyOffset int64         // Offset of the object that body is read to.
+	offset     int64         // Offset of the object for next reading.
+}
+
+// Open opens the object of `name` for reading.
+func (s *objectStore) Open(name string) (fs.File, error) {
+	return s.OpenCtx(context.Background(), name)
+}
+
+// OpenCtx opens the object of `name` for reading with context `ctx`, which is used for the
+// following reading of the file.
+func (s *objectStore) OpenCtx(ctx context.Context, name string) (fs.File, error) {
+	key, err := cleanName("open", name)
+	if err != nil {
+		return nil, err
+	}
+	response, err := s.do(ctx, objectRequest{Method: http.MethodGet, Key: key})
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkResponse("open", key, response); err != nil {
+		_ = response.Body.Close()
+		return nil, err
+	}
+	info := newObjectInfo(key, response)
+	if response.ContentLength >= 0 {
+		info.size = response.ContentLength
+	}
+	return &objectFile{
+		store: s,
+		ctx:   ctx,
+		key:   key,
+		info:  info,
+		body:  response.Body,
+	}, nil
+}
+
+// Stat returns the object information of `name`.
+func (s *objectStore) Stat(name string) (fs.FileInfo, error) {
+	return s.StatCtx(context.Background(), name)
+}
+
+// StatCtx returns the object information of `name` with context `ctx`.
+func (s *objectStore) StatCtx(ctx context.Context, name string) (fs.FileInfo, error) {
+	key, err := cleanName("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	response, err := s.do(ctx, objectRequest{Method: http.MethodHead, Key: key})
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if err = s.checkResponse("stat", key, response); err != nil {
+		return nil, err
+	}
+	return newObjectInfo(key, response), nil
+}
+
+// Put uploads the content read from `reader` as the object of `name`.
+// The content is streamed if its size can be determined, like the files and the uploaded files,
+// or else it is read into memory before uploading.
+func (s *objectStore) Put(ctx context.Context, name string, reader io.Reader) error {
+	key, err := cleanName("put", name)
+	if err != nil {
+		return err
+	}
+	in := objectRequest{
+		Method:      http.MethodPut,
+		Key:         key,
+		Body:        reader,
+		PayloadHash: unsignedPayload,
+	}
+	if in.Size, err = getReaderSize(reader); err != nil {
+		return gerror.Wrapf(err, `get size of content of object "%s" failed`, key)
+	}
+	if in.Size < 0 {
+		payload, err := io.ReadAll(reader)
+		if err != nil {
+			return gerror.Wrapf(err, `read content of object "%s" failed`, key)
+		}
+		payloadHash := sha256.Sum256(payload)
+		in.Body = bytes.NewReader(payload)
+		in.Size = int64(len(payload))
+		in.PayloadHash = hex.EncodeToString(payloadHash[:])
+	}
+	response, err := s.do(ctx, in)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	return s.checkResponse("put", key, response)
+}
+
+// Remove deletes the object of `name`.
+func (s *objectStore) Remove(ctx context.Context, name string) error {
+	key, err := cleanName("remove", name)
+	if err != nil {
+		return err
+	}
+	response, err := s.do(ctx, objectRequest{Method: http.MethodDelete, Key: key})
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return s.checkResponse("remove", key, response)
+}
+
+// do signs and sends the request for the object.
+func (s *objectStore) do(ctx context.Context, in objectRequest) (*http.Response, error) {
+	if in.Key == "." {
+		return nil, &fs.PathError{Op: strings.ToLower(in.Method), Path: in.Key, Err: fs.ErrInvalid}
+	}
+	req, err := http.NewRequestWithContext(ctx, in.Method, s.url(in.Key).String(), nil)
+	if err != nil {
+		return nil, gerror.Wrapf(err, `create request for object "%s" failed`, in.Key)
+	}
+	for name, values := range in.Header {
+		req.Header[name] = values
+	}
+	if in.Body != nil {
+		req.Body = io.NopCloser(in.Body)
+		req.ContentLength = in.Size
+		if in.Size == 0 {
+			req.Body = http.NoBody
+		}
+	}
+	if in.Method == http.MethodPut {
+		contentType := mime.TypeByExtension(path.Ext(in.Key))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		req.Header.Set("Content-Type", contentType)
+	}
+	if in.PayloadHash == "" {
+		in.PayloadHash = emptyPayloadHash
+	}
+	s.sign(req, in.PayloadHash)
+	client := s.client
+	if client == nil {
+		client = defaultClient
+	}
+	response, err := client.Do(req)
+	if err != nil {
+		return nil, gerror.Wrapf(err, `%s object "%s" failed`, in.Method, in.Key)
+	}
+	return response, nil
+}
+
+// checkResponse returns error if the response status is not successful.
+func (s *objectStore) checkResponse(op, key string, response *http.Response) error {
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return nil
+	}
+	if response.StatusCode == http.StatusNotFound {
+		return &fs.PathError{Op: op, Path: key, Err: fs.ErrNotExist}
+	}
+	body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+	return gerror.NewCodef(
+		gcode.CodeOperationFailed,
+		`%s object "%s" failed with status %d: %s`,
+		op, key, response.StatusCode, strings.TrimSpace(string(body)),
+	)
+}
+
+// newObjectInfo creates object information from the response headers.
+func newObjectInfo(key string, response *http.Response) *objectInfo {
+	info := &objectInfo{
+		name: path.Base(key),
+	}
+	info.size, _ = strconv.ParseInt(response.Header.Get("Content-Length"), 10, 64)
+	info.modTime, _ = http.ParseTime(response.Header.Get("Last-Modified"))
+	return info
+}
+
+// getReaderSize returns the size of the remaining content of `reader`, or -1 if it cannot be determined.
+func getReaderSize(reader io.Reader) (int64, error) {
+	switch r := reader.(type) {
+	case interface{ Len() int }:
+		return int64(r.Len()), nil
+	case io.Seeker:
+		current, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, err
+		}
+		end, err := r.Seek(0, io.SeekEnd)
+		if err != nil {
+			return 0, err
+		}
+		if _, err = r.Seek(current, io.SeekStart); err != nil {
+			return 0, err
+		}
+		return end - current, nil
+	}
+	return -1, nil
+}
+
+// Read reads the object content from current offset.
+func (f *objectFile) Read(p []byte) (n int, err error) {
+	if f.body == nil || f.bodyOffset != f.offset {
+		if f.offset >= f.info.size {
+			return 0, io.EOF
+		}
+		if err = f.requestRange(); err != nil {
+			return 0, err
+		}
+	}
+	n, err = f.body.Read(p)
+	f.offset += int64(n)
+	f.bodyOffset += int64(n)
+	return n, err
+}
+
+// Seek sets the offset for next Read, the object content is requested from the new offset when it is read.
+func (f *objectFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		offset += f.info.size
+	default:
+		return 0, &fs.PathError{Op: "seek", Path: f.key, Err: fs.ErrInvalid}
+	}
+	if offset < 0 {
+		return 0, &fs.PathError{Op: "seek", Path: f.key, Err: fs.ErrInvalid}
+	}
+	f.offset = offset
+	return offset, nil
+}
+
+// requestRange requests the object content from current offset, which replaces current response body.
+func (f *objectFile) requestRange() error {
+	if f.body != nil {
+		_ = f.body.Close()
+		f.body = nil
+	}
+	header := http.Header{}
+	header.Set("Range", fmt.Sprintf("bytes=%d-", f.offset))
+	response, err := f.store.do(f.ctx, objectRequest{Method: http.MethodGet, Key: f.key, Header: header})
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusPartialContent {
+		defer response.Body.Close()
+		if err = f.store.checkResponse("read", f.key, response); err != nil {
+			return err
+		}
+		return gerror.NewCodef(
+			gcode.CodeOperationFailed,
+			`read object "%s" from offset %d failed with status %d`,
+			f.key, f.offset, response.StatusCode,
+		)
+	}
+	f.body = response.Body
+	f.bodyOffset = f.offset
+	return nil
+}
+
+// Stat returns the object information.
+func (f *objectFile) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+// Close closes the response body of the object.
+func (f *objectFile) Close() error {
+	if f.body == nil {
+		return nil
+	}
+	err := f.body.Close()
+	f.body = nil
+	return err
+}
+
+func (i *objectInfo) Name() string       { return i.name }
+func (i *objectInfo) Size() int64        { return i.size }
+func (i *objectInfo) Mode() fs.FileMode  { return 0444 }
+func (i *objectInfo) ModTime() time.Time { return i.modTime }
+func (i *objectInfo) IsDir() bool        { return false }
+func (i *objectInfo) Sys() any           { return nil }
+
+// escapeKey escapes the object key for URL path, which keeps the slashes and unreserved characters.
+func escapeKey(key string) string {
+	var buffer strings.Builder
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || c == '/' {
+			buffer.WriteByte(c)
+		} else {
+			buffer.WriteString(fmt.Sprintf("%%%02X", c))
+		}
+	}
+	return buffer.String()
+}
+
+// newObjectURL creates the URL of endpoint with the unescaped `objectPath`.
+func newObjectURL(endpoint *url.URL, host, objectPath string) *url.URL {
+	return &url.URL{
+		Scheme:  endpoint.Scheme,
+		Host:    host,
+		Path:    objectPath,
+		RawPath: escapeKey(objectPath),
+	}
+}
+
+// parseEndpoint parses the endpoint, which uses https scheme if no scheme given.
+func parseEndpoint(endpoint string) *url.URL {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return &url.URL{Scheme: "https", Host: endpoint}
+	}
+	return u
+}
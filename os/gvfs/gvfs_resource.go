@@ -0,0 +1,107 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gvfs
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"strings"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gres"
+)
+
+// Resource is the read-only VFS implementation using the resource manager.
+type Resource struct {
+	resource *gres.Resource // Resource object.
+	prefix   string         // Path prefix in the resource as the root directory.
+}
+
+// NewResource creates and returns a read-only VFS using `resource` with the directory `prefix`
+// of the resource as its root. It uses the default resource object if `resource` is nil.
+func NewResource(resource *gres.Resource, prefix ...string) *Resource {
+	if resource == nil {
+		resource = gres.Instance()
+	}
+	r := &Resource{
+		resource: resource,
+	}
+	if len(prefix) > 0 {
+		r.prefix = strings.TrimRight(prefix[0], gres.Separator)
+	}
+	return r
+}
+
+// resourceFile implements fs.File and io.Seeker for the resource file,
+// each opening has its own reader so that it can be read concurrently.
+type resourceFile struct {
+	*bytes.Reader
+	file *gres.File
+}
+
+// Open opens the file of `name` for reading.
+func (r *Resource) Open(name string) (fs.File, error) {
+	file, err := r.getFile("open", name)
+	if err != nil {
+		return nil, err
+	}
+	return &resourceFile{
+		Reader: bytes.NewReader(file.Content()),
+		file:   file,
+	}, nil
+}
+
+// Stat returns the file information of `name`.
+func (r *Resource) Stat(name string) (fs.FileInfo, error) {
+	file, err := r.getFile("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	return file.FileInfo(), nil
+}
+
+// Put is not supported as the resource is read-only.
+func (r *Resource) Put(ctx context.Context, name string, reader io.Reader) error {
+	return gerror.NewCodef(gcode.CodeNotSupported, `resource is read-only, cannot put file "%s"`, name)
+}
+
+// Remove is not supported as the resource is read-only.
+func (r *Resource) Remove(ctx context.Context, name string) error {
+	return gerror.NewCodef(gcode.CodeNotSupported, `resource is read-only, cannot remove file "%s"`, name)
+}
+
+// getFile returns the resource file of `name`.
+func (r *Resource) getFile(op, name string) (*gres.File, error) {
+	name, err := cleanName(op, name)
+	if err != nil {
+		return nil, err
+	}
+	filePath := r.prefix
+	if name != "." {
+		filePath += gres.Separator + name
+	}
+	if filePath == "" {
+		filePath = gres.Separator
+	}
+	if file := r.resource.Get(filePath); file != nil {
+		return file, nil
+	}
+	return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+}
+
+// Stat returns the resource file information.
+func (f *resourceFile) Stat() (fs.FileInfo, error) {
+	return f.file.FileInfo(), nil
+}
+
+// Close implements the io.Closer interface.
+func (f *resourceFile) Close() error {
+	return nil
+}
@@ -0,0 +1,258 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gvfs_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/os/gres"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/os/gvfs"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+var (
+	ctx = context.TODO()
+)
+
+// newObjectServer creates a fake object storage server, which stores the objects in memory by the
+// request path, and records the authorization headers.
+func newObjectServer(authorizations *[]string) *httptest.Server {
+	var (
+		mu      sync.Mutex
+		objects = make(map[string][]byte)
+	)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		*authorizations = append(*authorizations, r.Header.Get("Authorization"))
+		key := r.Host + r.URL.Path
+		switch r.Method {
+		case http.MethodPut:
+			content, _ := io.ReadAll(r.Body)
+			objects[key] = content
+		case http.MethodGet, http.MethodHead:
+			content, ok := objects[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			http.ServeContent(w, r, "", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), bytes.NewReader(content))
+		case http.MethodDelete:
+			delete(objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+}
+
+func testVFS(t *gtest.T, vfs gvfs.VFS) {
+	t.AssertNil(gvfs.PutContents(ctx, vfs, "/images/a b.txt", []byte("hello")))
+	t.Assert(gvfs.Exists(vfs, "images/a b.txt"), true)
+	t.Assert(gvfs.Exists(vfs, "images/none.txt"), false)
+
+	content, err := gvfs.GetContents(vfs, "images/a b.txt")
+	t.AssertNil(err)
+	t.Assert(content, "hello")
+
+	info, err := vfs.Stat("images/a b.txt")
+	t.AssertNil(err)
+	t.Assert(info.Name(), "a b.txt")
+	t.Assert(info.Size(), 5)
+	t.Assert(info.IsDir(), false)
+
+	file, err := vfs.Open("images/a b.txt")
+	t.AssertNil(err)
+	seeker, ok := file.(io.Seeker)
+	t.Assert(ok, true)
+	size, err := seeker.Seek(0, io.SeekEnd)
+	t.AssertNil(err)
+	t.Assert(size, 5)
+	_, err = seeker.Seek(2, io.SeekStart)
+	t.AssertNil(err)
+	content, err = io.ReadAll(file)
+	t.AssertNil(err)
+	t.Assert(content, "llo")
+	t.AssertNil(file.Close())
+
+	file, err = gvfs.OpenCtx(ctx, vfs, "images/a b.txt")
+	t.AssertNil(err)
+	content, err = io.ReadAll(file)
+	t.AssertNil(err)
+	t.Assert(content, "hello")
+	t.AssertNil(file.Close())
+
+	_, err = vfs.Open("images/none.txt")
+	t.Assert(errors.Is(err, fs.ErrNotExist), true)
+
+	t.AssertNil(vfs.Remove(ctx, "images/a b.txt"))
+	t.Assert(gvfs.Exists(vfs, "images/a b.txt"), false)
+	t.AssertNil(vfs.Remove(ctx, "images/a b.txt"))
+}
+
+func Test_Local(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		root := gfile.Temp(gtime.TimestampNanoStr())
+		defer gfile.Remove(root)
+
+		vfs := gvfs.NewLocal(root)
+		testVFS(t, vfs)
+
+		// It cannot access the files outside the root.
+		t.AssertNil(gvfs.PutContents(ctx, vfs, "../../outside.txt", []byte("inside")))
+		t.Assert(gfile.GetContents(gfile.Join(root, "outside.txt")), "inside")
+	})
+}
+
+func Test_Resource(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		root := gfile.Temp(gtime.TimestampNanoStr())
+		defer gfile.Remove(root)
+		t.AssertNil(gfile.PutContents(gfile.Join(root, "static", "index.html"), "index"))
+
+		content, err := gres.Pack(gfile.Join(root, "static"), "static")
+		t.AssertNil(err)
+		resource := gres.New()
+		t.AssertNil(resource.Add(string(content)))
+
+		vfs := gvfs.NewResource(resource, "static")
+		data, err := gvfs.GetContents(vfs, "/index.html")
+		t.AssertNil(err)
+		t.Assert(data, "index")
+
+		info, err := vfs.Stat(".")
+		t.AssertNil(err)
+		t.Assert(info.IsDir(), true)
+
+		t.Assert(gvfs.Exists(vfs, "none.html"), false)
+		t.AssertNE(gvfs.PutContents(ctx, vfs, "index.html", []byte("")), nil)
+		t.AssertNE(vfs.Remove(ctx, "index.html"), nil)
+	})
+}
+
+func Test_S3(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			authorizations []string
+			server         = newObjectServer(&authorizations)
+		)
+		defer server.Close()
+		vfs := gvfs.NewS3(gvfs.S3Config{
+			Endpoint:  server.URL,
+			Bucket:    "bucket",
+			AccessKey: "ak",
+			SecretKey: "sk",
+			PathStyle: true,
+		})
+		testVFS(t, vfs)
+		t.AssertGT(len(authorizations), 0)
+		for _, authorization := range authorizations {
+			t.Assert(strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=ak/"), true)
+		}
+	})
+}
+
+func Test_OSS(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			authorizations []string
+			server         = newObjectServer(&authorizations)
+		)
+		defer server.Close()
+		vfs := gvfs.NewOSS(gvfs.OSSConfig{
+			Endpoint:  server.URL,
+			Bucket:    "bucket",
+			AccessKey: "ak",
+			SecretKey: "sk",
+			Client: &http.Client{
+				// The virtual hosted bucket domain is resolved to the test server.
+				Transport: &http.Transport{
+					DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+						return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+					},
+				},
+			},
+		})
+		testVFS(t, vfs)
+		t.AssertGT(len(authorizations), 0)
+		for _, authorization := range authorizations {
+			t.Assert(strings.HasPrefix(authorization, "OSS ak:"), true)
+		}
+	})
+}
+
+func Test_S3_Ctx(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			authorizations []string
+			server         = newObjectServer(&authorizations)
+		)
+		defer server.Close()
+		vfs := gvfs.NewS3(gvfs.S3Config{
+			Endpoint:  server.URL,
+			Bucket:    "bucket",
+			AccessKey: "ak",
+			SecretKey: "sk",
+			PathStyle: true,
+		})
+		t.AssertNil(gvfs.PutContents(ctx, vfs, "a.txt", []byte("hello")))
+
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := gvfs.OpenCtx(canceledCtx, vfs, "a.txt")
+		t.Assert(errors.Is(err, context.Canceled), true)
+		_, err = gvfs.StatCtx(canceledCtx, vfs, "a.txt")
+		t.Assert(errors.Is(err, context.Canceled), true)
+
+		// It uses the context ignoring VFS.
+		info, err := gvfs.StatCtx(canceledCtx, gvfs.NewLocal(gfile.Temp()), ".")
+		t.AssertNil(err)
+		t.Assert(info.IsDir(), true)
+	})
+}
+
+func Test_S3_Put_Stream(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			payloadHashes  []string
+			contentLengths []int64
+			server         = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.ReadAll(r.Body)
+				payloadHashes = append(payloadHashes, r.Header.Get("X-Amz-Content-Sha256"))
+				contentLengths = append(contentLengths, r.ContentLength)
+			}))
+		)
+		defer server.Close()
+		vfs := gvfs.NewS3(gvfs.S3Config{
+			Endpoint:  server.URL,
+			Bucket:    "bucket",
+			AccessKey: "ak",
+			SecretKey: "sk",
+			PathStyle: true,
+		})
+		// The content of known size is streamed without signing.
+		t.AssertNil(vfs.Put(ctx, "a.txt", strings.NewReader("hello")))
+		// The content of unknown size is read into memory and signed.
+		t.AssertNil(vfs.Put(ctx, "b.txt", io.MultiReader(strings.NewReader("hello"))))
+		t.Assert(payloadHashes, []string{
+			"UNSIGNED-PAYLOAD",
+			"2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+		})
+		t.Assert(contentLengths, []int64{5, 5})
+	})
+}
@@ -23,11 +23,12 @@ import (
 
 // View object for template engine.
 type View struct {
-	searchPaths  *garray.StrArray                    // Searching array for path, NOT concurrent-safe for performance purpose.
-	data         map[string]any                      // Global template variables.
-	funcMap      map[string]any                      // Global template function map.
-	fileCacheMap *gmap.KVMap[string, *fileCacheItem] // File cache map.
-	config       Config                              // Extra configuration for the view.
+	searchPaths   *garray.StrArray                    // Searching array for path, NOT concurrent-safe for performance purpose.
+	data          map[string]any                      // Global template variables.
+	funcMap       map[string]any                      // Global template function map.
+	i18nFuncNames map[string]struct{}                 // Names of build-in i18n functions that are not overridden by customized functions.
+	fileCacheMap  *gmap.KVMap[string, *fileCacheItem] // File cache map.
+	config        Config                              // Extra configuration for the view.
 }
 
 type (
@@ -154,6 +155,11 @@ func New(path ...string) *View {
 		"minus":      view.buildInFuncMinus,
 		"times":      view.buildInFuncTimes,
 		"divide":     view.buildInFuncDivide,
+		"t":          view.buildInFuncT,
+		"tf":         view.buildInFuncTf,
+		"tp":         view.buildInFuncTp,
+		"extends":    view.buildInFuncExtends,
 	})
+	view.i18nFuncNames = map[string]struct{}{"t": {}, "tf": {}, "tp": {}}
 	return view
 }
@@ -312,3 +312,29 @@ func (view *View) buildInFuncDivide(value any, values ...any) string {
 	}
 	return gconv.String(result)
 }
+
+// buildInFuncT implements build-in template function: t ,
+// which translates the content of `key` with i18n manager.
+// Note that it is bound with the language of each rendering during template executing.
+func (view *View) buildInFuncT(key any) string {
+	return view.i18nFuncs(context.TODO()).T(key)
+}
+
+// buildInFuncTf implements build-in template function: tf ,
+// which translates the content of `key` and formats it with `values`.
+func (view *View) buildInFuncTf(key any, values ...any) string {
+	return view.i18nFuncs(context.TODO()).Tf(key, values...)
+}
+
+// buildInFuncTp implements build-in template function: tp ,
+// which translates the pluralized content of `key` for `count`, eg: {{tp "cart.items" .count}}.
+func (view *View) buildInFuncTp(key any, count any, values ...any) string {
+	return view.i18nFuncs(context.TODO()).Tp(key, count, values...)
+}
+
+// buildInFuncExtends implements build-in template function: extends ,
+// which declares the layout file of current template file, eg: {{extends "layout/base.html"}}.
+// It outputs nothing, as the layout inheritance is handled before template executing.
+func (view *View) buildInFuncExtends(file any) string {
+	return ""
+}
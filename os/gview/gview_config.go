@@ -29,6 +29,7 @@ type Config struct {
 	Delimiters  []string       `json:"delimiters"`  // Custom template delimiters.
 	AutoEncode  bool           `json:"autoEncode"`  // Automatically encodes and provides safe html output, which is good for avoiding XSS.
 	I18nManager *gi18n.Manager `json:"-"`           // I18n manager for the view.
+	Sandbox     SandboxConfig  `json:"sandbox"`     // Sandbox configuration for rendering untrusted templates.
 }
 
 const (
@@ -255,6 +256,7 @@ func (view *View) SetAutoEncode(enable bool) {
 // The `name` is the function name which can be called in template content.
 func (view *View) BindFunc(name string, function any) {
 	view.funcMap[name] = function
+	delete(view.i18nFuncNames, name)
 	// Clear global template object cache.
 	templates.Clear()
 }
@@ -265,6 +267,7 @@ func (view *View) BindFunc(name string, function any) {
 func (view *View) BindFuncMap(funcMap FuncMap) {
 	for k, v := range funcMap {
 		view.funcMap[k] = v
+		delete(view.i18nFuncNames, k)
 	}
 	// Clear global template object cache.
 	templates.Clear()
@@ -13,6 +13,12 @@ import (
 	"github.com/gogf/gf/v2/util/gconv"
 )
 
+// i18nFuncs is the template functions for translating, which are bound with context of each rendering.
+type i18nFuncs struct {
+	ctx     context.Context
+	manager *gi18n.Manager
+}
+
 const (
 	i18nLanguageVariableName = "I18nLanguage"
 )
@@ -37,3 +43,64 @@ func (view *View) setI18nLanguageFromCtx(ctx context.Context, variables map[stri
 		}
 	}
 }
+
+// i18nFuncMap returns the translating template functions bound with the language of current rendering,
+// which override the build-in functions "t", "tf" and "tp" unless they are overridden by customized functions.
+func (view *View) i18nFuncMap(ctx context.Context, variables Params) FuncMap {
+	if len(view.i18nFuncNames) == 0 {
+		return nil
+	}
+	if language, ok := variables[i18nLanguageVariableName]; ok {
+		ctx = gi18n.WithLanguage(ctx, gconv.String(language))
+	}
+	var (
+		funcs   = view.i18nFuncs(ctx)
+		funcMap = FuncMap{
+			"t":  funcs.T,
+			"tf": funcs.Tf,
+			"tp": funcs.Tp,
+		}
+	)
+	for name := range funcMap {
+		if _, ok := view.i18nFuncNames[name]; !ok {
+			delete(funcMap, name)
+		}
+	}
+	return funcMap
+}
+
+// i18nFuncs creates and returns the translating template functions bound with `ctx`.
+func (view *View) i18nFuncs(ctx context.Context) *i18nFuncs {
+	return &i18nFuncs{
+		ctx:     ctx,
+		manager: view.config.I18nManager,
+	}
+}
+
+// T translates the content of `key`.
+func (f *i18nFuncs) T(key any) string {
+	if f.manager == nil {
+		return gconv.String(key)
+	}
+	return f.manager.T(f.ctx, gconv.String(key))
+}
+
+// Tf translates the content of `key` and formats it with `values`.
+// The content is not formatted if no `values` are given, so a literal "%" like "100%" is kept.
+func (f *i18nFuncs) Tf(key any, values ...any) string {
+	if f.manager == nil {
+		return gconv.String(key)
+	}
+	if len(values) == 0 {
+		return f.manager.T(f.ctx, gconv.String(key))
+	}
+	return f.manager.Tf(f.ctx, gconv.String(key), values...)
+}
+
+// Tp translates the pluralized content of `key` for `count`.
+func (f *i18nFuncs) Tp(key any, count any, values ...any) string {
+	if f.manager == nil {
+		return gconv.String(key)
+	}
+	return f.manager.Tp(f.ctx, gconv.String(key), gconv.Int(count), values...)
+}
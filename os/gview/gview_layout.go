@@ -0,0 +1,122 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gview
+
+import (
+	"context"
+	"fmt"
+	htmltpl "html/template"
+	texttpl "text/template"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gfile"
+	"github.com/gogf/gf/v2/text/gregex"
+)
+
+const (
+	// maxLayoutDepth is the maximum depth of layout inheritance, which also breaks inheritance loop.
+	maxLayoutDepth = 10
+
+	// layoutTplPrefix is the name prefix of parsed layout templates, which avoids redefining
+	// the templates of the same names in the cloned folder template set.
+	layoutTplPrefix = "layout:"
+)
+
+// getExtendsFile returns the layout file declared by function "extends" at the beginning of `content`,
+// or else it returns empty string.
+func (view *View) getExtendsFile(content string) string {
+	pattern := fmt.Sprintf(
+		`^\s*%s-?\s*extends\s+"([^"]+)"\s*-?%s`,
+		gregex.Quote(view.config.Delimiters[0]),
+		gregex.Quote(view.config.Delimiters[1]),
+	)
+	match, _ := gregex.MatchString(pattern, content)
+	if len(match) > 1 {
+		return match[1]
+	}
+	return ""
+}
+
+// doParseWithLayout parses the template file `page` which extends layout file `layoutFile`.
+//
+// The layout files are searched like template files in file system or resource manager, and the layout
+// can also extend another layout. The templates are parsed from the root layout to the page, so that the
+// blocks defined in the children override the ones in parents, and the root layout is executed at last.
+//
+// Example:
+//
+//	layout/base.html: <html><body>{{block "content" .}}default content{{end}}</body></html>
+//	index.html:       {{extends "layout/base.html"}}{{define "content"}}index content{{end}}
+func (view *View) doParseWithLayout(ctx context.Context, page *fileCacheItem, layoutFile string, params Params) (string, error) {
+	var (
+		err   error
+		chain = []*fileCacheItem{page}
+	)
+	for layoutFile != "" {
+		if len(chain) > maxLayoutDepth {
+			return "", gerror.NewCodef(
+				gcode.CodeInvalidOperation,
+				`layout inheritance of template "%s" exceeds the max depth %d`,
+				page.path, maxLayoutDepth,
+			)
+		}
+		var layout *fileCacheItem
+		if layout, err = view.getFileCacheItem(ctx, layoutFile); err != nil {
+			return "", err
+		}
+		chain = append(chain, layout)
+		layoutFile = view.getExtendsFile(layout.content)
+	}
+	var (
+		root = chain[len(chain)-1]
+		key  = fmt.Sprintf(
+			"layout:%s_%v_%v_%v",
+			page.path, view.config.Delimiters, view.config.AutoEncode, view.config.Sandbox.Enabled,
+		)
+	)
+	// The templates of the root layout folder are included for partial templates.
+	folderTpl, err := view.getTemplate(root.path, root.folder, fmt.Sprintf(`*%s`, gfile.Ext(root.path)))
+	if err != nil {
+		return "", err
+	}
+	tpl := templates.GetOrSetFuncLock(key, func() any {
+		for i := len(chain) - 1; i >= 0; i-- {
+			if err = view.checkSandboxContent(chain[i].path, chain[i].content); err != nil {
+				return nil
+			}
+		}
+		if view.config.AutoEncode {
+			var t *htmltpl.Template
+			if t, err = folderTpl.(*htmltpl.Template).Clone(); err != nil {
+				return nil
+			}
+			for i := len(chain) - 1; i >= 0; i-- {
+				if _, err = t.New(layoutTplPrefix + chain[i].path).Parse(chain[i].content); err != nil {
+					err = gerror.Wrap(err, chain[i].path)
+					return nil
+				}
+			}
+			return t.Lookup(layoutTplPrefix + root.path)
+		}
+		var t *texttpl.Template
+		if t, err = folderTpl.(*texttpl.Template).Clone(); err != nil {
+			return nil
+		}
+		for i := len(chain) - 1; i >= 0; i-- {
+			if _, err = t.New(layoutTplPrefix + chain[i].path).Parse(chain[i].content); err != nil {
+				err = gerror.Wrap(err, chain[i].path)
+				return nil
+			}
+		}
+		return t.Lookup(layoutTplPrefix + root.path)
+	})
+	if err != nil || tpl == nil {
+		return "", err
+	}
+	return view.doParseContentWithStdTemplate(ctx, tpl, params)
+}
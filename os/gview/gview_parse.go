@@ -11,6 +11,7 @@ import (
 	"context"
 	"fmt"
 	htmltpl "html/template"
+	"io"
 	"strconv"
 	"strings"
 	texttpl "text/template"
@@ -129,8 +130,53 @@ func (view *View) ParseWithOptions(ctx context.Context, opts Options) (result st
 	if opts.File == "" {
 		return "", gerror.New(`template file cannot be empty`)
 	}
+	r, err := view.getFileCacheItem(ctx, opts.File)
+	if err != nil || r == nil {
+		return "", err
+	}
+	// It's not necessary continuing parsing if template content is empty.
+	if r.content == "" {
+		return "", nil
+	}
+	// It parses the file with its layouts if it extends any layout.
+	if layoutFile := view.getExtendsFile(r.content); layoutFile != "" {
+		return view.doParseWithLayout(ctx, r, layoutFile, opts.Params)
+	}
+	// If it's an Orphan option, it just parses the single file by ParseContent.
+	if opts.Orphan {
+		return view.doParseContent(ctx, r.content, opts.Params)
+	}
+	if err = view.checkSandboxContent(r.path, r.content); err != nil {
+		return "", err
+	}
+	// Get the template object instance for `folder`.
+	var tpl any
+	tpl, err = view.getTemplate(r.path, r.folder, fmt.Sprintf(`*%s`, gfile.Ext(r.path)))
+	if err != nil {
+		return "", err
+	}
+	// Using memory lock to ensure concurrent safety for template parsing.
+	gmlock.LockFunc("gview.Parse:"+r.path, func() {
+		if view.config.AutoEncode {
+			tpl, err = tpl.(*htmltpl.Template).Parse(r.content)
+		} else {
+			tpl, err = tpl.(*texttpl.Template).Parse(r.content)
+		}
+		if err != nil && r.path != "" {
+			err = gerror.Wrap(err, r.path)
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return view.doParseContentWithStdTemplate(ctx, tpl, opts.Params)
+}
+
+// getFileCacheItem searches the template file `file` and returns its cached path, folder and content.
+func (view *View) getFileCacheItem(ctx context.Context, file string) (item *fileCacheItem, err error) {
 	// It caches the file, folder, and content to enhance performance.
-	r := view.fileCacheMap.GetOrSetFuncLock(opts.File, func() *fileCacheItem {
+	item = view.fileCacheMap.GetOrSetFuncLock(file, func() *fileCacheItem {
 		var (
 			path     string
 			folder   string
@@ -138,7 +184,7 @@ func (view *View) ParseWithOptions(ctx context.Context, opts Options) (result st
 			resource *gres.File
 		)
 		// Searching the absolute file path for `file`.
-		path, folder, resource, err = view.searchFile(ctx, opts.File)
+		path, folder, resource, err = view.searchFile(ctx, file)
 		if err != nil {
 			return nil
 		}
@@ -149,15 +195,15 @@ func (view *View) ParseWithOptions(ctx context.Context, opts Options) (result st
 		}
 		// Monitor template files changes using fsnotify asynchronously.
 		if resource == nil {
-			if _, err = gfsnotify.AddOnce(
+			if _, watchErr := gfsnotify.AddOnce(
 				"gview.Parse:"+folder, folder, func(event *gfsnotify.Event) {
 					// CLEAR THEM ALL.
 					view.fileCacheMap.Clear()
 					templates.Clear()
 					gfsnotify.Exit()
 				},
-			); err != nil {
-				intlog.Errorf(ctx, `%+v`, err)
+			); watchErr != nil {
+				intlog.Errorf(ctx, `%+v`, watchErr)
 			}
 		}
 		return &fileCacheItem{
@@ -166,39 +212,7 @@ func (view *View) ParseWithOptions(ctx context.Context, opts Options) (result st
 			content: content,
 		}
 	})
-	if r == nil {
-		return
-	}
-	// It's not necessary continuing parsing if template content is empty.
-	if r.content == "" {
-		return "", nil
-	}
-	// If it's an Orphan option, it just parses the single file by ParseContent.
-	if opts.Orphan {
-		return view.doParseContent(ctx, r.content, opts.Params)
-	}
-	// Get the template object instance for `folder`.
-	var tpl any
-	tpl, err = view.getTemplate(r.path, r.folder, fmt.Sprintf(`*%s`, gfile.Ext(r.path)))
-	if err != nil {
-		return "", err
-	}
-	// Using memory lock to ensure concurrent safety for template parsing.
-	gmlock.LockFunc("gview.Parse:"+r.path, func() {
-		if view.config.AutoEncode {
-			tpl, err = tpl.(*htmltpl.Template).Parse(r.content)
-		} else {
-			tpl, err = tpl.(*texttpl.Template).Parse(r.content)
-		}
-		if err != nil && r.path != "" {
-			err = gerror.Wrap(err, r.path)
-		}
-	})
-	if err != nil {
-		return "", err
-	}
-
-	return view.doParseContentWithStdTemplate(ctx, tpl, opts.Params)
+	return item, err
 }
 
 // doParseContent parses given template content `content` with template variables `params`
@@ -208,9 +222,15 @@ func (view *View) doParseContent(ctx context.Context, content string, params Par
 	if content == "" {
 		return "", nil
 	}
+	if err := view.checkSandboxContent(templateNameForContentParsing, content); err != nil {
+		return "", err
+	}
 	var (
 		err error
-		key = fmt.Sprintf("%s_%v_%v", templateNameForContentParsing, view.config.Delimiters, view.config.AutoEncode)
+		key = fmt.Sprintf(
+			"%s_%v_%v_%v",
+			templateNameForContentParsing, view.config.Delimiters, view.config.AutoEncode, view.config.Sandbox.Enabled,
+		)
 		tpl = templates.GetOrSetFuncLock(key, func() any {
 			if view.config.AutoEncode {
 				return htmltpl.New(templateNameForContentParsing).Delims(
@@ -250,7 +270,12 @@ func (view *View) doParseContentWithStdTemplate(ctx context.Context, tpl any, pa
 	}
 	view.setI18nLanguageFromCtx(ctx, variables)
 
-	buffer := bytes.NewBuffer(nil)
+	var (
+		buffer  = bytes.NewBuffer(nil)
+		funcMap = view.i18nFuncMap(ctx, variables)
+		execute func(writer io.Writer) error
+	)
+	// The template is cloned for binding the functions of current rendering.
 	if view.config.AutoEncode {
 		var newTpl *htmltpl.Template
 		newTpl, err := tpl.(*htmltpl.Template).Clone()
@@ -258,15 +283,49 @@ func (view *View) doParseContentWithStdTemplate(ctx context.Context, tpl any, pa
 			err = gerror.Wrapf(err, `template clone failed`)
 			return "", err
 		}
-		if err = newTpl.Execute(buffer, variables); err != nil {
-			err = gerror.Wrapf(err, `template parsing failed`)
-			return "", err
+		if len(funcMap) > 0 {
+			newTpl.Funcs(funcMap)
+		}
+		if view.config.Sandbox.Enabled {
+			// The trees of the cloned html template are copies, which can be changed in place.
+			for _, t := range newTpl.Templates() {
+				injectSandboxTicks(t.Tree)
+			}
+		}
+		execute = func(writer io.Writer) error {
+			return newTpl.Execute(writer, variables)
 		}
 	} else {
-		if err := tpl.(*texttpl.Template).Execute(buffer, variables); err != nil {
-			err = gerror.Wrapf(err, `template parsing failed`)
+		newTpl, err := tpl.(*texttpl.Template).Clone()
+		if err != nil {
+			err = gerror.Wrapf(err, `template clone failed`)
 			return "", err
 		}
+		if len(funcMap) > 0 {
+			newTpl.Funcs(funcMap)
+		}
+		if view.config.Sandbox.Enabled {
+			// The trees of the cloned text template are shared with the cached one, which are copied before changing.
+			for _, t := range newTpl.Templates() {
+				if t.Tree != nil {
+					t.Tree = t.Tree.Copy()
+					injectSandboxTicks(t.Tree)
+				}
+			}
+		}
+		execute = func(writer io.Writer) error {
+			return newTpl.Execute(writer, variables)
+		}
+	}
+	var err error
+	if view.config.Sandbox.Enabled {
+		err = view.executeInSandbox(ctx, buffer, execute)
+	} else {
+		err = execute(buffer)
+	}
+	if err != nil {
+		err = gerror.Wrapf(err, `template parsing failed`)
+		return "", err
 	}
 	// TODO any graceful plan to replace "<no value>"?
 	result := gstr.Replace(buffer.String(), "<no value>", "")
@@ -286,7 +345,9 @@ func (view *View) getBuiltInParams() map[string]any {
 // if the template files under `path` changes (recursively).
 func (view *View) getTemplate(filePath, folderPath, pattern string) (tpl any, err error) {
 	var (
-		mapKey  = fmt.Sprintf("%s_%v", filePath, view.config.Delimiters)
+		mapKey = fmt.Sprintf(
+			"%s_%v_%v_%v", filePath, view.config.Delimiters, view.config.AutoEncode, view.config.Sandbox.Enabled,
+		)
 		mapFunc = func() any {
 			tplName := filePath
 			if view.config.AutoEncode {
@@ -306,6 +367,9 @@ func (view *View) getTemplate(filePath, folderPath, pattern string) (tpl any, er
 					if view.config.AutoEncode {
 						var t = tpl.(*htmltpl.Template)
 						for _, v := range files {
+							if err = view.checkSandboxContent(v.Name(), string(v.Content())); err != nil {
+								return nil
+							}
 							_, err = t.New(v.FileInfo().Name()).Parse(string(v.Content()))
 							if err != nil {
 								err = view.formatTemplateObjectCreatingError(v.Name(), tplName, err)
@@ -315,6 +379,9 @@ func (view *View) getTemplate(filePath, folderPath, pattern string) (tpl any, er
 					} else {
 						var t = tpl.(*texttpl.Template)
 						for _, v := range files {
+							if err = view.checkSandboxContent(v.Name(), string(v.Content())); err != nil {
+								return nil
+							}
 							_, err = t.New(v.FileInfo().Name()).Parse(string(v.Content()))
 							if err != nil {
 								err = view.formatTemplateObjectCreatingError(v.Name(), tplName, err)
@@ -336,6 +403,9 @@ func (view *View) getTemplate(filePath, folderPath, pattern string) (tpl any, er
 			if view.config.AutoEncode {
 				t := tpl.(*htmltpl.Template)
 				for _, file := range files {
+					if err = view.checkSandboxContent(file, gfile.GetContents(file)); err != nil {
+						return nil
+					}
 					if _, err = t.Parse(gfile.GetContents(file)); err != nil {
 						err = view.formatTemplateObjectCreatingError(file, tplName, err)
 						return nil
@@ -344,6 +414,9 @@ func (view *View) getTemplate(filePath, folderPath, pattern string) (tpl any, er
 			} else {
 				t := tpl.(*texttpl.Template)
 				for _, file := range files {
+					if err = view.checkSandboxContent(file, gfile.GetContents(file)); err != nil {
+						return nil
+					}
 					if _, err = t.Parse(gfile.GetContents(file)); err != nil {
 						err = view.formatTemplateObjectCreatingError(file, tplName, err)
 						return nil
@@ -0,0 +1,293 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gview
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"text/template/parse"
+	"time"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// SandboxConfig is the configuration of sandbox mode, which is used for rendering untrusted templates,
+// like the email templates edited by administrators. The zero value of the limits means no limit.
+//
+// Note that the methods of template variables are still callable in sandbox mode,
+// so it should pass only plain data like maps and structs without dangerous methods as variables.
+type SandboxConfig struct {
+	Enabled        bool          `json:"enabled"`        // Enabled specifies whether the sandbox mode is enabled.
+	AllowedFuncs   []string      `json:"allowedFuncs"`   // AllowedFuncs is the whitelist of template functions, which is DefaultSandboxFuncs if empty.
+	MaxDepth       int           `json:"maxDepth"`       // MaxDepth is the maximum nesting depth of if/range/with actions.
+	MaxContentSize int           `json:"maxContentSize"` // MaxContentSize is the maximum bytes of template content.
+	MaxOutputSize  int           `json:"maxOutputSize"`  // MaxOutputSize is the maximum bytes of rendered output.
+	MaxIterations  int           `json:"maxIterations"`  // MaxIterations is the maximum count of range iterations and template invocations of single rendering.
+	Timeout        time.Duration `json:"timeout"`        // Timeout is the maximum duration of single rendering.
+}
+
+var (
+	// DefaultSandboxFuncs is the default whitelist of template functions in sandbox mode,
+	// which contains the functions without side effects and file or data accessing.
+	DefaultSandboxFuncs = []string{
+		// Go template built-in functions.
+		"and", "or", "not", "len", "index", "slice", "print", "printf", "println",
+		"html", "js", "urlquery", "eq", "ne", "lt", "le", "gt", "ge",
+		// GoFrame built-in functions.
+		"text", "htmlencode", "htmldecode", "encode", "decode", "url", "urlencode", "urldecode",
+		"date", "substr", "strlimit", "concat", "replace", "compare", "hidestr", "highlight",
+		"toupper", "tolower", "nl2br", "plus", "minus", "times", "divide", "t", "tf", "tp",
+	}
+
+	// goTemplateBuiltinFuncs is the name set of Go template built-in functions for template checking.
+	goTemplateBuiltinFuncs = map[string]any{
+		"and": true, "call": true, "html": true, "index": true, "slice": true, "js": true, "len": true,
+		"not": true, "or": true, "print": true, "printf": true, "println": true, "urlquery": true,
+		"eq": true, "ge": true, "gt": true, "le": true, "lt": true, "ne": true,
+	}
+)
+
+// DefaultSandboxConfig returns the sandbox configuration with enabled sandbox mode and recommended limits.
+func DefaultSandboxConfig() SandboxConfig {
+	return SandboxConfig{
+		Enabled:        true,
+		MaxDepth:       10,
+		MaxContentSize: 64 * 1024,
+		MaxOutputSize:  1024 * 1024,
+		MaxIterations:  100000,
+		Timeout:        time.Second,
+	}
+}
+
+// SetSandbox sets the sandbox configuration for the view.
+func (view *View) SetSandbox(config SandboxConfig) {
+	view.config.Sandbox = config
+}
+
+// checkSandboxContent checks the template content against the sandbox configuration,
+// which rejects the content exceeding size or nesting depth, or using not allowed functions.
+func (view *View) checkSandboxContent(name, content string) error {
+	sandbox := view.config.Sandbox
+	if !sandbox.Enabled {
+		return nil
+	}
+	if sandbox.MaxContentSize > 0 && len(content) > sandbox.MaxContentSize {
+		return gerror.NewCodef(
+			gcode.CodeSecurityReason,
+			`template "%s" size %d exceeds the sandbox limit %d`,
+			name, len(content), sandbox.MaxContentSize,
+		)
+	}
+	trees, err := parse.Parse(
+		name, content, view.config.Delimiters[0], view.config.Delimiters[1],
+		goTemplateBuiltinFuncs, view.funcMap,
+	)
+	if err != nil {
+		return gerror.Wrapf(err, `template parsing failed`)
+	}
+	allowedFuncs := sandbox.AllowedFuncs
+	if len(allowedFuncs) == 0 {
+		allowedFuncs = DefaultSandboxFuncs
+	}
+	checker := &sandboxChecker{
+		maxDepth: sandbox.MaxDepth,
+		allowed:  make(map[string]struct{}, len(allowedFuncs)),
+	}
+	for _, funcName := range allowedFuncs {
+		checker.allowed[funcName] = struct{}{}
+	}
+	for treeName, tree := range trees {
+		if err = checker.walk(tree.Root, 0); err != nil {
+			return gerror.WrapCodef(gcode.CodeSecurityReason, err, `template "%s" check failed`, treeName)
+		}
+	}
+	return nil
+}
+
+// sandboxChecker walks the template parse tree for checking functions and nesting depth.
+type sandboxChecker struct {
+	maxDepth int                 // Maximum nesting depth, no limit if it is 0.
+	allowed  map[string]struct{} // Allowed function names.
+}
+
+func (c *sandboxChecker) walk(node parse.Node, depth int) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := c.walk(child, depth); err != nil {
+				return err
+			}
+		}
+	case *parse.ActionNode:
+		return c.walk(n.Pipe, depth)
+	case *parse.IfNode:
+		return c.walkBranch(&n.BranchNode, depth)
+	case *parse.RangeNode:
+		return c.walkBranch(&n.BranchNode, depth)
+	case *parse.WithNode:
+		return c.walkBranch(&n.BranchNode, depth)
+	case *parse.TemplateNode:
+		return c.walk(n.Pipe, depth)
+	case *parse.PipeNode:
+		if n == nil {
+			return nil
+		}
+		for _, cmd := range n.Cmds {
+			if err := c.walk(cmd, depth); err != nil {
+				return err
+			}
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			if err := c.walk(arg, depth); err != nil {
+				return err
+			}
+		}
+	case *parse.ChainNode:
+		return c.walk(n.Node, depth)
+	case *parse.IdentifierNode:
+		if _, ok := c.allowed[n.Ident]; !ok {
+			return gerror.Newf(`function "%s" is not allowed in sandbox`, n.Ident)
+		}
+	}
+	return nil
+}
+
+func (c *sandboxChecker) walkBranch(n *parse.BranchNode, depth int) error {
+	depth++
+	if c.maxDepth > 0 && depth > c.maxDepth {
+		return gerror.Newf(`nesting depth exceeds the sandbox limit %d`, c.maxDepth)
+	}
+	if err := c.walk(n.Pipe, depth); err != nil {
+		return err
+	}
+	if err := c.walk(n.List, depth); err != nil {
+		return err
+	}
+	return c.walk(n.ElseList, depth)
+}
+
+// injectSandboxTicks inserts an empty text node at the beginning of `tree` and the body of every range
+// action in it. Go templates cannot be interrupted, but the empty text is written on each template invocation
+// and range iteration, which makes the sandbox writer able to check the timeout and iteration limits and
+// abort the rendering even for the loops not writing any output.
+func injectSandboxTicks(tree *parse.Tree) {
+	if tree == nil || tree.Root == nil {
+		return
+	}
+	tree.Root.Nodes = append([]parse.Node{newSandboxTick()}, tree.Root.Nodes...)
+	injectSandboxTicksToList(tree.Root)
+}
+
+func injectSandboxTicksToList(list *parse.ListNode) {
+	if list == nil {
+		return
+	}
+	for _, node := range list.Nodes {
+		switch n := node.(type) {
+		case *parse.IfNode:
+			injectSandboxTicksToList(n.List)
+			injectSandboxTicksToList(n.ElseList)
+		case *parse.WithNode:
+			injectSandboxTicksToList(n.List)
+			injectSandboxTicksToList(n.ElseList)
+		case *parse.RangeNode:
+			injectSandboxTicksToList(n.List)
+			injectSandboxTicksToList(n.ElseList)
+			if n.List != nil {
+				n.List.Nodes = append([]parse.Node{newSandboxTick()}, n.List.Nodes...)
+			}
+		}
+	}
+}
+
+// newSandboxTick creates and returns the empty text node for sandbox checking.
+func newSandboxTick() *parse.TextNode {
+	return &parse.TextNode{NodeType: parse.NodeText, Text: []byte{}}
+}
+
+// executeInSandbox executes the template rendering function `execute` with output size, iteration and
+// timeout limits, and writes the rendered content to `buffer` only if it succeeds.
+//
+// The templates should be injected with sandbox ticks using injectSandboxTicks before executing,
+// so that the rendering goroutine stops at its next range iteration or output writing after timeout.
+func (view *View) executeInSandbox(ctx context.Context, buffer *bytes.Buffer, execute func(writer io.Writer) error) error {
+	var (
+		sandbox = view.config.Sandbox
+		writer  = &sandboxWriter{
+			buffer:        bytes.NewBuffer(nil),
+			maxSize:       sandbox.MaxOutputSize,
+			maxIterations: sandbox.MaxIterations,
+		}
+	)
+	if sandbox.Timeout <= 0 {
+		if err := execute(writer); err != nil {
+			return err
+		}
+		buffer.Write(writer.buffer.Bytes())
+		return nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, sandbox.Timeout)
+	defer cancel()
+	writer.ctx = ctx
+	done := make(chan error, 1)
+	go func() {
+		done <- execute(writer)
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			return err
+		}
+		buffer.Write(writer.buffer.Bytes())
+		return nil
+	case <-ctx.Done():
+		return gerror.NewCodef(
+			gcode.CodeSecurityReason, `template rendering exceeds the sandbox timeout %s`, sandbox.Timeout,
+		)
+	}
+}
+
+// sandboxWriter is the writer limiting the output size and iterations, and aborting writing after context done.
+type sandboxWriter struct {
+	ctx           context.Context
+	buffer        *bytes.Buffer
+	maxSize       int
+	maxIterations int
+	iterations    int // Count of the empty writing of sandbox ticks.
+}
+
+func (w *sandboxWriter) Write(p []byte) (n int, err error) {
+	if w.ctx != nil {
+		if err = w.ctx.Err(); err != nil {
+			return 0, err
+		}
+	}
+	if len(p) == 0 {
+		w.iterations++
+		if w.maxIterations > 0 && w.iterations > w.maxIterations {
+			return 0, gerror.NewCodef(
+				gcode.CodeSecurityReason, `template iterations exceed the sandbox limit %d`, w.maxIterations,
+			)
+		}
+		return 0, nil
+	}
+	if w.maxSize > 0 && w.buffer.Len()+len(p) > w.maxSize {
+		return 0, gerror.NewCodef(
+			gcode.CodeSecurityReason, `template output exceeds the sandbox limit %d`, w.maxSize,
+		)
+	}
+	return w.buffer.Write(p)
+}
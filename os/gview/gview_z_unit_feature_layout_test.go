@@ -0,0 +1,71 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gview_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/i18n/gi18n"
+	"github.com/gogf/gf/v2/os/gview"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Layout_Extends(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			ctx  = context.TODO()
+			view = gview.New(gtest.DataPath("layout"))
+		)
+		view.SetI18n(gi18n.New(gi18n.Options{
+			Path:     gtest.DataPath("i18n-plural"),
+			Language: "en",
+		}))
+		result, err := view.Parse(ctx, "users.html", g.Map{"user": "john", "count": 2})
+		t.AssertNil(err)
+		t.Assert(result, `<html><title>Users</title><body><nav>john</nav><div class="admin">2 users</div></body></html>`)
+
+		result, err = view.Parse(ctx, "hello.html", g.Map{"user": "john"})
+		t.AssertNil(err)
+		t.Assert(result, `<html><title>Site</title><body><nav>john</nav>Hello</body></html>`)
+
+		result, err = view.Parse(gi18n.WithLanguage(ctx, "ru"), "hello.html", g.Map{"user": "john"})
+		t.AssertNil(err)
+		t.Assert(result, `<html><title>Site</title><body><nav>john</nav>Привет</body></html>`)
+
+		result, err = view.Parse(ctx, "layout/admin.html", g.Map{"user": "john"})
+		t.AssertNil(err)
+		t.Assert(result, `<html><title>Site</title><body><nav>john</nav><div class="admin">admin</div></body></html>`)
+
+		_, err = view.Parse(ctx, "loop.html")
+		t.Assert(gerror.Code(err), gcode.CodeInvalidOperation)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		view := gview.New(gtest.DataPath("layout"))
+		view.SetAutoEncode(true)
+		result, err := view.Parse(context.TODO(), "hello.html", g.Map{"user": "<b>john</b>"})
+		t.AssertNil(err)
+		t.Assert(result, `<html><title>Site</title><body><nav>&lt;b&gt;john&lt;/b&gt;</nav>hello</body></html>`)
+	})
+	// The customized functions "t", "tf" and "tp" are not overridden by the i18n functions.
+	gtest.C(t, func(t *gtest.T) {
+		view := gview.New()
+		view.SetI18n(gi18n.New(gi18n.Options{
+			Path:     gtest.DataPath("i18n-plural"),
+			Language: "en",
+		}))
+		view.BindFunc("t", func(key string) string {
+			return "custom-" + key
+		})
+		result, err := view.ParseContent(context.TODO(), `{{t "hello"}} {{tp "users.count" 2}}`)
+		t.AssertNil(err)
+		t.Assert(result, `custom-hello 2 users`)
+	})
+}
@@ -0,0 +1,170 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package gview_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogf/gf/v2/container/gtype"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gview"
+	"github.com/gogf/gf/v2/test/gtest"
+)
+
+func Test_Sandbox_Funcs(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		v := gview.New()
+		v.SetSandbox(gview.DefaultSandboxConfig())
+		v.BindFunc("secret", func() string { return "secret" })
+
+		result, err := v.ParseContent(context.TODO(), `{{.name | toupper}} {{if gt .age 18}}adult{{end}}`, g.Map{
+			"name": "john",
+			"age":  20,
+		})
+		t.AssertNil(err)
+		t.Assert(result, "JOHN adult")
+
+		_, err = v.ParseContent(context.TODO(), `{{include "config.yaml" .}}`)
+		t.AssertNE(err, nil)
+		t.Assert(gerror.Code(err), gcode.CodeSecurityReason)
+		t.Assert(strings.Contains(err.Error(), `function "include" is not allowed`), true)
+
+		_, err = v.ParseContent(context.TODO(), `{{if true}}{{secret}}{{end}}`)
+		t.Assert(gerror.Code(err), gcode.CodeSecurityReason)
+
+		_, err = v.ParseContent(context.TODO(), `{{call .fn}}`, g.Map{"fn": func() string { return "" }})
+		t.Assert(gerror.Code(err), gcode.CodeSecurityReason)
+
+		// Custom whitelist.
+		v.SetSandbox(gview.SandboxConfig{
+			Enabled:      true,
+			AllowedFuncs: []string{"secret"},
+		})
+		result, err = v.ParseContent(context.TODO(), `{{secret}}`)
+		t.AssertNil(err)
+		t.Assert(result, "secret")
+
+		// The same content is allowed without sandbox.
+		v.SetSandbox(gview.SandboxConfig{})
+		result, err = v.ParseContent(context.TODO(), `{{if true}}{{secret}}{{end}}`)
+		t.AssertNil(err)
+		t.Assert(result, "secret")
+	})
+}
+
+func Test_Sandbox_Limits(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		v := gview.New()
+		v.SetSandbox(gview.SandboxConfig{
+			Enabled:        true,
+			MaxDepth:       2,
+			MaxContentSize: 100,
+			MaxOutputSize:  10,
+		})
+		result, err := v.ParseContent(context.TODO(), `{{if true}}{{with 1}}ok{{end}}{{end}}`)
+		t.AssertNil(err)
+		t.Assert(result, "ok")
+
+		_, err = v.ParseContent(context.TODO(), `{{if true}}{{with 1}}{{range .}}{{end}}{{end}}{{end}}`)
+		t.Assert(gerror.Code(err), gcode.CodeSecurityReason)
+		t.Assert(strings.Contains(err.Error(), "nesting depth"), true)
+
+		_, err = v.ParseContent(context.TODO(), strings.Repeat("a", 101))
+		t.Assert(gerror.Code(err), gcode.CodeSecurityReason)
+
+		_, err = v.ParseContent(context.TODO(), `{{range .items}}abc{{end}}`, g.Map{
+			"items": make([]int, 4),
+		})
+		t.Assert(gerror.Code(err), gcode.CodeSecurityReason)
+		t.Assert(strings.Contains(err.Error(), "output exceeds"), true)
+	})
+}
+
+func Test_Sandbox_Timeout(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		v := gview.New()
+		v.BindFunc("sleep", func() string {
+			time.Sleep(500 * time.Millisecond)
+			return "awake"
+		})
+		v.SetSandbox(gview.SandboxConfig{
+			Enabled:      true,
+			AllowedFuncs: []string{"sleep"},
+			Timeout:      50 * time.Millisecond,
+		})
+		start := time.Now()
+		_, err := v.ParseContent(context.TODO(), `{{sleep}}`)
+		t.Assert(gerror.Code(err), gcode.CodeSecurityReason)
+		t.Assert(strings.Contains(err.Error(), "timeout"), true)
+		t.AssertLT(time.Since(start), 400*time.Millisecond)
+	})
+}
+
+func Test_Sandbox_Iterations(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		v := gview.New()
+		v.SetSandbox(gview.SandboxConfig{
+			Enabled:       true,
+			MaxIterations: 100,
+		})
+		result, err := v.ParseContent(context.TODO(), `{{range .items}}{{.}}{{end}}`, g.Map{
+			"items": []int{1, 2, 3},
+		})
+		t.AssertNil(err)
+		t.Assert(result, "123")
+
+		// The loops without output are limited as well.
+		_, err = v.ParseContent(context.TODO(), `{{range .items}}{{range $.items}}{{end}}{{end}}`, g.Map{
+			"items": make([]int, 20),
+		})
+		t.Assert(gerror.Code(err), gcode.CodeSecurityReason)
+		t.Assert(strings.Contains(err.Error(), "iterations exceed"), true)
+
+		v.SetAutoEncode(true)
+		result, err = v.ParseContent(context.TODO(), `{{range .items}}<b>{{.}}</b>{{end}}`, g.Map{
+			"items": []string{"<a>"},
+		})
+		t.AssertNil(err)
+		t.Assert(result, "<b>&lt;a&gt;</b>")
+
+		_, err = v.ParseContent(context.TODO(), `{{range .items}}{{range $.items}}{{end}}{{end}}`, g.Map{
+			"items": make([]int, 20),
+		})
+		t.Assert(gerror.Code(err), gcode.CodeSecurityReason)
+	})
+	// The rendering goroutine stops after timeout.
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			v     = gview.New()
+			calls = gtype.NewInt()
+		)
+		v.BindFunc("count", func() string {
+			calls.Add(1)
+			time.Sleep(10 * time.Millisecond)
+			return ""
+		})
+		v.SetSandbox(gview.SandboxConfig{
+			Enabled:      true,
+			AllowedFuncs: []string{"count"},
+			Timeout:      50 * time.Millisecond,
+		})
+		_, err := v.ParseContent(context.TODO(), `{{range .items}}{{if count}}{{end}}{{end}}`, g.Map{
+			"items": make([]int, 100),
+		})
+		t.Assert(gerror.Code(err), gcode.CodeSecurityReason)
+		time.Sleep(100 * time.Millisecond)
+		stopped := calls.Val()
+		time.Sleep(100 * time.Millisecond)
+		t.Assert(calls.Val(), stopped)
+		t.AssertLT(stopped, 100)
+	})
+}
@@ -113,3 +113,37 @@ func Test_I18n(t *testing.T) {
 	})
 
 }
+
+func Test_I18n_Funcs(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		var (
+			ctx  = context.TODO()
+			view = gview.New()
+		)
+		view.SetI18n(gi18n.New(gi18n.Options{
+			Path:     gtest.DataPath("i18n-plural"),
+			Language: "en",
+		}))
+		content := `{{t "hello"}}, {{tp "users.count" .count}}`
+		result, err := view.ParseContent(ctx, content, g.Map{"count": 1})
+		t.AssertNil(err)
+		t.Assert(result, "Hello, 1 user")
+
+		result, err = view.ParseContent(ctx, content, g.Map{"count": 2})
+		t.AssertNil(err)
+		t.Assert(result, "Hello, 2 users")
+
+		result, err = view.ParseContent(gi18n.WithLanguage(ctx, "ru"), content, g.Map{"count": 5})
+		t.AssertNil(err)
+		t.Assert(result, "Привет, 5 пользователей")
+
+		result, err = view.ParseContent(ctx, content, g.Map{"count": 3, "I18nLanguage": "ru"})
+		t.AssertNil(err)
+		t.Assert(result, "Привет, 3 пользователя")
+
+		// The literal "%" is kept if there are no arguments to format.
+		result, err = view.ParseContent(ctx, `{{tf "discount"}}, {{tp "discount" 2}}`)
+		t.AssertNil(err)
+		t.Assert(result, "Save 100%, Save 100%")
+	})
+}
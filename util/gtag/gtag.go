@@ -29,6 +29,7 @@ const (
 	Domain               = `domain`          // Route domain for HTTP request.
 	Mime                 = `mime`            // MIME type for HTTP request/response.
 	Consumes             = `consumes`        // MIME type for HTTP request.
+	MaxBodySize          = `maxBodySize`     // Max body size for HTTP request, like: 1MB, 500KB.
 	Summary              = `summary`         // Summary for struct, usually for OpenAPI in request struct.
 	SummaryShort         = `sm`              // Short name of Summary.
 	SummaryShort2        = `sum`             // Short name of Summary.
@@ -18,3 +18,15 @@ import (
 func Copy(src any) (dst any) {
 	return deepcopy.Copy(src)
 }
+
+// DeepCopy returns a deep copy of `src` with the same type.
+//
+// The circular references in `src` are copied as the circular references of the copy,
+// and the pointers referring to the same value still refer to the same copied value.
+// Like Copy, it is unable to copy unexported fields in a struct.
+func DeepCopy[T any](src T) T {
+	if dst, ok := deepcopy.Copy(src).(T); ok {
+		return dst
+	}
+	return src
+}
@@ -8,6 +8,7 @@ package gutil
 
 import (
 	"reflect"
+	"strings"
 
 	"github.com/gogf/gf/v2/internal/utils"
 )
@@ -113,3 +114,77 @@ func MapToSlice(data any) []any {
 	}
 	return nil
 }
+
+// DefaultRedactKeys is the default key words of secret values for MapRedact.
+var DefaultRedactKeys = []string{
+	"password", "passwd", "pwd", "pass", "secret", "token", "credential",
+	"apikey", "accesskey", "privatekey", "link", "dsn",
+}
+
+// redactSubstringMinLength is the minimum length of the key words matched as substring of keys,
+// the shorter ones are matched as suffix to avoid false positives, like "pass" for "passport".
+const redactSubstringMinLength = 5
+
+// MapRedact returns a deep copy of map `data` in which the values of secret keys are replaced
+// with "******". A key is secret if it contains any of `keys` ignoring cases and symbols,
+// and it uses DefaultRedactKeys if `keys` is not given. The nested maps and slices are also redacted.
+// Note that the key words shorter than 5 characters are matched as the suffix of the key,
+// eg: "pass" matches "sentinel_pass" but not "passport".
+//
+// It is usually used for dumping configuration without secrets, eg:
+// {"user": "root", "pass_word": "123"} => {"user": "root", "pass_word": "******"}
+func MapRedact(data map[string]any, keys ...string) map[string]any {
+	if data == nil {
+		return nil
+	}
+	if len(keys) == 0 {
+		keys = DefaultRedactKeys
+	}
+	redactKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key = strings.ToLower(utils.RemoveSymbols(key)); key != "" {
+			redactKeys = append(redactKeys, key)
+		}
+	}
+	return doMapRedact(data, redactKeys)
+}
+
+func doMapRedact(data map[string]any, keys []string) map[string]any {
+	redacted := make(map[string]any, len(data))
+	for k, v := range data {
+		if isRedactKey(k, keys) {
+			redacted[k] = "******"
+			continue
+		}
+		redacted[k] = doRedactValue(v, keys)
+	}
+	return redacted
+}
+
+func doRedactValue(value any, keys []string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		return doMapRedact(v, keys)
+	case []any:
+		array := make([]any, len(v))
+		for i, item := range v {
+			array[i] = doRedactValue(item, keys)
+		}
+		return array
+	default:
+		return value
+	}
+}
+
+func isRedactKey(key string, keys []string) bool {
+	key = strings.ToLower(utils.RemoveSymbols(key))
+	for _, k := range keys {
+		if strings.HasSuffix(key, k) {
+			return true
+		}
+		if len(k) >= redactSubstringMinLength && strings.Contains(key, k) {
+			return true
+		}
+	}
+	return false
+}
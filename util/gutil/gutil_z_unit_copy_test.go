@@ -41,3 +41,45 @@ func Test_Copy(t *testing.T) {
 		})
 	})
 }
+
+func Test_DeepCopy(t *testing.T) {
+	type Node struct {
+		Name     string
+		Tags     []string
+		Parent   *Node
+		Children []*Node
+	}
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(gutil.DeepCopy(1), 1)
+		t.Assert(gutil.DeepCopy("a"), "a")
+		t.Assert(gutil.DeepCopy[any](nil), nil)
+		t.Assert(gutil.DeepCopy[*Node](nil), nil)
+	})
+	gtest.C(t, func(t *gtest.T) {
+		root := &Node{Name: "root", Tags: []string{"a"}}
+		child := &Node{Name: "child", Parent: root}
+		root.Children = []*Node{child, child}
+
+		dst := gutil.DeepCopy(root)
+		t.Assert(dst == root, false)
+		t.Assert(dst.Name, "root")
+		t.Assert(dst.Children[0].Name, "child")
+		// Circular references.
+		t.Assert(dst.Children[0].Parent == dst, true)
+		t.Assert(dst.Children[0] == dst.Children[1], true)
+		t.Assert(dst.Children[0] == child, false)
+
+		dst.Tags[0] = "b"
+		dst.Children[0].Name = "copy"
+		t.Assert(root.Tags, []string{"a"})
+		t.Assert(child.Name, "child")
+	})
+	gtest.C(t, func(t *gtest.T) {
+		src := g.Map{"k": "v"}
+		src["self"] = src
+		dst := gutil.DeepCopy(src)
+		dst["k"] = "copy"
+		t.Assert(src["k"], "v")
+		t.Assert(dst["self"].(g.Map)["k"], "copy")
+	})
+}
@@ -194,3 +194,50 @@ func Test_MapToSlice(t *testing.T) {
 		t.Assert(s, nil)
 	})
 }
+
+func Test_MapRedact(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		m := g.Map{
+			"user":      "root",
+			"Pass_Word": "123",
+			"database": g.Map{
+				"link":  "mysql:root:123@tcp(127.0.0.1:3306)/test",
+				"debug": true,
+			},
+			"clients": g.Slice{
+				g.Map{"name": "c1", "apiKey": "key"},
+			},
+		}
+		r := gutil.MapRedact(m)
+		t.Assert(r, g.Map{
+			"user":      "root",
+			"Pass_Word": "******",
+			"database": g.Map{
+				"link":  "******",
+				"debug": true,
+			},
+			"clients": g.Slice{
+				g.Map{"name": "c1", "apiKey": "******"},
+			},
+		})
+		// The original map is not changed.
+		t.Assert(m["Pass_Word"], "123")
+		t.Assert(gutil.MapRedact(m, "user")["user"], "******")
+		t.Assert(gutil.MapRedact(nil), nil)
+	})
+	// Short key words are matched as suffix.
+	gtest.C(t, func(t *gtest.T) {
+		r := gutil.MapRedact(g.Map{
+			"pass":          "123",
+			"sentinel_pass": "123",
+			"passport":      "john",
+			"dbPwd":         "123",
+		})
+		t.Assert(r, g.Map{
+			"pass":          "******",
+			"sentinel_pass": "******",
+			"passport":      "john",
+			"dbPwd":         "******",
+		})
+	})
+}
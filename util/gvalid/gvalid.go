@@ -103,6 +103,18 @@ func ParseTagValue(tag string) (field, rule, msg string) {
 	return
 }
 
+// ContainsRule checks whether the rule string `rules` like "required|length:6,16" contains the rule
+// named `name`, eg: ContainsRule("required|length:6,16", "required") returns true.
+func ContainsRule(rules, name string) bool {
+	for _, item := range strings.Split(rules, "|") {
+		ruleName, _, _ := strings.Cut(strings.TrimSpace(item), ":")
+		if ruleName == name {
+			return true
+		}
+	}
+	return false
+}
+
 // GetTags returns the validation tags.
 func GetTags() []string {
 	return structTagPriority
@@ -48,3 +48,13 @@ func Test_GetTags(t *testing.T) {
 		t.Assert(structTagPriority, GetTags())
 	})
 }
+
+func Test_ContainsRule(t *testing.T) {
+	gtest.C(t, func(t *gtest.T) {
+		t.Assert(ContainsRule("required|length:6,16", "required"), true)
+		t.Assert(ContainsRule(" length:6,16 | required ", "required"), true)
+		t.Assert(ContainsRule("required-if:id,1|length:6,16", "required"), false)
+		t.Assert(ContainsRule("length:6,16", "length"), true)
+		t.Assert(ContainsRule("", "required"), false)
+	})
+}